@@ -34,6 +34,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // Element represents the elements with a XML schema complex type.
@@ -147,7 +148,13 @@ func remove(r *regexp.Regexp, s string) string {
 // toFieldName returns a valid go field name derived from the long description
 // for a HL7 field.
 func toFieldName(longName string) string {
-	return strings.Replace(strings.Title(nonWordCharacters.ReplaceAllString(remove(trailingType, removeHL7Prefix(longName)), " ")), " ", "", -1)
+	name := strings.Replace(strings.Title(nonWordCharacters.ReplaceAllString(remove(trailingType, removeHL7Prefix(longName)), " ")), " ", "", -1)
+	if name != "" && unicode.IsDigit(rune(name[0])) {
+		// Some long names are formats, eg "[(999)] 999-9999 [X99999][C Any Text]" for XTN.1 in HL7 2.3,
+		// and Go identifiers can't start with a digit.
+		name = "Field" + name
+	}
+	return name
 }
 
 func toFieldNameWithoutUnderscore(longName string) string {
@@ -206,8 +213,9 @@ func deduplicate(names []string) []string {
 }
 
 func tag(name string, required bool) string {
-	// We remove any quotes from the name, or it will produce a malformed tag.
-	return fmt.Sprintf("hl7:\"%t,%s\"", required, strings.Replace(name, "\"", "", -1))
+	// We remove any quotes and line breaks from the name, or it will produce a malformed tag.
+	name = strings.Join(strings.Fields(strings.Replace(name, "\"", "", -1)), " ")
+	return fmt.Sprintf("hl7:\"%t,%s\"", required, name)
 }
 
 // outputCompositeType writes a Go struct to p that represents the HL7 complex
@@ -426,7 +434,7 @@ func outputParseSegmentFunctions(p *Printer, spec *Specification) {
 		p.P("func (m *Message) %s() (*%s, error) {", name, name)
 		{
 			p.In()
-			p.P("ps, err := m.Parse(%q)", name)
+			p.P("ps, err := m.ParseWithTypes(Types, %q)", name)
 			p.P("pst, ok := ps.(*%s)", name)
 			p.P("if ok {")
 			{
@@ -451,7 +459,7 @@ func outputParseAllSegmentsFunctions(p *Printer, spec *Specification) {
 		p.P("func (m *Message) All%s() ([]*%s, error) {", name, name)
 		{
 			p.In()
-			p.P("pss, err := m.ParseAll(%q)", name)
+			p.P("pss, err := m.ParseAllWithTypes(Types, %q)", name)
 			p.P("return pss.([]*%s), err", name)
 			p.Out()
 		}
@@ -1151,6 +1159,7 @@ func main() {
 	schemaDirectory := flag.String("schema", "", "Directory containing the HL7 schema.")
 	extraDirectory := flag.String("extra_dir", "", "Directory containing synthetic message XSD files.")
 	maxVersion := flag.Int("max_hl7_version", 251, "The maximum HL7v2 version to generate schemas from, as a three digit integer: 240 for 2.4, 251 for 2.5.1, etc.")
+	packageName := flag.String("package", "hl7", "The name of the package of the generated code. The schemas of the versions other than 2.5.1 are generated in their own packages, eg v231 in pkg/hl7/schemas/231, which refer to the primitive types in package hl7")
 	var inputBlockListed sliceFlags
 	flag.Var(&inputBlockListed, "block_list", "Segments/messages/types to skip when parsing from xsd.  This flag can be specified multiple times.  i.e. --block_list=PPX --block_list=ORU")
 	flag.Parse()
@@ -1161,7 +1170,7 @@ func main() {
 	p := NewPrinter(os.Stdout)
 
 	log.Println("Generating code...")
-	outputHeader(s, p, *maxVersion)
+	outputHeader(s, p, *maxVersion, *packageName)
 	outputSpecification(s, p, blockListed)
 }
 
@@ -1297,11 +1306,11 @@ func amendElements(c *ComplexType) {
 	c.Elements = finalElements
 }
 
-func outputHeader(s *Specification, p *Printer, maxVersion int) {
+func outputHeader(s *Specification, p *Printer, maxVersion int, packageName string) {
 	p.P("// This file contains the schemas for HL7 messages, segments and values for HL7v2 version %s.", toHl7VersionName(maxVersion))
 	p.P("// It has been auto-generated from the HL7v2 specification.")
 	p.P("")
-	p.P("package hl7")
+	p.P("package %s", packageName)
 	p.P("")
 	p.P("import \"reflect\"")
 
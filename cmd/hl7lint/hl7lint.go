@@ -0,0 +1,147 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary hl7lint validates the HL7 messages in the files given as arguments against the structure
+// of their message types, and prints the issues found. The files can contain several messages, eg
+// the files written by Simulated Hospital with -output=file or -output=batch, or by the receiver.
+// The exit status is 1 if any message has errors.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/conformance"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas"
+	"github.com/bitcrshr/simhospital/pkg/hl7/validate"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logging.ForCallerPackage()
+
+	configFile   = flag.String("config_file", "", "Path to a YAML file with the maximum lengths and the tables of values of fields; if empty, only the message structures are checked")
+	profileFile  = flag.String("profile_file", "", "Path to a YAML file with a conformance profile that the messages are also checked against")
+	version      = flag.String("version", hl7.DefaultVersion, "Version of the HL7 specification used to validate messages whose MSH-12 is missing or has an unknown version")
+	forceVersion = flag.Bool("force_version", false, "Whether to validate all messages against -version, regardless of MSH-12")
+	warnings     = flag.Bool("warnings", true, "Whether to print the issues with severity WARNING")
+	logLevel     = flag.String("log_level", "INFO", "The logging granularity. One of PANIC, FATAL, ERROR, WARN, INFO, DEBUG. Not case sensitive")
+	hl7Timezone  = flag.String("hl7_timezone", "UTC", "The location for the timezone of the timestamps in the messages. The specified location must be installed on the operating system")
+)
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	if err := logging.SetLogLevelFromString(*logLevel); err != nil {
+		logrus.WithError(err).
+			WithField("log_level", *logLevel).
+			Fatal("Cannot configure hl7lint logger")
+	}
+	if err := hl7.TimezoneAndLocation(*hl7Timezone); err != nil {
+		logrus.WithError(err).
+			WithField("hl7_timezone", *hl7Timezone).
+			Fatal("Cannot configure HL7 timezone and location")
+	}
+	// Validate the messages against the types of the version in their MSH-12.
+	schemas.Register()
+	if flag.NArg() == 0 {
+		log.Fatal("Usage: hl7lint [flags] file...")
+	}
+
+	var config *validate.Config
+	if *configFile != "" {
+		var err error
+		if config, err = validate.LoadConfig(ctx, *configFile); err != nil {
+			log.WithError(err).WithField("config_file", *configFile).Fatal("Cannot load validation config")
+		}
+	}
+	validator, err := validate.NewValidator(config)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot create validator")
+	}
+	var profile *conformance.Profile
+	if *profileFile != "" {
+		if profile, err = conformance.LoadProfile(ctx, *profileFile); err != nil {
+			log.WithError(err).WithField("profile_file", *profileFile).Fatal("Cannot load conformance profile")
+		}
+	}
+
+	options := hl7.NewParseMessageOptions()
+	options.Version = *version
+	options.ForceVersion = *forceVersion
+
+	var total, invalid int
+	for _, f := range flag.Args() {
+		n, bad, err := lint(ctx, validator, profile, options, f)
+		if err != nil {
+			log.WithError(err).WithField("file", f).Error("Cannot validate file")
+			invalid++
+			continue
+		}
+		total += n
+		invalid += bad
+	}
+	log.Infof("Validated %d messages, %d with errors", total, invalid)
+	if invalid > 0 {
+		os.Exit(1)
+	}
+}
+
+// lint validates the messages in the given file, and checks them against the profile if it is not
+// nil, prints their issues, and returns the number of messages in the file and how many of them
+// have errors.
+func lint(ctx context.Context, validator *validate.Validator, profile *conformance.Profile, options *hl7.ParseMessageOptions, filename string) (int, int, error) {
+	b, err := files.Read(ctx, filename)
+	if err != nil {
+		return 0, 0, err
+	}
+	messages, err := hl7.ReadBatchFile(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	invalid := 0
+	for i, input := range messages {
+		prefix := fmt.Sprintf("%s: message %d", filename, i+1)
+		m, err := hl7.ParseMessageWithOptions(input, options)
+		if err != nil {
+			fmt.Printf("%s: ERROR cannot parse message: %v\n", prefix, err)
+			invalid++
+			continue
+		}
+		if msh, err := m.MSH(); err == nil && msh.MessageControlID != nil {
+			prefix = fmt.Sprintf("%s (%s)", prefix, msh.MessageControlID.String())
+		}
+		report := validator.Validate(m)
+		if profile != nil {
+			// The issues with the profile are printed after the issues with the structure.
+			report.Issues = append(report.Issues, profile.Check(m)...)
+		}
+		if !report.Valid() {
+			invalid++
+		}
+		for _, issue := range report.Issues {
+			if issue.Severity == validate.Warning && !*warnings {
+				continue
+			}
+			fmt.Printf("%s: %v\n", prefix, issue)
+		}
+	}
+	return len(messages), invalid, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary receiver listens for HL7 messages sent over MLLP and acknowledges them.
+// It can be used as a local stand-in for the system that Simulated Hospital sends messages to.
+package main
+
+import (
+	"context"
+	"flag"
+	"math/rand"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/sample"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logging.ForCallerPackage()
+
+	// Flags that control how messages are received.
+	listenAddress = flag.String("listen_address", ":6661", "Address on which to listen for MLLP connections")
+	output        = flag.String("output", "stdout", "Where the received HL7 messages will be written: [stdout, file]")
+	outputFile    = flag.String("output_file", "received.out", "File path to write received messages if -output=file")
+
+	// Flags that control how messages are acknowledged.
+	acceptWeight = flag.Uint("accept_weight", 1, "Relative frequency of messages that are acknowledged with AA")
+	errorWeight  = flag.Uint("error_weight", 0, "Relative frequency of messages that are acknowledged with AE")
+	rejectWeight = flag.Uint("reject_weight", 0, "Relative frequency of messages that are acknowledged with AR. Messages that cannot be parsed are always acknowledged with AR")
+	minLatency   = flag.Duration("min_latency", 0, "Minimum time to wait before acknowledging each message")
+	maxLatency   = flag.Duration("max_latency", 0, "Maximum time to wait before acknowledging each message. If smaller than -min_latency, -min_latency is used")
+
+	// Flags that control logging.
+	logLevel    = flag.String("log_level", "INFO", "The logging granularity. One of PANIC, FATAL, ERROR, WARN, INFO, DEBUG. Not case sensitive")
+	hl7Timezone = flag.String("hl7_timezone", "UTC", "The location for the timezone for dates in the acknowledgments. The specified location must be installed on the operating system")
+)
+
+func main() {
+	flag.Parse()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onShutdown(cancel)
+
+	if err := logging.SetLogLevelFromString(*logLevel); err != nil {
+		logrus.WithError(err).
+			WithField("log_level", *logLevel).
+			Fatal("Cannot configure MLLP receiver logger")
+	}
+	if err := hl7.TimezoneAndLocation(*hl7Timezone); err != nil {
+		logrus.WithError(err).
+			WithField("hl7_timezone", *hl7Timezone).
+			Fatal("Cannot configure HL7 timezone and location")
+	}
+
+	rand.Seed(time.Now().Unix())
+
+	options, err := receiverOptions()
+	if err != nil {
+		log.WithError(err).Fatal("Cannot create MLLP receiver options")
+	}
+	r, err := hl7.NewMLLPReceiver(*listenAddress, options)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot create MLLP receiver")
+	}
+	log.WithField("listen_address", r.Addr().String()).Info("Starting MLLP receiver")
+	if err := r.Serve(ctx); err != nil {
+		log.WithError(err).Error("MLLP receiver stopped")
+	}
+	if err := r.Close(); err != nil {
+		log.WithError(err).Error("Error when closing MLLP receiver")
+	}
+}
+
+func receiverOptions() (*hl7.MLLPReceiverOptions, error) {
+	options := hl7.NewMLLPReceiverOptions()
+	switch *output {
+	case "stdout":
+	case "file":
+		s, err := hl7.NewFileSender(*outputFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create file sender")
+		}
+		options.Output = s
+	default:
+		return nil, errors.Errorf("unknown output type: %s", *output)
+	}
+
+	options.AckCodes = sample.DiscreteDistribution{WeightedValues: []sample.WeightedValue{
+		{Value: hl7.AckApplicationAccept, Frequency: *acceptWeight},
+		{Value: hl7.AckApplicationError, Frequency: *errorWeight},
+		{Value: hl7.AckApplicationReject, Frequency: *rejectWeight},
+	}}
+	options.MinLatency = *minLatency
+	options.MaxLatency = *maxLatency
+	if options.MaxLatency < options.MinLatency {
+		options.MaxLatency = options.MinLatency
+	}
+	return options, nil
+}
+
+// onShutdown handles interrupt signals: SIGINT and SIGTERM,
+// and performs a graceful shutdown by calling a cancel function.
+func onShutdown(cancel context.CancelFunc) {
+	go func() {
+		s := make(chan os.Signal, 1)
+		signal.Notify(s, syscall.SIGINT, syscall.SIGTERM)
+		<-s
+		log.Info("Shutting down gracefully")
+		cancel()
+	}()
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary replay sends the HL7 messages recorded in a file again, reproducing the original gaps
+// between them. The file can be written by Simulated Hospital with -output=file or -output=batch.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hospital"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logging.ForCallerPackage()
+
+	// Flags that control which messages are replayed and how.
+	inputFile        = flag.String("input_file", "", "Path to the file with the messages to replay, written with -output=file or -output=batch. This file can be a local file or a GCS object")
+	speed            = flag.Float64("speed", 1, "How many times faster than originally the messages are sent, according to their MSH-7 timestamps, eg: 60 sends messages that were one minute apart one second apart. 0 sends messages as fast as possible")
+	maxGap           = flag.Duration("max_gap", 0, "Maximum time to wait between two messages; 0 means no maximum")
+	rewriteDateTime  = flag.Bool("rewrite_date_time", false, "Whether to set MSH-7 to the time each message is sent")
+	rewriteControlID = flag.Bool("rewrite_control_id", false, "Whether to set MSH-10 to a new message control ID")
+
+	// Flags that control where messages are sent.
+	output            = flag.String("output", "stdout", "Where the HL7 messages will be sent: [stdout, mllp, file, batch, http, routing]")
+	outputFile        = flag.String("output_file", "messages.out", "File path to write messages if -output=file")
+	batchFilePattern  = flag.String("batch_file_pattern", hl7.DefaultBatchFilenamePattern, "Pattern for the paths of the HL7 batch files if -output=batch")
+	routingConfigFile = flag.String("routing_config_file", "", "Path to a YAML file with the destinations and the routes that decide which destination each message is sent to, if -output=routing")
+	mllpDestination   = flag.String("mllp_destination", "", "Host:Port to which MLLP messages will be sent; only relevant if -output=mllp")
+	mllpTLS           = flag.Bool("mllp_tls", false, "Whether to send MLLP messages over TLS; only relevant if -output=mllp")
+	mllpTLSCAFile     = flag.String("mllp_tls_ca_file", "", "Path to a PEM file with the certificate authorities used to verify the server's certificate; only relevant if -mllp_tls=true")
+	mllpTLSCertFile   = flag.String("mllp_tls_cert_file", "", "Path to a PEM file with the client certificate for mutual TLS; only relevant if -mllp_tls=true")
+	mllpTLSKeyFile    = flag.String("mllp_tls_key_file", "", "Path to a PEM file with the private key of the client certificate for mutual TLS; only relevant if -mllp_tls=true")
+	mllpTLSServerName = flag.String("mllp_tls_server_name", "", "Name used to verify the server's certificate. If empty, the host in -mllp_destination is used; only relevant if -mllp_tls=true")
+	mllpTLSMinVersion = flag.String("mllp_tls_min_version", "1.2", "Minimum TLS version accepted: [1.0, 1.1, 1.2, 1.3]; only relevant if -mllp_tls=true")
+	httpURL           = flag.String("http_url", "", "URL to which HL7 messages will be posted; only relevant if -output=http")
+	httpHeaders       = flag.String("http_headers", "", "Comma-separated list of Name=Value pairs with additional headers to send with every request; only relevant if -output=http")
+	httpBearerToken   = flag.String("http_bearer_token", "", "Token to send in the Authorization header of every request; only relevant if -output=http")
+	mllpAckActions    = flag.String("mllp_ack_actions", "", "Comma-separated list of CODE=action pairs that override what to do when a message is acknowledged with each code; only relevant if -output=mllp")
+
+	// Flags that control logging.
+	logLevel    = flag.String("log_level", "INFO", "The logging granularity. One of PANIC, FATAL, ERROR, WARN, INFO, DEBUG. Not case sensitive")
+	hl7Timezone = flag.String("hl7_timezone", "UTC", "The location for the timezone of the MSH-7 timestamps, and of the rewritten ones. The specified location must be installed on the operating system")
+)
+
+func main() {
+	flag.Parse()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onShutdown(cancel)
+
+	if err := logging.SetLogLevelFromString(*logLevel); err != nil {
+		logrus.WithError(err).
+			WithField("log_level", *logLevel).
+			Fatal("Cannot configure replay logger")
+	}
+	if err := hl7.TimezoneAndLocation(*hl7Timezone); err != nil {
+		logrus.WithError(err).
+			WithField("hl7_timezone", *hl7Timezone).
+			Fatal("Cannot configure HL7 timezone and location")
+	}
+	if *inputFile == "" {
+		log.Fatal("-input_file must be set")
+	}
+
+	b, err := files.Read(ctx, *inputFile)
+	if err != nil {
+		log.WithError(err).WithField("input_file", *inputFile).Fatal("Cannot read input file")
+	}
+	messages, err := hl7.ReadBatchFile(b)
+	if err != nil {
+		log.WithError(err).WithField("input_file", *inputFile).Fatal("Cannot read messages from input file")
+	}
+
+	sender, err := hospital.NewSender(ctx, senderArguments())
+	if err != nil {
+		log.WithError(err).Fatal("Cannot create sender")
+	}
+	options := &hl7.ReplayOptions{
+		Speed:            *speed,
+		MaxGap:           *maxGap,
+		RewriteDateTime:  *rewriteDateTime,
+		RewriteControlID: *rewriteControlID,
+	}
+	log.WithField("input_file", *inputFile).Infof("Replaying %d messages", len(messages))
+	start := time.Now()
+	n, err := hl7.Replay(ctx, sender, messages, options)
+	if err != nil {
+		log.WithError(err).Errorf("Replay stopped after %d of %d messages", n, len(messages))
+	} else {
+		log.Infof("Replayed %d messages in %v", n, time.Since(start))
+	}
+	if err := sender.Close(); err != nil {
+		log.WithError(err).Error("Error when closing sender")
+	}
+}
+
+func senderArguments() hospital.SenderArguments {
+	var tlsOptions *hl7.TLSOptions
+	if *mllpTLS {
+		tlsOptions = &hl7.TLSOptions{
+			CAFile:     *mllpTLSCAFile,
+			CertFile:   *mllpTLSCertFile,
+			KeyFile:    *mllpTLSKeyFile,
+			ServerName: *mllpTLSServerName,
+			MinVersion: *mllpTLSMinVersion,
+		}
+	}
+	return hospital.SenderArguments{
+		Output:            *output,
+		OutputFile:        *outputFile,
+		BatchFilePattern:  *batchFilePattern,
+		RoutingConfigFile: *routingConfigFile,
+		MllpDestination:   *mllpDestination,
+		MllpTLS:           tlsOptions,
+		MllpAckActions:    *mllpAckActions,
+		HTTPURL:           *httpURL,
+		HTTPHeaders:       *httpHeaders,
+		HTTPBearerToken:   *httpBearerToken,
+	}
+}
+
+// onShutdown handles interrupt signals: SIGINT and SIGTERM,
+// and performs a graceful shutdown by calling a cancel function.
+func onShutdown(cancel context.CancelFunc) {
+	go func() {
+		s := make(chan os.Signal, 1)
+		signal.Notify(s, syscall.SIGINT, syscall.SIGTERM)
+		<-s
+		log.Info("Shutting down gracefully")
+		cancel()
+	}()
+}
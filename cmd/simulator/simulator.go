@@ -28,6 +28,7 @@ import (
 
 	"github.com/bitcrshr/simhospital/pkg/config"
 	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas"
 	"github.com/bitcrshr/simhospital/pkg/hospital"
 	"github.com/bitcrshr/simhospital/pkg/hospital/runner"
 	"github.com/bitcrshr/simhospital/pkg/logging"
@@ -59,6 +60,11 @@ var (
 	patientClassFile       = flag.String("patient_class_file", "configs/hl7_messages/patient_class.csv", "Path to a CSV file with the patient classes and types and how often they occur. This file can be a local file or a GCS object.")
 	doctorsFile            = flag.String("doctors_file", "configs/hl7_messages/doctors.yml", "Path to a YAML file with the doctors. This file can be a local file or a GCS object.")
 	orderProfilesFile      = flag.String("order_profile_file", "configs/hl7_messages/order_profiles.yml", "Path to a YAML file with the definition of the order profiles. This file can be a local file or a GCS object.")
+	medicationsFile        = flag.String("medications_file", "configs/hl7_messages/medications.yml", "Path to a YAML file with the medications that can be prescribed. This file can be a local file or a GCS object.")
+	vaccinesFile           = flag.String("vaccines_file", "configs/hl7_messages/vaccines.yml", "Path to a YAML file with the vaccines that can be administered. This file can be a local file or a GCS object.")
+	chargemasterFile       = flag.String("chargemaster_file", "configs/hl7_messages/chargemaster.yml", "Path to a YAML file with the items that can be charged to patient accounts and their prices. This file can be a local file or a GCS object.")
+	insuranceFile          = flag.String("insurance_file", "configs/hl7_messages/insurance.yml", "Path to a YAML file with the insurance plans that cover patients and the guarantors of patients. This file can be a local file or a GCS object.")
+	microbiologyFile       = flag.String("microbiology_file", "configs/hl7_messages/microbiology.yml", "Path to a YAML file with the organisms identified by microbiology cultures and the antibiotics that they are tested against. This file can be a local file or a GCS object.")
 
 	// Flags that control resource generation.
 	resourceOutput    = flag.String("resource_output", "stdout", "Where the generated resources will be written: [stdout, file, cloud]")
@@ -82,11 +88,58 @@ var (
 
 	// Flags for sending HL7 messages.
 	hl7Timezone           = flag.String("hl7_timezone", "UTC", "The location for the timezone for dates in the generated HL7 messages. The specified location must be installed on the operating system")
-	output                = flag.String("output", "stdout", "Where the generated HL7 messages will be sent: [stdout, mllp, file]")
+	output                = flag.String("output", "stdout", "Where the generated HL7 messages will be sent: [stdout, mllp, file, batch, http, routing]")
 	mllpDestination       = flag.String("mllp_destination", "", "Host:Port to which MLLP messages will be sent; only relevant if -output=mllp")
 	mllpKeepAlive         = flag.Bool("mllp_keep_alive", false, "Whether to send keep-alive messages on the MLLP connection; only relevant if -output=mllp")
 	mllpKeepAliveInterval = flag.Duration("mllp_keep_alive_interval", time.Minute, "Interval between keep-alive messages; only relevant if -output=mllp and -mllp_keep_alive=true")
+	outputFormat          = flag.String("output_format", "er7", "Encoding of the generated HL7 messages: [er7, xml]. er7 is the encoding with delimiters, eg |, and xml is the HL7v2 XML encoding (v2.xml), which cannot be used with -output=batch")
 	outputFile            = flag.String("output_file", "messages.out", "File path to write messages if -output=file")
+	routingConfigFile     = flag.String("routing_config_file", "", "Path to a YAML file with the destinations and the routes that decide which destination each message is sent to, if -output=routing. "+
+		"This file can be a local file or a GCS object")
+
+	// Flags for writing HL7 batch files.
+	batchFilePattern = flag.String("batch_file_pattern", hl7.DefaultBatchFilenamePattern, "Pattern for the paths of the HL7 batch files if -output=batch. "+
+		"{time} is replaced with the time the file is created, and {seq}, which is required, with the number of the file")
+	batchMaxMessages = flag.Int("batch_max_messages", 1000, "Maximum number of messages in a batch file; 0 means no maximum. Only relevant if -output=batch")
+	batchMaxBytes    = flag.Int64("batch_max_bytes", 0, "Size in bytes after which a new batch file is started; 0 means no maximum. Only relevant if -output=batch")
+	batchMaxDuration = flag.Duration("batch_max_duration", 0, "How long messages are added to a batch file before a new one is started; 0 means no maximum. Only relevant if -output=batch")
+
+	// Flags for sending HL7 messages over HTTP.
+	httpURL          = flag.String("http_url", "", "URL to which HL7 messages will be posted; only relevant if -output=http")
+	httpHeaders      = flag.String("http_headers", "", "Comma-separated list of Name=Value pairs with additional headers to send with every request; only relevant if -output=http")
+	httpBearerToken  = flag.String("http_bearer_token", "", "Token to send in the Authorization header of every request; only relevant if -output=http")
+	httpUsername     = flag.String("http_username", "", "Username for basic authentication. Ignored if -http_bearer_token is set; only relevant if -output=http")
+	httpPassword     = flag.String("http_password", "", "Password for basic authentication; only relevant if -output=http and -http_username is set")
+	httpTimeout      = flag.Duration("http_timeout", 30*time.Second, "Maximum duration of each request; 0 means no timeout. Only relevant if -output=http")
+	httpMaxRetries   = flag.Int("http_max_retries", 3, "Maximum number of times a message is re-sent if a request fails with a network error, a 5xx or 429 status code, or an AE or CE acknowledgment; only relevant if -output=http")
+	httpRetryBackoff = flag.Duration("http_retry_backoff", time.Second, "Time to wait before re-sending a message the first time; it doubles with every retry. Only relevant if -output=http")
+
+	// Flags for journaling the messages that are sent.
+	journalEnabled = flag.Bool("journal", false, "Whether to record every message in a journal before sending it, and keep the messages that cannot be sent in a dead-letter store. "+
+		"Dead-lettered messages can be listed, replayed and discarded from the deadLetters dashboard endpoint")
+	journalDir             = flag.String("journal_dir", "", "Directory where the journal and the dead-letter store are kept, so that they survive restarts. If empty, they are kept in memory; only relevant if -journal=true")
+	journalRetainDelivered = flag.Bool("journal_retain_delivered", false, "Whether to keep messages in the journal after they are delivered; only relevant if -journal=true")
+
+	// Flags for checking the messages against a conformance profile.
+	conformanceProfileFile = flag.String("conformance_profile_file", "", "Path to a YAML file with a conformance profile that every message is checked against before it is sent, "+
+		"eg configs/hl7_validation/conformance_profile.yml. If empty, messages are not checked")
+	conformanceFail = flag.Bool("conformance_fail", false, "Whether the messages that don't conform to the profile are not sent. If false, the issues are only logged; only relevant if -conformance_profile_file is set")
+
+	// Flags for sending MLLP messages over TLS.
+	mllpTLS           = flag.Bool("mllp_tls", false, "Whether to send MLLP messages over TLS; only relevant if -output=mllp")
+	mllpTLSCAFile     = flag.String("mllp_tls_ca_file", "", "Path to a PEM file with the certificate authorities used to verify the server's certificate. If empty, the system's certificate authorities are used; only relevant if -mllp_tls=true")
+	mllpTLSCertFile   = flag.String("mllp_tls_cert_file", "", "Path to a PEM file with the client certificate for mutual TLS; only relevant if -mllp_tls=true")
+	mllpTLSKeyFile    = flag.String("mllp_tls_key_file", "", "Path to a PEM file with the private key of the client certificate for mutual TLS; only relevant if -mllp_tls=true")
+	mllpTLSServerName = flag.String("mllp_tls_server_name", "", "Name used to verify the server's certificate. If empty, the host in -mllp_destination is used; only relevant if -mllp_tls=true")
+	mllpTLSMinVersion = flag.String("mllp_tls_min_version", "1.2", "Minimum TLS version accepted: [1.0, 1.1, 1.2, 1.3]; only relevant if -mllp_tls=true")
+
+	// Flags that control how acknowledgments to MLLP messages are handled.
+	mllpAckActions = flag.String("mllp_ack_actions", "", "Comma-separated list of CODE=action pairs that override what to do when a message is acknowledged with each code, eg: AE=retry,AR=dead_letter. "+
+		"Supported actions: [accept, retry, skip, halt, dead_letter]. By default, AA and CA are accepted, and AE, CE, AR and CR are logged and the sender carries on with the next message; only relevant if -output=mllp")
+	mllpMaxRetries     = flag.Int("mllp_max_retries", 3, "Maximum number of times a message is re-sent if the action for its acknowledgment code is retry; only relevant if -output=mllp")
+	mllpRetryBackoff   = flag.Duration("mllp_retry_backoff", time.Second, "Time to wait before re-sending a message the first time; it doubles with every retry. Only relevant if -output=mllp")
+	mllpDeadLetterFile = flag.String("mllp_dead_letter_file", "", "File path to write messages that are dead-lettered. Required if any action in -mllp_ack_actions is dead_letter. "+
+		"If set, messages that are not accepted after all retries are also dead-lettered; only relevant if -output=mllp")
 
 	// Flags that control how pathways run.
 	pathwaysDir        = flag.String("pathways_dir", "configs/pathways", "Path to a directory with YAML files with definitions of pathways. This directory can be on the local file system or GCS.")
@@ -161,6 +214,33 @@ func createRunner(ctx context.Context) (*runner.Hospital, error) {
 		include = strings.Split(*pathwayNames, ",")
 	}
 	exclude := strings.Split(*excludePathwayNames, ",")
+	var tlsOptions *hl7.TLSOptions
+	if *mllpTLS {
+		tlsOptions = &hl7.TLSOptions{
+			CAFile:     *mllpTLSCAFile,
+			CertFile:   *mllpTLSCertFile,
+			KeyFile:    *mllpTLSKeyFile,
+			ServerName: *mllpTLSServerName,
+			MinVersion: *mllpTLSMinVersion,
+		}
+	}
+	var journalArguments *hospital.JournalArguments
+	if *journalEnabled {
+		journalArguments = &hospital.JournalArguments{
+			Dir:             *journalDir,
+			RetainDelivered: *journalRetainDelivered,
+		}
+	}
+	var conformanceArguments *hospital.ConformanceArguments
+	if *conformanceProfileFile != "" {
+		// Check the messages against the types of the version in their MSH-12. The schemas of the
+		// other versions are only registered here, as they change how all the messages are parsed.
+		schemas.Register()
+		conformanceArguments = &hospital.ConformanceArguments{
+			ProfileFile: *conformanceProfileFile,
+			Fail:        *conformanceFail,
+		}
+	}
 	arguments := hospital.Arguments{
 		LocationsFile:            addLocalPathIfNotSetAndNotNil(locationsFile, "locations_file"),
 		HardcodedMessagesDir:     addLocalPathIfNotSetAndNotNil(hardcodedMessagesDir, "hardcoded_messages_dir"),
@@ -168,6 +248,11 @@ func createRunner(ctx context.Context) (*runner.Hospital, error) {
 		HeaderConfigFile:         addLocalPathIfNotSetAndNotNil(headerConfigFile, "header_config_file"),
 		DoctorsFile:              addLocalPathIfNotSetAndNotNil(doctorsFile, "doctors_file"),
 		OrderProfilesFile:        addLocalPathIfNotSetAndNotNil(orderProfilesFile, "order_profile_file"),
+		MedicationsFile:          addLocalPathIfNotSetAndNotNil(medicationsFile, "medications_file"),
+		VaccinesFile:             addLocalPathIfNotSetAndNotNil(vaccinesFile, "vaccines_file"),
+		ChargemasterFile:         addLocalPathIfNotSetAndNotNil(chargemasterFile, "chargemaster_file"),
+		InsuranceFile:            addLocalPathIfNotSetAndNotNil(insuranceFile, "insurance_file"),
+		MicrobiologyFile:         addLocalPathIfNotSetAndNotNil(microbiologyFile, "microbiology_file"),
 		DeletePatientsFromMemory: *deletePatientsFromMemory,
 		PathwayArguments: &hospital.PathwayArguments{
 			Dir:          addLocalPathIfNotSet(*pathwaysDir, "pathways_dir"),
@@ -186,11 +271,32 @@ func createRunner(ctx context.Context) (*runner.Hospital, error) {
 		},
 		SenderArguments: &hospital.SenderArguments{
 			Output:                *output,
+			Format:                *outputFormat,
 			OutputFile:            *outputFile,
+			BatchFilePattern:      *batchFilePattern,
+			BatchMaxMessages:      batchMaxMessages,
+			BatchMaxBytes:         batchMaxBytes,
+			BatchMaxDuration:      batchMaxDuration,
+			RoutingConfigFile:     *routingConfigFile,
 			MllpDestination:       *mllpDestination,
 			MllpKeepAlive:         *mllpKeepAlive,
 			MllpKeepAliveInterval: mllpKeepAliveInterval,
+			MllpTLS:               tlsOptions,
+			MllpAckActions:        *mllpAckActions,
+			MllpMaxRetries:        mllpMaxRetries,
+			MllpRetryBackoff:      mllpRetryBackoff,
+			MllpDeadLetterFile:    *mllpDeadLetterFile,
+			HTTPURL:               *httpURL,
+			HTTPHeaders:           *httpHeaders,
+			HTTPBearerToken:       *httpBearerToken,
+			HTTPUsername:          *httpUsername,
+			HTTPPassword:          *httpPassword,
+			HTTPTimeout:           httpTimeout,
+			HTTPMaxRetries:        httpMaxRetries,
+			HTTPRetryBackoff:      httpRetryBackoff,
 		},
+		JournalArguments:     journalArguments,
+		ConformanceArguments: conformanceArguments,
 		DataFiles: &config.DataFiles{
 			Nouns:             addLocalPathIfNotSet(*nounsFile, "nouns_file"),
 			DataConfig:        addLocalPathIfNotSet(*dataConfigFile, "data_config_file"),
@@ -215,8 +321,15 @@ func createRunner(ctx context.Context) (*runner.Hospital, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot instantiate Hospital")
 	}
+	// Messages sent from the dashboard are journaled too.
+	sender := config.Sender
+	var endpoints []runner.EndpointAndHandler
+	if j := h.Journal(); j != nil {
+		sender = j
+		endpoints = append(endpoints, runner.EndpointAndHandler{Endpoint: "deadLetters", Handler: j.ServeHTTP})
+	}
 	return runner.New(h, runner.Config{
-		PathwayStarter:     &starter.PathwayStarter{Hospital: h, Parser: config.PathwayParser, PathwayManager: config.PathwayManager, Sender: config.Sender},
+		PathwayStarter:     &starter.PathwayStarter{Hospital: h, Parser: config.PathwayParser, PathwayManager: config.PathwayManager, Sender: sender},
 		PathwaysPerHour:    *pathwaysPerHour,
 		DashboardURI:       *dashboardURI,
 		DashboardAddress:   *dashboardAddress,
@@ -225,6 +338,8 @@ func createRunner(ctx context.Context) (*runner.Hospital, error) {
 		SleepFor:           *sleepFor,
 		Clock:              config.Clock,
 		MaxPathways:        *maxPathways,
+
+		AdditionalDashboardEndpoints: endpoints,
 	})
 }
 
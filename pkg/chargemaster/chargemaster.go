@@ -0,0 +1,159 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chargemaster is responsible for parsing the chargemaster, i.e., the catalogue of the
+// items that can be charged to patient accounts and their prices.
+package chargemaster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/pkg/errors"
+)
+
+// Types of the items that are charged automatically.
+const (
+	// BedDay items are charged for every night the patient spends in a bed.
+	// They match the point of care of the bed.
+	BedDay = "bed_day"
+	// Order items are charged for orders. They match the name of the order profile.
+	Order = "order"
+	// Procedure items are charged for procedures. They match the code of the procedure.
+	Procedure = "procedure"
+)
+
+var log = logging.ForCallerPackage()
+
+// Chargemaster contains the items that can be charged.
+type Chargemaster struct {
+	// m is a map of Items keyed by their codes.
+	m map[string]*Item
+	// matches is a map of the Items of every type keyed by the values they match.
+	matches map[string]map[string]*Item
+	// defaults is a map of the Items that are charged when no other Item of the same type matches,
+	// keyed by type.
+	defaults map[string]*Item
+}
+
+// Item is an item that can be charged.
+type Item struct {
+	// Code identifies the item. Its Text is the description of the item.
+	Code ir.CodedElement
+	// UnitAmount is the price of one unit of the item.
+	UnitAmount float64
+	// Type is the type of the item if it is charged automatically, or empty if the item can only be
+	// charged explicitly.
+	Type string
+	// Match are the values that the item matches. See the item types for what values they match.
+	// An item with a type and no values is the default item of its type.
+	Match []string
+}
+
+// New returns a new Chargemaster from an items map keyed by the item codes.
+// If several items of the same type match the same value, the one with the lowest code is used.
+func New(m map[string]*Item) *Chargemaster {
+	codes := make([]string, 0, len(m))
+	for k := range m {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+
+	c := &Chargemaster{m: m, matches: map[string]map[string]*Item{}, defaults: map[string]*Item{}}
+	for _, code := range codes {
+		item := m[code]
+		if item.Type == "" {
+			continue
+		}
+		if len(item.Match) == 0 {
+			if _, ok := c.defaults[item.Type]; !ok {
+				c.defaults[item.Type] = item
+			}
+			continue
+		}
+		if c.matches[item.Type] == nil {
+			c.matches[item.Type] = map[string]*Item{}
+		}
+		for _, v := range item.Match {
+			if _, ok := c.matches[item.Type][v]; !ok {
+				c.matches[item.Type][v] = item
+			}
+		}
+	}
+	return c
+}
+
+// Get returns the Item with the given code.
+func (c *Chargemaster) Get(code string) (*Item, bool) {
+	item, ok := c.m[code]
+	return item, ok
+}
+
+// Match returns the Item of the given type that matches the given value, or the default Item of
+// that type if there isn't one. Returns nil if there are no Items to charge.
+func (c *Chargemaster) Match(itemType string, value string) *Item {
+	if item, ok := c.matches[itemType][value]; ok {
+		return item
+	}
+	return c.defaults[itemType]
+}
+
+type item struct {
+	Description  string
+	CodingSystem string `yaml:"coding_system"`
+	Amount       float64
+	Type         string
+	Match        []string
+}
+
+// Load parses the chargemaster from the given file.
+// The coding system of the items defaults to the coding system in the HL7 configuration.
+func Load(ctx context.Context, filename string, hl7Config *config.HL7Config) (*Chargemaster, error) {
+	parsed := map[string]item{}
+	if err := config.LoadYAML(ctx, filename, "chargemaster", &parsed); err != nil {
+		return nil, err
+	}
+
+	items := map[string]*Item{}
+	log.Info("Loading chargemaster")
+	for code, v := range parsed {
+		if v.Amount < 0 {
+			return nil, errors.Errorf("item %q in %s: amount cannot be negative", code, filename)
+		}
+		switch v.Type {
+		case BedDay, Order, Procedure:
+		case "":
+			if len(v.Match) > 0 {
+				return nil, errors.Errorf("item %q in %s: match requires a type", code, filename)
+			}
+		default:
+			return nil, errors.Errorf("item %q in %s: unknown type %q", code, filename, v.Type)
+		}
+		codingSystem := v.CodingSystem
+		if codingSystem == "" {
+			codingSystem = hl7Config.CodingSystem
+		}
+		items[code] = &Item{
+			Code:       ir.CodedElement{ID: code, Text: v.Description, CodingSystem: codingSystem},
+			UnitAmount: v.Amount,
+			Type:       v.Type,
+			Match:      v.Match,
+		}
+		log.Infof(" - %s", code)
+	}
+	return New(items), nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chargemaster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+var hl7Config = &config.HL7Config{CodingSystem: "WinPath"}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	c, err := Load(ctx, test.ChargemasterConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("Load(%s, %+v) failed with %v", test.ChargemasterConfigTest, hl7Config, err)
+	}
+
+	tests := []struct {
+		code string
+		want *Item
+	}{{
+		code: "LAB-UE",
+		want: &Item{
+			Code:       ir.CodedElement{ID: "LAB-UE", Text: "Urea and electrolytes", CodingSystem: "WinPath"},
+			UnitAmount: 25.5,
+			Type:       Order,
+			Match:      []string{"UREA AND ELECTROLYTES"},
+		},
+	}, {
+		code: "PROC-1",
+		want: &Item{
+			Code:       ir.CodedElement{ID: "PROC-1", Text: "Procedure1", CodingSystem: "LOCAL"},
+			UnitAmount: 300,
+			Type:       Procedure,
+			Match:      []string{"P24.9"},
+		},
+	}, {
+		code: "SUP-DRESS",
+		want: &Item{
+			Code:       ir.CodedElement{ID: "SUP-DRESS", Text: "Dressing pack", CodingSystem: "WinPath"},
+			UnitAmount: 12.4,
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.code, func(t *testing.T) {
+			got, ok := c.Get(tc.code)
+			if !ok {
+				t.Fatalf("Get(%q) got ok=false, want true", tc.code)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Get(%q) got diff (-want, +got):\n%s", tc.code, diff)
+			}
+		})
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	test.CheckLoadFails(t, func(fName string) error {
+		_, err := Load(context.Background(), fName, hl7Config)
+		return err
+	}, []test.InvalidConfig{{
+		Name: "Negative amount",
+		Content: `
+BED:
+  amount: -1`,
+	}, {
+		Name: "Unknown type",
+		Content: `
+BED:
+  amount: 100
+  type: bed`,
+	}, {
+		Name: "Match without type",
+		Content: `
+BED:
+  amount: 100
+  match: ['ED']`,
+	}, {
+		Name: "Unknown field",
+		Content: `
+BED:
+  amount: 100
+  currency: GBP`,
+	}})
+}
+
+func TestMatch(t *testing.T) {
+	renal := &Item{Code: ir.CodedElement{ID: "BED-RENAL"}, Type: BedDay, Match: []string{"RenalWard"}}
+	general := &Item{Code: ir.CodedElement{ID: "BED-GEN"}, Type: BedDay}
+	ue := &Item{Code: ir.CodedElement{ID: "LAB-UE"}, Type: Order, Match: []string{"UREA AND ELECTROLYTES"}}
+	c := New(map[string]*Item{"BED-RENAL": renal, "BED-GEN": general, "LAB-UE": ue})
+
+	tests := []struct {
+		itemType string
+		value    string
+		want     *Item
+	}{
+		{itemType: BedDay, value: "RenalWard", want: renal},
+		{itemType: BedDay, value: "ED", want: general},
+		{itemType: Order, value: "UREA AND ELECTROLYTES", want: ue},
+		{itemType: Order, value: "LIPID", want: nil},
+		{itemType: Procedure, value: "P24.9", want: nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.itemType+"-"+tc.value, func(t *testing.T) {
+			if got := c.Match(tc.itemType, tc.value); got != tc.want {
+				t.Errorf("Match(%q, %q) got %+v, want %+v", tc.itemType, tc.value, got, tc.want)
+			}
+		})
+	}
+}
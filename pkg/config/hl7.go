@@ -30,6 +30,16 @@ type HL7Config struct {
 
 	Document HL7Document
 
+	Appointment HL7Appointment
+
+	Medication HL7Medication
+
+	Vaccination HL7Vaccination
+
+	Billing HL7Billing
+
+	Order HL7Order
+
 	Procedure HL7Procedure
 
 	OrderControl OrderControl `yaml:"order_control"`
@@ -114,6 +124,73 @@ type HL7Document struct {
 	Types []string
 }
 
+// HL7Appointment contains the values used in the SCH segment of scheduling (SIU) messages.
+type HL7Appointment struct {
+	// Reasons are the possible values for the SCH.7-Appointment Reason field.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.3.1/Tables/0276
+	Reasons []string
+	// Types are the possible values for the SCH.8-Appointment Type field.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.3.1/Tables/0277
+	Types []string
+	// FillerStatus are the values for the SCH.25-Filler Status Code field.
+	FillerStatus AppointmentFillerStatus `yaml:"filler_status"`
+}
+
+// AppointmentFillerStatus are the appointment status values to set in the SCH.25-Filler Status Code field.
+// Values: https://hl7-definition.caristix.com/v2/HL7v2.3.1/Tables/0278
+type AppointmentFillerStatus struct {
+	// Booked means that the appointment is booked, including after it has been rescheduled.
+	Booked string
+	// Cancelled means that the appointment has been cancelled.
+	Cancelled string
+	// NoShow means that the patient did not show up for the appointment.
+	NoShow string `yaml:"no_show"`
+}
+
+// HL7Medication contains the values used in pharmacy (RDE, RDS and RAS) messages.
+type HL7Medication struct {
+	// CompletionStatus is the value of the RXA.20-Completion Status field of administrations.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0322
+	CompletionStatus string `yaml:"completion_status"`
+}
+
+// HL7Vaccination contains the values used in immunization (VXU) messages.
+type HL7Vaccination struct {
+	// CompletionStatus is the value of the RXA.20-Completion Status field of vaccinations.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0322
+	CompletionStatus string `yaml:"completion_status"`
+	// FundingEligibility is the funding program eligibility category of the patient.
+	// If set, it is sent in an OBX segment after the vaccination.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0064
+	FundingEligibility string `yaml:"funding_eligibility"`
+}
+
+// HL7Billing contains the values used in financial (DFT and BAR) messages.
+type HL7Billing struct {
+	// ChargeTransactionType is the value of the FT1.6-Transaction Type field of charges.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0017
+	ChargeTransactionType string `yaml:"charge_transaction_type"`
+	// SelfRelationship is the relationship to the patient of guarantors that are the patient
+	// themselves, sent in the GT1.11-Guarantor Relationship field.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0063
+	SelfRelationship string `yaml:"self_relationship"`
+}
+
+// HL7Order contains the values used in order messages.
+type HL7Order struct {
+	// MessageStyle is the style of the messages that place and acknowledge orders: "ORM" for ORM^O01
+	// and ORR^O02 messages, or "OML" for OML^O21 and ORL^O22 messages.
+	// It can be overridden per pathway step. Defaults to "ORM".
+	MessageStyle string `yaml:"message_style"`
+	// SpecimenType is the value of the SPM.4-Specimen Type field of OML^O21 and ORL^O22 messages.
+	// If empty, no SPM segment is sent.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0487
+	SpecimenType string `yaml:"specimen_type"`
+	// Priority is the value of the TQ1.9-Priority field of OML^O21 and ORL^O22 messages.
+	// Values: https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0485
+	Priority string
+}
+
 // HL7Procedure is the configuration for PR1 segment (procedure).
 type HL7Procedure struct {
 	// Types is the possible types of procedure to be set in the PR1.6.ProcedureTypes field.
@@ -133,15 +210,30 @@ type OrderControl struct {
 	// WithObservations is the order control value for a status of "Observations/Performed Service to follow" (the results
 	// have arrived).
 	WithObservations string `yaml:"with_observations"`
+	// Discontinue is the order control value to discontinue an order.
+	Discontinue string
+	// Cancel is the order control value to cancel an order before it is processed.
+	Cancel string
+	// Hold is the order control value to put an order on hold.
+	Hold string
+	// Release is the order control value to release an order that was on hold.
+	Release string
 }
 
 // ResultStatus for the OBR.25 Result Status field.
 // Values: http://hl7-definition.caristix.com:9010/HL7%20v2.3.1/Default.aspx?version=HL7%20v2.5.1&table=0123
 type ResultStatus struct {
+	// Preliminary means that the results are preliminary and will be followed by final results.
+	Preliminary string
 	// Final means that the results are stored and verified. Can only be changed with a corrected result.
 	Final string
 	// Corrected means that the record coming over is a correction and thus replaces a final result.
 	Corrected string
+	// Cancelled means that the result cannot be obtained for the observation.
+	// It only applies to individual results.
+	Cancelled string
+	// Deleted means that the result is deleted. It only applies to individual results.
+	Deleted string
 }
 
 // DocumentStatus is set in the OBR.25 Result Status field for a Clinical Note.
@@ -157,6 +249,12 @@ type OrderStatus struct {
 	Completed string
 	// InProcess means that the status is in process, unspecified.
 	InProcess string `yaml:"in_process"`
+	// Discontinued means that the order has been discontinued.
+	Discontinued string
+	// Cancelled means that the order was cancelled.
+	Cancelled string
+	// Held means that the order is on hold.
+	Held string
 }
 
 // PatientClass are the patient class values to set in the PV1.2.PatientClass field.
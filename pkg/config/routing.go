@@ -0,0 +1,82 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Routing is the configuration for sending messages to different destinations.
+type Routing struct {
+	// Destinations are the places messages can be sent to, by name.
+	Destinations map[string]RoutingDestination `yaml:"destinations"`
+	// Routes are evaluated in order, and messages are sent to the destination of the first route they match.
+	Routes []hl7.Route `yaml:"routes"`
+	// Default is the name of the destination for messages that don't match any route.
+	// If empty, messages that don't match any route cannot be sent.
+	Default string `yaml:"default"`
+}
+
+// RoutingDestination is a place messages can be sent to.
+// The settings of MLLP and HTTP destinations that are not specified here, eg: TLS, keep-alive or
+// authentication settings, are the same for all MLLP and HTTP destinations respectively.
+type RoutingDestination struct {
+	// Output is where the messages are sent: stdout, mllp, file, batch or http.
+	Output string `yaml:"output"`
+	// File is the file path to write messages to if Output=file.
+	File string `yaml:"file"`
+	// BatchFilePattern is the pattern for the paths of the batch files if Output=batch.
+	BatchFilePattern string `yaml:"batch_file_pattern"`
+	// MllpDestination is Host:Port to which MLLP messages are sent if Output=mllp.
+	MllpDestination string `yaml:"mllp_destination"`
+	// MllpDeadLetterFile is the file path to write messages to if they are dead-lettered.
+	// Only relevant if Output=mllp.
+	MllpDeadLetterFile string `yaml:"mllp_dead_letter_file"`
+	// HTTPURL is the URL to which messages are posted if Output=http.
+	HTTPURL string `yaml:"http_url"`
+	// Format is the encoding of the messages sent to this destination: er7 or xml.
+	// If empty, the format of the sender is used.
+	Format string `yaml:"format"`
+}
+
+// LoadRoutingConfig loads the routing configuration from the given file.
+func LoadRoutingConfig(ctx context.Context, fileName string) (*Routing, error) {
+	data, err := files.Read(ctx, fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read routing configuration file %s", fileName)
+	}
+
+	r := new(Routing)
+	if err := yaml.UnmarshalStrict(data, r); err != nil {
+		return nil, errors.Wrapf(err, "cannot unmarshal routing configuration file %s", fileName)
+	}
+	if len(r.Destinations) == 0 {
+		return nil, errors.Errorf("invalid routing configuration %s: no destinations", fileName)
+	}
+	for i, route := range r.Routes {
+		if _, ok := r.Destinations[route.Destination]; !ok {
+			return nil, errors.Errorf("invalid routing configuration %s: route %d has an unknown destination %q", fileName, i, route.Destination)
+		}
+	}
+	if _, ok := r.Destinations[r.Default]; r.Default != "" && !ok {
+		return nil, errors.Errorf("invalid routing configuration %s: unknown default destination %q", fileName, r.Default)
+	}
+	return r, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadRoutingConfig(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		config  string
+		want    *Routing
+		wantErr bool
+	}{{
+		name: "good config",
+		config: `
+destinations:
+  adt:
+    output: mllp
+    mllp_destination: localhost:6661
+  documents:
+    output: file
+    file: documents.out
+    format: xml
+routes:
+  - message_types: [ADT]
+    destination: adt
+  - message_types: [MDM^T02]
+    pathway_names: [notes]
+    destination: documents
+default: adt`,
+		want: &Routing{
+			Destinations: map[string]RoutingDestination{
+				"adt":       {Output: "mllp", MllpDestination: "localhost:6661"},
+				"documents": {Output: "file", File: "documents.out", Format: "xml"},
+			},
+			Routes: []hl7.Route{
+				{MessageTypes: []string{"ADT"}, Destination: "adt"},
+				{MessageTypes: []string{"MDM^T02"}, PathwayNames: []string{"notes"}, Destination: "documents"},
+			},
+			Default: "adt",
+		},
+	}, {
+		name:    "no destinations",
+		config:  `default: adt`,
+		wantErr: true,
+	}, {
+		name: "unknown route destination",
+		config: `
+destinations:
+  adt:
+    output: stdout
+routes:
+  - message_types: [ORU]
+    destination: results`,
+		wantErr: true,
+	}, {
+		name: "unknown default destination",
+		config: `
+destinations:
+  adt:
+    output: stdout
+default: results`,
+		wantErr: true,
+	}, {
+		name:    "unknown fields",
+		config:  `arbitrary_field: value`,
+		wantErr: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tmp := testwrite.BytesToFile(t, []byte(tc.config))
+			got, err := LoadRoutingConfig(ctx, tmp)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("LoadRoutingConfig(%s) got err %v; want error? %t", tmp, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LoadRoutingConfig(%s) got diff (-want, +got):\n%s", tmp, diff)
+			}
+		})
+	}
+}
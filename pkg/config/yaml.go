@@ -0,0 +1,37 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadYAML reads the YAML file with the given name, which can be a local file or a GCS object,
+// and unmarshals it into v. Fields in the file that v doesn't have are an error.
+// What describes the content of the file in the errors, eg: "medications".
+func LoadYAML(ctx context.Context, fileName string, what string, v interface{}) error {
+	data, err := files.Read(ctx, fileName)
+	if err != nil {
+		return errors.Wrapf(err, "cannot read %s file %s", what, fileName)
+	}
+	if err := yaml.UnmarshalStrict(data, v); err != nil {
+		return errors.Wrapf(err, "cannot unmarshal %s from %s", what, fileName)
+	}
+	return nil
+}
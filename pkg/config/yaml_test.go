@@ -0,0 +1,72 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadYAML(t *testing.T) {
+	ctx := context.Background()
+	type item struct {
+		Name   string
+		Weight int
+	}
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]item
+		wantErr bool
+	}{{
+		name:    "valid",
+		content: "a:\n  name: A\n  weight: 2",
+		want:    map[string]item{"a": {Name: "A", Weight: 2}},
+	}, {
+		name:    "unknown field",
+		content: "a:\n  name: A\n  height: 2",
+		wantErr: true,
+	}, {
+		name:    "invalid YAML",
+		content: "a: [",
+		wantErr: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fName := testwrite.BytesToFile(t, []byte(tc.content))
+			var got map[string]item
+			err := LoadYAML(ctx, fName, "items", &got)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("LoadYAML(%s) got err=%v, want error: %t", fName, err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LoadYAML(%s) got diff (-want, +got):\n%s", fName, diff)
+			}
+		})
+	}
+}
+
+func TestLoadYAML_MissingFile(t *testing.T) {
+	var v map[string]string
+	if err := LoadYAML(context.Background(), "/does/not/exist.yml", "items", &v); err == nil {
+		t.Error("LoadYAML() got nil error, want non nil")
+	}
+}
@@ -25,6 +25,8 @@ const (
 	EncounterStatusArrived = "arrived"
 	// EncounterStatusInProgress denotes that the Encounter has begun and the patient is present.
 	EncounterStatusInProgress = "in-progress"
+	// EncounterStatusOnLeave denotes that the Encounter has begun, but the patient is temporarily on leave.
+	EncounterStatusOnLeave = "onleave"
 	// EncounterStatusFinished denotes that the Encounter has ended.
 	EncounterStatusFinished = "finished"
 	// EncounterStatusCancelled denotes that the Encounter has ended before it has begun.
@@ -42,6 +42,10 @@ const (
 	NumericalValueType = "NM"
 	// TextualValueType indicates that the value is textual.
 	TextualValueType = "TX"
+	// CodedValueType indicates that the value is a coded element.
+	CodedValueType = "CE"
+	// StringValueType indicates that the value is a string.
+	StringValueType = "ST"
 
 	// R01 is the trigger event R01.
 	R01 = "R01"
@@ -49,4 +53,21 @@ const (
 	R03 = "R03"
 	// R32 is the trigger event R32.
 	R32 = "R32"
+
+	// ORMOrderStyle indicates that orders are placed with ORM^O01 messages and acknowledged with
+	// ORR^O02 messages.
+	ORMOrderStyle = "ORM"
+	// OMLOrderStyle indicates that orders are placed with OML^O21 messages and acknowledged with
+	// ORL^O22 messages.
+	OMLOrderStyle = "OML"
+
+	// Susceptible is the interpretation of an antibiotic susceptibility test
+	// indicating that the organism is susceptible to the antibiotic.
+	Susceptible = "S"
+	// Intermediate is the interpretation of an antibiotic susceptibility test
+	// indicating that the organism is intermediately susceptible to the antibiotic.
+	Intermediate = "I"
+	// Resistant is the interpretation of an antibiotic susceptibility test
+	// indicating that the organism is resistant to the antibiotic.
+	Resistant = "R"
 )
@@ -17,6 +17,7 @@ package fhir
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/bitcrshr/simhospital/pkg/constants"
@@ -28,12 +29,19 @@ import (
 	aipb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/allergy_intolerance_go_proto"
 	r4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
 	conditionpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/condition_go_proto"
+	coveragepb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/coverage_go_proto"
 	encounterpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/encounter_go_proto"
+	immunizationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/immunization_go_proto"
 	locationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/location_go_proto"
+	medadminpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_administration_go_proto"
+	medrequestpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_request_go_proto"
 	observationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/observation_go_proto"
 	patientpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/patient_go_proto"
 	practitionerpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/practitioner_go_proto"
 	procedurepb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/procedure_go_proto"
+	srpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/service_request_go_proto"
+	specimenpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/specimen_go_proto"
+	vspb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/valuesets_go_proto"
 )
 
 const (
@@ -65,6 +73,7 @@ var (
 		constants.EncounterStatusPlanned:    cpb.EncounterStatusCode_PLANNED,
 		constants.EncounterStatusInProgress: cpb.EncounterStatusCode_IN_PROGRESS,
 		constants.EncounterStatusArrived:    cpb.EncounterStatusCode_ARRIVED,
+		constants.EncounterStatusOnLeave:    cpb.EncounterStatusCode_ONLEAVE,
 		constants.EncounterStatusFinished:   cpb.EncounterStatusCode_FINISHED,
 		constants.EncounterStatusCancelled:  cpb.EncounterStatusCode_CANCELLED,
 		constants.EncounterStatusUnknown:    cpb.EncounterStatusCode_UNKNOWN,
@@ -134,10 +143,40 @@ func (b *Bundler) createBundle(p *ir.PatientInfo) *r4pb.Bundle {
 		addEntry(bundle, encounter)
 
 		for _, o := range ec.Orders {
-			observations := b.observations(encounterRef, patientRef, o)
+			practitioner, practitionerRef := b.practitioner(o.OrderingProvider)
+			addEntry(bundle, practitioner)
+
+			request, requestRef := b.serviceRequest(o, patientRef, practitionerRef, encounterRef)
+			addEntry(bundle, request)
+
+			specimen, specimenRef := b.specimen(o, patientRef, requestRef)
+			addEntry(bundle, specimen)
+
+			observations := b.observations(encounterRef, patientRef, requestRef, specimenRef, o)
 			addEntry(bundle, observations...)
 		}
 	}
+
+	for _, mo := range p.Medications {
+		practitioner, practitionerRef := b.practitioner(mo.Prescriber)
+		addEntry(bundle, practitioner)
+
+		request, requestRef := b.medicationRequest(mo, patientRef, practitionerRef)
+		addEntry(bundle, request)
+
+		administrations := b.medicationAdministrations(mo, patientRef, requestRef)
+		addEntry(bundle, administrations...)
+	}
+
+	for _, v := range p.Vaccinations {
+		practitioner, practitionerRef := b.practitioner(v.Administrator)
+		addEntry(bundle, practitioner)
+		addEntry(bundle, b.immunization(v, patientRef, practitionerRef))
+	}
+
+	for _, in := range p.Insurances {
+		addEntry(bundle, b.coverage(in, p.Person, patientRef))
+	}
 	return bundle
 }
 
@@ -365,47 +404,189 @@ func statusHistory(statusHistory []*ir.StatusHistory) []*encounterpb.Encounter_S
 	return sh
 }
 
-func (b *Bundler) observations(encounterRef *dpb.Reference, patientRef *dpb.Reference, order *ir.Order) []*r4pb.Bundle_Entry {
-	var observations []*r4pb.Bundle_Entry
-	for _, r := range order.Results {
-		id := b.idGenerator.NewID()
-		o := &observationpb.Observation{
-			Encounter: encounterRef,
-			Subject:   patientRef,
-			Id:        &dpb.Id{Value: id},
-			Note:      b.notes(r.Notes),
-			Status: &observationpb.Observation_StatusCode{
-				Value: b.oc.HL7ToFHIR(r.Status),
-			},
-			Text: narrative(r.Text(), strings.Join(r.Notes, "; ")),
-			Effective: &observationpb.Observation_EffectiveX{
-				Choice: &observationpb.Observation_EffectiveX_DateTime{
-					DateTime: dateTime(order.OrderDateTime),
-				},
-			},
-			Value: &observationpb.Observation_ValueX{
-				Choice: &observationpb.Observation_ValueX_Quantity{
-					Quantity: &dpb.Quantity{
-						Value: &dpb.Decimal{Value: r.Value},
-						Unit:  &dpb.String{Value: r.Unit},
-					},
-				},
+func (b *Bundler) serviceRequest(order *ir.Order, patientRef *dpb.Reference, practitionerRef *dpb.Reference, encounterRef *dpb.Reference) (*r4pb.Bundle_Entry, *dpb.Reference) {
+	id := b.idGenerator.NewID()
+	r := &srpb.ServiceRequest{
+		Id:         &dpb.Id{Value: id},
+		Identifier: identifier(order.Placer),
+		Status: &srpb.ServiceRequest_StatusCode{
+			Value: b.oc.OrderStatusHL7ToFHIR(order.OrderStatus),
+		},
+		Intent: &srpb.ServiceRequest_IntentCode{
+			Value: cpb.RequestIntentCode_ORDER,
+		},
+		Subject:    patientRef,
+		Encounter:  encounterRef,
+		AuthoredOn: dateTime(order.OrderDateTime),
+		Requester:  practitionerRef,
+	}
+	var name string
+	if order.OrderProfile != nil {
+		name = order.OrderProfile.Text
+		r.Code = b.codeableConcept(*order.OrderProfile)
+	}
+	r.Text = narrative(name)
+
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_ServiceRequest{r},
+		},
+	}
+
+	ref := fhircore.ServiceRequestRef(id)
+	ref.Display = fhircore.String(name)
+
+	return b.addURL(entry, id, "ServiceRequest"), ref
+}
+
+// specimen returns the Specimen resource for the specimen of the given order, or nil if the order
+// doesn't have a specimen.
+func (b *Bundler) specimen(order *ir.Order, patientRef *dpb.Reference, requestRef *dpb.Reference) (*r4pb.Bundle_Entry, *dpb.Reference) {
+	s := order.Specimen
+	if s == nil {
+		return nil, nil
+	}
+	id := b.idGenerator.NewID()
+	r := &specimenpb.Specimen{
+		Id:         &dpb.Id{Value: id},
+		Identifier: identifier(order.Placer),
+		Status: &specimenpb.Specimen_StatusCode{
+			Value: cpb.SpecimenStatusCode_AVAILABLE,
+		},
+		Subject:      patientRef,
+		ReceivedTime: dateTime(s.ReceivedDateTime),
+		Request:      []*dpb.Reference{requestRef},
+	}
+	var name string
+	if s.Type != nil {
+		name = s.Type.Text
+		r.Type = b.codeableConcept(*s.Type)
+	}
+	r.Text = narrative(name)
+
+	collection := &specimenpb.Specimen_Collection{}
+	if s.CollectedDateTime.Valid {
+		collection.Collected = &specimenpb.Specimen_Collection_CollectedX{
+			Choice: &specimenpb.Specimen_Collection_CollectedX_DateTime{
+				DateTime: dateTime(s.CollectedDateTime),
 			},
 		}
+	}
+	if s.CollectionMethod != nil {
+		collection.Method = b.codeableConcept(*s.CollectionMethod)
+	}
+	if s.BodySite != nil {
+		collection.BodySite = b.codeableConcept(*s.BodySite)
+	}
+	if collection.Collected != nil || collection.Method != nil || collection.BodySite != nil {
+		r.Collection = collection
+	}
+	if s.Container != nil {
+		r.Container = []*specimenpb.Specimen_Container{{Type: b.codeableConcept(*s.Container)}}
+	}
 
-		if r.TestName != nil {
-			o.Code = b.codeableConcept(*r.TestName)
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_Specimen{r},
+		},
+	}
+
+	ref := fhircore.SpecimenRef(id)
+	ref.Display = fhircore.String(name)
+
+	return b.addURL(entry, id, "Specimen"), ref
+}
+
+// observations returns the observations of the results of the given order.
+// The observations of the results of child orders, e.g., antibiotic susceptibilities, are derived
+// from the observation of their parent result, e.g., the organism identified by a culture.
+// Parent results are matched by their observation identifier and sub-ID rather than by pointer,
+// as orders might have been restored from a serialized state.
+func (b *Bundler) observations(encounterRef *dpb.Reference, patientRef *dpb.Reference, requestRef *dpb.Reference, specimenRef *dpb.Reference, order *ir.Order) []*r4pb.Bundle_Entry {
+	var observations []*r4pb.Bundle_Entry
+	refs := map[string]*dpb.Reference{}
+	for _, r := range order.Results {
+		entry, ref := b.observation(r, order, encounterRef, patientRef, requestRef, specimenRef, nil)
+		if key := resultKey(r); key != "" {
+			refs[key] = ref
 		}
+		observations = append(observations, entry)
+	}
+	for _, c := range order.Children {
+		for _, r := range c.Results {
+			entry, _ := b.observation(r, order, encounterRef, patientRef, requestRef, specimenRef, refs[resultKey(c.ParentResult)])
+			observations = append(observations, entry)
+		}
+	}
+	return observations
+}
 
-		entry := &r4pb.Bundle_Entry{
-			Resource: &r4pb.ContainedResource{
-				OneofResource: &r4pb.ContainedResource_Observation{o},
+// resultKey returns a key that identifies the given result within its order.
+func resultKey(r *ir.Result) string {
+	if r == nil || r.TestName == nil {
+		return ""
+	}
+	return r.TestName.ID + "^" + r.SubID
+}
+
+func (b *Bundler) observation(r *ir.Result, order *ir.Order, encounterRef *dpb.Reference, patientRef *dpb.Reference, requestRef *dpb.Reference, specimenRef *dpb.Reference, parentRef *dpb.Reference) (*r4pb.Bundle_Entry, *dpb.Reference) {
+	// The ID is kept in the result so that the revisions of the result are versions of the same
+	// Observation.
+	if r.ObservationID == "" {
+		r.ObservationID = b.idGenerator.NewID()
+	}
+	id := r.ObservationID
+	o := &observationpb.Observation{
+		BasedOn:   []*dpb.Reference{requestRef},
+		Encounter: encounterRef,
+		Subject:   patientRef,
+		Specimen:  specimenRef,
+		Id:        &dpb.Id{Value: id},
+		Note:      b.notes(r.Notes),
+		Status: &observationpb.Observation_StatusCode{
+			Value: b.oc.HL7ToFHIR(r.Status),
+		},
+		Text: narrative(r.Text(), strings.Join(r.Notes, "; ")),
+		Effective: &observationpb.Observation_EffectiveX{
+			Choice: &observationpb.Observation_EffectiveX_DateTime{
+				DateTime: dateTime(order.OrderDateTime),
+			},
+		},
+	}
+
+	if r.CodedValue != nil {
+		o.Value = &observationpb.Observation_ValueX{
+			Choice: &observationpb.Observation_ValueX_CodeableConcept{
+				CodeableConcept: b.codeableConcept(*r.CodedValue),
 			},
 		}
+	} else {
+		o.Value = &observationpb.Observation_ValueX{
+			Choice: &observationpb.Observation_ValueX_Quantity{
+				Quantity: &dpb.Quantity{
+					Value: &dpb.Decimal{Value: r.Value},
+					Unit:  &dpb.String{Value: r.Unit},
+				},
+			},
+		}
+	}
+	if r.TestName != nil {
+		o.Code = b.codeableConcept(*r.TestName)
+	}
+	if parentRef != nil {
+		o.DerivedFrom = []*dpb.Reference{parentRef}
+	}
+	if r.Revision > 0 {
+		// Every revision of the result is a new version of the observation.
+		o.Meta = &dpb.Meta{VersionId: &dpb.Id{Value: strconv.Itoa(r.Revision + 1)}}
+	}
 
-		observations = append(observations, b.addURL(entry, id, "Observation"))
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_Observation{o},
+		},
 	}
-	return observations
+	return b.addURL(entry, id, "Observation"), fhircore.ObservationRef(id)
 }
 
 func narrative(paragraphs ...string) *dpb.Narrative {
@@ -538,6 +719,235 @@ func (b *Bundler) condition(diagnosis *ir.DiagnosisOrProcedure, patientRef *dpb.
 	return b.addURL(entry, id, "Condition"), ref
 }
 
+func (b *Bundler) medicationRequest(mo *ir.MedicationOrder, patientRef *dpb.Reference, practitionerRef *dpb.Reference) (*r4pb.Bundle_Entry, *dpb.Reference) {
+	id := b.idGenerator.NewID()
+	status := cpb.MedicationrequestStatusCode_ACTIVE
+	if mo.Discontinued() {
+		status = cpb.MedicationrequestStatusCode_STOPPED
+	}
+	dosage := dosageText(mo.Dose, mo.DoseUnits, mo.Route, mo.Frequency)
+
+	r := &medrequestpb.MedicationRequest{
+		Id:         &dpb.Id{Value: id},
+		Identifier: identifier(mo.Filler),
+		Status:     &medrequestpb.MedicationRequest_StatusCode{Value: status},
+		Intent: &medrequestpb.MedicationRequest_IntentCode{
+			Value: cpb.MedicationRequestIntentCode_ORDER,
+		},
+		Subject:    patientRef,
+		AuthoredOn: dateTime(mo.OrderDateTime),
+		Requester:  practitionerRef,
+		DosageInstruction: []*dpb.Dosage{{
+			Text: &dpb.String{Value: dosage},
+		}},
+	}
+	var name string
+	if mo.Medication != nil {
+		name = mo.Medication.Text
+		r.Medication = &medrequestpb.MedicationRequest_MedicationX{
+			Choice: &medrequestpb.MedicationRequest_MedicationX_CodeableConcept{
+				CodeableConcept: b.codeableConcept(*mo.Medication),
+			},
+		}
+	}
+	if mo.Route != "" {
+		r.DosageInstruction[0].Route = &dpb.CodeableConcept{Text: &dpb.String{Value: mo.Route}}
+	}
+	if mo.DiscontinueReason != "" {
+		r.StatusReason = &dpb.CodeableConcept{Text: &dpb.String{Value: mo.DiscontinueReason}}
+	}
+	r.Text = narrative(name, dosage)
+
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_MedicationRequest{r},
+		},
+	}
+
+	ref := fhircore.MedicationRequestRef(id)
+	ref.Display = fhircore.String(name)
+
+	return b.addURL(entry, id, "MedicationRequest"), ref
+}
+
+func (b *Bundler) medicationAdministrations(mo *ir.MedicationOrder, patientRef *dpb.Reference, requestRef *dpb.Reference) []*r4pb.Bundle_Entry {
+	var administrations []*r4pb.Bundle_Entry
+	for _, a := range mo.Administrations {
+		id := b.idGenerator.NewID()
+		dosage := dosageText(a.Dose, a.DoseUnits, mo.Route, "")
+		ma := &medadminpb.MedicationAdministration{
+			Id: &dpb.Id{Value: id},
+			Status: &medadminpb.MedicationAdministration_StatusCode{
+				Value: cpb.MedicationAdministrationStatusCode_COMPLETED,
+			},
+			Subject: patientRef,
+			Effective: &medadminpb.MedicationAdministration_EffectiveX{
+				Choice: &medadminpb.MedicationAdministration_EffectiveX_DateTime{
+					DateTime: dateTime(a.DateTime),
+				},
+			},
+			Request: requestRef,
+			Dosage: &medadminpb.MedicationAdministration_Dosage{
+				Text: &dpb.String{Value: dosage},
+				Dose: &dpb.SimpleQuantity{
+					Value: &dpb.Decimal{Value: a.Dose},
+					Unit:  &dpb.String{Value: a.DoseUnits},
+				},
+			},
+		}
+		var name string
+		if mo.Medication != nil {
+			name = mo.Medication.Text
+			ma.Medication = &medadminpb.MedicationAdministration_MedicationX{
+				Choice: &medadminpb.MedicationAdministration_MedicationX_CodeableConcept{
+					CodeableConcept: b.codeableConcept(*mo.Medication),
+				},
+			}
+		}
+		ma.Text = narrative(name, dosage)
+
+		entry := &r4pb.Bundle_Entry{
+			Resource: &r4pb.ContainedResource{
+				OneofResource: &r4pb.ContainedResource_MedicationAdministration{ma},
+			},
+		}
+		administrations = append(administrations, b.addURL(entry, id, "MedicationAdministration"))
+	}
+	return administrations
+}
+
+func (b *Bundler) immunization(v *ir.Vaccination, patientRef *dpb.Reference, practitionerRef *dpb.Reference) *r4pb.Bundle_Entry {
+	id := b.idGenerator.NewID()
+	im := &immunizationpb.Immunization{
+		Id:         &dpb.Id{Value: id},
+		Identifier: identifier(v.Filler),
+		Status: &immunizationpb.Immunization_StatusCode{
+			Value: vspb.ImmunizationStatusCodesValueSet_COMPLETED,
+		},
+		Patient: patientRef,
+		Occurrence: &immunizationpb.Immunization_OccurrenceX{
+			Choice: &immunizationpb.Immunization_OccurrenceX_DateTime{
+				DateTime: dateTime(v.DateTime),
+			},
+		},
+		PrimarySource: &dpb.Boolean{Value: true},
+	}
+	var name string
+	if v.Vaccine != nil {
+		name = v.Vaccine.Text
+		im.VaccineCode = b.codeableConcept(*v.Vaccine)
+	}
+	if v.Manufacturer != nil {
+		im.Manufacturer = &dpb.Reference{Display: fhircore.String(v.Manufacturer.Text)}
+	}
+	if v.LotNumber != "" {
+		im.LotNumber = &dpb.String{Value: v.LotNumber}
+	}
+	if v.Site != "" {
+		im.Site = &dpb.CodeableConcept{Text: &dpb.String{Value: v.Site}}
+	}
+	if v.Route != "" {
+		im.Route = &dpb.CodeableConcept{Text: &dpb.String{Value: v.Route}}
+	}
+	if v.Dose != "" {
+		im.DoseQuantity = &dpb.SimpleQuantity{
+			Value: &dpb.Decimal{Value: v.Dose},
+			Unit:  &dpb.String{Value: v.DoseUnits},
+		}
+	}
+	if practitionerRef != nil {
+		im.Performer = []*immunizationpb.Immunization_Performer{{Actor: practitionerRef}}
+	}
+	im.Text = narrative(name, dosageText(v.Dose, v.DoseUnits, v.Route, ""))
+
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_Immunization{im},
+		},
+	}
+	return b.addURL(entry, id, "Immunization")
+}
+
+// coverage returns the Coverage of the given insurance. The policy holder and subscriber is the
+// patient if the patient is the insured person.
+func (b *Bundler) coverage(in *ir.Insurance, patient *ir.Person, patientRef *dpb.Reference) *r4pb.Bundle_Entry {
+	id := b.idGenerator.NewID()
+	c := &coveragepb.Coverage{
+		Id:         &dpb.Id{Value: id},
+		Identifier: identifier(in.MemberNumber),
+		Status: &coveragepb.Coverage_StatusCode{
+			Value: cpb.FinancialResourceStatusCode_ACTIVE,
+		},
+		SubscriberId: &dpb.String{Value: in.PolicyNumber},
+		Beneficiary:  patientRef,
+	}
+	var plan string
+	if in.Plan != nil {
+		plan = in.Plan.Text
+		c.ClassValue = append(c.ClassValue, coverageClass("plan", in.Plan.ID, in.Plan.Text))
+	}
+	if in.GroupNumber != "" {
+		c.ClassValue = append(c.ClassValue, coverageClass("group", in.GroupNumber, ""))
+	}
+	payor := in.CompanyName
+	if payor == "" && in.Plan != nil {
+		payor = in.Plan.ID
+	}
+	c.Payor = []*dpb.Reference{{Display: fhircore.String(payor)}}
+	if in.PlanType != "" {
+		c.Type = &dpb.CodeableConcept{Text: &dpb.String{Value: in.PlanType}}
+	}
+	if in.EffectiveDate.Valid {
+		c.Period = &dpb.Period{Start: dateTime(in.EffectiveDate)}
+	}
+	if in.Insured != nil {
+		subscriber := patientRef
+		if in.Insured.Person != patient {
+			subscriber = &dpb.Reference{Display: fhircore.String(in.Insured.Text())}
+		}
+		c.PolicyHolder = subscriber
+		c.Subscriber = subscriber
+		if in.Insured.Relationship != nil {
+			c.Relationship = b.codeableConcept(*in.Insured.Relationship)
+		}
+	}
+	c.Text = narrative(plan, in.CompanyName)
+
+	entry := &r4pb.Bundle_Entry{
+		Resource: &r4pb.ContainedResource{
+			OneofResource: &r4pb.ContainedResource_Coverage{c},
+		},
+	}
+	return b.addURL(entry, id, "Coverage")
+}
+
+func coverageClass(classType, value, name string) *coveragepb.Coverage_Class {
+	class := &coveragepb.Coverage_Class{
+		Type: &dpb.CodeableConcept{
+			Coding: []*dpb.Coding{{
+				System: &dpb.Uri{Value: "http://terminology.hl7.org/CodeSystem/coverage-class"},
+				Code:   &dpb.Code{Value: classType},
+			}},
+		},
+		Value: &dpb.String{Value: value},
+	}
+	if name != "" {
+		class.Name = &dpb.String{Value: name}
+	}
+	return class
+}
+
+// dosageText returns a human-readable dosage, e.g. "1000 mg PO Q6H".
+func dosageText(dose, units, route, frequency string) string {
+	var parts []string
+	for _, p := range []string{dose, units, route, frequency} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 func (b *Bundler) practitioner(doctor *ir.Doctor) (*r4pb.Bundle_Entry, *dpb.Reference) {
 	if doctor == nil {
 		return nil, nil
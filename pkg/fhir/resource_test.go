@@ -33,12 +33,19 @@ import (
 	aipb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/allergy_intolerance_go_proto"
 	r4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
 	conditionpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/condition_go_proto"
+	coveragepb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/coverage_go_proto"
 	encounterpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/encounter_go_proto"
+	immunizationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/immunization_go_proto"
 	locationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/location_go_proto"
+	medadminpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_administration_go_proto"
+	medrequestpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_request_go_proto"
 	observationpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/observation_go_proto"
 	patientpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/patient_go_proto"
 	practitionerpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/practitioner_go_proto"
 	procedurepb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/procedure_go_proto"
+	srpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/service_request_go_proto"
+	specimenpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/specimen_go_proto"
+	vspb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/valuesets_go_proto"
 )
 
 var (
@@ -52,6 +59,14 @@ var (
 )
 
 func TestGenerate(t *testing.T) {
+	organism := &ir.Result{
+		TestName:   &ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "SYSTEM"},
+		SubID:      "1",
+		CodedValue: &ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SYSTEM"},
+		ValueType:  "CE",
+		Status:     "F",
+	}
+
 	tests := []struct {
 		name        string
 		patientInfo *ir.PatientInfo
@@ -170,6 +185,13 @@ func TestGenerate(t *testing.T) {
 					DateTime: later,
 				}},
 				Orders: []*ir.Order{{
+					OrderProfile: &ir.CodedElement{
+						ID:           "PROFILE_ID",
+						Text:         "PROFILE",
+						CodingSystem: "SYSTEM",
+					},
+					Placer:        "PLACER",
+					OrderStatus:   "CM",
 					OrderDateTime: later,
 					Results: []*ir.Result{{
 						TestName: &ir.CodedElement{
@@ -182,6 +204,7 @@ func TestGenerate(t *testing.T) {
 						AbnormalFlag: "H",
 						Notes:        []string{"NOTE_1", "NOTE_2"},
 						Status:       "C",
+						Revision:     1,
 					}, {
 						TestName: &ir.CodedElement{
 							ID:           "TEST_ID_2",
@@ -693,7 +716,44 @@ func TestGenerate(t *testing.T) {
 					},
 				},
 			}, {
-				FullUrl: &dpb.Uri{Value: "Observation/11"},
+				FullUrl: &dpb.Uri{Value: "ServiceRequest/11"},
+				Request: &r4pb.Bundle_Entry_Request{
+					Method: &r4pb.Bundle_Entry_Request_MethodCode{Value: cpb.HTTPVerbCode_POST},
+					Url:    &dpb.Uri{Value: "ServiceRequest"},
+				},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_ServiceRequest{
+						&srpb.ServiceRequest{
+							Id:         &dpb.Id{Value: "11"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "PLACER"}}},
+							Status:     &srpb.ServiceRequest_StatusCode{Value: cpb.RequestStatusCode_COMPLETED},
+							Intent:     &srpb.ServiceRequest_IntentCode{Value: cpb.RequestIntentCode_ORDER},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "PROFILE_ID"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "PROFILE"},
+								}},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "William Burr"},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "4"}},
+							},
+							AuthoredOn: &dpb.DateTime{ValueUs: laterMicros, Precision: dpb.DateTime_SECOND},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>PROFILE</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Observation/12"},
 				Request: &r4pb.Bundle_Entry_Request{
 					Method: &r4pb.Bundle_Entry_Request_MethodCode{Value: cpb.HTTPVerbCode_POST},
 					Url:    &dpb.Uri{Value: "Observation"},
@@ -701,7 +761,12 @@ func TestGenerate(t *testing.T) {
 				Resource: &r4pb.ContainedResource{
 					OneofResource: &r4pb.ContainedResource_Observation{
 						&observationpb.Observation{
-							Id: &dpb.Id{Value: "11"},
+							Id:   &dpb.Id{Value: "12"},
+							Meta: &dpb.Meta{VersionId: &dpb.Id{Value: "2"}},
+							BasedOn: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "11"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
 							Code: &dpb.CodeableConcept{
 								Coding: []*dpb.Coding{{
 									Code:    &dpb.Code{Value: "TEST_ID_1"},
@@ -744,7 +809,7 @@ func TestGenerate(t *testing.T) {
 					},
 				},
 			}, {
-				FullUrl: &dpb.Uri{Value: "Observation/12"},
+				FullUrl: &dpb.Uri{Value: "Observation/13"},
 				Request: &r4pb.Bundle_Entry_Request{
 					Method: &r4pb.Bundle_Entry_Request_MethodCode{Value: cpb.HTTPVerbCode_POST},
 					Url:    &dpb.Uri{Value: "Observation"},
@@ -752,7 +817,11 @@ func TestGenerate(t *testing.T) {
 				Resource: &r4pb.ContainedResource{
 					OneofResource: &r4pb.ContainedResource_Observation{
 						&observationpb.Observation{
-							Id: &dpb.Id{Value: "12"},
+							Id: &dpb.Id{Value: "13"},
+							BasedOn: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "11"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
 							Code: &dpb.CodeableConcept{
 								Coding: []*dpb.Coding{{
 									Code:    &dpb.Code{Value: "TEST_ID_2"},
@@ -790,7 +859,7 @@ func TestGenerate(t *testing.T) {
 					},
 				},
 			}, {
-				FullUrl: &dpb.Uri{Value: "Encounter/13"},
+				FullUrl: &dpb.Uri{Value: "Encounter/14"},
 				Request: &r4pb.Bundle_Entry_Request{
 					Method: &r4pb.Bundle_Entry_Request_MethodCode{Value: cpb.HTTPVerbCode_POST},
 					Url:    &dpb.Uri{Value: "Encounter"},
@@ -798,7 +867,7 @@ func TestGenerate(t *testing.T) {
 				Resource: &r4pb.ContainedResource{
 					OneofResource: &r4pb.ContainedResource_Encounter{
 						&encounterpb.Encounter{
-							Id: &dpb.Id{Value: "13"},
+							Id: &dpb.Id{Value: "14"},
 							ClassValue: &dpb.Coding{
 								Code: &dpb.Code{Value: "IMP"},
 							},
@@ -871,64 +940,1213 @@ func TestGenerate(t *testing.T) {
 				},
 			}},
 		},
-	}}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			var b bytes.Buffer
-			cfg := BundlerConfig{
-				HL7Config: &config.HL7Config{
-					Gender: config.Gender{Male: "M", Female: "F"},
-					ResultStatus: config.ResultStatus{
-						Final:     "F",
-						Corrected: "C",
+	}, {
+		name:       "Patient on leave of absence",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Class: "IMP",
+			Encounters: []*ir.Encounter{{
+				Status:      constants.EncounterStatusOnLeave,
+				StatusStart: later,
+				Start:       now,
+				StatusHistory: []*ir.StatusHistory{{
+					Status: constants.EncounterStatusArrived,
+					Start:  now,
+					End:    later,
+				}},
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
+							},
+						},
 					},
-					Allergy: config.HL7Allergy{
-						Types:      []string{"FOOD", "MEDICATION"},
-						Severities: []string{"MILD", "MODERATE", "SEVERE"},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Encounter/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Encounter{
+						&encounterpb.Encounter{
+							Id: &dpb.Id{Value: "2"},
+							ClassValue: &dpb.Coding{
+								Code: &dpb.Code{Value: "IMP"},
+							},
+							Status: &encounterpb.Encounter_StatusCode{Value: cpb.EncounterStatusCode_ONLEAVE},
+							Period: &dpb.Period{
+								Start: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							},
+							StatusHistory: []*encounterpb.Encounter_StatusHistory{{
+								Status: &encounterpb.Encounter_StatusHistory_StatusCode{Value: cpb.EncounterStatusCode_ARRIVED},
+								Period: &dpb.Period{
+									Start: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+									End:   &dpb.DateTime{ValueUs: laterMicros, Precision: dpb.DateTime_SECOND},
+								},
+							}},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>Status: onleave</p><p>Active from Mon Feb 12 00:00:00 2018</p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
 					},
-					Mapping: config.CodeMapping{
-						FHIR: config.FHIRMapping{
-							CodingSystems: map[string]string{"SYSTEM": "SYSTEM_URI"},
-							AllergySeverities: map[string][]string{
-								"Severe":   {"SEVERE"},
-								"Moderate": {"MODERATE"},
-								"Mild":     {"MILD"},
+				},
+			}},
+		},
+	}, {
+		name:       "Patient with medications",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Medications: []*ir.MedicationOrder{{
+				Filler:        "FILLER",
+				OrderDateTime: now,
+				Medication: &ir.CodedElement{
+					ID:           "ID",
+					Text:         "Paracetamol",
+					CodingSystem: "SYSTEM",
+				},
+				Dose:      "1000",
+				DoseUnits: "mg",
+				Route:     "PO",
+				Frequency: "Q6H",
+				Prescriber: &ir.Doctor{
+					ID:        "ID",
+					Surname:   "Doctorson",
+					FirstName: "Doctor",
+					Prefix:    "Dr",
+				},
+				DiscontinuedDateTime: evenLater,
+				DiscontinueReason:    "Course completed",
+				Administrations: []*ir.MedicationAdministration{{
+					ID:        1,
+					DateTime:  later,
+					Dose:      "500",
+					DoseUnits: "mg",
+				}},
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
 							},
-							AllergyTypes: map[string][]string{
-								"Food":       {"FOOD"},
-								"Medication": {"MEDICATION"},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
 							},
 						},
 					},
 				},
-				IDGenerator: &testid.Generator{},
-				BundleType:  tc.bundleType,
-			}
-			bundler, err := NewBundler(cfg)
-			if err != nil {
-				t.Fatalf("NewBundler(%v) failed with: %v", cfg, err)
-			}
-			w := &Writer{
-				Bundler:    bundler,
-				Output:     &testfhir.ByteOutput{Bytes: &b},
-				Marshaller: prototext.MarshalOptions{},
-			}
-			if err := w.Generate(tc.patientInfo); err != nil {
-				t.Fatalf("w.Generate(%v) failed with: %v", tc.patientInfo, err)
-			}
-			if err := w.Close(); err != nil {
-				t.Errorf("w.Close() failed with: %v", err)
-			}
-
-			got := &r4pb.Bundle{}
-			if err := prototext.Unmarshal(b.Bytes(), got); err != nil {
-				t.Fatalf("prototext.Unmarshal(%v, %v) failed with: %v", b.String(), got, err)
-			}
-
-			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
-				t.Errorf("w.Generate(%v) returned diff (-want +got):\n%s", tc.patientInfo, diff)
-			}
-		})
+			}, {
+				FullUrl: &dpb.Uri{Value: "Practitioner/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Practitioner{
+						&practitionerpb.Practitioner{
+							Id: &dpb.Id{Value: "2"},
+							Identifier: []*dpb.Identifier{{
+								Value: &dpb.String{Value: "ID"},
+							}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Dr Doctor Doctorson</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Doctorson"},
+								Given:  []*dpb.String{{Value: "Doctor"}},
+								Prefix: []*dpb.String{{Value: "Dr"}},
+							}},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "MedicationRequest/3"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_MedicationRequest{
+						&medrequestpb.MedicationRequest{
+							Id:           &dpb.Id{Value: "3"},
+							Identifier:   []*dpb.Identifier{{Value: &dpb.String{Value: "FILLER"}}},
+							Status:       &medrequestpb.MedicationRequest_StatusCode{Value: cpb.MedicationrequestStatusCode_STOPPED},
+							StatusReason: &dpb.CodeableConcept{Text: &dpb.String{Value: "Course completed"}},
+							Intent:       &medrequestpb.MedicationRequest_IntentCode{Value: cpb.MedicationRequestIntentCode_ORDER},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Paracetamol</p><p>1000 mg PO Q6H</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Medication: &medrequestpb.MedicationRequest_MedicationX{
+								Choice: &medrequestpb.MedicationRequest_MedicationX_CodeableConcept{
+									CodeableConcept: &dpb.CodeableConcept{
+										Coding: []*dpb.Coding{{
+											System:  &dpb.Uri{Value: "SYSTEM_URI"},
+											Code:    &dpb.Code{Value: "ID"},
+											Display: &dpb.String{Value: "Paracetamol"},
+										}},
+									},
+								},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									PatientId: &dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							AuthoredOn: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							Requester: &dpb.Reference{
+								Reference: &dpb.Reference_PractitionerId{
+									PractitionerId: &dpb.ReferenceId{Value: "2"},
+								},
+								Display: &dpb.String{Value: "Doctor Doctorson"},
+							},
+							DosageInstruction: []*dpb.Dosage{{
+								Text:  &dpb.String{Value: "1000 mg PO Q6H"},
+								Route: &dpb.CodeableConcept{Text: &dpb.String{Value: "PO"}},
+							}},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "MedicationAdministration/4"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_MedicationAdministration{
+						&medadminpb.MedicationAdministration{
+							Id:     &dpb.Id{Value: "4"},
+							Status: &medadminpb.MedicationAdministration_StatusCode{Value: cpb.MedicationAdministrationStatusCode_COMPLETED},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Paracetamol</p><p>500 mg PO</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Medication: &medadminpb.MedicationAdministration_MedicationX{
+								Choice: &medadminpb.MedicationAdministration_MedicationX_CodeableConcept{
+									CodeableConcept: &dpb.CodeableConcept{
+										Coding: []*dpb.Coding{{
+											System:  &dpb.Uri{Value: "SYSTEM_URI"},
+											Code:    &dpb.Code{Value: "ID"},
+											Display: &dpb.String{Value: "Paracetamol"},
+										}},
+									},
+								},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									PatientId: &dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Effective: &medadminpb.MedicationAdministration_EffectiveX{
+								Choice: &medadminpb.MedicationAdministration_EffectiveX_DateTime{
+									DateTime: &dpb.DateTime{ValueUs: laterMicros, Precision: dpb.DateTime_SECOND},
+								},
+							},
+							Request: &dpb.Reference{
+								Reference: &dpb.Reference_MedicationRequestId{
+									MedicationRequestId: &dpb.ReferenceId{Value: "3"},
+								},
+								Display: &dpb.String{Value: "Paracetamol"},
+							},
+							Dosage: &medadminpb.MedicationAdministration_Dosage{
+								Text: &dpb.String{Value: "500 mg PO"},
+								Dose: &dpb.SimpleQuantity{
+									Value: &dpb.Decimal{Value: "500"},
+									Unit:  &dpb.String{Value: "mg"},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}, {
+		name:       "Patient with vaccinations",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Vaccinations: []*ir.Vaccination{{
+				Filler:   "FILLER",
+				DateTime: later,
+				Vaccine: &ir.CodedElement{
+					ID:           "141",
+					Text:         "Influenza",
+					CodingSystem: "SYSTEM",
+				},
+				Dose:      "0.5",
+				DoseUnits: "mL",
+				Route:     "IM",
+				Site:      "LD",
+				LotNumber: "LOT-1",
+				Manufacturer: &ir.CodedElement{
+					ID:   "SKB",
+					Text: "GlaxoSmithKline",
+				},
+				Administrator: &ir.Doctor{
+					ID:        "ID",
+					Surname:   "Doctorson",
+					FirstName: "Doctor",
+					Prefix:    "Dr",
+				},
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Practitioner/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Practitioner{
+						&practitionerpb.Practitioner{
+							Id: &dpb.Id{Value: "2"},
+							Identifier: []*dpb.Identifier{{
+								Value: &dpb.String{Value: "ID"},
+							}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Dr Doctor Doctorson</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Doctorson"},
+								Given:  []*dpb.String{{Value: "Doctor"}},
+								Prefix: []*dpb.String{{Value: "Dr"}},
+							}},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Immunization/3"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Immunization{
+						&immunizationpb.Immunization{
+							Id:         &dpb.Id{Value: "3"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "FILLER"}}},
+							Status:     &immunizationpb.Immunization_StatusCode{Value: vspb.ImmunizationStatusCodesValueSet_COMPLETED},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Influenza</p><p>0.5 mL IM</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							VaccineCode: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Code:    &dpb.Code{Value: "141"},
+									Display: &dpb.String{Value: "Influenza"},
+								}},
+							},
+							Patient: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									PatientId: &dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Occurrence: &immunizationpb.Immunization_OccurrenceX{
+								Choice: &immunizationpb.Immunization_OccurrenceX_DateTime{
+									DateTime: &dpb.DateTime{ValueUs: laterMicros, Precision: dpb.DateTime_SECOND},
+								},
+							},
+							PrimarySource: &dpb.Boolean{Value: true},
+							Manufacturer:  &dpb.Reference{Display: &dpb.String{Value: "GlaxoSmithKline"}},
+							LotNumber:     &dpb.String{Value: "LOT-1"},
+							Site:          &dpb.CodeableConcept{Text: &dpb.String{Value: "LD"}},
+							Route:         &dpb.CodeableConcept{Text: &dpb.String{Value: "IM"}},
+							DoseQuantity: &dpb.SimpleQuantity{
+								Value: &dpb.Decimal{Value: "0.5"},
+								Unit:  &dpb.String{Value: "mL"},
+							},
+							Performer: []*immunizationpb.Immunization_Performer{{
+								Actor: &dpb.Reference{
+									Reference: &dpb.Reference_PractitionerId{
+										PractitionerId: &dpb.ReferenceId{Value: "2"},
+									},
+									Display: &dpb.String{Value: "Doctor Doctorson"},
+								},
+							}},
+						},
+					},
+				},
+			}},
+		},
+	}, {
+		name:       "Patient with insurance",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Insurances: []*ir.Insurance{{
+				Plan: &ir.CodedElement{
+					ID:           "ACME-PPO",
+					Text:         "Acme PPO",
+					CodingSystem: "SYSTEM",
+				},
+				CompanyID:     "ACME",
+				CompanyName:   "Acme Health",
+				GroupNumber:   "G-100",
+				EffectiveDate: now,
+				PlanType:      "PPO",
+				Insured: &ir.AssociatedParty{
+					Person: &ir.Person{
+						FirstName: "Pedro",
+						Surname:   "Mogollon",
+					},
+					Relationship: &ir.CodedElement{
+						ID:           "SPO",
+						Text:         "Spouse",
+						CodingSystem: "SYSTEM",
+					},
+				},
+				PolicyNumber: "POL-1",
+				MemberNumber: "MEM-1",
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Coverage/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Coverage{
+						&coveragepb.Coverage{
+							Id:         &dpb.Id{Value: "2"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "MEM-1"}}},
+							Status:     &coveragepb.Coverage_StatusCode{Value: cpb.FinancialResourceStatusCode_ACTIVE},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Acme PPO</p><p>Acme Health</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Type:         &dpb.CodeableConcept{Text: &dpb.String{Value: "PPO"}},
+							PolicyHolder: &dpb.Reference{Display: &dpb.String{Value: "Pedro Mogollon"}},
+							Subscriber:   &dpb.Reference{Display: &dpb.String{Value: "Pedro Mogollon"}},
+							SubscriberId: &dpb.String{Value: "POL-1"},
+							Beneficiary: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									PatientId: &dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Relationship: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Code:    &dpb.Code{Value: "SPO"},
+									Display: &dpb.String{Value: "Spouse"},
+								}},
+							},
+							Period: &dpb.Period{Start: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND}},
+							Payor:  []*dpb.Reference{{Display: &dpb.String{Value: "Acme Health"}}},
+							ClassValue: []*coveragepb.Coverage_Class{{
+								Type: &dpb.CodeableConcept{
+									Coding: []*dpb.Coding{{
+										System: &dpb.Uri{Value: "http://terminology.hl7.org/CodeSystem/coverage-class"},
+										Code:   &dpb.Code{Value: "plan"},
+									}},
+								},
+								Value: &dpb.String{Value: "ACME-PPO"},
+								Name:  &dpb.String{Value: "Acme PPO"},
+							}, {
+								Type: &dpb.CodeableConcept{
+									Coding: []*dpb.Coding{{
+										System: &dpb.Uri{Value: "http://terminology.hl7.org/CodeSystem/coverage-class"},
+										Code:   &dpb.Code{Value: "group"},
+									}},
+								},
+								Value: &dpb.String{Value: "G-100"},
+							}},
+						},
+					},
+				},
+			}},
+		},
+	}, {
+		name:       "Patient with a specimen",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Class: "IMP",
+			Encounters: []*ir.Encounter{{
+				Status:      constants.EncounterStatusInProgress,
+				StatusStart: now,
+				Start:       now,
+				Orders: []*ir.Order{{
+					OrderProfile: &ir.CodedElement{
+						ID:           "PROFILE_ID",
+						Text:         "PROFILE",
+						CodingSystem: "SYSTEM",
+					},
+					Placer:        "PLACER",
+					OrderStatus:   "CM",
+					OrderDateTime: now,
+					Specimen: &ir.Specimen{
+						Type:              &ir.CodedElement{ID: "BLD", Text: "Whole blood", CodingSystem: "SYSTEM"},
+						CollectionMethod:  &ir.CodedElement{ID: "VENIP", Text: "Venipuncture", CodingSystem: "SYSTEM"},
+						BodySite:          &ir.CodedElement{ID: "LACF", Text: "Left Antecubital Fossa", CodingSystem: "SYSTEM"},
+						Container:         &ir.CodedElement{ID: "EDTA", Text: "EDTA tube", CodingSystem: "SYSTEM"},
+						CollectedDateTime: now,
+						ReceivedDateTime:  later,
+					},
+					Results: []*ir.Result{{
+						TestName: &ir.CodedElement{
+							ID:           "TEST_ID_1",
+							Text:         "TEST_NAME_1",
+							CodingSystem: "SYSTEM",
+						},
+						Value:  "VALUE",
+						Unit:   "UNIT",
+						Status: "F",
+					}},
+				}},
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Encounter/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Encounter{
+						&encounterpb.Encounter{
+							Id: &dpb.Id{Value: "2"},
+							ClassValue: &dpb.Coding{
+								Code: &dpb.Code{Value: "IMP"},
+							},
+							Status: &encounterpb.Encounter_StatusCode{Value: cpb.EncounterStatusCode_IN_PROGRESS},
+							Period: &dpb.Period{
+								Start: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>Status: in-progress</p><p>Active from Mon Feb 12 00:00:00 2018</p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "ServiceRequest/3"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_ServiceRequest{
+						&srpb.ServiceRequest{
+							Id:         &dpb.Id{Value: "3"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "PLACER"}}},
+							Status:     &srpb.ServiceRequest_StatusCode{Value: cpb.RequestStatusCode_COMPLETED},
+							Intent:     &srpb.ServiceRequest_IntentCode{Value: cpb.RequestIntentCode_ORDER},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "PROFILE_ID"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "PROFILE"},
+								}},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "2"}},
+							},
+							AuthoredOn: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>PROFILE</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Specimen/4"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Specimen{
+						&specimenpb.Specimen{
+							Id:         &dpb.Id{Value: "4"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "PLACER"}}},
+							Status:     &specimenpb.Specimen_StatusCode{Value: cpb.SpecimenStatusCode_AVAILABLE},
+							Type: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "BLD"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "Whole blood"},
+								}},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							ReceivedTime: &dpb.DateTime{ValueUs: laterMicros, Precision: dpb.DateTime_SECOND},
+							Request: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "3"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
+							Collection: &specimenpb.Specimen_Collection{
+								Collected: &specimenpb.Specimen_Collection_CollectedX{
+									Choice: &specimenpb.Specimen_Collection_CollectedX_DateTime{
+										&dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+									},
+								},
+								Method: &dpb.CodeableConcept{
+									Coding: []*dpb.Coding{{
+										Code:    &dpb.Code{Value: "VENIP"},
+										System:  &dpb.Uri{Value: "SYSTEM_URI"},
+										Display: &dpb.String{Value: "Venipuncture"},
+									}},
+								},
+								BodySite: &dpb.CodeableConcept{
+									Coding: []*dpb.Coding{{
+										Code:    &dpb.Code{Value: "LACF"},
+										System:  &dpb.Uri{Value: "SYSTEM_URI"},
+										Display: &dpb.String{Value: "Left Antecubital Fossa"},
+									}},
+								},
+							},
+							Container: []*specimenpb.Specimen_Container{{
+								Type: &dpb.CodeableConcept{
+									Coding: []*dpb.Coding{{
+										Code:    &dpb.Code{Value: "EDTA"},
+										System:  &dpb.Uri{Value: "SYSTEM_URI"},
+										Display: &dpb.String{Value: "EDTA tube"},
+									}},
+								},
+							}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Whole blood</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Observation/5"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Observation{
+						&observationpb.Observation{
+							Id: &dpb.Id{Value: "5"},
+							BasedOn: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "3"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "TEST_ID_1"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "TEST_NAME_1"},
+								}},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "2"}},
+							},
+							Specimen: &dpb.Reference{
+								Reference: &dpb.Reference_SpecimenId{&dpb.ReferenceId{Value: "4"}},
+								Display:   &dpb.String{Value: "Whole blood"},
+							},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>TEST_NAME_1: VALUE UNIT</p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Status: &observationpb.Observation_StatusCode{Value: cpb.ObservationStatusCode_FINAL},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Value: &observationpb.Observation_ValueX{
+								Choice: &observationpb.Observation_ValueX_Quantity{
+									&dpb.Quantity{Value: &dpb.Decimal{Value: "VALUE"}, Unit: &dpb.String{Value: "UNIT"}},
+								},
+							},
+							Effective: &observationpb.Observation_EffectiveX{
+								Choice: &observationpb.Observation_EffectiveX_DateTime{
+									&dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}, {
+		name:       "Patient with a culture",
+		bundleType: Collection,
+		patientInfo: &ir.PatientInfo{
+			Person: &ir.Person{
+				MRN:       "8888",
+				FirstName: "Elisa",
+				Surname:   "Mogollon",
+				Address: &ir.Address{
+					FirstLine:  "FIRST_LINE",
+					City:       "CITY",
+					Country:    "COUNTRY",
+					PostalCode: "ABC DEF",
+					Type:       "UNKNOWN",
+				},
+			},
+			Class: "IMP",
+			Encounters: []*ir.Encounter{{
+				Status:      constants.EncounterStatusInProgress,
+				StatusStart: now,
+				Start:       now,
+				Orders: []*ir.Order{{
+					OrderProfile: &ir.CodedElement{
+						ID:           "PROFILE_ID",
+						Text:         "PROFILE",
+						CodingSystem: "SYSTEM",
+					},
+					Placer:        "PLACER",
+					OrderStatus:   "CM",
+					OrderDateTime: now,
+					Results:       []*ir.Result{organism},
+					Children: []*ir.ChildOrder{{
+						UniversalService: &ir.CodedElement{ID: "29576-6", Text: "Bacterial susceptibility panel", CodingSystem: "SYSTEM"},
+						ParentResult:     organism,
+						Results: []*ir.Result{{
+							TestName: &ir.CodedElement{
+								ID:           "AMP",
+								Text:         "Ampicillin",
+								CodingSystem: "SYSTEM",
+							},
+							Value:        "16",
+							Unit:         "mg/L",
+							AbnormalFlag: "R",
+							Status:       "F",
+						}},
+					}},
+				}},
+			}},
+		},
+		want: &r4pb.Bundle{
+			Type: &r4pb.Bundle_TypeCode{Value: cpb.BundleTypeCode_COLLECTION},
+			Entry: []*r4pb.Bundle_Entry{{
+				FullUrl: &dpb.Uri{Value: "Patient/1"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Patient{
+						&patientpb.Patient{
+							Id:         &dpb.Id{Value: "1"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "8888"}}},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>Elisa Mogollon</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Name: []*dpb.HumanName{{
+								Family: &dpb.String{Value: "Mogollon"},
+								Given:  []*dpb.String{{Value: "Elisa"}},
+							}},
+							Gender: &patientpb.Patient_GenderCode{Value: cpb.AdministrativeGenderCode_UNKNOWN},
+							Address: []*dpb.Address{{
+								Line:       []*dpb.String{{Value: "FIRST_LINE"}},
+								City:       &dpb.String{Value: "CITY"},
+								Country:    &dpb.String{Value: "COUNTRY"},
+								PostalCode: &dpb.String{Value: "ABC DEF"},
+								Type:       &dpb.Address_TypeCode{Value: cpb.AddressTypeCode_BOTH},
+								Use:        &dpb.Address_UseCode{Value: cpb.AddressUseCode_INVALID_UNINITIALIZED},
+							}},
+							Deceased: &patientpb.Patient_DeceasedX{
+								Choice: &patientpb.Patient_DeceasedX_Boolean{
+									Boolean: &dpb.Boolean{
+										Value: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Encounter/2"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Encounter{
+						&encounterpb.Encounter{
+							Id: &dpb.Id{Value: "2"},
+							ClassValue: &dpb.Coding{
+								Code: &dpb.Code{Value: "IMP"},
+							},
+							Status: &encounterpb.Encounter_StatusCode{Value: cpb.EncounterStatusCode_IN_PROGRESS},
+							Period: &dpb.Period{
+								Start: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>Status: in-progress</p><p>Active from Mon Feb 12 00:00:00 2018</p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "ServiceRequest/3"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_ServiceRequest{
+						&srpb.ServiceRequest{
+							Id:         &dpb.Id{Value: "3"},
+							Identifier: []*dpb.Identifier{{Value: &dpb.String{Value: "PLACER"}}},
+							Status:     &srpb.ServiceRequest_StatusCode{Value: cpb.RequestStatusCode_COMPLETED},
+							Intent:     &srpb.ServiceRequest_IntentCode{Value: cpb.RequestIntentCode_ORDER},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "PROFILE_ID"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "PROFILE"},
+								}},
+							},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "2"}},
+							},
+							AuthoredOn: &dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+							Text: &dpb.Narrative{
+								Div:    &dpb.Xhtml{Value: "<div><p>PROFILE</p></div>"},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Observation/4"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Observation{
+						&observationpb.Observation{
+							Id: &dpb.Id{Value: "4"},
+							BasedOn: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "3"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "600-7"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "Bacteria identified"},
+								}},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "2"}},
+							},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>Bacteria identified: Escherichia coli </p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Status: &observationpb.Observation_StatusCode{Value: cpb.ObservationStatusCode_FINAL},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Value: &observationpb.Observation_ValueX{
+								Choice: &observationpb.Observation_ValueX_CodeableConcept{
+									&dpb.CodeableConcept{
+										Coding: []*dpb.Coding{{
+											Code:    &dpb.Code{Value: "112283007"},
+											System:  &dpb.Uri{Value: "SYSTEM_URI"},
+											Display: &dpb.String{Value: "Escherichia coli"},
+										}},
+									},
+								},
+							},
+							Effective: &observationpb.Observation_EffectiveX{
+								Choice: &observationpb.Observation_EffectiveX_DateTime{
+									&dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+								},
+							},
+						},
+					},
+				},
+			}, {
+				FullUrl: &dpb.Uri{Value: "Observation/5"},
+				Resource: &r4pb.ContainedResource{
+					OneofResource: &r4pb.ContainedResource_Observation{
+						&observationpb.Observation{
+							Id: &dpb.Id{Value: "5"},
+							BasedOn: []*dpb.Reference{{
+								Reference: &dpb.Reference_ServiceRequestId{&dpb.ReferenceId{Value: "3"}},
+								Display:   &dpb.String{Value: "PROFILE"},
+							}},
+							Code: &dpb.CodeableConcept{
+								Coding: []*dpb.Coding{{
+									Code:    &dpb.Code{Value: "AMP"},
+									System:  &dpb.Uri{Value: "SYSTEM_URI"},
+									Display: &dpb.String{Value: "Ampicillin"},
+								}},
+							},
+							Encounter: &dpb.Reference{
+								Reference: &dpb.Reference_EncounterId{&dpb.ReferenceId{Value: "2"}},
+							},
+							Text: &dpb.Narrative{
+								Div: &dpb.Xhtml{
+									Value: "<div><p>Ampicillin: 16 mg/L (R)</p></div>",
+								},
+								Status: &dpb.Narrative_StatusCode{Value: cpb.NarrativeStatusCode_GENERATED},
+							},
+							Status: &observationpb.Observation_StatusCode{Value: cpb.ObservationStatusCode_FINAL},
+							Subject: &dpb.Reference{
+								Reference: &dpb.Reference_PatientId{
+									&dpb.ReferenceId{Value: "1"},
+								},
+								Display: &dpb.String{Value: "Elisa Mogollon"},
+							},
+							Value: &observationpb.Observation_ValueX{
+								Choice: &observationpb.Observation_ValueX_Quantity{
+									&dpb.Quantity{Value: &dpb.Decimal{Value: "16"}, Unit: &dpb.String{Value: "mg/L"}},
+								},
+							},
+							DerivedFrom: []*dpb.Reference{{
+								Reference: &dpb.Reference_ObservationId{&dpb.ReferenceId{Value: "4"}},
+							}},
+							Effective: &observationpb.Observation_EffectiveX{
+								Choice: &observationpb.Observation_EffectiveX_DateTime{
+									&dpb.DateTime{ValueUs: nowMicros, Precision: dpb.DateTime_SECOND},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			cfg := BundlerConfig{
+				HL7Config: &config.HL7Config{
+					Gender: config.Gender{Male: "M", Female: "F"},
+					ResultStatus: config.ResultStatus{
+						Final:     "F",
+						Corrected: "C",
+					},
+					OrderStatus: config.OrderStatus{
+						Completed: "CM",
+						InProcess: "IP",
+					},
+					Allergy: config.HL7Allergy{
+						Types:      []string{"FOOD", "MEDICATION"},
+						Severities: []string{"MILD", "MODERATE", "SEVERE"},
+					},
+					Mapping: config.CodeMapping{
+						FHIR: config.FHIRMapping{
+							CodingSystems: map[string]string{"SYSTEM": "SYSTEM_URI"},
+							AllergySeverities: map[string][]string{
+								"Severe":   {"SEVERE"},
+								"Moderate": {"MODERATE"},
+								"Mild":     {"MILD"},
+							},
+							AllergyTypes: map[string][]string{
+								"Food":       {"FOOD"},
+								"Medication": {"MEDICATION"},
+							},
+						},
+					},
+				},
+				IDGenerator: &testid.Generator{},
+				BundleType:  tc.bundleType,
+			}
+			bundler, err := NewBundler(cfg)
+			if err != nil {
+				t.Fatalf("NewBundler(%v) failed with: %v", cfg, err)
+			}
+			w := &Writer{
+				Bundler:    bundler,
+				Output:     &testfhir.ByteOutput{Bytes: &b},
+				Marshaller: prototext.MarshalOptions{},
+			}
+			if err := w.Generate(tc.patientInfo); err != nil {
+				t.Fatalf("w.Generate(%v) failed with: %v", tc.patientInfo, err)
+			}
+			if err := w.Close(); err != nil {
+				t.Errorf("w.Close() failed with: %v", err)
+			}
+
+			got := &r4pb.Bundle{}
+			if err := prototext.Unmarshal(b.Bytes(), got); err != nil {
+				t.Fatalf("prototext.Unmarshal(%v, %v) failed with: %v", b.String(), got, err)
+			}
+
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("w.Generate(%v) returned diff (-want +got):\n%s", tc.patientInfo, diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_ObservationRevisions(t *testing.T) {
+	cfg := BundlerConfig{
+		HL7Config: &config.HL7Config{
+			ResultStatus: config.ResultStatus{Final: "F", Corrected: "C"},
+		},
+		IDGenerator: &testid.Generator{},
+		BundleType:  "BATCH",
+	}
+	bundler, err := NewBundler(cfg)
+	if err != nil {
+		t.Fatalf("NewBundler(%v) failed with: %v", cfg, err)
+	}
+	result := &ir.Result{
+		TestName: &ir.CodedElement{ID: "TEST_ID", Text: "TEST_NAME", CodingSystem: "SYSTEM"},
+		Value:    "VALUE",
+		Unit:     "UNIT",
+		Status:   "F",
+	}
+	order := &ir.Order{
+		OrderProfile:  &ir.CodedElement{ID: "PROFILE_ID", Text: "PROFILE", CodingSystem: "SYSTEM"},
+		OrderDateTime: now,
+		Results:       []*ir.Result{result},
+	}
+	patientInfo := &ir.PatientInfo{
+		Person:     &ir.Person{FirstName: "William", Surname: "Burr", MRN: "1234", Address: &ir.Address{City: "CITY"}},
+		Encounters: []*ir.Encounter{{Orders: []*ir.Order{order}}},
+	}
+
+	// observation returns the only Observation in the bundle of the patient.
+	observation := func() *observationpb.Observation {
+		t.Helper()
+		bundle, err := bundler.Generate(patientInfo)
+		if err != nil {
+			t.Fatalf("Generate(%v) failed with: %v", patientInfo, err)
+		}
+		var observations []*observationpb.Observation
+		for _, e := range bundle.GetEntry() {
+			if o := e.GetResource().GetObservation(); o != nil {
+				observations = append(observations, o)
+			}
+		}
+		if len(observations) != 1 {
+			t.Fatalf("Generate(%v) got %d observations, want 1", patientInfo, len(observations))
+		}
+		return observations[0]
+	}
+
+	first := observation()
+	if first.GetMeta() != nil {
+		t.Errorf("Meta of the first version got %v, want nil", first.GetMeta())
+	}
+
+	// A revision of the result replaces it in the order, as the order generator does.
+	revised := *result
+	revised.Value = "NEW_VALUE"
+	revised.Status = "C"
+	revised.Revision = 1
+	order.Results = []*ir.Result{&revised}
+	second := observation()
+
+	if got, want := second.GetId().GetValue(), first.GetId().GetValue(); got != want {
+		t.Errorf("Observation ID of the revision got %q, want %q", got, want)
+	}
+	if got, want := second.GetMeta().GetVersionId().GetValue(), "2"; got != want {
+		t.Errorf("Observation versionId of the revision got %q, want %q", got, want)
+	}
+	if got, want := second.GetStatus().GetValue(), cpb.ObservationStatusCode_AMENDED; got != want {
+		t.Errorf("Observation status of the revision got %v, want %v", got, want)
 	}
 }
@@ -54,6 +54,10 @@ func ServiceRequestRef(id string) *pb.Reference {
 	return &pb.Reference{Reference: &pb.Reference_ServiceRequestId{refID(id)}}
 }
 
+func SpecimenRef(id string) *pb.Reference {
+	return &pb.Reference{Reference: &pb.Reference_SpecimenId{refID(id)}}
+}
+
 func EncounterRef(id string) *pb.Reference {
 	return &pb.Reference{Reference: &pb.Reference_EncounterId{refID(id)}}
 }
@@ -73,3 +77,7 @@ func ProcedureRef(id string) *pb.Reference {
 func ConditionRef(id string) *pb.Reference {
 	return &pb.Reference{Reference: &pb.Reference_ConditionId{refID(id)}}
 }
+
+func MedicationRequestRef(id string) *pb.Reference {
+	return &pb.Reference{Reference: &pb.Reference_MedicationRequestId{refID(id)}}
+}
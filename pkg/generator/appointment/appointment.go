@@ -0,0 +1,159 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appointment contains functions needed to generate an ir.Appointment object.
+package appointment
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/doctor"
+	"github.com/bitcrshr/simhospital/pkg/generator/id"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/location"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultDuration is the duration of appointments if the pathway doesn't set one.
+	defaultDuration = 30 * time.Minute
+	// maxDaysFromNow is the maximum number of days between the step and the appointment, if the
+	// pathway doesn't set when the appointment is.
+	maxDaysFromNow = 28
+	// slot is the granularity of the appointment times that are picked at random.
+	slot = 15 * time.Minute
+	// reasonCodingSystem is the coding system of the SCH.7-Appointment Reason field.
+	reasonCodingSystem = "HL70276"
+	// typeCodingSystem is the coding system of the SCH.8-Appointment Type field.
+	typeCodingSystem = "HL70277"
+)
+
+// Generator generates appointments.
+type Generator struct {
+	AppointmentConfig *config.HL7Appointment
+	// HospitalService is the service of the appointments with doctors without a specialty.
+	HospitalService string
+	Doctors         *doctor.Doctors
+	LocationManager *location.Manager
+	PlacerGenerator id.Generator
+	FillerGenerator id.Generator
+}
+
+// Appointment returns a booked Appointment from the given configuration.
+// See pathway.Appointment for information on how every field is populated.
+// Returns an error if the location or the doctor don't exist.
+func (g *Generator) Appointment(eventTime time.Time, a *pathway.Appointment) (*ir.Appointment, error) {
+	loc, err := g.location(a.Loc)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := g.doctor(a.DoctorID)
+	if err != nil {
+		return nil, err
+	}
+	service := a.Service
+	if service == "" && doc != nil {
+		service = doc.Specialty
+	}
+	if service == "" {
+		service = g.HospitalService
+	}
+	duration := defaultDuration
+	if a.Duration != nil {
+		duration = *a.Duration
+	}
+	return &ir.Appointment{
+		PlacerAppointmentID: g.PlacerGenerator.NewID(),
+		FillerAppointmentID: g.FillerGenerator.NewID(),
+		AppointmentReason:   codedElement(a.Reason, g.AppointmentConfig.Reasons, reasonCodingSystem),
+		AppointmentType:     codedElement(a.Type, g.AppointmentConfig.Types, typeCodingSystem),
+		FillerStatus:        g.AppointmentConfig.FillerStatus.Booked,
+		Start:               ir.NewValidTime(startTime(eventTime, a.TimeFromNow)),
+		DurationMinutes:     int(duration.Minutes()),
+		Service:             &ir.CodedElement{ID: service, Text: service},
+		Location:            loc,
+		Doctor:              doc,
+	}, nil
+}
+
+// Reschedule updates an existing appointment in place based on a pathway.RescheduleAppointment
+// configuration. The appointment is booked again if it was not booked.
+// Returns an error if the new location doesn't exist.
+func (g *Generator) Reschedule(eventTime time.Time, am *ir.Appointment, r *pathway.RescheduleAppointment) error {
+	if r.Loc != "" {
+		loc, err := g.location(r.Loc)
+		if err != nil {
+			return err
+		}
+		am.Location = loc
+	}
+	if r.Duration != nil {
+		am.DurationMinutes = int(r.Duration.Minutes())
+	}
+	am.Start = ir.NewValidTime(startTime(eventTime, r.TimeFromNow))
+	am.EventReason = r.Reason
+	am.FillerStatus = g.AppointmentConfig.FillerStatus.Booked
+	return nil
+}
+
+func (g *Generator) location(name string) (*ir.PatientLocation, error) {
+	if g.LocationManager == nil {
+		return nil, errors.New("cannot pick a clinic: no location manager")
+	}
+	if name != "" {
+		return g.LocationManager.Clinic(name)
+	}
+	if loc := g.LocationManager.RandomClinic(); loc != nil {
+		return loc, nil
+	}
+	return nil, errors.New("cannot pick a clinic: there are no locations")
+}
+
+// doctor returns the doctor with the given ID, or a random doctor if id is empty.
+// The random doctor is nil if there are no doctors.
+func (g *Generator) doctor(id string) (*ir.Doctor, error) {
+	if id == "" {
+		return g.Doctors.GetRandomDoctor(), nil
+	}
+	if d := g.Doctors.GetByID(id); d != nil {
+		return d, nil
+	}
+	return nil, fmt.Errorf("unknown doctor ID %q", id)
+}
+
+// startTime returns the time of an appointment booked at eventTime. If timeFromNow is nil, the
+// appointment is in the following maxDaysFromNow days, at a time that is a multiple of slot.
+func startTime(eventTime time.Time, timeFromNow *time.Duration) time.Time {
+	if timeFromNow != nil {
+		return eventTime.Add(*timeFromNow)
+	}
+	d := time.Duration(1+rand.Intn(maxDaysFromNow)) * 24 * time.Hour
+	return eventTime.Add(d).Truncate(slot)
+}
+
+// codedElement returns a coded element with the given value, or with a random value from values if
+// value is empty. Returns nil if both value and values are empty.
+func codedElement(value string, values []string, codingSystem string) *ir.CodedElement {
+	if value == "" && len(values) > 0 {
+		value = values[rand.Intn(len(values))]
+	}
+	if value == "" {
+		return nil
+	}
+	return &ir.CodedElement{ID: value, Text: value, CodingSystem: codingSystem}
+}
@@ -0,0 +1,201 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appointment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/bitcrshr/simhospital/pkg/test/testid"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	placerID = "placer-1"
+	fillerID = "filler-1"
+)
+
+var (
+	date              = time.Date(2018, 2, 12, 1, 25, 0, 0, time.UTC)
+	appointmentConfig = config.HL7Appointment{
+		Reasons: []string{"ROUTINE"},
+		Types:   []string{"Normal"},
+		FillerStatus: config.AppointmentFillerStatus{
+			Booked:    "Booked",
+			Cancelled: "Cancelled",
+			NoShow:    "Noshow",
+		},
+	}
+)
+
+func TestAppointment(t *testing.T) {
+	g := testGenerator(t)
+	timeFromNow := 2 * time.Hour
+	duration := 45 * time.Minute
+	renal, err := g.LocationManager.Clinic("Renal")
+	if err != nil {
+		t.Fatalf("Clinic(%q) failed with %v", "Renal", err)
+	}
+
+	tests := []struct {
+		name  string
+		input *pathway.Appointment
+		want  *ir.Appointment
+	}{{
+		name: "Fixed values",
+		input: &pathway.Appointment{
+			Loc:         "Renal",
+			DoctorID:    "id-1",
+			TimeFromNow: &timeFromNow,
+			Duration:    &duration,
+			Reason:      "CHECKUP",
+			Type:        "Tentative",
+			Service:     "NEPH",
+		},
+		want: &ir.Appointment{
+			PlacerAppointmentID: placerID,
+			FillerAppointmentID: fillerID,
+			AppointmentReason:   &ir.CodedElement{ID: "CHECKUP", Text: "CHECKUP", CodingSystem: reasonCodingSystem},
+			AppointmentType:     &ir.CodedElement{ID: "Tentative", Text: "Tentative", CodingSystem: typeCodingSystem},
+			FillerStatus:        "Booked",
+			Start:               ir.NewValidTime(date.Add(timeFromNow)),
+			DurationMinutes:     45,
+			Service:             &ir.CodedElement{ID: "NEPH", Text: "NEPH"},
+			Location:            renal,
+			Doctor:              g.Doctors.GetByID("id-1"),
+		},
+	}, {
+		name: "Default values",
+		input: &pathway.Appointment{
+			// The location and the doctor are fixed so that we can do assertions on the entire appointment.
+			// TestAppointment_RandomValues tests unspecified values.
+			Loc:         "Renal",
+			DoctorID:    "id-2",
+			TimeFromNow: &timeFromNow,
+		},
+		want: &ir.Appointment{
+			PlacerAppointmentID: "placer-2",
+			FillerAppointmentID: "filler-2",
+			AppointmentReason:   &ir.CodedElement{ID: "ROUTINE", Text: "ROUTINE", CodingSystem: reasonCodingSystem},
+			AppointmentType:     &ir.CodedElement{ID: "Normal", Text: "Normal", CodingSystem: typeCodingSystem},
+			FillerStatus:        "Booked",
+			Start:               ir.NewValidTime(date.Add(timeFromNow)),
+			DurationMinutes:     30,
+			Service:             &ir.CodedElement{ID: "specialty-2", Text: "specialty-2"},
+			Location:            renal,
+			Doctor:              g.Doctors.GetByID("id-2"),
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := g.Appointment(date, tc.input)
+			if err != nil {
+				t.Fatalf("Appointment(%v, %+v) failed with %v", date, tc.input, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Appointment(%v, %+v) got diff (-want, +got):\n%s", date, tc.input, diff)
+			}
+		})
+	}
+}
+
+func TestAppointment_RandomValues(t *testing.T) {
+	g := testGenerator(t)
+	for i := 0; i < 100; i++ {
+		got, err := g.Appointment(date, &pathway.Appointment{})
+		if err != nil {
+			t.Fatalf("Appointment(%v, %+v) failed with %v", date, &pathway.Appointment{}, err)
+		}
+		start := got.Start.Time
+		if min, max := date.Add(24*time.Hour-slot), date.Add(maxDaysFromNow*24*time.Hour); start.Before(min) || start.After(max) {
+			t.Errorf("got.Start=%v, want between %v and %v", start, min, max)
+		}
+		if start.Truncate(slot) != start {
+			t.Errorf("got.Start=%v, want a multiple of %v", start, slot)
+		}
+		if got.Location == nil {
+			t.Error("got.Location is <nil>, want non nil")
+		}
+		if got.Doctor == nil {
+			t.Error("got.Doctor is <nil>, want non nil")
+		}
+	}
+}
+
+func TestAppointment_Error(t *testing.T) {
+	g := testGenerator(t)
+	tests := []struct {
+		name  string
+		input *pathway.Appointment
+	}{
+		{name: "Unknown location", input: &pathway.Appointment{Loc: "unknown"}},
+		{name: "Unknown doctor", input: &pathway.Appointment{DoctorID: "unknown"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := g.Appointment(date, tc.input); err == nil {
+				t.Errorf("Appointment(%v, %+v) got nil error, want non nil", date, tc.input)
+			}
+		})
+	}
+}
+
+func TestReschedule(t *testing.T) {
+	g := testGenerator(t)
+	timeFromNow := time.Hour
+	duration := time.Hour
+	am, err := g.Appointment(date, &pathway.Appointment{Loc: "ED"})
+	if err != nil {
+		t.Fatalf("Appointment(%v, %+v) failed with %v", date, &pathway.Appointment{Loc: "ED"}, err)
+	}
+	am.FillerStatus = "Pending"
+
+	r := &pathway.RescheduleAppointment{Loc: "Renal", TimeFromNow: &timeFromNow, Duration: &duration, Reason: "Clinic closed"}
+	if err := g.Reschedule(date, am, r); err != nil {
+		t.Fatalf("Reschedule(%v, %v, %+v) failed with %v", date, am, r, err)
+	}
+	if got, want := am.Location.Poc, "RenalWard"; got != want {
+		t.Errorf("am.Location.Poc=%v, want %v", got, want)
+	}
+	if got, want := am.Start, ir.NewValidTime(date.Add(timeFromNow)); got != want {
+		t.Errorf("am.Start=%v, want %v", got, want)
+	}
+	if got, want := am.DurationMinutes, 60; got != want {
+		t.Errorf("am.DurationMinutes=%v, want %v", got, want)
+	}
+	if got, want := am.EventReason, "Clinic closed"; got != want {
+		t.Errorf("am.EventReason=%v, want %v", got, want)
+	}
+	if got, want := am.FillerStatus, "Booked"; got != want {
+		t.Errorf("am.FillerStatus=%v, want %v", got, want)
+	}
+}
+
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	return &Generator{
+		AppointmentConfig: &appointmentConfig,
+		HospitalService:   "180",
+		Doctors:           testconfig.Doctors(t),
+		LocationManager:   testconfig.LocationManager(t),
+		PlacerGenerator:   &testid.Generator{Prefix: "placer-"},
+		FillerGenerator:   &testid.Generator{Prefix: "filler-"},
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package billing contains functions needed to generate ir.Account and ir.Charge objects.
+package billing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/chargemaster"
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/generator/id"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+)
+
+const (
+	// relationshipCodingSystem is the coding system of the guarantor relationships.
+	relationshipCodingSystem = "HL70063"
+	day                      = 24 * time.Hour
+)
+
+// Generator generates accounts and charges.
+type Generator struct {
+	BillingConfig        *config.HL7Billing
+	Chargemaster         *chargemaster.Chargemaster
+	AccountGenerator     id.Generator
+	TransactionGenerator id.Generator
+}
+
+// Account returns a new Account that starts at eventTime.
+func (g *Generator) Account(eventTime time.Time) *ir.Account {
+	return &ir.Account{
+		Number:        g.AccountGenerator.NewID(),
+		StartDateTime: ir.NewValidTime(eventTime),
+	}
+}
+
+// SelfGuarantor returns a guarantor that is the given person.
+func (g *Generator) SelfGuarantor(p *ir.Person) *ir.AssociatedParty {
+	return &ir.AssociatedParty{
+		Person: p,
+		Relationship: &ir.CodedElement{
+			ID:           g.BillingConfig.SelfRelationship,
+			Text:         g.BillingConfig.SelfRelationship,
+			CodingSystem: relationshipCodingSystem,
+		},
+	}
+}
+
+// Charges returns the charges to post to the account of the patient.
+// If c.Code is set, it returns a single charge for that item of the chargemaster. Otherwise, it
+// returns one charge for every order, procedure and night in a bed of the latest encounter of the
+// patient that matches an item of the chargemaster and has not been charged to the account yet.
+// Returns an error if c.Code is not in the chargemaster.
+func (g *Generator) Charges(eventTime time.Time, p *ir.PatientInfo, account *ir.Account, c *pathway.Charge) ([]*ir.Charge, error) {
+	if c.Code != "" {
+		item, ok := g.item(c.Code)
+		if !ok {
+			return nil, fmt.Errorf("unknown chargemaster item %q", c.Code)
+		}
+		quantity := c.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		charge := g.charge(eventTime, item, quantity)
+		charge.Location = p.Location
+		charge.PerformedBy = p.AttendingDoctor
+		return []*ir.Charge{charge}, nil
+	}
+
+	ec := p.LatestEncounter()
+	if ec == nil || g.Chargemaster == nil {
+		return nil, nil
+	}
+	charged := map[string]bool{}
+	for _, ch := range account.Charges {
+		charged[ch.Source] = true
+	}
+	var charges []*ir.Charge
+	add := func(ch *ir.Charge) {
+		if !charged[ch.Source] {
+			charged[ch.Source] = true
+			charges = append(charges, ch)
+		}
+	}
+	for _, o := range ec.Orders {
+		if ch := g.orderCharge(eventTime, p, o); ch != nil {
+			add(ch)
+		}
+	}
+	for _, pr := range ec.Procedures {
+		if ch := g.procedureCharge(eventTime, p, pr); ch != nil {
+			add(ch)
+		}
+	}
+	for _, l := range ec.LocationHistory {
+		for _, ch := range g.bedDayCharges(eventTime, l) {
+			add(ch)
+		}
+	}
+	return charges, nil
+}
+
+func (g *Generator) orderCharge(eventTime time.Time, p *ir.PatientInfo, o *ir.Order) *ir.Charge {
+	var profile string
+	if o.OrderProfile != nil {
+		profile = o.OrderProfile.Text
+	}
+	item := g.Chargemaster.Match(chargemaster.Order, profile)
+	if item == nil {
+		return nil
+	}
+	charge := g.charge(eventTime, item, 1)
+	charge.TransactionDateTime = o.OrderDateTime
+	charge.Location = p.Location
+	charge.OrderedBy = o.OrderingProvider
+	charge.FillerOrderNumber = o.Filler
+	charge.Source = fmt.Sprintf("order:%s", o.Placer)
+	return charge
+}
+
+func (g *Generator) procedureCharge(eventTime time.Time, p *ir.PatientInfo, pr *ir.DiagnosisOrProcedure) *ir.Charge {
+	if pr.Description == nil {
+		return nil
+	}
+	item := g.Chargemaster.Match(chargemaster.Procedure, pr.Description.ID)
+	if item == nil {
+		return nil
+	}
+	charge := g.charge(eventTime, item, 1)
+	if pr.DateTime.Valid {
+		charge.TransactionDateTime = pr.DateTime
+	}
+	charge.Location = p.Location
+	charge.PerformedBy = pr.Clinician
+	charge.Procedure = pr.Description
+	charge.Source = fmt.Sprintf("procedure:%s@%s", pr.Description.ID, pr.DateTime.Format(time.RFC3339))
+	return charge
+}
+
+// bedDayCharges returns one charge for every midnight the patient spent in the bed of the given
+// location until eventTime. The charges are dated on the day before each midnight.
+func (g *Generator) bedDayCharges(eventTime time.Time, l *ir.LocationHistory) []*ir.Charge {
+	if l.Location == nil || l.Location.Bed == "" || !l.Start.Valid {
+		return nil
+	}
+	item := g.Chargemaster.Match(chargemaster.BedDay, l.Location.Poc)
+	if item == nil {
+		return nil
+	}
+	end := eventTime
+	if l.End.Valid && l.End.Before(end) {
+		end = l.End.Time
+	}
+	var charges []*ir.Charge
+	for midnight := l.Start.Truncate(day).Add(day); !midnight.After(end); midnight = midnight.Add(day) {
+		date := midnight.Add(-day)
+		charge := g.charge(eventTime, item, 1)
+		charge.TransactionDateTime = ir.NewMidnightTime(date)
+		charge.Location = l.Location
+		charge.Source = fmt.Sprintf("bed-day:%s", date.Format("2006-01-02"))
+		charges = append(charges, charge)
+	}
+	return charges
+}
+
+func (g *Generator) charge(eventTime time.Time, item *chargemaster.Item, quantity int) *ir.Charge {
+	code := item.Code
+	return &ir.Charge{
+		ID:                  g.TransactionGenerator.NewID(),
+		TransactionDateTime: ir.NewValidTime(eventTime),
+		PostingDateTime:     ir.NewValidTime(eventTime),
+		TransactionType:     g.BillingConfig.ChargeTransactionType,
+		Code:                &code,
+		Quantity:            quantity,
+		UnitAmount:          item.UnitAmount,
+	}
+}
+
+func (g *Generator) item(code string) (*chargemaster.Item, bool) {
+	if g.Chargemaster == nil {
+		return nil, false
+	}
+	return g.Chargemaster.Get(code)
+}
@@ -0,0 +1,219 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/bitcrshr/simhospital/pkg/test/testid"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	accountNumber = "account-1"
+)
+
+var (
+	date          = time.Date(2018, 2, 12, 1, 25, 0, 0, time.UTC)
+	billingConfig = config.HL7Billing{ChargeTransactionType: "CG", SelfRelationship: "SEL"}
+	doctor        = &ir.Doctor{ID: "id-1", Surname: "Doctorson", FirstName: "Doctor"}
+	renalBed      = &ir.PatientLocation{Poc: "RenalWard", Room: "Room-1", Bed: "Bed-1"}
+)
+
+func TestAccount(t *testing.T) {
+	g := testGenerator(t)
+	want := &ir.Account{Number: accountNumber, StartDateTime: ir.NewValidTime(date)}
+	if diff := cmp.Diff(want, g.Account(date)); diff != "" {
+		t.Errorf("Account(%v) got diff (-want, +got):\n%s", date, diff)
+	}
+}
+
+func TestSelfGuarantor(t *testing.T) {
+	g := testGenerator(t)
+	p := &ir.Person{FirstName: "Jane", Surname: "Smith"}
+	got := g.SelfGuarantor(p)
+	if got.Person != p {
+		t.Errorf("SelfGuarantor(%+v).Person got %+v, want %+v", p, got.Person, p)
+	}
+	want := &ir.CodedElement{ID: "SEL", Text: "SEL", CodingSystem: "HL70063"}
+	if diff := cmp.Diff(want, got.Relationship); diff != "" {
+		t.Errorf("SelfGuarantor(%+v).Relationship got diff (-want, +got):\n%s", p, diff)
+	}
+}
+
+func TestCharges_Code(t *testing.T) {
+	g := testGenerator(t)
+	p := &ir.PatientInfo{Location: renalBed, AttendingDoctor: doctor}
+	c := &pathway.Charge{Code: "SUP-DRESS", Quantity: 3}
+	got, err := g.Charges(date, p, &ir.Account{}, c)
+	if err != nil {
+		t.Fatalf("Charges(%v, %+v, _, %+v) failed with %v", date, p, c, err)
+	}
+	want := []*ir.Charge{{
+		ID:                  "transaction-1",
+		TransactionDateTime: ir.NewValidTime(date),
+		PostingDateTime:     ir.NewValidTime(date),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "SUP-DRESS", Text: "Dressing pack", CodingSystem: "WinPath"},
+		Quantity:            3,
+		UnitAmount:          12.4,
+		Location:            renalBed,
+		PerformedBy:         doctor,
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Charges(%v, %+v, _, %+v) got diff (-want, +got):\n%s", date, p, c, diff)
+	}
+}
+
+func TestCharges_UnknownCode(t *testing.T) {
+	g := testGenerator(t)
+	c := &pathway.Charge{Code: "UNKNOWN"}
+	if _, err := g.Charges(date, &ir.PatientInfo{}, &ir.Account{}, c); err == nil {
+		t.Errorf("Charges(%v, _, _, %+v) got nil error, want non nil", date, c)
+	}
+}
+
+func TestCharges_Automatic(t *testing.T) {
+	g := testGenerator(t)
+	procedureTime := ir.NewValidTime(date.Add(-time.Hour))
+	p := &ir.PatientInfo{
+		Location: renalBed,
+		Encounters: []*ir.Encounter{{
+			Orders: []*ir.Order{{
+				OrderProfile:     &ir.CodedElement{Text: "UREA AND ELECTROLYTES"},
+				Placer:           "placer-1",
+				Filler:           "filler-1",
+				OrderDateTime:    ir.NewValidTime(date.Add(-2 * time.Hour)),
+				OrderingProvider: doctor,
+			}, {
+				// There is no item for this order profile and no default item for orders.
+				OrderProfile: &ir.CodedElement{Text: "LIPID"},
+				Placer:       "placer-2",
+			}},
+			Procedures: []*ir.DiagnosisOrProcedure{{
+				Description: &ir.CodedElement{ID: "P24.9", Text: "Procedure1"},
+				DateTime:    procedureTime,
+				Clinician:   doctor,
+			}, {
+				// There is no item for this procedure and no default item for procedures.
+				Description: &ir.CodedElement{ID: "P25.8", Text: "Procedure2"},
+				DateTime:    procedureTime,
+			}},
+			LocationHistory: []*ir.LocationHistory{{
+				// The patient doesn't stay in a bed, so there are no bed-days.
+				Location: &ir.PatientLocation{Poc: "ED"},
+				Start:    ir.NewValidTime(time.Date(2018, 2, 9, 20, 0, 0, 0, time.UTC)),
+				End:      ir.NewValidTime(time.Date(2018, 2, 10, 10, 0, 0, 0, time.UTC)),
+			}, {
+				Location: renalBed,
+				Start:    ir.NewValidTime(time.Date(2018, 2, 10, 10, 0, 0, 0, time.UTC)),
+			}},
+		}},
+	}
+	account := &ir.Account{}
+
+	got, err := g.Charges(date, p, account, &pathway.Charge{})
+	if err != nil {
+		t.Fatalf("Charges(%v, %+v, %+v, %+v) failed with %v", date, p, account, &pathway.Charge{}, err)
+	}
+	want := []*ir.Charge{{
+		ID:                  "transaction-1",
+		TransactionDateTime: ir.NewValidTime(date.Add(-2 * time.Hour)),
+		PostingDateTime:     ir.NewValidTime(date),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "LAB-UE", Text: "Urea and electrolytes", CodingSystem: "WinPath"},
+		Quantity:            1,
+		UnitAmount:          25.5,
+		Location:            renalBed,
+		OrderedBy:           doctor,
+		FillerOrderNumber:   "filler-1",
+		Source:              "order:placer-1",
+	}, {
+		ID:                  "transaction-2",
+		TransactionDateTime: procedureTime,
+		PostingDateTime:     ir.NewValidTime(date),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "PROC-1", Text: "Procedure1", CodingSystem: "LOCAL"},
+		Quantity:            1,
+		UnitAmount:          300,
+		Location:            renalBed,
+		PerformedBy:         doctor,
+		Procedure:           &ir.CodedElement{ID: "P24.9", Text: "Procedure1"},
+		Source:              "procedure:P24.9@2018-02-12T00:25:00Z",
+	}, {
+		ID:                  "transaction-3",
+		TransactionDateTime: ir.NewMidnightTime(time.Date(2018, 2, 10, 0, 0, 0, 0, time.UTC)),
+		PostingDateTime:     ir.NewValidTime(date),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "BED-RENAL", Text: "Renal ward bed", CodingSystem: "WinPath"},
+		Quantity:            1,
+		UnitAmount:          900,
+		Location:            renalBed,
+		Source:              "bed-day:2018-02-10",
+	}, {
+		ID:                  "transaction-4",
+		TransactionDateTime: ir.NewMidnightTime(time.Date(2018, 2, 11, 0, 0, 0, 0, time.UTC)),
+		PostingDateTime:     ir.NewValidTime(date),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "BED-RENAL", Text: "Renal ward bed", CodingSystem: "WinPath"},
+		Quantity:            1,
+		UnitAmount:          900,
+		Location:            renalBed,
+		Source:              "bed-day:2018-02-11",
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Charges(%v, %+v, %+v, %+v) got diff (-want, +got):\n%s", date, p, account, &pathway.Charge{}, diff)
+	}
+
+	// Once the charges are posted, they are not captured again.
+	account.Charges = got
+	later := date.Add(24 * time.Hour)
+	got, err = g.Charges(later, p, account, &pathway.Charge{})
+	if err != nil {
+		t.Fatalf("Charges(%v, %+v, %+v, %+v) failed with %v", later, p, account, &pathway.Charge{}, err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("Charges(%v, %+v, %+v, %+v) got %d charges, want 1", later, p, account, &pathway.Charge{}, len(got))
+	}
+	if got, want := got[0].Source, "bed-day:2018-02-12"; got != want {
+		t.Errorf("Charges(%v, %+v, %+v, %+v)[0].Source got %q, want %q", later, p, account, &pathway.Charge{}, got, want)
+	}
+}
+
+func TestCharges_NoEncounter(t *testing.T) {
+	g := testGenerator(t)
+	got, err := g.Charges(date, &ir.PatientInfo{}, &ir.Account{}, &pathway.Charge{})
+	if err != nil {
+		t.Fatalf("Charges(%v, _, _, %+v) failed with %v", date, &pathway.Charge{}, err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Charges(%v, _, _, %+v) got %v, want no charges", date, &pathway.Charge{}, got)
+	}
+}
+
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	return &Generator{
+		BillingConfig:        &billingConfig,
+		Chargemaster:         testconfig.Chargemaster(t, &config.HL7Config{CodingSystem: "WinPath"}),
+		AccountGenerator:     &testid.Generator{Prefix: "account-"},
+		TransactionGenerator: &testid.Generator{Prefix: "transaction-"},
+	}
+}
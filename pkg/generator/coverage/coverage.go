@@ -0,0 +1,167 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coverage contains functions needed to generate the insurances and the guarantors of
+// patients.
+package coverage
+
+import (
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/clock"
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/generator/id"
+	"github.com/bitcrshr/simhospital/pkg/insurance"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+)
+
+const (
+	// relationshipCodingSystem is the coding system of the guarantor relationships.
+	relationshipCodingSystem = "HL70063"
+	// ageOfMajority is the age under which patients cannot be their own guarantors.
+	ageOfMajority = 18
+)
+
+// associatedPartyAge is the age of the associated parties that are generated as guarantors.
+var associatedPartyAge = &pathway.Age{From: 25, To: 80}
+
+// PersonGenerator is an interface to generate people.
+type PersonGenerator interface {
+	NewPerson(*pathway.Person) *ir.Person
+}
+
+// Generator generates insurances and guarantors.
+type Generator struct {
+	Clock           clock.Clock
+	BillingConfig   *config.HL7Billing
+	Catalogue       *insurance.Catalogue
+	PersonGenerator PersonGenerator
+	PolicyGenerator id.Generator
+	MemberGenerator id.Generator
+}
+
+// UpdateFromPathway sets the guarantor and the insurances of the patient.
+// The guarantor and insurances set in the pathway's person always override the patient's.
+// If they are not set in the pathway, they are only generated if the patient doesn't have them yet.
+// Guarantors that are not the patient are added to the associated parties of the patient.
+// Does nothing if there is no insurance catalogue.
+func (g *Generator) UpdateFromPathway(p *ir.PatientInfo, pathwayPerson *pathway.Person) {
+	if g.Catalogue == nil {
+		return
+	}
+	if pathwayPerson == nil {
+		pathwayPerson = &pathway.Person{}
+	}
+	if pathwayPerson.Guarantor != nil || p.Guarantor == nil {
+		p.Guarantor = g.guarantor(p, pathwayPerson.Guarantor)
+	}
+	// Insurances is set to an empty slice for patients that are not insured, so that they are not
+	// generated again.
+	if pathwayPerson.Insurance != nil || p.Insurances == nil {
+		p.Insurances = []*ir.Insurance{}
+		if in := g.insurance(p, pathwayPerson.Insurance); in != nil {
+			p.Insurances = append(p.Insurances, in)
+		}
+	}
+}
+
+func (g *Generator) guarantor(p *ir.PatientInfo, pg *pathway.Guarantor) *ir.AssociatedParty {
+	var relationship string
+	if pg != nil {
+		relationship = pg.Relationship
+	} else {
+		relationship = g.Catalogue.RandomRelationship(g.isMinor(p.Person))
+	}
+	if relationship == "" || relationship == g.BillingConfig.SelfRelationship {
+		return &ir.AssociatedParty{Person: p.Person, Relationship: g.relationship(g.BillingConfig.SelfRelationship)}
+	}
+	party := &ir.AssociatedParty{Person: g.associatedPerson(p.Person), Relationship: g.relationship(relationship)}
+	p.AssociatedParties = append(p.AssociatedParties, party)
+	return party
+}
+
+// associatedPerson returns a new adult person who shares the surname and the address of the given
+// person.
+func (g *Generator) associatedPerson(person *ir.Person) *ir.Person {
+	pathwayPerson := &pathway.Person{Age: associatedPartyAge}
+	if person != nil {
+		pathwayPerson.Surname = pathway.OptionalRandomString(person.Surname)
+	}
+	ap := g.PersonGenerator.NewPerson(pathwayPerson)
+	// Associated parties are not patients, so they don't have MRNs.
+	ap.MRN = ""
+	if person != nil && person.Address != nil {
+		address := *person.Address
+		ap.Address = &address
+	}
+	return ap
+}
+
+// insurance returns the insurance of the patient, or nil if the patient is not insured.
+// The guarantor of the patient holds the policy.
+func (g *Generator) insurance(p *ir.PatientInfo, pi *pathway.Insurance) *ir.Insurance {
+	var plan *insurance.Plan
+	switch {
+	case pi == nil:
+		plan = g.Catalogue.RandomPlan()
+	case pi.SelfPay:
+		return nil
+	case pi.Plan == "":
+		plan = g.Catalogue.RandomInsuredPlan()
+	default:
+		var ok bool
+		if plan, ok = g.Catalogue.Plan(pi.Plan); !ok {
+			plan = &insurance.Plan{Code: ir.CodedElement{ID: pi.Plan}}
+		}
+	}
+	if plan == nil {
+		return nil
+	}
+	if pi == nil {
+		pi = &pathway.Insurance{}
+	}
+
+	code := plan.Code
+	now := g.Clock.Now()
+	in := &ir.Insurance{
+		Plan:        &code,
+		GroupNumber: pi.GroupNumber,
+		// Plans are renewed at the start of every year.
+		EffectiveDate: ir.NewMidnightTime(time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)),
+		PlanType:      plan.Type,
+		Insured:       p.Guarantor,
+		PolicyNumber:  pi.PolicyNumber,
+		MemberNumber:  g.MemberGenerator.NewID(),
+	}
+	if plan.Payer != nil {
+		in.CompanyID = plan.Payer.ID
+		in.CompanyName = plan.Payer.Name
+	}
+	if in.GroupNumber == "" {
+		in.GroupNumber = plan.RandomGroupNumber()
+	}
+	if in.PolicyNumber == "" {
+		in.PolicyNumber = g.PolicyGenerator.NewID()
+	}
+	return in
+}
+
+func (g *Generator) relationship(code string) *ir.CodedElement {
+	return &ir.CodedElement{ID: code, Text: code, CodingSystem: relationshipCodingSystem}
+}
+
+func (g *Generator) isMinor(person *ir.Person) bool {
+	return person != nil && person.Birth.Valid && person.Birth.AddDate(ageOfMajority, 0, 0).After(g.Clock.Now())
+}
@@ -0,0 +1,208 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testclock"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/bitcrshr/simhospital/pkg/test/testid"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	policyNumber = "policy-1"
+	memberNumber = "member-1"
+)
+
+var (
+	date          = time.Date(2018, 2, 12, 1, 25, 0, 0, time.UTC)
+	billingConfig = config.HL7Billing{SelfRelationship: "SEL"}
+	self          = &ir.CodedElement{ID: "SEL", Text: "SEL", CodingSystem: "HL70063"}
+	address       = &ir.Address{FirstLine: "1 Goodwill Hunting Road", City: "London"}
+)
+
+func TestUpdateFromPathway_Random(t *testing.T) {
+	g := testGenerator(t)
+	person := &ir.Person{Surname: "Smith", Birth: ir.NewValidTime(date.AddDate(-40, 0, 0)), Address: address}
+	p := &ir.PatientInfo{Person: person}
+	g.UpdateFromPathway(p, nil)
+
+	wantGuarantor := &ir.AssociatedParty{Person: person, Relationship: self}
+	if diff := cmp.Diff(wantGuarantor, p.Guarantor); diff != "" {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Guarantor diff (-want, +got):\n%s", p, diff)
+	}
+	wantInsurances := []*ir.Insurance{{
+		Plan:          &ir.CodedElement{ID: "ACME-PPO", Text: "Acme PPO", CodingSystem: "WinPath"},
+		CompanyID:     "ACME",
+		CompanyName:   "Acme Health",
+		GroupNumber:   "G-100",
+		EffectiveDate: ir.NewMidnightTime(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)),
+		PlanType:      "PPO",
+		Insured:       wantGuarantor,
+		PolicyNumber:  policyNumber,
+		MemberNumber:  memberNumber,
+	}}
+	if diff := cmp.Diff(wantInsurances, p.Insurances); diff != "" {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Insurances diff (-want, +got):\n%s", p, diff)
+	}
+	if len(p.AssociatedParties) != 0 {
+		t.Errorf("UpdateFromPathway(%+v, nil) got AssociatedParties %v, want none", p, p.AssociatedParties)
+	}
+
+	// The guarantor and insurances are not generated again.
+	guarantor, insurances := p.Guarantor, p.Insurances
+	g.UpdateFromPathway(p, &pathway.Person{})
+	if p.Guarantor != guarantor {
+		t.Errorf("UpdateFromPathway(%+v, {}) got Guarantor %+v, want %+v", p, p.Guarantor, guarantor)
+	}
+	if diff := cmp.Diff(insurances, p.Insurances); diff != "" {
+		t.Errorf("UpdateFromPathway(%+v, {}) got Insurances diff (-want, +got):\n%s", p, diff)
+	}
+}
+
+func TestUpdateFromPathway_Minor(t *testing.T) {
+	g := testGenerator(t)
+	person := &ir.Person{Surname: "Smith", Birth: ir.NewValidTime(date.AddDate(-10, 0, 0)), Address: address}
+	p := &ir.PatientInfo{Person: person}
+	g.UpdateFromPathway(p, nil)
+
+	if got, want := p.Guarantor.Relationship.ID, "PAR"; got != want {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Guarantor.Relationship.ID %q, want %q", p, got, want)
+	}
+	if got, want := p.Guarantor.Surname, "Smith"; got != want {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Guarantor.Surname %q, want %q", p, got, want)
+	}
+	if diff := cmp.Diff(address, p.Guarantor.Address); diff != "" {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Guarantor.Address diff (-want, +got):\n%s", p, diff)
+	}
+	if p.Guarantor.Address == address {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Guarantor.Address shared with the patient, want a copy", p)
+	}
+	if len(p.AssociatedParties) != 1 || p.AssociatedParties[0] != p.Guarantor {
+		t.Errorf("UpdateFromPathway(%+v, nil) got AssociatedParties %v, want [%v]", p, p.AssociatedParties, p.Guarantor)
+	}
+	if got, want := p.Insurances[0].Insured, p.Guarantor; got != want {
+		t.Errorf("UpdateFromPathway(%+v, nil) got Insurances[0].Insured %+v, want %+v", p, got, want)
+	}
+}
+
+func TestUpdateFromPathway_Overrides(t *testing.T) {
+	tests := []struct {
+		name          string
+		pathwayPerson *pathway.Person
+		wantPlan      *ir.CodedElement
+		wantCompany   string
+		wantGroup     string
+		wantPolicy    string
+		wantSelf      bool
+		wantUninsured bool
+	}{{
+		name:          "Plan from the catalogue",
+		pathwayPerson: &pathway.Person{Insurance: &pathway.Insurance{Plan: "ACME-LOCAL", GroupNumber: "G-200", PolicyNumber: "POL-1"}},
+		wantPlan:      &ir.CodedElement{ID: "ACME-LOCAL", Text: "Acme Local", CodingSystem: "LOCAL"},
+		wantCompany:   "Acme Health",
+		wantGroup:     "G-200",
+		wantPolicy:    "POL-1",
+		wantSelf:      true,
+	}, {
+		name:          "Plan not in the catalogue",
+		pathwayPerson: &pathway.Person{Insurance: &pathway.Insurance{Plan: "OTHER"}},
+		wantPlan:      &ir.CodedElement{ID: "OTHER"},
+		wantPolicy:    policyNumber,
+		wantSelf:      true,
+	}, {
+		name:          "Self pay",
+		pathwayPerson: &pathway.Person{Insurance: &pathway.Insurance{SelfPay: true}},
+		wantSelf:      true,
+		wantUninsured: true,
+	}, {
+		name:          "Guarantor",
+		pathwayPerson: &pathway.Person{Guarantor: &pathway.Guarantor{Relationship: "SPO"}},
+		wantPlan:      &ir.CodedElement{ID: "ACME-PPO", Text: "Acme PPO", CodingSystem: "WinPath"},
+		wantCompany:   "Acme Health",
+		wantGroup:     "G-100",
+		wantPolicy:    policyNumber,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := testGenerator(t)
+			p := &ir.PatientInfo{Person: &ir.Person{Birth: ir.NewValidTime(date.AddDate(-40, 0, 0))}}
+			g.UpdateFromPathway(p, tc.pathwayPerson)
+
+			if got := p.Guarantor.Person == p.Person; got != tc.wantSelf {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got self guarantor %t, want %t", p, tc.pathwayPerson, got, tc.wantSelf)
+			}
+			if tc.wantUninsured {
+				if p.Insurances == nil || len(p.Insurances) != 0 {
+					t.Errorf("UpdateFromPathway(%+v, %+v) got Insurances %v, want empty", p, tc.pathwayPerson, p.Insurances)
+				}
+				return
+			}
+			if len(p.Insurances) != 1 {
+				t.Fatalf("UpdateFromPathway(%+v, %+v) got %d insurances, want 1", p, tc.pathwayPerson, len(p.Insurances))
+			}
+			in := p.Insurances[0]
+			if diff := cmp.Diff(tc.wantPlan, in.Plan); diff != "" {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got Plan diff (-want, +got):\n%s", p, tc.pathwayPerson, diff)
+			}
+			if got, want := in.CompanyName, tc.wantCompany; got != want {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got CompanyName %q, want %q", p, tc.pathwayPerson, got, want)
+			}
+			if got, want := in.GroupNumber, tc.wantGroup; got != want {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got GroupNumber %q, want %q", p, tc.pathwayPerson, got, want)
+			}
+			if got, want := in.PolicyNumber, tc.wantPolicy; got != want {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got PolicyNumber %q, want %q", p, tc.pathwayPerson, got, want)
+			}
+			if in.Insured != p.Guarantor {
+				t.Errorf("UpdateFromPathway(%+v, %+v) got Insured %+v, want %+v", p, tc.pathwayPerson, in.Insured, p.Guarantor)
+			}
+		})
+	}
+}
+
+func TestUpdateFromPathway_NoCatalogue(t *testing.T) {
+	g := testGenerator(t)
+	g.Catalogue = nil
+	p := &ir.PatientInfo{Person: &ir.Person{}}
+	g.UpdateFromPathway(p, &pathway.Person{Guarantor: &pathway.Guarantor{Relationship: "SPO"}})
+	if p.Guarantor != nil || p.Insurances != nil {
+		t.Errorf("UpdateFromPathway(_, _) got Guarantor %+v and Insurances %v, want nil", p.Guarantor, p.Insurances)
+	}
+}
+
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	return &Generator{
+		Clock:           testclock.New(date),
+		BillingConfig:   &billingConfig,
+		Catalogue:       testconfig.Insurance(t, &config.HL7Config{CodingSystem: "WinPath"}),
+		PersonGenerator: &fakePersonGenerator{},
+		PolicyGenerator: &testid.Generator{Prefix: "policy-"},
+		MemberGenerator: &testid.Generator{Prefix: "member-"},
+	}
+}
+
+type fakePersonGenerator struct{}
+
+func (g *fakePersonGenerator) NewPerson(p *pathway.Person) *ir.Person {
+	return &ir.Person{FirstName: "Associated", Surname: string(p.Surname)}
+}
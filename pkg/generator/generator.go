@@ -18,7 +18,12 @@
 // - orders and test results,
 // - allergies,
 // - diagnosis,
-// - procedures.
+// - procedures,
+// - appointments,
+// - medication orders,
+// - vaccinations,
+// - accounts and charges,
+// - insurances and guarantors.
 //
 // The data is generated based on information provided in the pathway.
 package generator
@@ -29,12 +34,16 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/bitcrshr/simhospital/pkg/chargemaster"
 	"github.com/bitcrshr/simhospital/pkg/clock"
 	"github.com/bitcrshr/simhospital/pkg/config"
 	"github.com/bitcrshr/simhospital/pkg/doctor"
 	"github.com/bitcrshr/simhospital/pkg/gender"
 	"github.com/bitcrshr/simhospital/pkg/generator/address"
+	"github.com/bitcrshr/simhospital/pkg/generator/appointment"
+	"github.com/bitcrshr/simhospital/pkg/generator/billing"
 	"github.com/bitcrshr/simhospital/pkg/generator/codedelement"
+	"github.com/bitcrshr/simhospital/pkg/generator/coverage"
 	"github.com/bitcrshr/simhospital/pkg/generator/document"
 	"github.com/bitcrshr/simhospital/pkg/generator/header"
 	"github.com/bitcrshr/simhospital/pkg/generator/id"
@@ -42,13 +51,20 @@ import (
 	"github.com/bitcrshr/simhospital/pkg/generator/notes"
 	"github.com/bitcrshr/simhospital/pkg/generator/order"
 	"github.com/bitcrshr/simhospital/pkg/generator/person"
+	"github.com/bitcrshr/simhospital/pkg/generator/pharmacy"
 	"github.com/bitcrshr/simhospital/pkg/generator/text"
+	"github.com/bitcrshr/simhospital/pkg/generator/vaccination"
+	"github.com/bitcrshr/simhospital/pkg/insurance"
 	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/location"
 	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/medication"
 	"github.com/bitcrshr/simhospital/pkg/message"
+	"github.com/bitcrshr/simhospital/pkg/microbiology"
 	"github.com/bitcrshr/simhospital/pkg/orderprofile"
 	"github.com/bitcrshr/simhospital/pkg/pathway"
 	"github.com/bitcrshr/simhospital/pkg/state"
+	"github.com/bitcrshr/simhospital/pkg/vaccine"
 )
 
 var log = logging.ForCallerPackage()
@@ -72,6 +88,11 @@ type Generator struct {
 	headerGenerator       *header.Generator
 	orderGenerator        *order.Generator
 	documentGenerator     *document.Generator
+	appointmentGenerator  *appointment.Generator
+	pharmacyGenerator     *pharmacy.Generator
+	vaccinationGenerator  *vaccination.Generator
+	billingGenerator      *billing.Generator
+	coverageGenerator     *coverage.Generator
 }
 
 type diagnosisOrProcedureGenerator interface {
@@ -89,10 +110,12 @@ func (g Generator) NewPerson(pathwayPerson *pathway.Person) *ir.Person {
 // - diagnoses
 // - procedures
 // - allergies
+// - insurances and guarantor
 func (g Generator) UpdateFromPathway(patientInfo *ir.PatientInfo, updatePerson *pathway.UpdatePerson) {
 	if updatePerson.Person != nil {
 		g.personGenerator.UpdatePersonFromPathway(patientInfo.Person, updatePerson.Person)
 	}
+	g.SetCoverage(patientInfo, updatePerson.Person)
 	g.setDiagnoses(patientInfo, updatePerson.Diagnoses)
 	g.setProcedures(patientInfo, updatePerson.Procedures)
 	g.AddAllergies(patientInfo, updatePerson.Allergies)
@@ -109,8 +132,9 @@ func (g Generator) NewPatient(person *ir.Person, doctor *ir.Doctor) *state.Patie
 			AttendingDoctor: doctor,
 		},
 		// The code downstream assumes that Orders exists.
-		Orders:    make(map[string]*ir.Order),
-		Documents: make(map[string]*ir.Document),
+		Orders:       make(map[string]*ir.Order),
+		Documents:    make(map[string]*ir.Document),
+		Appointments: make(map[string]*ir.Appointment),
 	}
 	// If none of the g.messageConfig.PrimaryFacility fields is set, we want the resulting HL7 message to have the entire
 	// PD1.3 Patient Primary Facility field empty. This is achieved by leaving p.PatientInfo.PrimaryFacility nil.
@@ -129,6 +153,13 @@ func (g Generator) NewPatient(person *ir.Person, doctor *ir.Doctor) *state.Patie
 	return p
 }
 
+// SetCoverage sets the insurances and the guarantor of the patient based on pathway.Person.
+// The insurances and the guarantor are only generated if they are set in the pathway or if the
+// patient doesn't have them yet.
+func (g Generator) SetCoverage(patientInfo *ir.PatientInfo, pathwayPerson *pathway.Person) {
+	g.coverageGenerator.UpdateFromPathway(patientInfo, pathwayPerson)
+}
+
 // NewDoctor returns a new doctor based on the Consultant information from the pathway.
 // If consultant is not specified, it returns a random doctor.
 // Otherwise, it attempts to lookup an existic doctor basd on consultant ID. If any doctor is found, it returns it.
@@ -159,6 +190,17 @@ func (g Generator) NewDoctor(c *pathway.Consultant) *ir.Doctor {
 func (g Generator) ResetPatient(p *state.Patient) *state.Patient {
 	newP := g.NewPatient(p.PatientInfo.Person, p.PatientInfo.AttendingDoctor)
 	newP.Orders = p.Orders
+	if p.Appointments != nil {
+		// Appointments booked before the reset are still booked.
+		newP.Appointments = p.Appointments
+	}
+	// Prescriptions made before the reset are still active.
+	newP.MedicationOrders = p.MedicationOrders
+	newP.PatientInfo.Medications = p.PatientInfo.Medications
+	newP.PatientInfo.Vaccinations = p.PatientInfo.Vaccinations
+	newP.PatientInfo.Account = p.PatientInfo.Account
+	newP.PatientInfo.Guarantor = p.PatientInfo.Guarantor
+	newP.PatientInfo.Insurances = p.PatientInfo.Insurances
 	newP.PatientInfo.HospitalService = p.PatientInfo.HospitalService
 	newP.PatientInfo.Encounters = p.PatientInfo.Encounters
 	newP.PastVisits = p.PastVisits
@@ -275,6 +317,11 @@ func (g Generator) NewVisitID() uint64 {
 	return rand.Uint64()
 }
 
+// NewMRN generates a new MRN.
+func (g Generator) NewMRN() string {
+	return g.personGenerator.MRNGenerator.NewID()
+}
+
 // NewHeader returns a new header for the given step.
 func (g *Generator) NewHeader(step *pathway.Step) *message.HeaderInfo {
 	return g.headerGenerator.NewHeader(step)
@@ -290,6 +337,63 @@ func (g Generator) UpdateDocumentContent(dm *ir.Document, dp *pathway.Document)
 	return g.documentGenerator.UpdateDocumentContent(dm, dp)
 }
 
+// NewAppointment returns a new appointment based on appointment information from the pathway and eventTime.
+// Returns an error if the appointment cannot be created.
+func (g Generator) NewAppointment(eventTime time.Time, a *pathway.Appointment) (*ir.Appointment, error) {
+	return g.appointmentGenerator.Appointment(eventTime, a)
+}
+
+// RescheduleAppointment updates the given appointment based on reschedule information from the pathway.
+// Returns an error if the appointment cannot be updated.
+func (g Generator) RescheduleAppointment(eventTime time.Time, am *ir.Appointment, r *pathway.RescheduleAppointment) error {
+	return g.appointmentGenerator.Reschedule(eventTime, am, r)
+}
+
+// NewMedicationOrder returns a new medication order based on prescription information from the
+// pathway and eventTime. Returns an error if the medication order cannot be created.
+func (g Generator) NewMedicationOrder(eventTime time.Time, p *pathway.Prescribe, prescriber *ir.Doctor) (*ir.MedicationOrder, error) {
+	return g.pharmacyGenerator.MedicationOrder(eventTime, p, prescriber)
+}
+
+// DispenseMedication adds a dispense to the given medication order based on information from the
+// pathway, and returns it.
+func (g Generator) DispenseMedication(eventTime time.Time, mo *ir.MedicationOrder, d *pathway.Dispense) *ir.MedicationDispense {
+	return g.pharmacyGenerator.Dispense(eventTime, mo, d)
+}
+
+// AdministerMedication adds an administration to the given medication order based on information
+// from the pathway, and returns it.
+func (g Generator) AdministerMedication(eventTime time.Time, mo *ir.MedicationOrder, a *pathway.Administer) *ir.MedicationAdministration {
+	return g.pharmacyGenerator.Administer(eventTime, mo, a)
+}
+
+// DiscontinueMedication marks the given medication order as discontinued.
+func (g Generator) DiscontinueMedication(eventTime time.Time, mo *ir.MedicationOrder, d *pathway.DiscontinueMedication) {
+	g.pharmacyGenerator.Discontinue(eventTime, mo, d)
+}
+
+// NewVaccination returns a new vaccination based on vaccination information from the pathway and
+// eventTime. Returns an error if the vaccination cannot be created.
+func (g Generator) NewVaccination(eventTime time.Time, v *pathway.Vaccination) (*ir.Vaccination, error) {
+	return g.vaccinationGenerator.Vaccination(eventTime, v)
+}
+
+// NewAccount returns a new account that starts at eventTime.
+func (g Generator) NewAccount(eventTime time.Time) *ir.Account {
+	return g.billingGenerator.Account(eventTime)
+}
+
+// NewSelfGuarantor returns a guarantor that is the given person.
+func (g Generator) NewSelfGuarantor(p *ir.Person) *ir.AssociatedParty {
+	return g.billingGenerator.SelfGuarantor(p)
+}
+
+// NewCharges returns the charges to post to the given account based on charge information from the
+// pathway and eventTime. Returns an error if the charges cannot be created.
+func (g Generator) NewCharges(eventTime time.Time, p *ir.PatientInfo, account *ir.Account, c *pathway.Charge) ([]*ir.Charge, error) {
+	return g.billingGenerator.Charges(eventTime, p, account, c)
+}
+
 // Config contains the configuration for Generator.
 type Config struct {
 	Clock            clock.Clock
@@ -306,6 +410,12 @@ type Config struct {
 	Doctors          *doctor.Doctors
 	MsgCtrlGenerator *header.MessageControlGenerator
 	OrderProfiles    *orderprofile.OrderProfiles
+	Medications      *medication.Medications
+	Vaccines         *vaccine.Vaccines
+	Chargemaster     *chargemaster.Chargemaster
+	Insurance        *insurance.Catalogue
+	Microbiology     *microbiology.Catalogue
+	LocationManager  *location.Manager
 }
 
 // NewGenerator creates a new Generator.
@@ -355,6 +465,10 @@ func NewGenerator(cfg Config) *Generator {
 		Country:            cfg.Data.Address.Country,
 	}
 
+	// The people associated to patients don't take MRNs from the MRN generator, as they are not patients.
+	associatedPersonGenerator := *personGenerator
+	associatedPersonGenerator.MRNGenerator = &randomIDGenerator{}
+
 	orderGenerator := &order.Generator{
 		MessageConfig:         cfg.HL7Config,
 		OrderProfiles:         cfg.OrderProfiles,
@@ -363,6 +477,7 @@ func NewGenerator(cfg Config) *Generator {
 		FillerGenerator:       fillerGenerator,
 		AbnormalFlagConvertor: order.NewAbnormalFlagConvertor(cfg.HL7Config),
 		Doctors:               cfg.Doctors,
+		Microbiology:          cfg.Microbiology,
 	}
 
 	return &Generator{
@@ -376,5 +491,40 @@ func NewGenerator(cfg Config) *Generator {
 		headerGenerator:       &header.Generator{Header: cfg.Header, MsgCtrlGen: cfg.MsgCtrlGenerator},
 		orderGenerator:        orderGenerator,
 		documentGenerator:     &document.Generator{DocumentConfig: &cfg.HL7Config.Document, TextGenerator: tg},
+		appointmentGenerator: &appointment.Generator{
+			AppointmentConfig: &cfg.HL7Config.Appointment,
+			HospitalService:   cfg.HL7Config.HospitalService,
+			Doctors:           cfg.Doctors,
+			LocationManager:   cfg.LocationManager,
+			PlacerGenerator:   placerGenerator,
+			FillerGenerator:   fillerGenerator,
+		},
+		pharmacyGenerator: &pharmacy.Generator{
+			HL7Config:       cfg.HL7Config,
+			Medications:     cfg.Medications,
+			PlacerGenerator: placerGenerator,
+			FillerGenerator: fillerGenerator,
+		},
+		vaccinationGenerator: &vaccination.Generator{
+			VaccinationConfig: &cfg.HL7Config.Vaccination,
+			Vaccines:          cfg.Vaccines,
+			Doctors:           cfg.Doctors,
+			PlacerGenerator:   placerGenerator,
+			FillerGenerator:   fillerGenerator,
+		},
+		billingGenerator: &billing.Generator{
+			BillingConfig:        &cfg.HL7Config.Billing,
+			Chargemaster:         cfg.Chargemaster,
+			AccountGenerator:     &randomIDGenerator{},
+			TransactionGenerator: &randomIDGenerator{},
+		},
+		coverageGenerator: &coverage.Generator{
+			Clock:           cfg.Clock,
+			BillingConfig:   &cfg.HL7Config.Billing,
+			Catalogue:       cfg.Insurance,
+			PersonGenerator: associatedPersonGenerator,
+			PolicyGenerator: &randomIDGenerator{},
+			MemberGenerator: &randomIDGenerator{},
+		},
 	}
 }
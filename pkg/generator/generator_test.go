@@ -671,8 +671,9 @@ hospital_service: "180"
 					Person:          person,
 					HospitalService: "",
 				},
-				Orders:    make(map[string]*ir.Order),
-				Documents: make(map[string]*ir.Document),
+				Orders:       make(map[string]*ir.Order),
+				Documents:    make(map[string]*ir.Document),
+				Appointments: make(map[string]*ir.Appointment),
 			},
 		}, {
 			name:   "Existing doctor, override hospital service",
@@ -685,8 +686,9 @@ hospital_service: "180"
 					HospitalService: existingDoctor.Specialty,
 					AttendingDoctor: existingDoctor,
 				},
-				Orders:    make(map[string]*ir.Order),
-				Documents: make(map[string]*ir.Document),
+				Orders:       make(map[string]*ir.Order),
+				Documents:    make(map[string]*ir.Document),
+				Appointments: make(map[string]*ir.Appointment),
 			},
 		}, {
 			name:   "New doctor, don't override hospital service",
@@ -699,8 +701,9 @@ hospital_service: "180"
 					HospitalService: "",
 					AttendingDoctor: newDoctor,
 				},
-				Orders:    make(map[string]*ir.Order),
-				Documents: make(map[string]*ir.Document),
+				Orders:       make(map[string]*ir.Order),
+				Documents:    make(map[string]*ir.Document),
+				Appointments: make(map[string]*ir.Appointment),
 			},
 		}, {
 			name:   "Nil doctor, primary facility, hospital service and patient class from config",
@@ -717,8 +720,9 @@ hospital_service: "180"
 						ID:           "123",
 					},
 				},
-				Orders:    make(map[string]*ir.Order),
-				Documents: make(map[string]*ir.Document),
+				Orders:       make(map[string]*ir.Order),
+				Documents:    make(map[string]*ir.Document),
+				Appointments: make(map[string]*ir.Appointment),
 			},
 		}, {
 			name:   "Existing doctor, defined config, override hospital service",
@@ -735,8 +739,9 @@ hospital_service: "180"
 						ID:           "123",
 					},
 				},
-				Orders:    make(map[string]*ir.Order),
-				Documents: make(map[string]*ir.Document),
+				Orders:       make(map[string]*ir.Order),
+				Documents:    make(map[string]*ir.Document),
+				Appointments: make(map[string]*ir.Appointment),
 			},
 		},
 	}
@@ -1294,7 +1299,10 @@ func TestGeneratorResetPatientInfo(t *testing.T) {
 		Orders: map[string]*ir.Order{
 			"order-id": urineOrder(defaultDate, hl7Config),
 		},
-		Documents:  map[string]*ir.Document{},
+		Documents: map[string]*ir.Document{},
+		Appointments: map[string]*ir.Appointment{
+			"appointment-id": {FillerAppointmentID: "1", FillerStatus: "Booked"},
+		},
 		PastVisits: []uint64{1, 2},
 	}
 
@@ -1328,7 +1336,10 @@ func TestGeneratorResetPatientInfo(t *testing.T) {
 		Orders: map[string]*ir.Order{
 			"order-id": urineOrder(defaultDate, hl7Config),
 		},
-		Documents:  map[string]*ir.Document{},
+		Documents: map[string]*ir.Document{},
+		Appointments: map[string]*ir.Appointment{
+			"appointment-id": {FillerAppointmentID: "1", FillerStatus: "Booked"},
+		},
 		PastVisits: []uint64{1, 2},
 	}
 
@@ -0,0 +1,248 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/orderprofile"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/pkg/errors"
+)
+
+const (
+	// noGrowth is the value of the final results of cultures where no organisms grew.
+	noGrowth = "No growth"
+	// noGrowthToDate is the value of the preliminary results of cultures where no organisms grew.
+	noGrowthToDate = "No growth to date"
+	// susceptibilitiesToFollow is the note of the preliminary results of identified organisms.
+	susceptibilitiesToFollow = "Susceptibilities to follow"
+)
+
+// identifiedOrganism is an organism identified by a culture together with its susceptibilities.
+type identifiedOrganism struct {
+	code *ir.CodedElement
+	// susceptibilities are the results of the susceptibility tests of the organism.
+	susceptibilities []*ir.Result
+}
+
+// setCultureResults adds the results of a microbiology culture to the given order: a result with
+// the organism identified by the culture, or with the lack of growth, and a child order with the
+// antibiotic susceptibilities of each organism. The organisms are told apart by the sub-IDs of
+// their results.
+// Preliminary results don't include the susceptibilities.
+// If the organisms are not specified in the pathway, the organisms identified by the previous
+// results of the order are reported again, with the same susceptibilities if they were reported,
+// so that the final results of a culture match its preliminary results.
+func (g Generator) setCultureResults(o *ir.Order, c *pathway.Culture, previous []*ir.Result, previousChildren []*ir.ChildOrder) error {
+	if g.Microbiology == nil {
+		return errors.New("cannot generate culture results without a microbiology catalogue")
+	}
+	preliminary := o.ResultsStatus == g.MessageConfig.ResultStatus.Preliminary
+
+	if c.NoGrowth {
+		r := g.organismResult(o, "")
+		r.ValueType = constants.TextualValueType
+		r.Value = noGrowth
+		if preliminary {
+			r.Value = noGrowthToDate
+		}
+		o.Results = append(o.Results, r)
+		return nil
+	}
+
+	organisms, err := g.identifiedOrganisms(c, previous, previousChildren)
+	if err != nil {
+		return err
+	}
+	for i, org := range organisms {
+		r := g.organismResult(o, strconv.Itoa(i+1))
+		r.ValueType = constants.CodedValueType
+		r.CodedValue = org.code
+		o.Results = append(o.Results, r)
+		if preliminary {
+			r.Notes = []string{susceptibilitiesToFollow}
+			continue
+		}
+		if len(org.susceptibilities) == 0 {
+			continue
+		}
+		for _, s := range org.susceptibilities {
+			s.Status = o.ResultsStatus
+			s.ObservationDateTime = o.CollectedDateTime
+		}
+		panel := g.Microbiology.SusceptibilityPanel
+		o.Children = append(o.Children, &ir.ChildOrder{
+			UniversalService: &panel,
+			ParentResult:     r,
+			Results:          org.susceptibilities,
+		})
+	}
+	return nil
+}
+
+// organismResult returns a result for the organisms identified by a culture, without a value.
+func (g Generator) organismResult(o *ir.Order, subID string) *ir.Result {
+	testName := g.Microbiology.OrganismObservation
+	return &ir.Result{
+		TestName:            &testName,
+		SubID:               subID,
+		Status:              o.ResultsStatus,
+		ObservationDateTime: o.CollectedDateTime,
+	}
+}
+
+// identifiedOrganisms returns the organisms identified by the culture. These are either specified
+// in the pathway, identified by the previous results, or picked at random from the catalogue,
+// in this order of preference.
+func (g Generator) identifiedOrganisms(c *pathway.Culture, previous []*ir.Result, previousChildren []*ir.ChildOrder) ([]*identifiedOrganism, error) {
+	var organisms []*identifiedOrganism
+	for _, po := range c.Organisms {
+		org := &identifiedOrganism{code: &ir.CodedElement{ID: po.Name, Text: po.Name}}
+		catalogueOrganism, ok := g.Microbiology.Organism(po.Name)
+		if ok {
+			code := catalogueOrganism.Code
+			org.code = &code
+		}
+		for _, s := range po.Susceptibilities {
+			org.susceptibilities = append(org.susceptibilities, g.susceptibility(s))
+		}
+		if org.susceptibilities == nil {
+			org.susceptibilities = previousSusceptibilities(org.code, previousChildren)
+		}
+		if org.susceptibilities == nil {
+			org.susceptibilities = g.randomSusceptibilities(org.code.ID)
+		}
+		organisms = append(organisms, org)
+	}
+	if len(organisms) > 0 {
+		return organisms, nil
+	}
+
+	for _, r := range previous {
+		if r.CodedValue == nil || r.TestName == nil || r.TestName.ID != g.Microbiology.OrganismObservation.ID {
+			continue
+		}
+		code := *r.CodedValue
+		org := &identifiedOrganism{code: &code, susceptibilities: previousSusceptibilities(&code, previousChildren)}
+		if org.susceptibilities == nil {
+			org.susceptibilities = g.randomSusceptibilities(code.ID)
+		}
+		organisms = append(organisms, org)
+	}
+	if len(organisms) > 0 {
+		return organisms, nil
+	}
+
+	random := g.Microbiology.RandomOrganism()
+	if random == nil {
+		return nil, errors.New("cannot pick a random organism: no organism in the microbiology catalogue has a weight")
+	}
+	code := random.Code
+	return []*identifiedOrganism{{code: &code, susceptibilities: g.randomSusceptibilities(code.ID)}}, nil
+}
+
+// previousSusceptibilities returns copies of the susceptibilities that were reported for the
+// organism with the given code in the previous child orders, or nil if there are none.
+func previousSusceptibilities(code *ir.CodedElement, previousChildren []*ir.ChildOrder) []*ir.Result {
+	for _, child := range previousChildren {
+		parent := child.ParentResult
+		if parent == nil || parent.CodedValue == nil || parent.CodedValue.ID != code.ID {
+			continue
+		}
+		var susceptibilities []*ir.Result
+		for _, r := range child.Results {
+			s := *r
+			susceptibilities = append(susceptibilities, &s)
+		}
+		return susceptibilities
+	}
+	return nil
+}
+
+// randomSusceptibilities returns random susceptibilities for the susceptibility panel of the
+// organism with the given code in the catalogue. Returns nil if the organism is not in the
+// catalogue.
+func (g Generator) randomSusceptibilities(code string) []*ir.Result {
+	org, ok := g.Microbiology.Organism(code)
+	if !ok {
+		return nil
+	}
+	var susceptibilities []*ir.Result
+	for _, p := range org.Panel {
+		interpretation := p.RandomInterpretation()
+		var mic string
+		if v, ok := p.Antibiotic.RandomMIC(interpretation); ok {
+			mic = formatMIC(v)
+		}
+		susceptibilities = append(susceptibilities, g.susceptibilityResult(p.Antibiotic.Code, interpretation, mic))
+	}
+	return susceptibilities
+}
+
+// susceptibility returns the result of the susceptibility test specified in the pathway.
+// The interpretation is derived from the MIC if it is not specified, and a random MIC that
+// matches the interpretation is generated if the MIC is not specified. Neither can be derived
+// for antibiotics that are not in the catalogue.
+func (g Generator) susceptibility(s *pathway.Susceptibility) *ir.Result {
+	ab, ok := g.Microbiology.Antibiotic(s.Antibiotic)
+	if !ok {
+		return g.susceptibilityResult(ir.CodedElement{ID: s.Antibiotic, Text: s.Antibiotic}, s.Interpretation, s.MIC)
+	}
+	interpretation, mic := s.Interpretation, s.MIC
+	if interpretation == "" {
+		// The MIC is valid if the pathway has been validated.
+		prefix, v, _ := orderprofile.ValueFromString(mic)
+		if strings.HasPrefix(prefix, ">") && !strings.HasPrefix(prefix, ">=") {
+			v = math.Nextafter(v, math.Inf(1))
+		}
+		interpretation = ab.Interpretation(v)
+	}
+	if mic == "" {
+		if v, ok := ab.RandomMIC(interpretation); ok {
+			mic = formatMIC(v)
+		}
+	}
+	return g.susceptibilityResult(ab.Code, interpretation, mic)
+}
+
+// susceptibilityResult returns the result of a susceptibility test of the given antibiotic.
+// The interpretation is set as the abnormal flag of the result.
+func (g Generator) susceptibilityResult(antibiotic ir.CodedElement, interpretation string, mic string) *ir.Result {
+	r := &ir.Result{
+		TestName:     &antibiotic,
+		Value:        mic,
+		AbnormalFlag: interpretation,
+	}
+	if mic == "" {
+		return r
+	}
+	r.Unit = g.Microbiology.MICUnit
+	if _, err := strconv.ParseFloat(mic, 64); err == nil {
+		r.ValueType = constants.NumericalValueType
+	} else {
+		// MICs with prefixes, e.g., "<=0.25".
+		r.ValueType = constants.StringValueType
+	}
+	return r
+}
+
+func formatMIC(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,311 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	bloodCultureCE     = &ir.CodedElement{ID: "BC", Text: "BLOOD CULTURE", CodingSystem: "WinPath"}
+	bacteriaCE         = &ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "LN"}
+	susceptibilityCE   = &ir.CodedElement{ID: "29576-6", Text: "29576-6", CodingSystem: "LN"}
+	ecoliCE            = &ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SCT"}
+	saureusCE          = &ir.CodedElement{ID: "3092008", Text: "Staphylococcus aureus", CodingSystem: "SCT"}
+	ampicillinCE       = &ir.CodedElement{ID: "AMP", Text: "Ampicillin", CodingSystem: "WinPath"}
+	ciprofloxacinCE    = &ir.CodedElement{ID: "CIP", Text: "Ciprofloxacin", CodingSystem: "LOCAL"}
+	vancomycinCE       = &ir.CodedElement{ID: "VAN", Text: "Vancomycin", CodingSystem: "WinPath"}
+	preliminaryResults = &pathway.Results{OrderProfile: "BLOOD CULTURE", OrderStatus: "A", ResultStatus: "P", Culture: &pathway.Culture{}}
+)
+
+func TestSetResultsCulture_NoGrowth(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testCultureGenerator(ctx, t)
+
+	cases := []struct {
+		name      string
+		r         *pathway.Results
+		wantValue string
+	}{{
+		name:      "final",
+		r:         &pathway.Results{OrderProfile: "BLOOD CULTURE", Culture: &pathway.Culture{NoGrowth: true}},
+		wantValue: "No growth",
+	}, {
+		name:      "preliminary",
+		r:         &pathway.Results{OrderProfile: "BLOOD CULTURE", OrderStatus: "A", ResultStatus: "P", Culture: &pathway.Culture{NoGrowth: true}},
+		wantValue: "No growth to date",
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := bloodCultureOrder(eventTime, hl7Config)
+			got, err := g.SetResults(o, tc.r, eventTime)
+			if err != nil {
+				t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, tc.r, eventTime, err)
+			}
+			want := []*ir.Result{{
+				TestName:            bacteriaCE,
+				Value:               tc.wantValue,
+				ValueType:           constants.TextualValueType,
+				Status:              got.ResultsStatus,
+				ObservationDateTime: got.CollectedDateTime,
+			}}
+			if diff := cmp.Diff(want, got.Results); diff != "" {
+				t.Errorf("SetResults(%v, %v, %v) got results diff (-want, +got):\n%s", o, tc.r, eventTime, diff)
+			}
+			if got.Children != nil {
+				t.Errorf("SetResults(%v, %v, %v) got children %v, want nil", o, tc.r, eventTime, got.Children)
+			}
+		})
+	}
+}
+
+func TestSetResultsCulture_Organisms(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testCultureGenerator(ctx, t)
+
+	r := &pathway.Results{
+		OrderProfile: "BLOOD CULTURE",
+		Culture: &pathway.Culture{
+			Organisms: []*pathway.Organism{{
+				Name: "Escherichia coli",
+				Susceptibilities: []*pathway.Susceptibility{
+					{Antibiotic: "AMP", Interpretation: "S", MIC: "4"},
+					// The interpretation is derived from the MIC.
+					{Antibiotic: "Ciprofloxacin", MIC: "0.5"},
+					{Antibiotic: "CIP", MIC: ">0.5"},
+					// Antibiotics not in the catalogue are reported as specified.
+					{Antibiotic: "Colistin", Interpretation: "R"},
+				},
+			}, {
+				// Organisms not in the catalogue are reported as specified.
+				Name: "Unknown organism",
+				Susceptibilities: []*pathway.Susceptibility{
+					{Antibiotic: "VAN", MIC: "<=1"},
+				},
+			}},
+		},
+	}
+	o := bloodCultureOrder(eventTime, hl7Config)
+	got, err := g.SetResults(o, r, eventTime)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, eventTime, err)
+	}
+
+	final := hl7Config.ResultStatus.Final
+	collected := got.CollectedDateTime
+	ecoli := &ir.Result{TestName: bacteriaCE, SubID: "1", CodedValue: ecoliCE, ValueType: "CE", Status: final, ObservationDateTime: collected}
+	unknown := &ir.Result{
+		TestName:            bacteriaCE,
+		SubID:               "2",
+		CodedValue:          &ir.CodedElement{ID: "Unknown organism", Text: "Unknown organism"},
+		ValueType:           "CE",
+		Status:              final,
+		ObservationDateTime: collected,
+	}
+	want := &ir.Order{
+		OrderProfile:          bloodCultureCE,
+		Placer:                seqID,
+		Filler:                seqID,
+		OrderDateTime:         ir.NewValidTime(eventTime),
+		CollectedDateTime:     collected,
+		ReceivedInLabDateTime: got.ReceivedInLabDateTime,
+		ReportedDateTime:      ir.NewValidTime(eventTime),
+		OrderControl:          hl7Config.OrderControl.New,
+		OrderStatus:           hl7Config.OrderStatus.Completed,
+		ResultsStatus:         final,
+		Results:               []*ir.Result{ecoli, unknown},
+		Children: []*ir.ChildOrder{{
+			UniversalService: susceptibilityCE,
+			ParentResult:     ecoli,
+			Results: []*ir.Result{
+				{TestName: ampicillinCE, Value: "4", Unit: "mg/L", ValueType: "NM", AbnormalFlag: "S", Status: final, ObservationDateTime: collected},
+				{TestName: ciprofloxacinCE, Value: "0.5", Unit: "mg/L", ValueType: "NM", AbnormalFlag: "I", Status: final, ObservationDateTime: collected},
+				{TestName: ciprofloxacinCE, Value: ">0.5", Unit: "mg/L", ValueType: "ST", AbnormalFlag: "R", Status: final, ObservationDateTime: collected},
+				{TestName: &ir.CodedElement{ID: "Colistin", Text: "Colistin"}, AbnormalFlag: "R", Status: final, ObservationDateTime: collected},
+			},
+		}, {
+			UniversalService: susceptibilityCE,
+			ParentResult:     unknown,
+			Results: []*ir.Result{
+				{TestName: vancomycinCE, Value: "<=1", Unit: "mg/L", ValueType: "ST", AbnormalFlag: "S", Status: final, ObservationDateTime: collected},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SetResults(%v, %v, %v) diff (-want, +got):\n%s", o, r, eventTime, diff)
+	}
+}
+
+func TestSetResultsCulture_RandomOrganism(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testCultureGenerator(ctx, t)
+
+	r := &pathway.Results{OrderProfile: "BLOOD CULTURE", Culture: &pathway.Culture{}}
+	o := bloodCultureOrder(eventTime, hl7Config)
+	got, err := g.SetResults(o, r, eventTime)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, eventTime, err)
+	}
+
+	// Escherichia coli is the only organism with a weight in the test catalogue.
+	if got, want := len(got.Results), 1; got != want {
+		t.Fatalf("len(Results) got %d, want %d", got, want)
+	}
+	if diff := cmp.Diff(ecoliCE, got.Results[0].CodedValue); diff != "" {
+		t.Errorf("Results[0].CodedValue got diff (-want, +got):\n%s", diff)
+	}
+	if got, want := len(got.Children), 1; got != want {
+		t.Fatalf("len(Children) got %d, want %d", got, want)
+	}
+	// Escherichia coli is always resistant to Ampicillin and susceptible to Ciprofloxacin.
+	wantFlags := map[string]string{"AMP": "R", "CIP": "S"}
+	gotFlags := map[string]string{}
+	for _, s := range got.Children[0].Results {
+		gotFlags[s.TestName.ID] = s.AbnormalFlag
+		if s.Value == "" {
+			t.Errorf("susceptibility to %q got empty MIC, want a random MIC", s.TestName.ID)
+		}
+	}
+	if diff := cmp.Diff(wantFlags, gotFlags); diff != "" {
+		t.Errorf("susceptibility interpretations got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSetResultsCulture_PreliminaryThenFinal(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testCultureGenerator(ctx, t)
+
+	r := &pathway.Results{
+		OrderProfile: "BLOOD CULTURE",
+		OrderStatus:  "A",
+		ResultStatus: "P",
+		Culture:      &pathway.Culture{Organisms: []*pathway.Organism{{Name: "Staphylococcus aureus"}}},
+	}
+	o := bloodCultureOrder(eventTime, hl7Config)
+	o, err := g.SetResults(o, r, eventTime)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, eventTime, err)
+	}
+	if got, want := len(o.Results), 1; got != want {
+		t.Fatalf("len(Results) got %d, want %d", got, want)
+	}
+	if diff := cmp.Diff(saureusCE, o.Results[0].CodedValue); diff != "" {
+		t.Errorf("preliminary Results[0].CodedValue got diff (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Susceptibilities to follow"}, o.Results[0].Notes); diff != "" {
+		t.Errorf("preliminary Results[0].Notes got diff (-want, +got):\n%s", diff)
+	}
+	if o.Children != nil {
+		t.Errorf("preliminary Children got %v, want nil", o.Children)
+	}
+
+	// The final results report the organism from the preliminary results, with its susceptibilities.
+	later := eventTime.Add(48 * time.Hour)
+	r = &pathway.Results{OrderProfile: "BLOOD CULTURE", Culture: &pathway.Culture{}}
+	o, err = g.SetResults(o, r, later)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, later, err)
+	}
+	if got, want := o.ResultsStatus, hl7Config.ResultStatus.Final; got != want {
+		t.Errorf("ResultsStatus got %q, want %q", got, want)
+	}
+	if got, want := len(o.Results), 1; got != want {
+		t.Fatalf("len(Results) got %d, want %d", got, want)
+	}
+	if diff := cmp.Diff(saureusCE, o.Results[0].CodedValue); diff != "" {
+		t.Errorf("final Results[0].CodedValue got diff (-want, +got):\n%s", diff)
+	}
+	if got := o.Results[0].Notes; len(got) != 0 {
+		t.Errorf("final Results[0].Notes got %v, want none", got)
+	}
+	if got, want := len(o.Children), 1; got != want {
+		t.Fatalf("len(Children) got %d, want %d", got, want)
+	}
+	if got, want := o.Children[0].Results[0].TestName.ID, "VAN"; got != want {
+		t.Errorf("final susceptibility got antibiotic %q, want %q", got, want)
+	}
+	if got, want := o.Children[0].Results[0].AbnormalFlag, "S"; got != want {
+		t.Errorf("final susceptibility got interpretation %q, want %q", got, want)
+	}
+}
+
+func TestSetResultsCulture_CorrectionKeepsSusceptibilities(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testCultureGenerator(ctx, t)
+
+	r := &pathway.Results{OrderProfile: "BLOOD CULTURE", Culture: &pathway.Culture{}}
+	o := bloodCultureOrder(eventTime, hl7Config)
+	o, err := g.SetResults(o, r, eventTime)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, eventTime, err)
+	}
+	var final []string
+	for _, s := range o.Children[0].Results {
+		final = append(final, s.Value)
+	}
+
+	later := eventTime.Add(24 * time.Hour)
+	o, err = g.SetResults(o, r, later)
+	if err != nil {
+		t.Fatalf("SetResults(%v, %v, %v) failed with %v", o, r, later, err)
+	}
+	if got, want := o.ResultsStatus, hl7Config.ResultStatus.Corrected; got != want {
+		t.Errorf("ResultsStatus got %q, want %q", got, want)
+	}
+	var corrected []string
+	for _, s := range o.Children[0].Results {
+		corrected = append(corrected, s.Value)
+		if got, want := s.Status, hl7Config.ResultStatus.Corrected; got != want {
+			t.Errorf("susceptibility to %q got status %q, want %q", s.TestName.ID, got, want)
+		}
+	}
+	if diff := cmp.Diff(final, corrected); diff != "" {
+		t.Errorf("corrected MICs got diff (-final, +corrected):\n%s", diff)
+	}
+}
+
+func TestSetResultsCulture_NoCatalogue(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testGenerator(ctx, t)
+
+	r := &pathway.Results{OrderProfile: "BLOOD CULTURE", Culture: &pathway.Culture{}}
+	o := bloodCultureOrder(eventTime, hl7Config)
+	if _, err := g.SetResults(o, r, eventTime); err == nil {
+		t.Errorf("SetResults(%v, %v, %v) got nil error, want non nil", o, r, eventTime)
+	}
+}
+
+func testCultureGenerator(ctx context.Context, t *testing.T) (*Generator, *config.HL7Config) {
+	t.Helper()
+	g, hl7Config := testGenerator(ctx, t)
+	g.Microbiology = testconfig.Microbiology(t, hl7Config)
+	return g, hl7Config
+}
+
+func bloodCultureOrder(eventTime time.Time, c *config.HL7Config) *ir.Order {
+	o := ureaOrder(eventTime, c)
+	o.OrderProfile = bloodCultureCE
+	return o
+}
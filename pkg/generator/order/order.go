@@ -18,6 +18,7 @@ package order
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/bitcrshr/simhospital/pkg/config"
@@ -28,6 +29,7 @@ import (
 	"github.com/bitcrshr/simhospital/pkg/ir"
 	"github.com/bitcrshr/simhospital/pkg/logging"
 	"github.com/bitcrshr/simhospital/pkg/message"
+	"github.com/bitcrshr/simhospital/pkg/microbiology"
 	"github.com/bitcrshr/simhospital/pkg/orderprofile"
 	"github.com/bitcrshr/simhospital/pkg/pathway"
 	cpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/codes_go_proto"
@@ -36,6 +38,9 @@ import (
 
 var log = logging.ForCallerPackage()
 
+// specimenTypeCodingSystem is the coding system of the specimen types.
+const specimenTypeCodingSystem = "HL70487"
+
 // NotesGenerator is an interface to generate notes for results and clinical notes.
 type NotesGenerator interface {
 	// RandomNotesForResult generates textual notes for a Result, to be set in NTE segments related to the result.
@@ -53,6 +58,8 @@ type Generator struct {
 	FillerGenerator       id.Generator
 	AbnormalFlagConvertor AbnormalFlagConvertor
 	Doctors               *doctor.Doctors
+	// Microbiology is the catalogue of organisms and antibiotics used to generate culture results.
+	Microbiology *microbiology.Catalogue
 }
 
 // NewOrder returns a new order based on order information from the pathway and eventTime.
@@ -61,13 +68,44 @@ func (g Generator) NewOrder(o *pathway.Order, eventTime time.Time) *ir.Order {
 	if orderStatus == "" {
 		orderStatus = g.MessageConfig.OrderStatus.InProcess
 	}
+	orderProfile := g.OrderProfiles.Generate(o.OrderProfile)
 	return &ir.Order{
-		OrderProfile:  g.OrderProfiles.Generate(o.OrderProfile),
+		OrderProfile:  orderProfile,
 		Placer:        g.PlacerGenerator.NewID(),
 		OrderDateTime: ir.NewValidTime(eventTime),
 		OrderControl:  g.MessageConfig.OrderControl.New,
 		OrderStatus:   orderStatus,
+		MessageStyle:  g.messageStyle(o),
+		Priority:      g.MessageConfig.Order.Priority,
+		Specimen:      g.specimen(orderProfile),
+	}
+}
+
+// specimen returns the specimen of an order with the given order profile: the specimen of the
+// order profile if it defines one, otherwise a specimen with the type in the HL7 configuration.
+// Returns nil if neither is set.
+func (g Generator) specimen(orderProfile *ir.CodedElement) *ir.Specimen {
+	if op, ok := g.OrderProfiles.Get(orderProfile.Text); ok && op.Specimen != nil {
+		s := *op.Specimen
+		return &s
+	}
+	if t := g.MessageConfig.Order.SpecimenType; t != "" {
+		return &ir.Specimen{Type: &ir.CodedElement{ID: t, Text: t, CodingSystem: specimenTypeCodingSystem}}
+	}
+	return nil
+}
+
+// messageStyle returns the message style of the order: the one in the pathway if set, otherwise the
+// one in the HL7 configuration, otherwise constants.ORMOrderStyle.
+func (g Generator) messageStyle(o *pathway.Order) string {
+	style := o.MessageStyle
+	if style == "" {
+		style = g.MessageConfig.Order.MessageStyle
 	}
+	if style == "" {
+		return constants.ORMOrderStyle
+	}
+	return strings.ToUpper(style)
 }
 
 // OrderWithClinicalNote updates an order with a Clinical Note. If the supplied order is nil, a new order is created.
@@ -115,6 +153,11 @@ func (g Generator) OrderWithClinicalNote(ctx context.Context, order *ir.Order, n
 // If the Order already has the Results, they are replaced with Results from the pathway as the corrected results,
 // unless another status is explicitly specified in the pathway.
 // In the case of correction, only results specified in the pathway are included.
+// If the pathway revises earlier results instead, the earlier results that are not specified in the
+// pathway are carried forward unchanged.
+//
+// If the pathway specifies a result ID, the results are recorded in the order so that they can be
+// revised later.
 func (g Generator) SetResults(o *ir.Order, r *pathway.Results, eventTime time.Time) (*ir.Order, error) {
 	if o == nil {
 		o = g.NewOrder(&pathway.Order{OrderProfile: r.OrderProfile}, eventTime)
@@ -130,6 +173,12 @@ func (g Generator) SetResults(o *ir.Order, r *pathway.Results, eventTime time.Ti
 	if err := g.setOrderResults(o, r); err != nil {
 		return nil, errors.Wrap(err, "cannot set results on the order")
 	}
+	if r.ResultID != "" {
+		if o.ResultSets == nil {
+			o.ResultSets = map[string]*ir.ResultSet{}
+		}
+		o.ResultSets[r.ResultID] = &ir.ResultSet{Results: o.Results, NumberOfPreviousResults: o.NumberOfPreviousResults}
+	}
 
 	return o, nil
 }
@@ -168,6 +217,7 @@ func (g Generator) setOrderStatuses(o *ir.Order, r *pathway.Results) {
 // order time <= collected time <= received in lab time <= reported time
 // If CollectedDateTime or ReceivedInLabDateTime are specified explicitly in the pathway,
 // then they override the order dates.
+// The collection and received dates of the order's specimen, if any, are the same as the order's.
 func (g Generator) setOrderDates(o *ir.Order, r *pathway.Results, eventTime time.Time) error {
 	o.ReportedDateTime = ir.NewValidTime(eventTime)
 	// If this is the first Result for this order, also set CollectedDateTime and ReceivedInLabDateTime.
@@ -202,6 +252,10 @@ func (g Generator) setOrderDates(o *ir.Order, r *pathway.Results, eventTime time
 		o.ReceivedInLabDateTime = received
 	}
 
+	if o.Specimen != nil {
+		o.Specimen.CollectedDateTime = o.CollectedDateTime
+		o.Specimen.ReceivedDateTime = o.ReceivedInLabDateTime
+	}
 	return nil
 }
 
@@ -213,13 +267,25 @@ func (g Generator) setOrderDates(o *ir.Order, r *pathway.Results, eventTime time
 //
 // Otherwise, if the results are defined for non-existing order profile, then
 // only results specified explicitly are included.
+// The results of a culture, if specified, are included after the results above, and random
+// results are not generated for the test types of the Order Profile.
 func (g Generator) setOrderResults(o *ir.Order, r *pathway.Results) error {
+	previous, previousChildren := o.Results, o.Children
 	o.Results = make([]*ir.Result, 0)
+	o.Children = nil
 	opName := o.OrderProfile.Text
 	op, ok := g.OrderProfiles.Get(opName)
 
+	if r.Revises != "" {
+		set, ok := o.ResultSets[r.Revises]
+		if !ok {
+			return fmt.Errorf("unknown result id %q", r.Revises)
+		}
+		return g.setRevisedResults(o, op, r, set)
+	}
+
 	switch {
-	case ok && len(r.Results) == 0:
+	case ok && len(r.Results) == 0 && r.Culture == nil:
 		// Include a result for each test type specified in the order profile.
 		for _, tt := range op.TestTypes {
 			placeholder := &pathway.Result{
@@ -248,9 +314,91 @@ func (g Generator) setOrderResults(o *ir.Order, r *pathway.Results) error {
 			o.Results = append(o.Results, tr)
 		}
 	}
+
+	if r.Culture != nil {
+		if err := g.setCultureResults(o, r.Culture, previous, previousChildren); err != nil {
+			return errors.Wrap(err, "cannot generate culture results")
+		}
+	}
+	return nil
+}
+
+// setRevisedResults sets the results of the given order to a revision of the given set of results.
+// The results in the pathway replace the results in the set with the same test name, and are
+// added if there are none; the other results in the set are carried forward unchanged.
+// Carried forward results keep their status if the revision is a correction, so that only the
+// revised results are flagged as corrected, and take the status of the revision otherwise, e.g.,
+// when preliminary results become final.
+func (g Generator) setRevisedResults(o *ir.Order, op *orderprofile.OrderProfile, r *pathway.Results, set *ir.ResultSet) error {
+	o.NumberOfPreviousResults = set.NumberOfPreviousResults
+	revised := map[string]*pathway.Result{}
+	for _, pr := range r.Results {
+		revised[pr.TestName] = pr
+	}
+
+	for _, previous := range set.Results {
+		pr, ok := revised[previous.TestName.Text]
+		if !ok {
+			carried := *previous
+			if o.ResultsStatus != g.MessageConfig.ResultStatus.Corrected {
+				carried.Status = o.ResultsStatus
+			}
+			if carried.Status != previous.Status {
+				carried.Revision++
+			}
+			o.Results = append(o.Results, &carried)
+			continue
+		}
+		delete(revised, previous.TestName.Text)
+		tr, err := g.revisedResult(o, op, pr, previous)
+		if err != nil {
+			return errors.Wrapf(err, "cannot revise test result %q", pr.TestName)
+		}
+		tr.Revision = previous.Revision + 1
+		tr.ObservationID = previous.ObservationID
+		if r.AmendmentNote != "" {
+			tr.Notes = append(append([]string{}, tr.Notes...), r.AmendmentNote)
+		}
+		o.Results = append(o.Results, tr)
+	}
+
+	// Add the results that were not in the set, in the order of the pathway.
+	for _, pr := range r.Results {
+		if _, ok := revised[pr.TestName]; !ok {
+			continue
+		}
+		tr, err := g.testResult(op, pr, o.ResultsStatus, o.CollectedDateTime)
+		if err != nil {
+			return errors.Wrap(err, "cannot generate test result")
+		}
+		o.Results = append(o.Results, tr)
+	}
 	return nil
 }
 
+// revisedResult returns the revision of the given previous result specified in the pathway.
+// If the value is not specified in the pathway, the previous value is kept, or cleared if the
+// result is cancelled, and only the status and the notes are revised.
+func (g Generator) revisedResult(o *ir.Order, op *orderprofile.OrderProfile, pr *pathway.Result, previous *ir.Result) (*ir.Result, error) {
+	if pr.Value != "" {
+		return g.testResult(op, pr, o.ResultsStatus, o.CollectedDateTime)
+	}
+	tr := *previous
+	tr.Status = o.ResultsStatus
+	if pr.ResultStatus != "" {
+		tr.Status = pr.ResultStatus
+	}
+	if len(pr.Notes) > 0 {
+		tr.Notes = pr.Notes
+	}
+	if tr.Status == g.MessageConfig.ResultStatus.Cancelled {
+		tr.Value = ""
+		tr.Unit = ""
+		tr.AbnormalFlag = ""
+	}
+	return &tr, nil
+}
+
 func overriddenDate(fromPathway string, t ir.NullTime) (ir.NullTime, error) {
 	switch fromPathway {
 	case constants.EmptyString:
@@ -420,18 +568,29 @@ func (g Generator) setValueSpecifiedInThePathway(result *ir.Result, pathwayResul
 	return nil
 }
 
-// Convertor converts between the HL7 and FHIR representations of a result status.
+// Convertor converts between the HL7 and FHIR representations of result and order statuses.
 type Convertor struct {
 	hl7ToFHIR *hl7tofhirmap.Convertor
 }
 
-// NewConvertor returns a new result status Convertor based on the HL7Config.
-// Full set of codes can be found at https://www.hl7.org/fhir/codesystem-observation-status.html.
+// NewConvertor returns a new status Convertor based on the HL7Config.
+// Full set of codes can be found at https://www.hl7.org/fhir/codesystem-observation-status.html
+// and https://www.hl7.org/fhir/codesystem-request-status.html.
 func NewConvertor(c *config.HL7Config) Convertor {
 	return Convertor{hl7ToFHIR: &hl7tofhirmap.Convertor{
 		ObservationStatusCodeMap: map[string]cpb.ObservationStatusCode_Value{
-			c.ResultStatus.Final:     cpb.ObservationStatusCode_FINAL,
-			c.ResultStatus.Corrected: cpb.ObservationStatusCode_AMENDED,
+			c.ResultStatus.Preliminary: cpb.ObservationStatusCode_PRELIMINARY,
+			c.ResultStatus.Final:       cpb.ObservationStatusCode_FINAL,
+			c.ResultStatus.Corrected:   cpb.ObservationStatusCode_AMENDED,
+			c.ResultStatus.Cancelled:   cpb.ObservationStatusCode_CANCELLED,
+			c.ResultStatus.Deleted:     cpb.ObservationStatusCode_ENTERED_IN_ERROR,
+		},
+		RequestStatusCodeMap: map[string]cpb.RequestStatusCode_Value{
+			c.OrderStatus.InProcess:    cpb.RequestStatusCode_ACTIVE,
+			c.OrderStatus.Completed:    cpb.RequestStatusCode_COMPLETED,
+			c.OrderStatus.Held:         cpb.RequestStatusCode_ON_HOLD,
+			c.OrderStatus.Discontinued: cpb.RequestStatusCode_REVOKED,
+			c.OrderStatus.Cancelled:    cpb.RequestStatusCode_REVOKED,
 		},
 	}}
 }
@@ -440,3 +599,15 @@ func NewConvertor(c *config.HL7Config) Convertor {
 func (c Convertor) HL7ToFHIR(status string) cpb.ObservationStatusCode_Value {
 	return c.hl7ToFHIR.ObservationStatusCode(status)
 }
+
+// OrderStatusHL7ToFHIR returns the FHIR representation for the given HL7 order status.
+// Order statuses that don't have a FHIR representation are converted to UNKNOWN.
+func (c Convertor) OrderStatusHL7ToFHIR(status string) cpb.RequestStatusCode_Value {
+	if status == "" {
+		return cpb.RequestStatusCode_UNKNOWN
+	}
+	if s := c.hl7ToFHIR.RequestStatusCode(status); s != cpb.RequestStatusCode_INVALID_UNINITIALIZED {
+		return s
+	}
+	return cpb.RequestStatusCode_UNKNOWN
+}
@@ -79,31 +79,42 @@ UREA AND ELECTROLYTES:
 	g, hl7Config := testGeneratorWithOrderProfile(ctx, t, op)
 
 	cases := []struct {
-		name            string
-		pathway         *pathway.Order
-		wantOrderStatus string
-		wantOP          *ir.CodedElement
+		name             string
+		pathway          *pathway.Order
+		wantOrderStatus  string
+		wantOP           *ir.CodedElement
+		wantMessageStyle string
 	}{
 		{
-			name:            "Existing order profile",
-			pathway:         &pathway.Order{OrderProfile: "UREA AND ELECTROLYTES"},
-			wantOrderStatus: hl7Config.OrderStatus.InProcess,
-			wantOP:          &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			name:             "Existing order profile",
+			pathway:          &pathway.Order{OrderProfile: "UREA AND ELECTROLYTES"},
+			wantOrderStatus:  hl7Config.OrderStatus.InProcess,
+			wantOP:           &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			wantMessageStyle: constants.ORMOrderStyle,
+		}, {
+			name:             "No matching order profile",
+			pathway:          &pathway.Order{OrderProfile: "Foo"},
+			wantOrderStatus:  hl7Config.OrderStatus.InProcess,
+			wantOP:           &ir.CodedElement{ID: "Foo", Text: "Foo"},
+			wantMessageStyle: constants.ORMOrderStyle,
 		}, {
-			name:            "No matching order profile",
-			pathway:         &pathway.Order{OrderProfile: "Foo"},
-			wantOrderStatus: hl7Config.OrderStatus.InProcess,
-			wantOP:          &ir.CodedElement{ID: "Foo", Text: "Foo"},
+			name:             "Random order profile",
+			pathway:          &pathway.Order{OrderProfile: constants.RandomString},
+			wantOrderStatus:  hl7Config.OrderStatus.InProcess,
+			wantOP:           &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			wantMessageStyle: constants.ORMOrderStyle,
 		}, {
-			name:            "Random order profile",
-			pathway:         &pathway.Order{OrderProfile: constants.RandomString},
-			wantOrderStatus: hl7Config.OrderStatus.InProcess,
-			wantOP:          &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			name:             "OrderStatus explicitly specified",
+			pathway:          &pathway.Order{OrderProfile: constants.RandomString, OrderStatus: "DISPATCH"},
+			wantOrderStatus:  "DISPATCH",
+			wantOP:           &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			wantMessageStyle: constants.ORMOrderStyle,
 		}, {
-			name:            "OrderStatus explicitly specified",
-			pathway:         &pathway.Order{OrderProfile: constants.RandomString, OrderStatus: "DISPATCH"},
-			wantOrderStatus: "DISPATCH",
-			wantOP:          &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			name:             "MessageStyle explicitly specified",
+			pathway:          &pathway.Order{OrderProfile: "UREA AND ELECTROLYTES", MessageStyle: "oml"},
+			wantOrderStatus:  hl7Config.OrderStatus.InProcess,
+			wantOP:           &ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			wantMessageStyle: constants.OMLOrderStyle,
 		},
 	}
 
@@ -118,6 +129,9 @@ UREA AND ELECTROLYTES:
 				CollectedDateTime:     ir.NewInvalidTime(),
 				ReceivedInLabDateTime: ir.NewInvalidTime(),
 				ReportedDateTime:      ir.NewInvalidTime(),
+				MessageStyle:          tc.wantMessageStyle,
+				Priority:              hl7Config.Order.Priority,
+				Specimen:              &ir.Specimen{Type: &ir.CodedElement{ID: "BLD", Text: "BLD", CodingSystem: "HL70487"}},
 			}
 			got := g.NewOrder(tc.pathway, eventTime)
 			if diff := cmp.Diff(want, got); diff != "" {
@@ -127,6 +141,61 @@ UREA AND ELECTROLYTES:
 	}
 }
 
+func TestNewOrder_Specimen(t *testing.T) {
+	b := []byte(`
+UREA AND ELECTROLYTES:
+  universal_service_id: lpdc-3969
+  specimen:
+    type:
+      id: SER
+      text: Serum
+    container:
+      id: SST
+  test_types:
+    Creatinine:
+      id: lpdc-2012
+      value_type: NM
+      value: 51
+      unit: UMOLL
+      ref_range: 49 - 92`)
+	op := testwrite.BytesToFile(t, b)
+
+	ctx := context.Background()
+	g, _ := testGeneratorWithOrderProfile(ctx, t, op)
+
+	cases := []struct {
+		name         string
+		pathway      *pathway.Order
+		wantSpecimen *ir.Specimen
+	}{{
+		name:    "Specimen from the order profile",
+		pathway: &pathway.Order{OrderProfile: "UREA AND ELECTROLYTES"},
+		wantSpecimen: &ir.Specimen{
+			Type:      &ir.CodedElement{ID: "SER", Text: "Serum", CodingSystem: "HL70487"},
+			Container: &ir.CodedElement{ID: "SST", Text: "SST", CodingSystem: "WinPath"},
+		},
+	}, {
+		name:         "Specimen type from the HL7 config",
+		pathway:      &pathway.Order{OrderProfile: "Foo"},
+		wantSpecimen: &ir.Specimen{Type: &ir.CodedElement{ID: "BLD", Text: "BLD", CodingSystem: "HL70487"}},
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := g.NewOrder(tc.pathway, eventTime)
+			if diff := cmp.Diff(tc.wantSpecimen, got.Specimen); diff != "" {
+				t.Errorf("g.NewOrder(%v, %v).Specimen diff: (-want, +got):\n%s", tc.pathway, eventTime, diff)
+			}
+		})
+	}
+
+	// Orders with the same order profile don't share their specimens.
+	o1 := g.NewOrder(&pathway.Order{OrderProfile: "UREA AND ELECTROLYTES"}, eventTime)
+	o2 := g.NewOrder(&pathway.Order{OrderProfile: "UREA AND ELECTROLYTES"}, eventTime)
+	if o1.Specimen == o2.Specimen {
+		t.Errorf("g.NewOrder() returned orders that share the specimen %+v, want different specimens", o1.Specimen)
+	}
+}
+
 func TestOrderWithClinicalNote(t *testing.T) {
 	ctx := context.Background()
 	hl7Config, err := config.LoadHL7Config(ctx, test.MessageConfigTest)
@@ -406,6 +475,16 @@ func TestSetResultsOverrideDates(t *testing.T) {
 					},
 				},
 			}
+			if tc.order == nil {
+				// Orders created by SetResults use the order settings in the HL7 configuration.
+				want.MessageStyle = constants.ORMOrderStyle
+				want.Priority = hl7Config.Order.Priority
+				want.Specimen = &ir.Specimen{
+					Type:              &ir.CodedElement{ID: "BLD", Text: "BLD", CodingSystem: "HL70487"},
+					CollectedDateTime: tc.wantCollectedDateTime,
+					ReceivedDateTime:  tc.wantReceivedInLabDateTime,
+				}
+			}
 			got, err := g.SetResults(tc.order, tc.pathwayR, eventTime)
 			if err != nil {
 				t.Fatalf("SetResults(%+v, %+v, %+v) failed with %v", tc.order, tc.pathwayR, eventTime, err)
@@ -1484,6 +1563,151 @@ func TestSetResultsOverrideNotes(t *testing.T) {
 	}
 }
 
+func TestSetResultsRevisions(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testGeneratorWithOrderProfile(ctx, t, test.ComplexOrderProfilesConfigTest)
+	obsTime := ir.NewValidTime(eventTime)
+	// observationID is the ID set on the results as if they were converted to FHIR after they are
+	// first sent. It must be kept by their revisions.
+	observationID := func(ce *ir.CodedElement, revision int) string {
+		if revision == 0 {
+			return ""
+		}
+		return "observation-" + ce.ID
+	}
+	creatinine := func(value string, flag string, status string, revision int, notes ...string) *ir.Result {
+		return &ir.Result{
+			TestName:            creatinineCE,
+			Value:               value,
+			Unit:                "UMOLL",
+			ValueType:           "NM",
+			Range:               creatinineRange,
+			AbnormalFlag:        flag,
+			Status:              status,
+			ObservationDateTime: obsTime,
+			Notes:               notes,
+			Revision:            revision,
+			ObservationID:       observationID(creatinineCE, revision),
+		}
+	}
+	potassium := func(value string, unit string, status string, revision int) *ir.Result {
+		return &ir.Result{
+			TestName:            potassiumCE,
+			Value:               value,
+			Unit:                unit,
+			ValueType:           "NM",
+			Range:               "3.5 - 5.1",
+			Status:              status,
+			ObservationDateTime: obsTime,
+			Revision:            revision,
+			ObservationID:       observationID(potassiumCE, revision),
+		}
+	}
+
+	steps := []struct {
+		name                        string
+		r                           *pathway.Results
+		wantResultsStatus           string
+		wantResults                 []*ir.Result
+		wantNumberOfPreviousResults int
+	}{{
+		name: "preliminary",
+		r: &pathway.Results{
+			OrderProfile: "UREA AND ELECTROLYTES",
+			OrderStatus:  "A",
+			ResultStatus: hl7Config.ResultStatus.Preliminary,
+			ResultID:     "preliminary",
+			Results: []*pathway.Result{
+				{TestName: "Creatinine", Value: "100", Unit: "UMOLL", AbnormalFlag: constants.AbnormalFlagDefault},
+				{TestName: "Potassium", Value: "3.6", Unit: "MMOLL"},
+			},
+		},
+		wantResultsStatus: hl7Config.ResultStatus.Preliminary,
+		wantResults: []*ir.Result{
+			creatinine("100", "H", hl7Config.ResultStatus.Preliminary, 0),
+			potassium("3.6", "MMOLL", hl7Config.ResultStatus.Preliminary, 0),
+		},
+	}, {
+		name:              "final carries forward all results",
+		r:                 &pathway.Results{Revises: "preliminary", ResultID: "final"},
+		wantResultsStatus: hl7Config.ResultStatus.Final,
+		wantResults: []*ir.Result{
+			creatinine("100", "H", hl7Config.ResultStatus.Final, 1),
+			potassium("3.6", "MMOLL", hl7Config.ResultStatus.Final, 1),
+		},
+	}, {
+		name: "correction only flags the changed result",
+		r: &pathway.Results{
+			Revises:       "final",
+			ResultID:      "corrected",
+			AmendmentNote: "Recalculated after haemolysis",
+			Results:       []*pathway.Result{{TestName: "Creatinine", Value: "60", Unit: "UMOLL", AbnormalFlag: constants.AbnormalFlagDefault}},
+		},
+		wantResultsStatus: hl7Config.ResultStatus.Corrected,
+		wantResults: []*ir.Result{
+			creatinine("60", "", hl7Config.ResultStatus.Corrected, 2, "Recalculated after haemolysis"),
+			potassium("3.6", "MMOLL", hl7Config.ResultStatus.Final, 1),
+		},
+	}, {
+		name: "cancelled result keeps no value",
+		r: &pathway.Results{
+			Revises: "corrected",
+			Results: []*pathway.Result{{TestName: "Potassium", ResultStatus: hl7Config.ResultStatus.Cancelled}},
+		},
+		wantResultsStatus: hl7Config.ResultStatus.Corrected,
+		wantResults: []*ir.Result{
+			creatinine("60", "", hl7Config.ResultStatus.Corrected, 2, "Recalculated after haemolysis"),
+			potassium("", "", hl7Config.ResultStatus.Cancelled, 2),
+		},
+	}, {
+		name: "deleted result keeps its value",
+		r: &pathway.Results{
+			Revises: "corrected",
+			Results: []*pathway.Result{{TestName: "Potassium", ResultStatus: hl7Config.ResultStatus.Deleted}},
+		},
+		wantResultsStatus: hl7Config.ResultStatus.Corrected,
+		wantResults: []*ir.Result{
+			creatinine("60", "", hl7Config.ResultStatus.Corrected, 2, "Recalculated after haemolysis"),
+			potassium("3.6", "MMOLL", hl7Config.ResultStatus.Deleted, 2),
+		},
+	}}
+
+	o := ureaOrder(eventTime, hl7Config)
+	for _, step := range steps {
+		got, err := g.SetResults(o, step.r, eventTime)
+		if err != nil {
+			t.Fatalf("[%s] SetResults(%v, %v, %v) failed with %v", step.name, o, step.r, eventTime, err)
+		}
+		if got, want := got.ResultsStatus, step.wantResultsStatus; got != want {
+			t.Errorf("[%s] SetResults(%v, %v, %v) got ResultsStatus %q, want %q", step.name, o, step.r, eventTime, got, want)
+		}
+		if diff := cmp.Diff(step.wantResults, got.Results); diff != "" {
+			t.Errorf("[%s] SetResults(%v, %v, %v) got results diff (-want, +got):\n%s", step.name, o, step.r, eventTime, diff)
+		}
+		if got, want := got.NumberOfPreviousResults, step.wantNumberOfPreviousResults; got != want {
+			t.Errorf("[%s] SetResults(%v, %v, %v) got NumberOfPreviousResults %d, want %d", step.name, o, step.r, eventTime, got, want)
+		}
+		// Results that are not revised are sent with new SetIDs.
+		got.NumberOfPreviousResults += len(got.Results)
+		for _, r := range got.Results {
+			if r.ObservationID == "" {
+				r.ObservationID = "observation-" + r.TestName.ID
+			}
+		}
+		o = got
+	}
+}
+
+func TestSetResultsRevisions_UnknownResultID(t *testing.T) {
+	ctx := context.Background()
+	g, hl7Config := testGenerator(ctx, t)
+	o := ureaOrderWithPotassiumResult(eventTime, hl7Config)
+	r := &pathway.Results{Revises: "unknown"}
+	if _, err := g.SetResults(o, r, eventTime); err == nil {
+		t.Errorf("SetResults(%v, %v, %v) got nil error, want non nil", o, r, eventTime)
+	}
+}
+
 func TestConvertorHL7ToFHIR(t *testing.T) {
 	ctx := context.Background()
 	hl7Config, err := config.LoadHL7Config(ctx, test.MessageConfigTest)
@@ -1492,9 +1716,12 @@ func TestConvertorHL7ToFHIR(t *testing.T) {
 	}
 
 	wantMapping := map[string]cpb.ObservationStatusCode_Value{
-		"":                               cpb.ObservationStatusCode_INVALID_UNINITIALIZED,
-		hl7Config.ResultStatus.Final:     cpb.ObservationStatusCode_FINAL,
-		hl7Config.ResultStatus.Corrected: cpb.ObservationStatusCode_AMENDED,
+		"":                                 cpb.ObservationStatusCode_INVALID_UNINITIALIZED,
+		hl7Config.ResultStatus.Preliminary: cpb.ObservationStatusCode_PRELIMINARY,
+		hl7Config.ResultStatus.Final:       cpb.ObservationStatusCode_FINAL,
+		hl7Config.ResultStatus.Corrected:   cpb.ObservationStatusCode_AMENDED,
+		hl7Config.ResultStatus.Cancelled:   cpb.ObservationStatusCode_CANCELLED,
+		hl7Config.ResultStatus.Deleted:     cpb.ObservationStatusCode_ENTERED_IN_ERROR,
 	}
 	c := NewConvertor(hl7Config)
 
@@ -1507,6 +1734,33 @@ func TestConvertorHL7ToFHIR(t *testing.T) {
 	}
 }
 
+func TestConvertorOrderStatusHL7ToFHIR(t *testing.T) {
+	ctx := context.Background()
+	hl7Config, err := config.LoadHL7Config(ctx, test.MessageConfigTest)
+	if err != nil {
+		t.Fatalf("LoadHL7Config(%s) failed with %v", test.MessageConfigTest, err)
+	}
+
+	wantMapping := map[string]cpb.RequestStatusCode_Value{
+		"":                                 cpb.RequestStatusCode_UNKNOWN,
+		"unknown-status":                   cpb.RequestStatusCode_UNKNOWN,
+		hl7Config.OrderStatus.InProcess:    cpb.RequestStatusCode_ACTIVE,
+		hl7Config.OrderStatus.Completed:    cpb.RequestStatusCode_COMPLETED,
+		hl7Config.OrderStatus.Held:         cpb.RequestStatusCode_ON_HOLD,
+		hl7Config.OrderStatus.Discontinued: cpb.RequestStatusCode_REVOKED,
+		hl7Config.OrderStatus.Cancelled:    cpb.RequestStatusCode_REVOKED,
+	}
+	c := NewConvertor(hl7Config)
+
+	for k, v := range wantMapping {
+		t.Run(fmt.Sprintf("%v-%v", k, v), func(t *testing.T) {
+			if got, want := c.OrderStatusHL7ToFHIR(k), v; got != want {
+				t.Errorf("c.OrderStatusHL7ToFHIR(%v)=%v, want %v", k, got, want)
+			}
+		})
+	}
+}
+
 func testGenerator(ctx context.Context, t *testing.T) (*Generator, *config.HL7Config) {
 	t.Helper()
 	return testGeneratorWithOrderProfile(ctx, t, test.OrderProfilesConfigTest)
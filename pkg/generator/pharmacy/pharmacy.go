@@ -0,0 +1,127 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pharmacy contains functions needed to generate ir.MedicationOrder objects, and their
+// dispenses and administrations.
+package pharmacy
+
+import (
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/generator/id"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/medication"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/pkg/errors"
+)
+
+// Generator generates medication orders.
+type Generator struct {
+	HL7Config       *config.HL7Config
+	Medications     *medication.Medications
+	PlacerGenerator id.Generator
+	FillerGenerator id.Generator
+}
+
+// MedicationOrder returns a new medication order from the given prescription.
+// See pathway.Prescribe for information on how every field is populated.
+// Returns an error if a random medication is requested and there are no medications.
+func (g *Generator) MedicationOrder(eventTime time.Time, p *pathway.Prescribe, prescriber *ir.Doctor) (*ir.MedicationOrder, error) {
+	m, err := g.medication(p.Medication)
+	if err != nil {
+		return nil, err
+	}
+	code := m.Code
+	return &ir.MedicationOrder{
+		Placer:         g.PlacerGenerator.NewID(),
+		Filler:         g.FillerGenerator.NewID(),
+		OrderDateTime:  ir.NewValidTime(eventTime),
+		OrderControl:   g.HL7Config.OrderControl.New,
+		OrderStatus:    g.HL7Config.OrderStatus.InProcess,
+		Medication:     &code,
+		Dose:           valueOrDefault(p.Dose, m.Dose),
+		DoseUnits:      valueOrDefault(p.DoseUnits, m.DoseUnits),
+		DosageForm:     m.DosageForm,
+		Route:          valueOrDefault(p.Route, m.Route),
+		Frequency:      valueOrDefault(p.Frequency, m.Frequency),
+		DispenseAmount: m.DispenseAmount,
+		DispenseUnits:  m.DispenseUnits,
+		Components:     m.Components,
+		Prescriber:     prescriber,
+	}, nil
+}
+
+// Dispense adds a dispense to the given medication order, and returns it.
+func (g *Generator) Dispense(eventTime time.Time, mo *ir.MedicationOrder, d *pathway.Dispense) *ir.MedicationDispense {
+	dispense := &ir.MedicationDispense{
+		ID:       len(mo.Dispenses) + 1,
+		DateTime: ir.NewValidTime(eventTime),
+		Amount:   valueOrDefault(d.Amount, mo.DispenseAmount),
+		Units:    mo.DispenseUnits,
+	}
+	mo.OrderControl = g.HL7Config.OrderControl.WithObservations
+	mo.Dispenses = append(mo.Dispenses, dispense)
+	return dispense
+}
+
+// Administer adds an administration to the given medication order, and returns it.
+func (g *Generator) Administer(eventTime time.Time, mo *ir.MedicationOrder, a *pathway.Administer) *ir.MedicationAdministration {
+	administration := &ir.MedicationAdministration{
+		ID:               len(mo.Administrations) + 1,
+		DateTime:         ir.NewValidTime(eventTime),
+		Dose:             valueOrDefault(a.Dose, mo.Dose),
+		DoseUnits:        mo.DoseUnits,
+		CompletionStatus: g.HL7Config.Medication.CompletionStatus,
+	}
+	mo.OrderControl = g.HL7Config.OrderControl.WithObservations
+	mo.Administrations = append(mo.Administrations, administration)
+	return administration
+}
+
+// Discontinue marks the given medication order as discontinued.
+func (g *Generator) Discontinue(eventTime time.Time, mo *ir.MedicationOrder, d *pathway.DiscontinueMedication) {
+	mo.OrderControl = g.HL7Config.OrderControl.Discontinue
+	mo.OrderStatus = g.HL7Config.OrderStatus.Discontinued
+	mo.DiscontinuedDateTime = ir.NewValidTime(eventTime)
+	mo.DiscontinueReason = d.Reason
+}
+
+// medication returns the medication with the given name from the medications file, or a random
+// medication if the name is empty or RANDOM. Medications that are not in the file are returned
+// with the name as both the code and the text, and no default values.
+func (g *Generator) medication(name string) (*medication.Medication, error) {
+	if name == "" || name == constants.RandomString {
+		if g.Medications != nil {
+			if m := g.Medications.Random(); m != nil {
+				return m, nil
+			}
+		}
+		return nil, errors.New("cannot pick a random medication: there are no medications")
+	}
+	if g.Medications != nil {
+		if m, ok := g.Medications.Get(name); ok {
+			return m, nil
+		}
+	}
+	return &medication.Medication{Code: ir.CodedElement{ID: name, Text: name}}, nil
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+	return defaultValue
+}
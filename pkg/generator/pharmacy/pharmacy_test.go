@@ -0,0 +1,198 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pharmacy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/medication"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/bitcrshr/simhospital/pkg/test/testid"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	placerID = "placer-1"
+	fillerID = "filler-1"
+)
+
+var (
+	date       = time.Date(2018, 2, 12, 1, 25, 0, 0, time.UTC)
+	prescriber = &ir.Doctor{ID: "id-1", Surname: "Osman", FirstName: "Arthur", Prefix: "Dr"}
+	hl7Config  = &config.HL7Config{
+		CodingSystem: "WinPath",
+		OrderControl: config.OrderControl{New: "NW", WithObservations: "RE", Discontinue: "DC"},
+		OrderStatus:  config.OrderStatus{InProcess: "IP", Discontinued: "DC"},
+		Medication:   config.HL7Medication{CompletionStatus: "CP"},
+	}
+)
+
+func TestMedicationOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *pathway.Prescribe
+		want  *ir.MedicationOrder
+	}{{
+		name:  "Default values",
+		input: &pathway.Prescribe{Medication: "Paracetamol"},
+		want: &ir.MedicationOrder{
+			Placer:         placerID,
+			Filler:         fillerID,
+			OrderDateTime:  ir.NewValidTime(date),
+			OrderControl:   "NW",
+			OrderStatus:    "IP",
+			Medication:     &ir.CodedElement{ID: "med-1", Text: "Paracetamol", CodingSystem: "WinPath"},
+			Dose:           "1000",
+			DoseUnits:      "mg",
+			DosageForm:     "TAB",
+			Route:          "PO",
+			Frequency:      "Q6H",
+			DispenseAmount: "32",
+			DispenseUnits:  "TAB",
+			Prescriber:     prescriber,
+		},
+	}, {
+		name:  "Values from the pathway",
+		input: &pathway.Prescribe{Medication: "Paracetamol", Dose: "500", DoseUnits: "milligram", Route: "PR", Frequency: "Q4H"},
+		want: &ir.MedicationOrder{
+			Placer:         placerID,
+			Filler:         fillerID,
+			OrderDateTime:  ir.NewValidTime(date),
+			OrderControl:   "NW",
+			OrderStatus:    "IP",
+			Medication:     &ir.CodedElement{ID: "med-1", Text: "Paracetamol", CodingSystem: "WinPath"},
+			Dose:           "500",
+			DoseUnits:      "milligram",
+			DosageForm:     "TAB",
+			Route:          "PR",
+			Frequency:      "Q4H",
+			DispenseAmount: "32",
+			DispenseUnits:  "TAB",
+			Prescriber:     prescriber,
+		},
+	}, {
+		name:  "Medication not in the file",
+		input: &pathway.Prescribe{Medication: "Ibuprofen", Dose: "400", DoseUnits: "mg"},
+		want: &ir.MedicationOrder{
+			Placer:        placerID,
+			Filler:        fillerID,
+			OrderDateTime: ir.NewValidTime(date),
+			OrderControl:  "NW",
+			OrderStatus:   "IP",
+			Medication:    &ir.CodedElement{ID: "Ibuprofen", Text: "Ibuprofen"},
+			Dose:          "400",
+			DoseUnits:     "mg",
+			Prescriber:    prescriber,
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := testGenerator(t)
+			got, err := g.MedicationOrder(date, tc.input, prescriber)
+			if err != nil {
+				t.Fatalf("MedicationOrder(%v, %+v, %v) failed with %v", date, tc.input, prescriber, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MedicationOrder(%v, %+v, %v) got diff (-want, +got):\n%s", date, tc.input, prescriber, diff)
+			}
+		})
+	}
+}
+
+func TestMedicationOrder_Random(t *testing.T) {
+	g := testGenerator(t)
+	for _, name := range []string{"", "RANDOM"} {
+		got, err := g.MedicationOrder(date, &pathway.Prescribe{Medication: name}, prescriber)
+		if err != nil {
+			t.Fatalf("MedicationOrder(%v, %q, %v) failed with %v", date, name, prescriber, err)
+		}
+		if got.Medication == nil || got.Medication.ID == "" {
+			t.Errorf("MedicationOrder(%v, %q, %v).Medication=%v, want a medication from the file", date, name, prescriber, got.Medication)
+		}
+	}
+
+	g.Medications = medication.New(nil)
+	if _, err := g.MedicationOrder(date, &pathway.Prescribe{}, prescriber); err == nil {
+		t.Errorf("MedicationOrder(%v, %+v, %v) with no medications got nil error, want non nil", date, &pathway.Prescribe{}, prescriber)
+	}
+}
+
+func TestDispenseAndAdminister(t *testing.T) {
+	g := testGenerator(t)
+	mo, err := g.MedicationOrder(date, &pathway.Prescribe{Medication: "Paracetamol"}, prescriber)
+	if err != nil {
+		t.Fatalf("MedicationOrder(%v, Paracetamol, %v) failed with %v", date, prescriber, err)
+	}
+	later := date.Add(time.Hour)
+
+	g.Dispense(date, mo, &pathway.Dispense{})
+	g.Dispense(later, mo, &pathway.Dispense{Amount: "16"})
+	wantDispenses := []*ir.MedicationDispense{
+		{ID: 1, DateTime: ir.NewValidTime(date), Amount: "32", Units: "TAB"},
+		{ID: 2, DateTime: ir.NewValidTime(later), Amount: "16", Units: "TAB"},
+	}
+	if diff := cmp.Diff(wantDispenses, mo.Dispenses); diff != "" {
+		t.Errorf("mo.Dispenses got diff (-want, +got):\n%s", diff)
+	}
+
+	g.Administer(date, mo, &pathway.Administer{})
+	g.Administer(later, mo, &pathway.Administer{Dose: "500"})
+	wantAdministrations := []*ir.MedicationAdministration{
+		{ID: 1, DateTime: ir.NewValidTime(date), Dose: "1000", DoseUnits: "mg", CompletionStatus: "CP"},
+		{ID: 2, DateTime: ir.NewValidTime(later), Dose: "500", DoseUnits: "mg", CompletionStatus: "CP"},
+	}
+	if diff := cmp.Diff(wantAdministrations, mo.Administrations); diff != "" {
+		t.Errorf("mo.Administrations got diff (-want, +got):\n%s", diff)
+	}
+	if got, want := mo.OrderControl, "RE"; got != want {
+		t.Errorf("mo.OrderControl=%v, want %v", got, want)
+	}
+}
+
+func TestDiscontinue(t *testing.T) {
+	g := testGenerator(t)
+	mo, err := g.MedicationOrder(date, &pathway.Prescribe{Medication: "Paracetamol"}, prescriber)
+	if err != nil {
+		t.Fatalf("MedicationOrder(%v, Paracetamol, %v) failed with %v", date, prescriber, err)
+	}
+	g.Discontinue(date, mo, &pathway.DiscontinueMedication{ID: "med1", Reason: "Adverse reaction"})
+	if !mo.Discontinued() {
+		t.Error("mo.Discontinued() is false, want true")
+	}
+	if got, want := mo.OrderControl, "DC"; got != want {
+		t.Errorf("mo.OrderControl=%v, want %v", got, want)
+	}
+	if got, want := mo.OrderStatus, "DC"; got != want {
+		t.Errorf("mo.OrderStatus=%v, want %v", got, want)
+	}
+	if got, want := mo.DiscontinueReason, "Adverse reaction"; got != want {
+		t.Errorf("mo.DiscontinueReason=%v, want %v", got, want)
+	}
+}
+
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	return &Generator{
+		HL7Config:       hl7Config,
+		Medications:     testconfig.Medications(t, hl7Config),
+		PlacerGenerator: &testid.Generator{Prefix: "placer-"},
+		FillerGenerator: &testid.Generator{Prefix: "filler-"},
+	}
+}
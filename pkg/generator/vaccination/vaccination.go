@@ -0,0 +1,139 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vaccination contains functions needed to generate an ir.Vaccination object.
+package vaccination
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/doctor"
+	"github.com/bitcrshr/simhospital/pkg/generator/id"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/vaccine"
+	"github.com/pkg/errors"
+)
+
+const (
+	// fundingEligibilityCodingSystem is the coding system of the funding eligibility observations.
+	fundingEligibilityCodingSystem = "HL70064"
+	// loinc is the coding system of the observation identifiers.
+	loinc = "LN"
+	// fundingEligibilityLOINC is the LOINC code of the funding eligibility observations.
+	fundingEligibilityLOINC = "64994-7"
+	// fundingEligibilityText is the text of the funding eligibility observations.
+	fundingEligibilityText = "Vaccine funding program eligibility category"
+	// finalResultStatus is the OBX.11-Observation Result Status of the observations.
+	finalResultStatus = "F"
+)
+
+// Generator generates vaccinations.
+type Generator struct {
+	VaccinationConfig *config.HL7Vaccination
+	Vaccines          *vaccine.Vaccines
+	Doctors           *doctor.Doctors
+	PlacerGenerator   id.Generator
+	FillerGenerator   id.Generator
+}
+
+// Vaccination returns a new Vaccination from the given configuration.
+// See pathway.Vaccination for information on how every field is populated.
+// Returns an error if a random vaccine is requested and there are no vaccines, or if the doctor
+// doesn't exist.
+func (g *Generator) Vaccination(eventTime time.Time, v *pathway.Vaccination) (*ir.Vaccination, error) {
+	vc, err := g.vaccine(v.Vaccine)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := g.doctor(v.DoctorID)
+	if err != nil {
+		return nil, err
+	}
+	lotNumber := v.LotNumber
+	if lotNumber == "" {
+		lotNumber = vc.RandomLotNumber()
+	}
+	code := vc.Code
+	vaccination := &ir.Vaccination{
+		Placer:           g.PlacerGenerator.NewID(),
+		Filler:           g.FillerGenerator.NewID(),
+		DateTime:         ir.NewValidTime(eventTime),
+		Vaccine:          &code,
+		Dose:             valueOrDefault(v.Dose, vc.Dose),
+		DoseUnits:        vc.DoseUnits,
+		Route:            vc.Route,
+		Site:             valueOrDefault(v.Site, vc.Site),
+		LotNumber:        lotNumber,
+		Manufacturer:     vc.Manufacturer,
+		Administrator:    doc,
+		CompletionStatus: g.VaccinationConfig.CompletionStatus,
+	}
+	if eligibility := g.VaccinationConfig.FundingEligibility; eligibility != "" {
+		vaccination.Observations = append(vaccination.Observations, &ir.Result{
+			TestName: &ir.CodedElement{
+				ID:           fundingEligibilityLOINC,
+				Text:         fundingEligibilityText,
+				CodingSystem: loinc,
+			},
+			ValueType:           "CE",
+			Value:               fmt.Sprintf("%s^^%s", eligibility, fundingEligibilityCodingSystem),
+			Status:              finalResultStatus,
+			ObservationDateTime: ir.NewValidTime(eventTime),
+		})
+	}
+	return vaccination, nil
+}
+
+// vaccine returns the vaccine with the given name from the vaccines file, or a random vaccine if
+// the name is empty or RANDOM. Vaccines that are not in the file are returned with the name as
+// both the code and the text, and no default values.
+func (g *Generator) vaccine(name string) (*vaccine.Vaccine, error) {
+	if name == "" || name == constants.RandomString {
+		if g.Vaccines != nil {
+			if v := g.Vaccines.Random(); v != nil {
+				return v, nil
+			}
+		}
+		return nil, errors.New("cannot pick a random vaccine: there are no vaccines")
+	}
+	if g.Vaccines != nil {
+		if v, ok := g.Vaccines.Get(name); ok {
+			return v, nil
+		}
+	}
+	return &vaccine.Vaccine{Code: ir.CodedElement{ID: name, Text: name}}, nil
+}
+
+// doctor returns the doctor with the given ID, or a random doctor if id is empty.
+// The random doctor is nil if there are no doctors.
+func (g *Generator) doctor(id string) (*ir.Doctor, error) {
+	if id == "" {
+		return g.Doctors.GetRandomDoctor(), nil
+	}
+	if d := g.Doctors.GetByID(id); d != nil {
+		return d, nil
+	}
+	return nil, fmt.Errorf("unknown doctor ID %q", id)
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaccination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/pathway"
+	"github.com/bitcrshr/simhospital/pkg/test/testconfig"
+	"github.com/bitcrshr/simhospital/pkg/test/testid"
+	"github.com/bitcrshr/simhospital/pkg/vaccine"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	placerID = "placer-1"
+	fillerID = "filler-1"
+)
+
+var date = time.Date(2018, 2, 12, 1, 25, 0, 0, time.UTC)
+
+func TestVaccination(t *testing.T) {
+	g := testGenerator(t)
+	tests := []struct {
+		name  string
+		input *pathway.Vaccination
+		want  *ir.Vaccination
+	}{{
+		name:  "Default values",
+		input: &pathway.Vaccination{Vaccine: "Influenza", DoctorID: "id-1"},
+		want: &ir.Vaccination{
+			Placer:           placerID,
+			Filler:           fillerID,
+			DateTime:         ir.NewValidTime(date),
+			Vaccine:          &ir.CodedElement{ID: "141", Text: "Influenza", CodingSystem: "CVX"},
+			Dose:             "0.5",
+			DoseUnits:        "mL",
+			Route:            "IM",
+			Site:             "LD",
+			LotNumber:        "LOT-1",
+			Manufacturer:     &ir.CodedElement{ID: "SKB", Text: "GlaxoSmithKline", CodingSystem: "MVX"},
+			Administrator:    g.Doctors.GetByID("id-1"),
+			CompletionStatus: "CP",
+			Observations: []*ir.Result{{
+				TestName:            &ir.CodedElement{ID: "64994-7", Text: "Vaccine funding program eligibility category", CodingSystem: "LN"},
+				ValueType:           "CE",
+				Value:               "V01^^HL70064",
+				Status:              "F",
+				ObservationDateTime: ir.NewValidTime(date),
+			}},
+		},
+	}, {
+		name:  "Values from the pathway",
+		input: &pathway.Vaccination{Vaccine: "Influenza", DoctorID: "id-2", Dose: "0.25", Site: "RD", LotNumber: "LOT-9"},
+		want: &ir.Vaccination{
+			Placer:           "placer-2",
+			Filler:           "filler-2",
+			DateTime:         ir.NewValidTime(date),
+			Vaccine:          &ir.CodedElement{ID: "141", Text: "Influenza", CodingSystem: "CVX"},
+			Dose:             "0.25",
+			DoseUnits:        "mL",
+			Route:            "IM",
+			Site:             "RD",
+			LotNumber:        "LOT-9",
+			Manufacturer:     &ir.CodedElement{ID: "SKB", Text: "GlaxoSmithKline", CodingSystem: "MVX"},
+			Administrator:    g.Doctors.GetByID("id-2"),
+			CompletionStatus: "CP",
+			Observations: []*ir.Result{{
+				TestName:            &ir.CodedElement{ID: "64994-7", Text: "Vaccine funding program eligibility category", CodingSystem: "LN"},
+				ValueType:           "CE",
+				Value:               "V01^^HL70064",
+				Status:              "F",
+				ObservationDateTime: ir.NewValidTime(date),
+			}},
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := g.Vaccination(date, tc.input)
+			if err != nil {
+				t.Fatalf("Vaccination(%v, %+v) failed with %v", date, tc.input, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Vaccination(%v, %+v) got diff (-want, +got):\n%s", date, tc.input, diff)
+			}
+		})
+	}
+}
+
+func TestVaccination_UnknownVaccine(t *testing.T) {
+	g := testGenerator(t)
+	g.VaccinationConfig = &config.HL7Vaccination{}
+	input := &pathway.Vaccination{Vaccine: "Yellow fever"}
+	got, err := g.Vaccination(date, input)
+	if err != nil {
+		t.Fatalf("Vaccination(%v, %+v) failed with %v", date, input, err)
+	}
+	if diff := cmp.Diff(&ir.CodedElement{ID: "Yellow fever", Text: "Yellow fever"}, got.Vaccine); diff != "" {
+		t.Errorf("Vaccination(%v, %+v).Vaccine got diff (-want, +got):\n%s", date, input, diff)
+	}
+	if got.Administrator == nil {
+		t.Error("Vaccination().Administrator is <nil>, want non nil")
+	}
+	if len(got.Observations) != 0 {
+		t.Errorf("len(Vaccination().Observations) = %d, want 0", len(got.Observations))
+	}
+}
+
+func TestVaccination_Error(t *testing.T) {
+	g := testGenerator(t)
+	tests := []struct {
+		name  string
+		g     *Generator
+		input *pathway.Vaccination
+	}{
+		{name: "Unknown doctor", g: g, input: &pathway.Vaccination{Vaccine: "Influenza", DoctorID: "unknown"}},
+		{name: "Random with no vaccines", g: &Generator{Vaccines: vaccine.New(nil)}, input: &pathway.Vaccination{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.g.Vaccination(date, tc.input); err == nil {
+				t.Errorf("Vaccination(%v, %+v) got nil error, want non nil", date, tc.input)
+			}
+		})
+	}
+}
+
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	return &Generator{
+		VaccinationConfig: &config.HL7Vaccination{CompletionStatus: "CP", FundingEligibility: "V01"},
+		Vaccines:          testconfig.Vaccines(t),
+		Doctors:           testconfig.Doctors(t),
+		PlacerGenerator:   &testid.Generator{Prefix: "placer-"},
+		FillerGenerator:   &testid.Generator{Prefix: "filler-"},
+	}
+}
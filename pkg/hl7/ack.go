@@ -0,0 +1,329 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AckCode is an acknowledgment code, as sent in MSA-1.
+type AckCode string
+
+// Acknowledgment codes defined in HL7 table 0008.
+const (
+	// AckApplicationAccept is the "Application Accept" acknowledgment code.
+	AckApplicationAccept = AckCode("AA")
+	// AckApplicationError is the "Application Error" acknowledgment code.
+	AckApplicationError = AckCode("AE")
+	// AckApplicationReject is the "Application Reject" acknowledgment code.
+	AckApplicationReject = AckCode("AR")
+	// AckCommitAccept is the "Commit Accept" acknowledgment code.
+	AckCommitAccept = AckCode("CA")
+	// AckCommitError is the "Commit Error" acknowledgment code.
+	AckCommitError = AckCode("CE")
+	// AckCommitReject is the "Commit Reject" acknowledgment code.
+	AckCommitReject = AckCode("CR")
+)
+
+var ackCodes = map[AckCode]bool{
+	AckApplicationAccept: true,
+	AckApplicationError:  true,
+	AckApplicationReject: true,
+	AckCommitAccept:      true,
+	AckCommitError:       true,
+	AckCommitReject:      true,
+}
+
+// Ack is the decoded content of an acknowledgment message.
+type Ack struct {
+	// Code is the acknowledgment code from MSA-1.
+	Code AckCode
+	// ControlID is the message control ID of the acknowledged message, from MSA-2.
+	ControlID string
+	// Text is the text message from MSA-3, if any.
+	Text string
+	// Errors contains a description of each of the ERR segments in the acknowledgment, if any.
+	Errors []string
+}
+
+// IsAccept returns whether the acknowledgment code indicates the message was accepted.
+func (a *Ack) IsAccept() bool {
+	return a.Code == AckApplicationAccept || a.Code == AckCommitAccept
+}
+
+// String returns a human readable representation of the acknowledgment.
+func (a *Ack) String() string {
+	s := fmt.Sprintf("%s for control ID %q", a.Code, a.ControlID)
+	if a.Text != "" {
+		s = fmt.Sprintf("%s: %s", s, a.Text)
+	}
+	if len(a.Errors) > 0 {
+		s = fmt.Sprintf("%s (errors: %s)", s, strings.Join(a.Errors, "; "))
+	}
+	return s
+}
+
+// ParseAck parses an acknowledgment message and decodes its MSA and ERR segments.
+// Returns an error if the message cannot be parsed, or if it does not contain a MSA segment
+// with a valid acknowledgment code.
+func ParseAck(ack []byte) (*Ack, error) {
+	m, err := ParseMessage(ack)
+	if err != nil {
+		return nil, errors.Wrap(err, "ack message cannot be parsed")
+	}
+	msa, err := m.MSA()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse MSA segment")
+	}
+	if msa == nil {
+		return nil, errors.New("ack message does not contain a MSA segment")
+	}
+	code := AckCode(strings.TrimSpace(msa.AcknowledgmentCode.String()))
+	if !ackCodes[code] {
+		return nil, errors.Errorf("invalid acknowledgment code %q in MSA-1", code)
+	}
+	a := &Ack{
+		Code:      code,
+		ControlID: msa.MessageControlID.String(),
+		Text:      msa.TextMessage.String(),
+	}
+	errs, err := m.AllERR()
+	if err != nil {
+		// The errors are only used to give more context, so a badly formatted
+		// ERR segment should not prevent the acknowledgment from being interpreted.
+		log.WithError(err).Warning("Cannot parse ERR segments in the ack message")
+	}
+	for _, e := range errs {
+		if d := errDescription(e); d != "" {
+			a.Errors = append(a.Errors, d)
+		}
+	}
+	return a, nil
+}
+
+// errDescription returns a description of the ERR segment that can be logged.
+func errDescription(e *ERR) string {
+	var parts []string
+	if e.HL7ErrorCode != nil {
+		parts = append(parts, strings.Trim(fmt.Sprintf("%s %s", e.HL7ErrorCode.Identifier.String(), e.HL7ErrorCode.Text.String()), " "))
+	}
+	// ERR-1 is the only field populated by receivers that use HL7 versions older than 2.5.
+	for _, eld := range e.ErrorCodeAndLocation {
+		if eld.CodeIdentifyingError != nil {
+			parts = append(parts, strings.Trim(fmt.Sprintf("%s %s", eld.CodeIdentifyingError.Identifier.String(), eld.CodeIdentifyingError.Text.String()), " "))
+		}
+	}
+	if e.DiagnosticInformation != nil {
+		parts = append(parts, string(*e.DiagnosticInformation))
+	}
+	if e.UserMessage != nil {
+		parts = append(parts, string(*e.UserMessage))
+	}
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
+
+// AckAction is the action a sender takes when it receives an acknowledgment.
+type AckAction string
+
+const (
+	// AckActionAccept treats the message as successfully sent.
+	AckActionAccept = AckAction("accept")
+	// AckActionRetry sends the message again, with backoff, up to a maximum number of retries.
+	// If the message is still not accepted after all retries, it is dead-lettered if a dead-letter
+	// sink is configured, or an error is returned otherwise.
+	AckActionRetry = AckAction("retry")
+	// AckActionSkip logs the acknowledgment and carries on with the next message.
+	// The message is not counted as successfully sent.
+	AckActionSkip = AckAction("skip")
+	// AckActionHalt returns an error and stops the sender from sending any further messages.
+	AckActionHalt = AckAction("halt")
+	// AckActionDeadLetter sends the message to the dead-letter sink and carries on with the next message.
+	AckActionDeadLetter = AckAction("dead_letter")
+)
+
+var ackActions = map[AckAction]bool{
+	AckActionAccept:     true,
+	AckActionRetry:      true,
+	AckActionSkip:       true,
+	AckActionHalt:       true,
+	AckActionDeadLetter: true,
+}
+
+// AckPolicy defines what a sender does with each acknowledgment code.
+type AckPolicy struct {
+	// Actions maps each acknowledgment code to the action to take.
+	// Acknowledgment codes that are not in the map are accepted if they are AA or CA,
+	// and skipped otherwise.
+	Actions map[AckCode]AckAction
+	// MaxRetries is the maximum number of times a message is re-sent if the action is AckActionRetry.
+	MaxRetries int
+	// RetryBackoff is the time to wait before the first retry. The time doubles with every retry.
+	RetryBackoff time.Duration
+	// DeadLetter is where messages are sent to when they are dead-lettered.
+	// Required if any of the actions is AckActionDeadLetter.
+	DeadLetter Sender
+}
+
+// NewAckPolicy returns the default AckPolicy: errors and rejections are logged and the sender
+// carries on with the next message, as it did before acknowledgments were interpreted.
+// Retrying, halting and dead-lettering have to be set in Actions. If they are, messages are
+// retried three times.
+func NewAckPolicy() *AckPolicy {
+	return &AckPolicy{
+		Actions: map[AckCode]AckAction{
+			AckApplicationAccept: AckActionAccept,
+			AckCommitAccept:      AckActionAccept,
+			AckApplicationError:  AckActionSkip,
+			AckCommitError:       AckActionSkip,
+			AckApplicationReject: AckActionSkip,
+			AckCommitReject:      AckActionSkip,
+		},
+		MaxRetries:   3,
+		RetryBackoff: time.Second,
+	}
+}
+
+// Action returns the action to take for the given acknowledgment code.
+func (p *AckPolicy) Action(code AckCode) AckAction {
+	if a, ok := p.Actions[code]; ok {
+		return a
+	}
+	if code == AckApplicationAccept || code == AckCommitAccept {
+		return AckActionAccept
+	}
+	return AckActionSkip
+}
+
+// Validate returns an error if the policy is not consistent.
+func (p *AckPolicy) Validate() error {
+	for code, a := range p.Actions {
+		if !ackCodes[code] {
+			return errors.Errorf("invalid acknowledgment code %q", code)
+		}
+		if !ackActions[a] {
+			return errors.Errorf("invalid action %q for acknowledgment code %q", a, code)
+		}
+		if a == AckActionDeadLetter && p.DeadLetter == nil {
+			return errors.Errorf("acknowledgment code %q is dead-lettered, but no dead-letter sink was provided", code)
+		}
+	}
+	if p.MaxRetries < 0 {
+		return errors.Errorf("invalid number of retries %d; must be non-negative", p.MaxRetries)
+	}
+	return nil
+}
+
+// ParseAckActions parses a comma-separated list of CODE=action pairs, eg: "AE=retry,AR=dead_letter".
+func ParseAckActions(s string) (map[AckCode]AckAction, error) {
+	actions := map[AckCode]AckAction{}
+	if strings.TrimSpace(s) == "" {
+		return actions, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("invalid acknowledgment action %q; want CODE=action", pair)
+		}
+		code := AckCode(strings.ToUpper(strings.TrimSpace(kv[0])))
+		action := AckAction(strings.ToLower(strings.TrimSpace(kv[1])))
+		if !ackCodes[code] {
+			return nil, errors.Errorf("invalid acknowledgment code %q", code)
+		}
+		if !ackActions[action] {
+			return nil, errors.Errorf("invalid action %q for acknowledgment code %q", action, code)
+		}
+		actions[code] = action
+	}
+	return actions, nil
+}
+
+// messageControlID returns the message control ID (MSH-10) of the given message,
+// or an empty string if it cannot be determined.
+func messageControlID(message []byte) string {
+	m, err := ParseMessage(message)
+	if err != nil {
+		return ""
+	}
+	return m.msh.MessageControlID.String()
+}
+
+// BuildAck builds an acknowledgment message for the message m, with the given acknowledgment code
+// and text message. The header of the acknowledgment swaps the sending and receiving application
+// and facility of m, and uses controlID as its message control ID (MSH-10) and t as its date/time.
+// If m is nil, for instance because the message could not be parsed, a generic acknowledgment
+// that does not reference any message is built.
+// If the code is not an accept code, an ERR segment is also included.
+func BuildAck(m *Message, code AckCode, text string, controlID string, t time.Time) ([]byte, error) {
+	c := *DefaultContextWithoutLocation
+	var orig MSH
+	if m != nil {
+		c = *m.Context
+		orig = m.msh
+	}
+	if c.TimezoneLoc == nil {
+		c.TimezoneLoc = time.UTC
+	}
+	delimiters := *c.Delimiters
+	msh := &MSH{
+		EncodingCharacters:   &delimiters,
+		SendingApplication:   orig.ReceivingApplication,
+		SendingFacility:      orig.ReceivingFacility,
+		ReceivingApplication: orig.SendingApplication,
+		ReceivingFacility:    orig.SendingFacility,
+		DateTimeOfMessage:    &TS{Time: t, Precision: SecondPrecision},
+		MessageType:          &MSG{MessageCode: NewID("ACK"), MessageStructure: NewID("ACK")},
+		MessageControlID:     NewST(ST(controlID)),
+		ProcessingID:         orig.ProcessingID,
+		VersionID:            orig.VersionID,
+	}
+	if orig.MessageType != nil {
+		msh.MessageType.TriggerEvent = orig.MessageType.TriggerEvent
+	}
+	if msh.ProcessingID == nil {
+		msh.ProcessingID = &PT{ProcessingID: NewID("P")}
+	}
+	if msh.VersionID == nil {
+		msh.VersionID = &VID{VersionID: NewID("2.5.1")}
+	}
+	msa := &MSA{
+		AcknowledgmentCode: NewID(ID(code)),
+		MessageControlID:   orig.MessageControlID,
+	}
+	if msa.MessageControlID == nil {
+		msa.MessageControlID = NewST("")
+	}
+	if text != "" {
+		msa.TextMessage = NewST(ST(text))
+	}
+	segments := []Segment{msh, msa}
+	if code != AckApplicationAccept && code != AckCommitAccept {
+		errText := ST("Application internal error")
+		segments = append(segments, &ERR{
+			HL7ErrorCode: &CWE{Identifier: NewST("207"), Text: &errText, NameOfCodingSystem: NewID("HL70357")},
+			Severity:     NewID("E"),
+		})
+	}
+	return MarshalSegments(segments, &c)
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAck(t *testing.T) {
+	tests := []struct {
+		name    string
+		ack     string
+		want    *Ack
+		wantErr bool
+	}{{
+		name: "accept",
+		ack:  "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200101000001||ACK|5678|T|2.3\rMSA|AA|1234",
+		want: &Ack{Code: AckApplicationAccept, ControlID: "1234"},
+	}, {
+		name: "error with 2.5 ERR segment",
+		ack:  "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200101000001||ACK|5678|T|2.5\rMSA|AE|1234|Failed\rERR||PID^1^3|102^Data type error^HL70357|E||||Bad MRN",
+		want: &Ack{Code: AckApplicationError, ControlID: "1234", Text: "Failed", Errors: []string{"102 Data type error, Bad MRN"}},
+	}, {
+		name: "reject with 2.3 ERR segment",
+		ack:  "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200101000001||ACK|5678|T|2.3\rMSA|CR|1234\rERR|PID^1^3^207&Application internal error",
+		want: &Ack{Code: AckCommitReject, ControlID: "1234", Errors: []string{"207 Application internal error"}},
+	}, {
+		name:    "not a message",
+		ack:     "Ack",
+		wantErr: true,
+	}, {
+		name:    "no MSA",
+		ack:     "MSH|^~\\&|",
+		wantErr: true,
+	}, {
+		name:    "invalid code",
+		ack:     "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200101000001||ACK|5678|T|2.3\rMSA|XX|1234",
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseAck([]byte(tc.ack))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ParseAck(%q) got err=%v, want error? %t", tc.ack, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseAck(%q) got diff (-want, +got):\n%s", tc.ack, diff)
+			}
+		})
+	}
+}
+
+func TestParseAckActions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[AckCode]AckAction
+		wantErr bool
+	}{{
+		name:  "empty",
+		input: "",
+		want:  map[AckCode]AckAction{},
+	}, {
+		name:  "several",
+		input: "AE=retry, ar=Dead_Letter,CE=skip",
+		want: map[AckCode]AckAction{
+			AckApplicationError:  AckActionRetry,
+			AckApplicationReject: AckActionDeadLetter,
+			AckCommitError:       AckActionSkip,
+		},
+	}, {
+		name:    "missing action",
+		input:   "AE",
+		wantErr: true,
+	}, {
+		name:    "invalid code",
+		input:   "XX=retry",
+		wantErr: true,
+	}, {
+		name:    "invalid action",
+		input:   "AE=ignore",
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseAckActions(tc.input)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ParseAckActions(%q) got err=%v, want error? %t", tc.input, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseAckActions(%q) got diff (-want, +got):\n%s", tc.input, diff)
+			}
+		})
+	}
+}
+
+func TestAckPolicyAction(t *testing.T) {
+	p := &AckPolicy{Actions: map[AckCode]AckAction{AckApplicationError: AckActionSkip}}
+	tests := []struct {
+		code AckCode
+		want AckAction
+	}{
+		{AckApplicationError, AckActionSkip},
+		{AckApplicationAccept, AckActionAccept},
+		{AckCommitAccept, AckActionAccept},
+		{AckApplicationReject, AckActionSkip},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.code), func(t *testing.T) {
+			if got := p.Action(tc.code); got != tc.want {
+				t.Errorf("Action(%q) got %q, want %q", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildAck(t *testing.T) {
+	options := NewParseMessageOptions()
+	options.TimezoneLoc = time.UTC
+	m, err := ParseMessageWithOptions([]byte(sentMessage), options)
+	if err != nil {
+		t.Fatalf("ParseMessageWithOptions(%q) failed with %v", sentMessage, err)
+	}
+	now := time.Date(2020, 2, 12, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		m    *Message
+		code AckCode
+		text string
+		want string
+	}{{
+		name: "accept",
+		m:    m,
+		code: AckApplicationAccept,
+		want: "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200212103000||ACK^A01^ACK|ACK1|T|2.3\rMSA|AA|1234",
+	}, {
+		name: "error",
+		m:    m,
+		code: AckApplicationError,
+		text: "Failed",
+		want: "MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200212103000||ACK^A01^ACK|ACK1|T|2.3\rMSA|AE|1234|Failed\rERR|||207^Application internal error^HL70357|E",
+	}, {
+		name: "no message",
+		code: AckApplicationReject,
+		text: "Message cannot be parsed",
+		want: "MSH|^~\\&|||||20200212103000||ACK^^ACK|ACK1|P|2.5.1\rMSA|AR||Message cannot be parsed\rERR|||207^Application internal error^HL70357|E",
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := BuildAck(tc.m, tc.code, tc.text, "ACK1", now)
+			if err != nil {
+				t.Fatalf("BuildAck() failed with %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("BuildAck() got diff (-want, +got):\n%s", diff)
+			}
+			ack, err := ParseAck(got)
+			if err != nil {
+				t.Fatalf("ParseAck(%q) failed with %v", got, err)
+			}
+			if ack.Code != tc.code {
+				t.Errorf("ParseAck(%q).Code got %q, want %q", got, ack.Code, tc.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	batchTimeLayout = "20060102150405"
+
+	// Placeholders that can be used in BatchFileSenderOptions.FilenamePattern.
+	batchPatternTime     = "{time}"
+	batchPatternSequence = "{seq}"
+
+	// DefaultBatchFilenamePattern is the default pattern for the names of batch files.
+	DefaultBatchFilenamePattern = "messages_" + batchPatternTime + "_" + batchPatternSequence + ".hl7"
+)
+
+// BatchFileSenderOptions contains optional parameters to NewBatchFileSender.
+type BatchFileSenderOptions struct {
+	// FilenamePattern is the pattern for the path of each batch file. The placeholder {time} is
+	// replaced with the time the file was created, formatted as YYYYMMDDHHMMSS, and {seq} with
+	// the number of the file, starting at 1. It must contain {seq}, so that files created within
+	// the same second have different names.
+	FilenamePattern string
+	// MaxMessages is the maximum number of messages in a batch file. If 0, there is no maximum.
+	MaxMessages int
+	// MaxBytes is the size of a batch file after which a new batch file is started.
+	// If 0, there is no maximum.
+	MaxBytes int64
+	// MaxDuration is how long messages are added to a batch file before a new one is started.
+	// If 0, there is no maximum.
+	MaxDuration time.Duration
+}
+
+// NewBatchFileSenderOptions returns the default BatchFileSenderOptions,
+// which create a new batch file every 1000 messages.
+func NewBatchFileSenderOptions() *BatchFileSenderOptions {
+	return &BatchFileSenderOptions{
+		FilenamePattern: DefaultBatchFilenamePattern,
+		MaxMessages:     1000,
+	}
+}
+
+// batchFileSender writes HL7 messages to batch files.
+// Each file contains one batch: FHS, BHS, the messages, BTS and FTS.
+type batchFileSender struct {
+	options *BatchFileSenderOptions
+	now     func() time.Time
+	// file is the current batch file, or nil if no message has been written since the last rotation.
+	file     *os.File
+	fileName string
+	opened   time.Time
+	messages int
+	size     int64
+	seq      int
+}
+
+// NewBatchFileSender returns a sender that writes HL7 messages to batch files, and starts a new
+// file when the current one reaches any of the limits in options.
+func NewBatchFileSender(options *BatchFileSenderOptions) (Sender, error) {
+	return newBatchFileSender(options, time.Now)
+}
+
+func newBatchFileSender(options *BatchFileSenderOptions, now func() time.Time) (*batchFileSender, error) {
+	if !strings.Contains(options.FilenamePattern, batchPatternSequence) {
+		return nil, errors.Errorf("batch filename pattern %q must contain %s", options.FilenamePattern, batchPatternSequence)
+	}
+	if options.MaxMessages < 0 || options.MaxBytes < 0 || options.MaxDuration < 0 {
+		return nil, errors.New("batch file limits must not be negative")
+	}
+	return &batchFileSender{options: options, now: now}, nil
+}
+
+// Send writes the message to the current batch file, starting a new one if needed.
+func (s *batchFileSender) Send(message []byte) error {
+	if s.file != nil && s.full() {
+		if err := s.closeFile(); err != nil {
+			return err
+		}
+	}
+	if s.file == nil {
+		if err := s.openFile(message); err != nil {
+			return err
+		}
+	}
+	if err := s.write(terminateSegments(message)); err != nil {
+		return errors.Wrap(err, "cannot write a message")
+	}
+	s.messages++
+	return nil
+}
+
+func (s *batchFileSender) full() bool {
+	o := s.options
+	return (o.MaxMessages > 0 && s.messages >= o.MaxMessages) ||
+		(o.MaxBytes > 0 && s.size >= o.MaxBytes) ||
+		(o.MaxDuration > 0 && s.now().Sub(s.opened) >= o.MaxDuration)
+}
+
+// openFile creates a new batch file and writes its headers.
+// The sending and receiving applications and facilities in the headers are copied from the
+// MSH segment of the first message in the file.
+// Existing files are never overwritten: if a file with the name already exists, for instance
+// one written by a previous run, the sequence number is increased until the name is free.
+func (s *batchFileSender) openFile(firstMessage []byte) error {
+	s.opened = s.now()
+	created := s.opened.In(batchLocation()).Format(batchTimeLayout)
+	for {
+		s.seq++
+		s.fileName = strings.NewReplacer(
+			batchPatternTime, created,
+			batchPatternSequence, strconv.Itoa(s.seq),
+		).Replace(s.options.FilenamePattern)
+		file, err := os.OpenFile(s.fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0666)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot create batch file %s", s.fileName)
+		}
+		s.file = file
+		break
+	}
+	s.messages = 0
+	s.size = 0
+
+	var sendApp, sendFac, recvApp, recvFac string
+	if m, err := ParseMessage(firstMessage); err == nil {
+		sendApp = marshalHD(m.msh.SendingApplication)
+		sendFac = marshalHD(m.msh.SendingFacility)
+		recvApp = marshalHD(m.msh.ReceivingApplication)
+		recvFac = marshalHD(m.msh.ReceivingFacility)
+	}
+	controlID := fmt.Sprintf("%s%d", created, s.seq)
+	header := fmt.Sprintf("FHS|^~\\&|%s|%s|%s|%s|%s||%s||%s\rBHS|^~\\&|%s|%s|%s|%s|%s||||%s\r",
+		sendApp, sendFac, recvApp, recvFac, created, filepath.Base(s.fileName), controlID,
+		sendApp, sendFac, recvApp, recvFac, created, controlID)
+	if err := s.write([]byte(header)); err != nil {
+		return errors.Wrapf(err, "cannot write headers to batch file %s", s.fileName)
+	}
+	return nil
+}
+
+// closeFile writes the trailers of the current batch file and closes it.
+func (s *batchFileSender) closeFile() error {
+	trailer := fmt.Sprintf("BTS|%d\rFTS|1\r", s.messages)
+	if err := s.write([]byte(trailer)); err != nil {
+		s.file.Close()
+		s.file = nil
+		return errors.Wrapf(err, "cannot write trailers to batch file %s", s.fileName)
+	}
+	err := s.file.Close()
+	s.file = nil
+	if err != nil {
+		return errors.Wrapf(err, "cannot close batch file %s", s.fileName)
+	}
+	log.WithField("file", s.fileName).Infof("Wrote batch file with %d messages", s.messages)
+	return nil
+}
+
+func (s *batchFileSender) write(b []byte) error {
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return err
+}
+
+// Close completes the current batch file, if any.
+func (s *batchFileSender) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.closeFile()
+}
+
+// marshalHD returns the HD value using the default delimiters, which are the ones used in the
+// batch headers.
+func marshalHD(hd *HD) string {
+	if hd == nil {
+		return ""
+	}
+	parts := []string{hd.NamespaceID.String(), hd.UniversalID.String(), hd.UniversalIDType.String()}
+	component := string(DefaultDelimiters.Component)
+	return strings.TrimRight(strings.Join(parts, component), component)
+}
+
+func batchLocation() *time.Location {
+	if Location != nil {
+		return Location
+	}
+	return time.UTC
+}
+
+// terminateSegments returns the message with every segment terminated with a carriage return.
+// Messages can be generated with other line endings, which are normalised so that the segments of
+// all the messages in a batch file are terminated the same way.
+func terminateSegments(message []byte) []byte {
+	var b bytes.Buffer
+	for _, s := range splitSegments(message) {
+		b.Write(s)
+		b.WriteByte(SegmentTerminator)
+	}
+	return b.Bytes()
+}
+
+// splitSegments splits b into segments terminated by "\r", "\n" or "\r\n", and discards the empty ones.
+func splitSegments(b []byte) [][]byte {
+	var segments [][]byte
+	for _, line := range bytes.Split(bytes.ReplaceAll(b, []byte("\r\n"), []byte("\r")), []byte("\r")) {
+		for _, s := range bytes.Split(line, []byte("\n")) {
+			if len(bytes.TrimSpace(s)) > 0 {
+				segments = append(segments, s)
+			}
+		}
+	}
+	return segments
+}
+
+// ReadBatchFile splits the content of an HL7 batch file into the messages it contains.
+// The file headers and trailers (FHS and FTS) and the batch headers and trailers (BHS and BTS)
+// are optional, so files with several messages and no envelope can also be read.
+// If a trailer contains a count of messages or batches, the count is checked.
+func ReadBatchFile(b []byte) ([][]byte, error) {
+	var messages [][]byte
+	var current [][]byte
+	batches := 0
+	inBatch := 0
+	endMessage := func() {
+		if current != nil {
+			messages = append(messages, bytes.Join(current, []byte{SegmentTerminator}))
+			current = nil
+		}
+	}
+	for i, segment := range splitSegments(b) {
+		name := string(segment[:min(3, len(segment))])
+		switch name {
+		case "FHS", "FTS", "BHS", "BTS":
+			endMessage()
+		}
+		switch name {
+		case "FHS":
+		case "BHS":
+			batches++
+			inBatch = 0
+		case "BTS":
+			if err := checkCount(segment, inBatch, "messages in batch"); err != nil {
+				return nil, errors.Wrapf(err, "invalid batch %d", batches)
+			}
+		case "FTS":
+			if err := checkCount(segment, batches, "batches in file"); err != nil {
+				return nil, err
+			}
+		case "MSH":
+			endMessage()
+			current = [][]byte{segment}
+			inBatch++
+		default:
+			if current == nil {
+				return nil, errors.Errorf("segment %d (%s) is not part of a message", i+1, name)
+			}
+			current = append(current, segment)
+		}
+	}
+	endMessage()
+	return messages, nil
+}
+
+// checkCount checks that the first field of a BTS or FTS segment, if present, equals want.
+func checkCount(segment []byte, want int, what string) error {
+	if len(segment) < 4 {
+		return nil
+	}
+	fields := bytes.Split(segment[4:], segment[3:4])
+	if len(fields[0]) == 0 {
+		return nil
+	}
+	got, err := strconv.Atoi(string(fields[0]))
+	if err != nil {
+		return errors.Wrapf(err, "invalid count in %s segment", segment[:3])
+	}
+	if got != want {
+		return errors.Errorf("%s segment says there are %d %s, but there are %d", segment[:3], got, what, want)
+	}
+	return nil
+}
+
+// ParseBatchFile parses each of the messages in an HL7 batch file.
+// See ReadBatchFile for the supported formats.
+func ParseBatchFile(b []byte) ([]*Message, error) {
+	raw, err := ReadBatchFile(b)
+	if err != nil {
+		return nil, err
+	}
+	var messages []*Message
+	for i, r := range raw {
+		m, err := ParseMessage(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse message %d", i+1)
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
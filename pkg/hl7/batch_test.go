@@ -0,0 +1,278 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	batchMessage1 = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ADT^A01|1|T|2.3\rPID|1"
+	batchMessage2 = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ADT^A03|2|T|2.3\rPID|1"
+	batchMessage3 = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ORU^R01|3|T|2.3\rPID|1\rOBR|1"
+)
+
+// fakeNow returns a function that returns the given time, which can be changed with the returned pointer.
+func fakeNow(t time.Time) (func() time.Time, *time.Time) {
+	now := t
+	return func() time.Time { return now }, &now
+}
+
+func readBatchFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	names, err := filepath.Glob(path.Join(dir, "*.hl7"))
+	if err != nil {
+		t.Fatalf("filepath.Glob() failed with %v", err)
+	}
+	sort.Strings(names)
+	files := map[string]string{}
+	for _, n := range names {
+		b, err := ioutil.ReadFile(n)
+		if err != nil {
+			t.Fatalf("ioutil.ReadFile(%s) failed with %v", n, err)
+		}
+		files[filepath.Base(n)] = string(b)
+	}
+	return files
+}
+
+func TestBatchFileSender(t *testing.T) {
+	dir := testwrite.TempDir(t)
+	now, _ := fakeNow(time.Date(2020, 2, 12, 10, 30, 0, 0, Location))
+	options := &BatchFileSenderOptions{FilenamePattern: path.Join(dir, "batch_{time}_{seq}.hl7"), MaxMessages: 2}
+	s, err := newBatchFileSender(options, now)
+	if err != nil {
+		t.Fatalf("newBatchFileSender(%+v) failed with %v", options, err)
+	}
+	// Messages with newlines as segment terminators are written with carriage returns.
+	for _, m := range []string{batchMessage1, batchMessage2, "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ORU^R01|3|T|2.3\nPID|1\r\nOBR|1\n"} {
+		if err := s.Send([]byte(m)); err != nil {
+			t.Fatalf("Send(%q) failed with %v", m, err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() failed with %v", err)
+	}
+
+	want := map[string]string{
+		"batch_20200212103000_1.hl7": "FHS|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200212103000||batch_20200212103000_1.hl7||202002121030001\r" +
+			"BHS|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200212103000||||202002121030001\r" +
+			batchMessage1 + "\r" + batchMessage2 + "\r" +
+			"BTS|2\rFTS|1\r",
+		"batch_20200212103000_2.hl7": "FHS|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200212103000||batch_20200212103000_2.hl7||202002121030002\r" +
+			"BHS|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200212103000||||202002121030002\r" +
+			batchMessage3 + "\r" +
+			"BTS|1\rFTS|1\r",
+	}
+	if diff := cmp.Diff(want, readBatchFiles(t, dir)); diff != "" {
+		t.Errorf("batch files got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBatchFileSender_Rotation(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   BatchFileSenderOptions
+		advance   time.Duration
+		wantFiles int
+	}{
+		{name: "no limits", wantFiles: 1},
+		{name: "max messages", options: BatchFileSenderOptions{MaxMessages: 1}, wantFiles: 3},
+		{name: "max bytes", options: BatchFileSenderOptions{MaxBytes: 100}, wantFiles: 3},
+		{name: "max duration", options: BatchFileSenderOptions{MaxDuration: time.Hour}, advance: 40 * time.Minute, wantFiles: 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := testwrite.TempDir(t)
+			now, current := fakeNow(time.Date(2020, 2, 12, 10, 30, 0, 0, time.UTC))
+			options := tc.options
+			options.FilenamePattern = path.Join(dir, "{seq}.hl7")
+			s, err := newBatchFileSender(&options, now)
+			if err != nil {
+				t.Fatalf("newBatchFileSender(%+v) failed with %v", options, err)
+			}
+			for _, m := range []string{batchMessage1, batchMessage2, batchMessage3} {
+				if err := s.Send([]byte(m)); err != nil {
+					t.Fatalf("Send(%q) failed with %v", m, err)
+				}
+				*current = current.Add(tc.advance)
+			}
+			if err := s.Close(); err != nil {
+				t.Fatalf("Close() failed with %v", err)
+			}
+
+			files := readBatchFiles(t, dir)
+			if got := len(files); got != tc.wantFiles {
+				t.Errorf("got %d batch files, want %d", got, tc.wantFiles)
+			}
+			var got []string
+			for _, name := range []string{"1.hl7", "2.hl7", "3.hl7"} {
+				if content, ok := files[name]; ok {
+					messages, err := ReadBatchFile([]byte(content))
+					if err != nil {
+						t.Fatalf("ReadBatchFile(%q) failed with %v", content, err)
+					}
+					for _, m := range messages {
+						got = append(got, string(m))
+					}
+				}
+			}
+			if diff := cmp.Diff([]string{batchMessage1, batchMessage2, batchMessage3}, got); diff != "" {
+				t.Errorf("messages in batch files got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchFileSender_RotationInTheSameSecond(t *testing.T) {
+	dir := testwrite.TempDir(t)
+	// A file written by a previous run is not overwritten.
+	existing := path.Join(dir, "batch_20200212103000_1.hl7")
+	if err := ioutil.WriteFile(existing, []byte("existing"), 0666); err != nil {
+		t.Fatalf("ioutil.WriteFile(%s) failed with %v", existing, err)
+	}
+	now, _ := fakeNow(time.Date(2020, 2, 12, 10, 30, 0, 0, time.UTC))
+	options := &BatchFileSenderOptions{FilenamePattern: path.Join(dir, "batch_{time}_{seq}.hl7"), MaxMessages: 1}
+	s, err := newBatchFileSender(options, now)
+	if err != nil {
+		t.Fatalf("newBatchFileSender(%+v) failed with %v", options, err)
+	}
+	for _, m := range []string{batchMessage1, batchMessage2, batchMessage3} {
+		if err := s.Send([]byte(m)); err != nil {
+			t.Fatalf("Send(%q) failed with %v", m, err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() failed with %v", err)
+	}
+
+	files := readBatchFiles(t, dir)
+	if got, want := files["batch_20200212103000_1.hl7"], "existing"; got != want {
+		t.Errorf("batch_20200212103000_1.hl7 got content %q, want %q", got, want)
+	}
+	var got []string
+	for _, name := range []string{"batch_20200212103000_2.hl7", "batch_20200212103000_3.hl7", "batch_20200212103000_4.hl7"} {
+		messages, err := ReadBatchFile([]byte(files[name]))
+		if err != nil {
+			t.Fatalf("ReadBatchFile(%q) failed with %v", files[name], err)
+		}
+		for _, m := range messages {
+			got = append(got, string(m))
+		}
+	}
+	if diff := cmp.Diff([]string{batchMessage1, batchMessage2, batchMessage3}, got); diff != "" {
+		t.Errorf("messages in batch files got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestNewBatchFileSender_InvalidOptions(t *testing.T) {
+	for _, options := range []*BatchFileSenderOptions{
+		{FilenamePattern: "messages.hl7"},
+		{FilenamePattern: "messages_{time}.hl7"},
+		{FilenamePattern: DefaultBatchFilenamePattern, MaxMessages: -1},
+	} {
+		if _, err := NewBatchFileSender(options); err == nil {
+			t.Errorf("NewBatchFileSender(%+v) got nil err, want non-nil err", options)
+		}
+	}
+}
+
+func TestReadBatchFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		want    []string
+		wantErr bool
+	}{{
+		name: "file with one batch",
+		file: "FHS|^~\\&|SIMHOSP\rBHS|^~\\&|SIMHOSP\r" + batchMessage1 + "\r" + batchMessage2 + "\rBTS|2\rFTS|1\r",
+		want: []string{batchMessage1, batchMessage2},
+	}, {
+		name: "file with several batches and newlines",
+		file: "FHS|^~\\&|SIMHOSP\nBHS|^~\\&\n" + batchMessage1 + "\nBTS|1\nBHS|^~\\&\r\n" + batchMessage2 + "\r\n" + batchMessage3 + "\r\nBTS|2\r\nFTS|2\r\n",
+		want: []string{batchMessage1, batchMessage2, batchMessage3},
+	}, {
+		name: "batch without file header",
+		file: "BHS|^~\\&\r" + batchMessage1 + "\rBTS\r",
+		want: []string{batchMessage1},
+	}, {
+		name: "messages without envelope",
+		file: batchMessage1 + "\n\n" + batchMessage3 + "\n\n",
+		want: []string{batchMessage1, batchMessage3},
+	}, {
+		name:    "wrong message count",
+		file:    "BHS|^~\\&\r" + batchMessage1 + "\rBTS|2\r",
+		wantErr: true,
+	}, {
+		name:    "wrong batch count",
+		file:    "FHS|^~\\&\rBHS|^~\\&\r" + batchMessage1 + "\rBTS|1\rFTS|3\r",
+		wantErr: true,
+	}, {
+		name:    "invalid count",
+		file:    "BHS|^~\\&\r" + batchMessage1 + "\rBTS|one\r",
+		wantErr: true,
+	}, {
+		name:    "segment outside of a message",
+		file:    "BHS|^~\\&\rPID|1\r" + batchMessage1 + "\rBTS|1\r",
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ReadBatchFile([]byte(tc.file))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ReadBatchFile(%q) got err=%v, want error? %t", tc.file, err, tc.wantErr)
+			}
+			var gotS []string
+			for _, m := range got {
+				gotS = append(gotS, string(m))
+			}
+			if diff := cmp.Diff(tc.want, gotS); diff != "" {
+				t.Errorf("ReadBatchFile(%q) got diff (-want, +got):\n%s", tc.file, diff)
+			}
+		})
+	}
+}
+
+func TestParseBatchFile(t *testing.T) {
+	file := "FHS|^~\\&\rBHS|^~\\&\r" + batchMessage1 + "\r" + batchMessage3 + "\rBTS|2\rFTS|1\r"
+	messages, err := ParseBatchFile([]byte(file))
+	if err != nil {
+		t.Fatalf("ParseBatchFile(%q) failed with %v", file, err)
+	}
+	var got []string
+	for _, m := range messages {
+		msh, err := m.MSH()
+		if err != nil {
+			t.Fatalf("MSH() failed with %v", err)
+		}
+		got = append(got, msh.MessageControlID.String())
+	}
+	if diff := cmp.Diff([]string{"1", "3"}, got); diff != "" {
+		t.Errorf("ParseBatchFile(%q) message control IDs got diff (-want, +got):\n%s", file, diff)
+	}
+
+	if _, err := ParseBatchFile([]byte("BHS|^~\\&\rMSH|\rBTS|1\r")); err == nil {
+		t.Error("ParseBatchFile() with an invalid message got nil err, want non-nil err")
+	}
+}
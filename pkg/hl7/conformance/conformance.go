@@ -0,0 +1,407 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance checks HL7 messages against conformance profiles, which describe the
+// implementation guide of a site on top of the HL7 specification, eg IHE PAM or a local guide:
+// the fields that are required, the fields that must have fixed values, the code sets of fields,
+// and the segments that must not be sent.
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/validate"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// The rules of the profiles, in addition to validate.RuleRequired and validate.RuleTable.
+const (
+	RuleFixedValue       = "fixed_value"
+	RuleForbiddenSegment = "forbidden_segment"
+)
+
+// Profile is a conformance profile.
+type Profile struct {
+	// Name identifies the profile in the logs.
+	Name string `yaml:"name"`
+	// Structure is whether to validate the messages against the structure of their message types
+	// with validate.Validator, in addition to the rules of the profile.
+	Structure bool `yaml:"structure"`
+	// Tables contains the code sets, keyed by table name.
+	Tables map[string][]string `yaml:"tables"`
+	// All contains the rules for all messages.
+	All MessageProfile `yaml:"all"`
+	// Messages contains the rules for specific messages, keyed by message type and trigger event,
+	// eg ADT^A01, or by message type only, eg ADT. The rules for a message are the rules in All,
+	// and the rules for its message type and for its message type and trigger event.
+	Messages map[string]MessageProfile `yaml:"messages"`
+}
+
+// MessageProfile contains the rules for a group of messages.
+// Fields, components and subcomponents are identified by their locations, eg PV1-3 or PID-3.1.
+// The rules apply to all the segments and repetitions with the given locations.
+type MessageProfile struct {
+	// Required are the locations that must have a value.
+	Required []string `yaml:"required"`
+	// Fixed contains the values that some locations must have, keyed by location.
+	Fixed map[string]string `yaml:"fixed"`
+	// Values contains the names of the tables with the code sets of some locations, keyed by
+	// location. The locations can be empty.
+	Values map[string]string `yaml:"values"`
+	// ForbiddenSegments are the names of the segments that must not be present.
+	ForbiddenSegments []string `yaml:"forbidden_segments"`
+}
+
+// LoadProfile loads a Profile from the given YAML file.
+func LoadProfile(ctx context.Context, filename string) (*Profile, error) {
+	data, err := files.Read(ctx, filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read conformance profile file %s", filename)
+	}
+	var p Profile
+	if err := yaml.UnmarshalStrict(data, &p); err != nil {
+		return nil, errors.Wrapf(err, "cannot unmarshal conformance profile from %s", filename)
+	}
+	if err := p.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid conformance profile in %s", filename)
+	}
+	return &p, nil
+}
+
+var messageKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}(\^[A-Z0-9]{3})?$`)
+
+// validate returns an error if any of the locations, tables or segment names in the profile is
+// invalid.
+func (p *Profile) validate() error {
+	if err := p.validateMessageProfile(p.All); err != nil {
+		return errors.Wrap(err, "all")
+	}
+	for key, mp := range p.Messages {
+		if !messageKeyRegex.MatchString(key) {
+			return errors.Errorf("message %q: invalid message type, want eg ADT^A01 or ADT", key)
+		}
+		if err := p.validateMessageProfile(mp); err != nil {
+			return errors.Wrapf(err, "message %q", key)
+		}
+	}
+	return nil
+}
+
+func (p *Profile) validateMessageProfile(mp MessageProfile) error {
+	locations := append([]string{}, mp.Required...)
+	for l := range mp.Fixed {
+		locations = append(locations, l)
+	}
+	for l, table := range mp.Values {
+		if _, ok := p.Tables[table]; !ok {
+			return errors.Errorf("values of %s: unknown table %q", l, table)
+		}
+		locations = append(locations, l)
+	}
+	for _, l := range locations {
+		if _, err := parseLocation(l); err != nil {
+			return err
+		}
+	}
+	for _, s := range mp.ForbiddenSegments {
+		if !segmentNameRegex.MatchString(s) {
+			return errors.Errorf("forbidden_segments: invalid segment name %q", s)
+		}
+	}
+	return nil
+}
+
+// Check checks the message against the rules of the profile that apply to its message type, in
+// MSH-9, and returns the issues found. The issues all have severity validate.Error.
+// The structure of the message is not checked; see Structure.
+func (p *Profile) Check(m *hl7.Message) []validate.Issue {
+	c := &check{profile: p, delims: m.Delimiters}
+	for _, t := range m.Segments {
+		if len(bytes.TrimSpace(t.Value)) > 0 {
+			c.segments = append(c.segments, t.Value)
+		}
+	}
+	for _, mp := range p.messageProfiles(c.messageType()) {
+		c.check(mp)
+	}
+	sort.SliceStable(c.issues, func(i, j int) bool { return c.issues[i].Segment < c.issues[j].Segment })
+	return c.issues
+}
+
+// messageProfiles returns the rules that apply to messages of the given type, eg ADT^A01.
+func (p *Profile) messageProfiles(messageType string) []MessageProfile {
+	mps := []MessageProfile{p.All}
+	code := strings.SplitN(messageType, "^", 2)[0]
+	if mp, ok := p.Messages[code]; ok {
+		mps = append(mps, mp)
+	}
+	if mp, ok := p.Messages[messageType]; ok && messageType != code {
+		mps = append(mps, mp)
+	}
+	return mps
+}
+
+// check contains the state of the check of one message.
+type check struct {
+	profile  *Profile
+	delims   *hl7.Delimiters
+	segments [][]byte
+	issues   []validate.Issue
+}
+
+func (c *check) add(rule string, n int, location string, format string, args ...interface{}) {
+	c.issues = append(c.issues, validate.Issue{
+		Severity: validate.Error,
+		Rule:     rule,
+		Segment:  n,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// messageType returns the message type and trigger event in MSH-9, eg ADT^A01.
+func (c *check) messageType() string {
+	l := &location{segment: "MSH", field: 9}
+	var parts []string
+	for _, o := range l.find(c.segments, c.delims) {
+		components := bytes.Split([]byte(o.value), []byte{c.delims.Component})
+		for i := 0; i < len(components) && i < 2; i++ {
+			if len(components[i]) > 0 {
+				parts = append(parts, string(components[i]))
+			}
+		}
+		break
+	}
+	return strings.Join(parts, "^")
+}
+
+func (c *check) check(mp MessageProfile) {
+	for _, s := range mp.ForbiddenSegments {
+		for i, segment := range c.segments {
+			if segmentName(segment) == s {
+				c.add(RuleForbiddenSegment, i+1, s, "segment %s must not be sent", s)
+			}
+		}
+	}
+	for _, l := range mp.Required {
+		c.checkRequired(mustParseLocation(l))
+	}
+	for _, l := range sortedKeys(mp.Fixed) {
+		c.checkFixed(mustParseLocation(l), mp.Fixed[l])
+	}
+	for _, l := range sortedKeys(mp.Values) {
+		c.checkValues(mustParseLocation(l), mp.Values[l])
+	}
+}
+
+// checkRequired checks that every segment with the location has a value in at least one
+// repetition.
+func (c *check) checkRequired(l *location) {
+	found := map[int]bool{}
+	for _, o := range l.find(c.segments, c.delims) {
+		if _, ok := found[o.segment]; !ok {
+			found[o.segment] = false
+		}
+		if o.value != "" {
+			found[o.segment] = true
+		}
+	}
+	if len(found) == 0 {
+		c.add(validate.RuleRequired, 0, l.String(), "required by the profile, but the message has no %s segment", l.segment)
+	}
+	for _, n := range sortedSegments(found) {
+		if !found[n] {
+			c.add(validate.RuleRequired, n, l.String(), "required by the profile, but is empty")
+		}
+	}
+}
+
+// checkFixed checks that every occurrence of the location has the given value.
+func (c *check) checkFixed(l *location, want string) {
+	occurrences := l.find(c.segments, c.delims)
+	if len(occurrences) == 0 {
+		c.add(RuleFixedValue, 0, l.String(), "must be %q, but the message has no %s segment", want, l.segment)
+	}
+	for _, o := range occurrences {
+		if o.value != want {
+			c.add(RuleFixedValue, o.segment, o.location, "value %q is not the fixed value %q", o.value, want)
+		}
+	}
+}
+
+// checkValues checks that every occurrence of the location with a value has a value in the table.
+func (c *check) checkValues(l *location, table string) {
+	values := map[string]bool{}
+	for _, v := range c.profile.Tables[table] {
+		values[v] = true
+	}
+	for _, o := range l.find(c.segments, c.delims) {
+		if o.value == "" || hl7.IsHL7Null([]byte(o.value)) {
+			continue
+		}
+		if !values[o.value] {
+			c.add(validate.RuleTable, o.segment, o.location, "value %q is not in table %s", o.value, table)
+		}
+	}
+}
+
+var (
+	segmentNameRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}$`)
+	locationRegex    = regexp.MustCompile(`^([A-Z][A-Z0-9]{2})-([1-9][0-9]*)(?:\.([1-9][0-9]*))?(?:\.([1-9][0-9]*))?$`)
+)
+
+// location identifies a field, component or subcomponent in all the segments with a given name.
+type location struct {
+	segment string
+	field   int
+	// component and subcomponent are 0 if the location is a field or a component respectively.
+	component    int
+	subcomponent int
+}
+
+func parseLocation(s string) (*location, error) {
+	parts := locationRegex.FindStringSubmatch(s)
+	if parts == nil {
+		return nil, errors.Errorf("invalid location %q, want eg PV1-3 or PID-3.1", s)
+	}
+	l := &location{segment: parts[1]}
+	l.field, _ = strconv.Atoi(parts[2])
+	if parts[3] != "" {
+		l.component, _ = strconv.Atoi(parts[3])
+	}
+	if parts[4] != "" {
+		l.subcomponent, _ = strconv.Atoi(parts[4])
+	}
+	return l, nil
+}
+
+// mustParseLocation parses a location that was already validated when the profile was loaded.
+func mustParseLocation(s string) *location {
+	l, err := parseLocation(s)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+func (l *location) String() string {
+	return l.withRepetition(0)
+}
+
+// withRepetition returns the location with the given repetition, eg PID-3[2].1, or without
+// repetition if r is 0.
+func (l *location) withRepetition(r int) string {
+	s := fmt.Sprintf("%s-%d", l.segment, l.field)
+	if r > 0 {
+		s += fmt.Sprintf("[%d]", r)
+	}
+	if l.component > 0 {
+		s += fmt.Sprintf(".%d", l.component)
+	}
+	if l.subcomponent > 0 {
+		s += fmt.Sprintf(".%d", l.subcomponent)
+	}
+	return s
+}
+
+// occurrence is the value of a location in a segment and repetition.
+type occurrence struct {
+	// segment is the position of the segment in the message, starting at 1.
+	segment  int
+	location string
+	value    string
+}
+
+// find returns the values of the location in every segment and repetition. Segments where the
+// field is empty have a single occurrence with an empty value.
+func (l *location) find(segments [][]byte, d *hl7.Delimiters) []occurrence {
+	var occurrences []occurrence
+	for i, s := range segments {
+		if segmentName(s) != l.segment {
+			continue
+		}
+		fields := bytes.Split(s, []byte{d.Field})
+		index := l.field
+		if l.segment == "MSH" {
+			// MSH-1 is the field separator itself, so MSH-n is at index n-1.
+			index--
+		}
+		var field []byte
+		if index < len(fields) {
+			field = fields[index]
+		}
+		repetitions := [][]byte{field}
+		if !(l.segment == "MSH" && l.field == 2) {
+			repetitions = bytes.Split(field, []byte{d.Repetition})
+		}
+		for r, value := range repetitions {
+			if l.component > 0 {
+				value = nth(value, d.Component, l.component)
+			}
+			if l.subcomponent > 0 {
+				value = nth(value, d.Subcomponent, l.subcomponent)
+			}
+			location := l.String()
+			if len(repetitions) > 1 {
+				location = l.withRepetition(r + 1)
+			}
+			occurrences = append(occurrences, occurrence{segment: i + 1, location: location, value: string(value)})
+		}
+	}
+	return occurrences
+}
+
+// nth returns the nth part of value split by delimiter, starting at 1, or nil if there is no such
+// part.
+func nth(value []byte, delimiter byte, n int) []byte {
+	parts := bytes.Split(value, []byte{delimiter})
+	if n <= len(parts) {
+		return parts[n-1]
+	}
+	return nil
+}
+
+func segmentName(segment []byte) string {
+	if len(segment) < 3 {
+		return string(segment)
+	}
+	return string(segment[:3])
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedSegments(m map[int]bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
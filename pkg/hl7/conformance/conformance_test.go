@@ -0,0 +1,220 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas"
+	"github.com/bitcrshr/simhospital/pkg/hl7/validate"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestMain(m *testing.M) {
+	hl7.TimezoneAndLocation("Europe/London")
+	schemas.Register()
+	retCode := m.Run()
+	os.Exit(retCode)
+}
+
+const (
+	msh = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A01|1|T|2.3"
+	evn = "EVN|A01|20200101120000"
+	pid = "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John||19800101|M"
+	pv1 = "PV1|1|I||||||||MED"
+
+	profile = `
+name: test
+tables:
+  patient_class: ["I", "O"]
+  hospital_service: ["MED", "SUR"]
+all:
+  required:
+    - MSH-10
+    - PID-3.5
+  fixed:
+    MSH-4: SFAC
+    MSH-6: RFAC
+  values:
+    PV1-2: patient_class
+messages:
+  ADT:
+    required:
+      - EVN-2
+    values:
+      PV1-10: hospital_service
+  ADT^A01:
+    forbidden_segments:
+      - NTE
+  ORU^R01:
+    required:
+      - OBR-4
+`
+)
+
+func message(segments ...string) []byte {
+	return []byte(strings.Join(segments, hl7.SegmentTerminatorStr))
+}
+
+func loadProfile(t *testing.T, p string) *Profile {
+	t.Helper()
+	fName := testwrite.BytesToFile(t, []byte(p))
+	profile, err := LoadProfile(context.Background(), fName)
+	if err != nil {
+		t.Fatalf("LoadProfile(%s) failed with %v", fName, err)
+	}
+	return profile
+}
+
+func parse(t *testing.T, input []byte) *hl7.Message {
+	t.Helper()
+	m, err := hl7.ParseMessage(input)
+	if err != nil {
+		t.Fatalf("hl7.ParseMessage() failed with %v", err)
+	}
+	return m
+}
+
+// ignoreMessage ignores the text of the issues, so that the tests don't depend on the wording.
+var ignoreMessage = cmpopts.IgnoreFields(validate.Issue{}, "Message")
+
+func TestCheck(t *testing.T) {
+	p := loadProfile(t, profile)
+	tests := []struct {
+		name  string
+		input []byte
+		want  []validate.Issue
+	}{{
+		name:  "conforms",
+		input: message(msh, evn, pid, pv1),
+	}, {
+		name:  "fixed value mismatch",
+		input: message(strings.Replace(msh, "RFAC", "OTHER", 1), evn, pid, pv1),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: RuleFixedValue, Segment: 1, Location: "MSH-6"}},
+	}, {
+		name:  "fixed value missing",
+		input: message(strings.Replace(msh, "SFAC", "", 1), evn, pid, pv1),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: RuleFixedValue, Segment: 1, Location: "MSH-4"}},
+	}, {
+		name:  "value not in table",
+		input: message(msh, evn, pid, "PV1|1|X||||||||MED"),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleTable, Segment: 4, Location: "PV1-2"}},
+	}, {
+		name:  "empty and null values are not checked against tables",
+		input: message(msh, evn, pid, `PV1|1|""`),
+	}, {
+		name:  "value not in table for the message type",
+		input: message(msh, evn, pid, "PV1|1|I||||||||URO"),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleTable, Segment: 4, Location: "PV1-10"}},
+	}, {
+		name:  "required component missing in one repetition",
+		input: message(msh, evn, "PID|1||1234^^^SIMULATOR MRN~5678^^^NHS^NHSNMBR", pv1),
+	}, {
+		name:  "required component missing in all repetitions",
+		input: message(msh, evn, "PID|1||1234^^^SIMULATOR MRN~5678^^^NHS", pv1),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleRequired, Segment: 3, Location: "PID-3.5"}},
+	}, {
+		name:  "required field missing",
+		input: message(msh, "EVN|A01", pid, pv1),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleRequired, Segment: 2, Location: "EVN-2"}},
+	}, {
+		name:  "required field in missing segment",
+		input: message(msh, pid, pv1),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleRequired, Segment: 0, Location: "EVN-2"}},
+	}, {
+		name:  "forbidden segment",
+		input: message(msh, evn, pid, pv1, "NTE|1||Note"),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: RuleForbiddenSegment, Segment: 5, Location: "NTE"}},
+	}, {
+		name:  "forbidden segment in other message type",
+		input: message(strings.Replace(msh, "ADT^A01", "ADT^A03", 1), evn, pid, pv1, "NTE|1||Note"),
+	}, {
+		name:  "rules of other message types",
+		input: message(strings.Replace(msh, "ADT^A01", "ORU^R01", 1), pid, "OBR|1", "NTE|1||Note"),
+		want:  []validate.Issue{{Severity: validate.Error, Rule: validate.RuleRequired, Segment: 3, Location: "OBR-4"}},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := p.Check(parse(t, tc.input))
+			if diff := cmp.Diff(tc.want, got, ignoreMessage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Check() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheck_Repetitions(t *testing.T) {
+	p := loadProfile(t, "all:\n  fixed:\n    PID-3.4: SIMULATOR MRN\n")
+	got := p.Check(parse(t, message(msh, evn, pid, pv1)))
+	want := []validate.Issue{{Severity: validate.Error, Rule: RuleFixedValue, Segment: 3, Location: "PID-3[2].4"}}
+	if diff := cmp.Diff(want, got, ignoreMessage); diff != "" {
+		t.Errorf("Check() got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestLoadProfile_Prod(t *testing.T) {
+	ctx := context.Background()
+	p, err := LoadProfile(ctx, test.ConformanceProfileProd)
+	if err != nil {
+		t.Fatalf("LoadProfile(%s) failed with %v", test.ConformanceProfileProd, err)
+	}
+	if len(p.All.Fixed) == 0 {
+		t.Errorf("LoadProfile(%s) got no fixed values, want some", test.ConformanceProfileProd)
+	}
+}
+
+func TestLoadProfile_Invalid(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		profile string
+	}{{
+		name:    "unknown table",
+		profile: "all:\n  values:\n    PV1-2: patient_class\n",
+	}, {
+		name:    "invalid location",
+		profile: "all:\n  required:\n    - PID\n",
+	}, {
+		name:    "location with repetition",
+		profile: "all:\n  fixed:\n    PID-3[1].1: A\n",
+	}, {
+		name:    "invalid segment name",
+		profile: "all:\n  forbidden_segments:\n    - nte\n",
+	}, {
+		name:    "invalid message type",
+		profile: "messages:\n  ADT_A01:\n    required:\n      - EVN-2\n",
+	}, {
+		name:    "invalid location for message type",
+		profile: "messages:\n  ADT^A01:\n    required:\n      - EVN\n",
+	}, {
+		name:    "unknown key",
+		profile: "all:\n  mandatory:\n    - PID-3\n",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fName := testwrite.BytesToFile(t, []byte(tc.profile))
+			if _, err := LoadProfile(ctx, fName); err == nil {
+				t.Errorf("LoadProfile(%s) got nil error, want non nil", fName)
+			}
+		})
+	}
+}
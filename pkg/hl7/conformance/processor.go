@@ -0,0 +1,103 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/validate"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/state"
+	"github.com/pkg/errors"
+)
+
+var log = logging.ForCallerPackage()
+
+// Processor is a message processor that checks every message against a conformance profile.
+// It can be used as a hospital.MessageProcessor, typically in Processors.MessagePre so that the
+// messages that don't conform to the profile are not sent.
+type Processor struct {
+	profile   *Profile
+	validator *validate.Validator
+	fail      bool
+}
+
+// NewProcessor returns a Processor that checks messages against the given profile.
+// If fail is true, Process returns an error for the messages with errors; otherwise the issues are
+// only logged.
+func NewProcessor(p *Profile, fail bool) (*Processor, error) {
+	proc := &Processor{profile: p, fail: fail}
+	if p.Structure {
+		v, err := validate.NewValidator(nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create validator")
+		}
+		proc.validator = v
+	}
+	return proc, nil
+}
+
+// Matches returns true: all messages are checked.
+func (p *Processor) Matches(*state.HL7Message) bool {
+	return true
+}
+
+// Process checks the message against the profile and logs the issues found.
+// It returns an error if the message cannot be parsed, or if the processor was created with
+// fail=true and the message has errors.
+func (p *Processor) Process(m *state.HL7Message) error {
+	if m.Message == nil {
+		return nil
+	}
+	msg, err := hl7.ParseMessage([]byte(m.Message.Message))
+	if err != nil {
+		return p.failOrWarn(m, errors.Wrap(err, "cannot parse message"))
+	}
+	issues := p.Issues(msg)
+	errs := 0
+	for _, issue := range issues {
+		if issue.Severity == validate.Error {
+			errs++
+		}
+		log.WithField("profile", p.profile.Name).
+			WithField("message_name", m.Name).
+			WithField("pathway_name", m.PathwayName).
+			Warningf("Message doesn't conform to profile: %v", issue)
+	}
+	if errs > 0 {
+		return p.failOrWarn(m, errors.Errorf("message has %d conformance errors", errs))
+	}
+	return nil
+}
+
+// Issues returns the issues found in the message: the issues of its structure if the profile
+// has Structure=true, and the issues with the rules of the profile.
+func (p *Processor) Issues(m *hl7.Message) []validate.Issue {
+	var issues []validate.Issue
+	if p.validator != nil {
+		issues = append(issues, p.validator.Validate(m).Issues...)
+	}
+	return append(issues, p.profile.Check(m)...)
+}
+
+func (p *Processor) failOrWarn(m *state.HL7Message, err error) error {
+	if p.fail {
+		return errors.Wrapf(err, "conformance profile %q", p.profile.Name)
+	}
+	log.WithError(err).
+		WithField("profile", p.profile.Name).
+		WithField("message_name", m.Name).
+		Warning("Message doesn't conform to profile")
+	return nil
+}
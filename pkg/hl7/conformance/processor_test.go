@@ -0,0 +1,87 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7/validate"
+	hl7message "github.com/bitcrshr/simhospital/pkg/message"
+	"github.com/bitcrshr/simhospital/pkg/state"
+)
+
+func hl7Message(input []byte) *state.HL7Message {
+	return &state.HL7Message{Name: "test", Message: &hl7message.HL7Message{Message: string(input)}}
+}
+
+func TestProcessor(t *testing.T) {
+	conforming := message(msh, evn, pid, pv1)
+	nonConforming := message(msh, evn, pid, "PV1|1|X")
+	// Valid against the profile, but PV1 is missing from the structure of ADT_A01.
+	invalidStructure := message(msh, evn, pid)
+
+	tests := []struct {
+		name      string
+		structure bool
+		fail      bool
+		input     []byte
+		wantErr   bool
+	}{
+		{name: "conforms", fail: true, input: conforming},
+		{name: "doesn't conform, fail", fail: true, input: nonConforming, wantErr: true},
+		{name: "doesn't conform, warn", fail: false, input: nonConforming},
+		{name: "cannot be parsed, fail", fail: true, input: []byte("PID|1"), wantErr: true},
+		{name: "cannot be parsed, warn", fail: false, input: []byte("PID|1")},
+		{name: "invalid structure not checked", fail: true, input: invalidStructure},
+		{name: "invalid structure checked", structure: true, fail: true, input: invalidStructure, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := loadProfile(t, profile)
+			p.Structure = tc.structure
+			proc, err := NewProcessor(p, tc.fail)
+			if err != nil {
+				t.Fatalf("NewProcessor() failed with %v", err)
+			}
+			m := hl7Message(tc.input)
+			if !proc.Matches(m) {
+				t.Error("Matches() got false, want true")
+			}
+			if err := proc.Process(m); (err != nil) != tc.wantErr {
+				t.Errorf("Process() got err=%v, want error: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestProcessor_Issues(t *testing.T) {
+	p := loadProfile(t, profile)
+	p.Structure = true
+	proc, err := NewProcessor(p, true)
+	if err != nil {
+		t.Fatalf("NewProcessor() failed with %v", err)
+	}
+	issues := proc.Issues(parse(t, message(strings.Replace(msh, "SFAC", "XFAC", 1), evn, pid)))
+	rules := map[string]bool{}
+	for _, i := range issues {
+		rules[i.Rule] = true
+	}
+	for _, want := range []string{validate.RuleRequired, RuleFixedValue} {
+		if !rules[want] {
+			t.Errorf("Issues() got %v, want an issue with rule %q", issues, want)
+		}
+	}
+}
@@ -788,6 +788,28 @@ func TestParseST_UnescapesTextWithErrors(t *testing.T) {
 	}
 }
 
+func TestMarshalST_EscapesText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"One|Field", `One\F\Field`},
+		{`Escape\Character`, `Escape\E\Character`},
+		{"Delimiters^&~", `Delimiters\S\\T\\R\`},
+	}
+	for _, test := range tests {
+		st := ST(test.in)
+		out, err := st.Marshal(testContext)
+		if err != nil {
+			t.Fatalf("Marshal(%q) failed with %v", test.in, err)
+		}
+		if got, want := string(out), test.want; got != want {
+			t.Errorf("Marshal(%q) got %v, want %v", test.in, got, want)
+		}
+	}
+}
+
 func TestParseFT_UnescapesText(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -910,6 +932,28 @@ func TestTX_UnescapesText(t *testing.T) {
 	}
 }
 
+func TestTX_EscapesText(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{"One|Escape", `One\F\Escape`},
+		{"result\nresult", `result\.br\result`},
+		{"", ""},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			tx := TX(tt.in)
+			got, err := tx.Marshal(testContext)
+			if err != nil {
+				t.Fatalf("tx.Marshal(testContext) failed with error %+v", err)
+			}
+			if want := tt.want; string(got) != want {
+				t.Errorf("tx.Marshal(testContext)=%q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestHDString(t *testing.T) {
 	tests := []struct {
 		in      HD
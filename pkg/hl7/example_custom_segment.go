@@ -45,13 +45,13 @@ func (s *ZCM) SegmentName() string {
 // AllZCM returns a slice containing all ZCM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllZCM() ([]*ZCM, error) {
-	pss, err := m.ParseAll("ZCM")
+	pss, err := m.ParseAllWithTypes(Types, "ZCM")
 	return pss.([]*ZCM), err
 }
 
 // ZCM returns the first ZCM segment within the message, or nil if there isn't one.
 func (m *Message) ZCM() (*ZCM, error) {
-	ps, err := m.Parse("ZCM")
+	ps, err := m.ParseWithTypes(Types, "ZCM")
 	pst, ok := ps.(*ZCM)
 	if ok {
 		return pst, err
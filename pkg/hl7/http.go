@@ -0,0 +1,224 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultHTTPContentType is the default content type of the messages sent by the HTTP sender.
+const DefaultHTTPContentType = "x-application/hl7-v2+er7"
+
+// XMLHTTPContentType is the content type of the messages in the XML encoding.
+const XMLHTTPContentType = "application/hl7-v2+xml"
+
+// maxHTTPResponseSize is the maximum number of bytes read from the body of a response.
+const maxHTTPResponseSize = 1 << 20
+
+// HTTPSenderOptions contains optional parameters to NewHTTPSender.
+type HTTPSenderOptions struct {
+	// ContentType is the value of the Content-Type header of the requests.
+	ContentType string
+	// Headers are additional headers sent with every request.
+	Headers http.Header
+	// BearerToken, if set, is sent in the Authorization header of every request.
+	BearerToken string
+	// Username and Password, if Username is set, are used for basic authentication.
+	// They are ignored if BearerToken is set.
+	Username string
+	Password string
+	// Timeout is the maximum duration of each request. If 0, there is no timeout.
+	Timeout time.Duration
+	// MaxRetries is the maximum number of times a message is re-sent if the request fails with an
+	// error that might be temporary: a network error, a 5xx or 429 status code, or an acknowledgment
+	// with an AE or CE code.
+	MaxRetries int
+	// RetryBackoff is the time to wait before re-sending a message the first time.
+	// The time doubles with every retry.
+	RetryBackoff time.Duration
+	// TLS is the configuration used for HTTPS requests. If nil, the default configuration is used.
+	TLS *tls.Config
+}
+
+// NewHTTPSenderOptions returns the default HTTPSenderOptions, with a timeout of 30 seconds and
+// three retries.
+func NewHTTPSenderOptions() *HTTPSenderOptions {
+	return &HTTPSenderOptions{
+		ContentType:  DefaultHTTPContentType,
+		Timeout:      30 * time.Second,
+		MaxRetries:   3,
+		RetryBackoff: time.Second,
+	}
+}
+
+// httpSender sends HL7 messages in the body of HTTP POST requests.
+type httpSender struct {
+	url     string
+	client  *http.Client
+	options *HTTPSenderOptions
+	count   int
+}
+
+// NewHTTPSender returns a sender that posts each HL7 message to the given URL.
+// A message is sent successfully if the response has a 2xx status code and, if the body of the
+// response is an HL7 acknowledgment, its acknowledgment code is AA or CA.
+func NewHTTPSender(url string, options *HTTPSenderOptions) (Sender, error) {
+	if url == "" {
+		return nil, errors.New("URL must be nonempty if sending messages over HTTP")
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, errors.Errorf("invalid URL %q: the scheme must be http or https", url)
+	}
+	if options.MaxRetries < 0 || options.RetryBackoff < 0 || options.Timeout < 0 {
+		return nil, errors.New("HTTP sender timeout and retries must not be negative")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if options.TLS != nil {
+		transport.TLSClientConfig = options.TLS
+	}
+	return &httpSender{
+		url:     url,
+		client:  &http.Client{Timeout: options.Timeout, Transport: transport},
+		options: options,
+	}, nil
+}
+
+// httpError is an error sending a message over HTTP, with whether the message can be re-sent.
+type httpError struct {
+	err       error
+	retryable bool
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *httpError) Unwrap() error {
+	return e.err
+}
+
+// Send posts the message, retrying as defined in the sender's options.
+func (s *httpSender) Send(message []byte) error {
+	for attempt := 0; ; attempt++ {
+		err := s.sendOnce(message)
+		if err == nil {
+			s.count++
+			return nil
+		}
+		var httpErr *httpError
+		if !errors.As(err, &httpErr) || !httpErr.retryable || attempt >= s.options.MaxRetries {
+			return errors.Wrapf(err, "cannot send message to %s", s.url)
+		}
+		backoff := s.options.RetryBackoff * (1 << uint(attempt))
+		log.WithError(err).WithField("url", s.url).Warningf("Cannot send message, retrying in %v", backoff)
+		counters.SimulatedHospital.HttpSendRetriesTotal.Inc()
+		time.Sleep(backoff)
+	}
+}
+
+// sendOnce posts the message and interprets the response.
+func (s *httpSender) sendOnce(message []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(message))
+	if err != nil {
+		return errors.Wrap(err, "cannot create request")
+	}
+	for k, vs := range s.options.Headers {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", s.options.ContentType)
+	switch {
+	case s.options.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+s.options.BearerToken)
+	case s.options.Username != "":
+		req.SetBasicAuth(s.options.Username, s.options.Password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &httpError{err: err, retryable: true}
+	}
+	defer resp.Body.Close()
+	// One more byte than the maximum is read to detect bodies that are too large.
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize+1))
+	if err != nil {
+		return &httpError{err: errors.Wrap(err, "cannot read response"), retryable: true}
+	}
+	counters.SimulatedHospital.HttpResponsesTotal.With(prometheus.Labels{"status_code": strconv.Itoa(resp.StatusCode)}).Inc()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &httpError{
+			err:       errors.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(body)),
+			retryable: resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests,
+		}
+	}
+	if len(body) > maxHTTPResponseSize {
+		// Sending the message again would most likely get the same response.
+		return errors.Errorf("response body is larger than %d bytes", maxHTTPResponseSize)
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("MSH")) {
+		// The body is not an acknowledgment, so the status code is all there is to go by.
+		return nil
+	}
+	ack, err := ParseAck(body)
+	if err != nil {
+		return errors.Wrap(err, "cannot interpret ack")
+	}
+	if controlID := messageControlID(message); controlID != "" && ack.ControlID != controlID {
+		return errors.Errorf("ack control ID %q does not match the control ID of the message sent %q", ack.ControlID, controlID)
+	}
+	if ack.IsAccept() {
+		return nil
+	}
+	return &httpError{
+		err:       &NackError{Ack: ack},
+		retryable: ack.Code == AckApplicationError || ack.Code == AckCommitError,
+	}
+}
+
+// Close prints the number of messages that have been sent.
+func (s *httpSender) Close() error {
+	log.Infof("Messages successfully sent by the httpSender: %d", s.count)
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// ParseHTTPHeaders parses a comma-separated list of Name=Value pairs into HTTP headers,
+// eg: "X-Source=simhospital,X-Environment=test".
+func ParseHTTPHeaders(s string) (http.Header, error) {
+	headers := http.Header{}
+	if s == "" {
+		return headers, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, errors.Errorf("invalid header %q: must be Name=Value", pair)
+		}
+		headers.Add(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+	}
+	return headers, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// httpResponse is a response returned by the test HTTP server.
+type httpResponse struct {
+	status int
+	body   string
+}
+
+// httpServer returns a server that returns the given responses in order, and records the requests.
+// Once all the responses are returned, the last one is repeated.
+func httpServer(t *testing.T, responses ...httpResponse) (*httptest.Server, *[]*http.Request, *[]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("ioutil.ReadAll() failed with %v", err)
+		}
+		requests = append(requests, r)
+		bodies = append(bodies, string(b))
+		resp := responses[len(responses)-1]
+		if len(requests) <= len(responses) {
+			resp = responses[len(requests)-1]
+		}
+		w.WriteHeader(resp.status)
+		w.Write([]byte(resp.body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests, &bodies
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	cases := []struct {
+		name         string
+		responses    []httpResponse
+		wantErr      bool
+		wantRequests int
+	}{{
+		name:         "200 without body",
+		responses:    []httpResponse{{status: http.StatusOK}},
+		wantRequests: 1,
+	}, {
+		name:         "AA ack",
+		responses:    []httpResponse{{status: http.StatusOK, body: ackMessage(AckApplicationAccept, "1234")}},
+		wantRequests: 1,
+	}, {
+		name: "AE ack then AA ack",
+		responses: []httpResponse{
+			{status: http.StatusOK, body: ackMessage(AckApplicationError, "1234")},
+			{status: http.StatusOK, body: ackMessage(AckApplicationAccept, "1234")},
+		},
+		wantRequests: 2,
+	}, {
+		name:         "AR ack is not retried",
+		responses:    []httpResponse{{status: http.StatusOK, body: ackMessage(AckApplicationReject, "1234")}},
+		wantErr:      true,
+		wantRequests: 1,
+	}, {
+		name:         "ack with another control ID",
+		responses:    []httpResponse{{status: http.StatusOK, body: ackMessage(AckApplicationAccept, "other")}},
+		wantErr:      true,
+		wantRequests: 1,
+	}, {
+		name:         "503 then 200",
+		responses:    []httpResponse{{status: http.StatusServiceUnavailable}, {status: http.StatusAccepted}},
+		wantRequests: 2,
+	}, {
+		name:         "500 until retries are exhausted",
+		responses:    []httpResponse{{status: http.StatusInternalServerError}},
+		wantErr:      true,
+		wantRequests: 3,
+	}, {
+		name:         "400 is not retried",
+		responses:    []httpResponse{{status: http.StatusBadRequest, body: "invalid message"}},
+		wantErr:      true,
+		wantRequests: 1,
+	}, {
+		name:         "body larger than the maximum is not retried",
+		responses:    []httpResponse{{status: http.StatusOK, body: ackMessage(AckApplicationAccept, "1234") + strings.Repeat(" ", maxHTTPResponseSize)}},
+		wantErr:      true,
+		wantRequests: 1,
+	}, {
+		name:         "body of the maximum size",
+		responses:    []httpResponse{{status: http.StatusOK, body: strings.Repeat(" ", maxHTTPResponseSize)}},
+		wantRequests: 1,
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, requests, bodies := httpServer(t, tc.responses...)
+			options := NewHTTPSenderOptions()
+			options.MaxRetries = 2
+			options.RetryBackoff = time.Millisecond
+			s, err := NewHTTPSender(server.URL, options)
+			if err != nil {
+				t.Fatalf("NewHTTPSender(%q) failed with %v", server.URL, err)
+			}
+			defer s.Close()
+
+			err = s.Send([]byte(sentMessage))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Send() got err %v, want error: %t", err, tc.wantErr)
+			}
+			if got, want := len(*requests), tc.wantRequests; got != want {
+				t.Errorf("number of requests got %d, want %d", got, want)
+			}
+			for _, b := range *bodies {
+				if b != sentMessage {
+					t.Errorf("request body got %q, want %q", b, sentMessage)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Headers(t *testing.T) {
+	headers, err := ParseHTTPHeaders("X-Source=simhospital, X-Environment=test")
+	if err != nil {
+		t.Fatalf("ParseHTTPHeaders() failed with %v", err)
+	}
+	cases := []struct {
+		name              string
+		options           *HTTPSenderOptions
+		wantAuthorization string
+	}{{
+		name:              "bearer token",
+		options:           &HTTPSenderOptions{ContentType: DefaultHTTPContentType, Headers: headers, BearerToken: "token", Username: "user"},
+		wantAuthorization: "Bearer token",
+	}, {
+		name:              "basic auth",
+		options:           &HTTPSenderOptions{ContentType: DefaultHTTPContentType, Headers: headers, Username: "user", Password: "pass"},
+		wantAuthorization: "Basic dXNlcjpwYXNz",
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, requests, _ := httpServer(t, httpResponse{status: http.StatusOK})
+			s, err := NewHTTPSender(server.URL, tc.options)
+			if err != nil {
+				t.Fatalf("NewHTTPSender(%q) failed with %v", server.URL, err)
+			}
+			defer s.Close()
+			if err := s.Send([]byte(sentMessage)); err != nil {
+				t.Fatalf("Send() failed with %v", err)
+			}
+			if len(*requests) != 1 {
+				t.Fatalf("number of requests got %d, want 1", len(*requests))
+			}
+			r := (*requests)[0]
+			want := map[string]string{
+				"Authorization": tc.wantAuthorization,
+				"Content-Type":  DefaultHTTPContentType,
+				"X-Source":      "simhospital",
+				"X-Environment": "test",
+			}
+			got := map[string]string{}
+			for k := range want {
+				got[k] = r.Header.Get(k)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("headers diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Timeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	// The handler must return before the server can be closed.
+	defer close(done)
+
+	options := NewHTTPSenderOptions()
+	options.Timeout = 50 * time.Millisecond
+	options.MaxRetries = 0
+	s, err := NewHTTPSender(server.URL, options)
+	if err != nil {
+		t.Fatalf("NewHTTPSender(%q) failed with %v", server.URL, err)
+	}
+	defer s.Close()
+	if err := s.Send([]byte(sentMessage)); err == nil {
+		t.Error("Send() got nil error, want timeout error")
+	}
+}
+
+func TestNewHTTPSender_Invalid(t *testing.T) {
+	for _, url := range []string{"", "localhost:8080", "ftp://localhost"} {
+		if _, err := NewHTTPSender(url, NewHTTPSenderOptions()); err == nil {
+			t.Errorf("NewHTTPSender(%q) got nil error, want error", url)
+		}
+	}
+	if _, err := ParseHTTPHeaders("X-Source"); err == nil {
+		t.Errorf("ParseHTTPHeaders(%q) got nil error, want error", "X-Source")
+	}
+}
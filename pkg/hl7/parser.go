@@ -20,6 +20,10 @@
 //
 // The MLLP specification is defined here:
 // http://www.hl7.org/documentcenter/public/wg/inm/mllp_transport_specification.PDF
+//
+// The types in this package are those of HL7 2.5.1. Messages are parsed with the types of the
+// version in their MSH-12 if the schema of that version is registered, eg by importing
+// pkg/hl7/schemas, and with the types in this package otherwise.
 package hl7
 
 import (
@@ -176,6 +180,11 @@ type ParseMessageOptions struct {
 	SegmentTerminator []byte
 	Rewrites          *[]Rewrite
 	AllowNullHeader   bool
+	// Version is the version of the HL7 specification used to parse messages whose MSH-12 is
+	// missing or has a version without a registered schema. If empty, DefaultVersion is used.
+	Version string
+	// ForceVersion makes the parser use Version for all messages, regardless of MSH-12.
+	ForceVersion bool
 }
 
 // NewParseMessageOptions returns a ParseMessageOptions, which can be used to
@@ -400,10 +409,69 @@ func appendLocation(location string, next string) string {
 }
 
 // Message is an HL7 message.
+// Parse, All and ParseMessageType use the types of the version of the message, but the typed
+// accessors, eg PID(), always return the types of DefaultVersion. To access the segments of a
+// message of another version with the types of that version, use the Message type of the package
+// of the version under pkg/hl7/schemas, eg v230.NewMessage(m).
 type Message struct {
 	*Context
 	Segments []Token
 	msh      MSH
+	// version is the version of the HL7 specification whose schema is used to parse the message.
+	version string
+	schema  *Schema
+}
+
+// Version returns the version of the HL7 specification whose types are used to parse the
+// message, eg "2.3.1".
+func (m *Message) Version() string {
+	if m.schema == nil {
+		return DefaultVersion
+	}
+	return m.version
+}
+
+// Schema returns the schema of the version of the HL7 specification whose types are used to parse
+// the message.
+func (m *Message) Schema() *Schema {
+	return &Schema{Types: m.types(), FollowSets: m.followSets()}
+}
+
+// types returns the types used to parse the message.
+func (m *Message) types() map[string]reflect.Type {
+	if m.schema == nil {
+		return Types
+	}
+	return m.schema.Types
+}
+
+// followSets returns the follow sets used to parse the message with ParseMessageV2.
+func (m *Message) followSets() map[string]StringSet {
+	if m.schema == nil {
+		return FollowSets
+	}
+	return m.schema.FollowSets
+}
+
+// selectSchema sets the schema used to parse the message, picked according to the version in
+// MSH-12 and the given options.
+func (m *Message) selectSchema(options *ParseMessageOptions) error {
+	fallback := options.Version
+	if fallback == "" {
+		fallback = DefaultVersion
+	}
+	if !options.ForceVersion && m.msh.VersionID != nil && m.msh.VersionID.VersionID != nil {
+		if s, v, ok := schemaForVersion(string(*m.msh.VersionID.VersionID)); ok {
+			m.schema, m.version = s, v
+			return nil
+		}
+	}
+	s, v, ok := schemaForVersion(fallback)
+	if !ok {
+		return fmt.Errorf("no schema registered for HL7 version %q", fallback)
+	}
+	m.schema, m.version = s, v
+	return nil
 }
 
 // messageTypeName will return the name of the message struct that corresponds
@@ -440,6 +508,10 @@ func ParseMessage(input []byte) (*Message, error) {
 // dates from the message. If not specified, Timezone is used.
 // If options.AllowNullHeader is set and the message has a null header, defaultDelimiters is used
 // as the delimiters.
+// The segments and the message type are parsed with the types of the version of the HL7
+// specification in MSH-12, if a schema for that version is registered with RegisterSchema.
+// Otherwise, or if options.ForceVersion is set, options.Version is used, which defaults to
+// DefaultVersion.
 // options.segmentTerminator is used as the segment terminator or delimiter. The default value is
 // \r. The spec doesn't allow custom values for this delimiter, but it might be necessary to change
 // it to deal with some messages that use a non-standard terminator.
@@ -460,6 +532,9 @@ func ParseMessageWithOptions(input []byte, options *ParseMessageOptions) (*Messa
 		if !options.AllowNullHeader {
 			return nil, errors.New("Bad HL7 MSH header")
 		}
+		if err := m.selectSchema(options); err != nil {
+			return nil, err
+		}
 		return m, nil
 	}
 
@@ -482,6 +557,9 @@ func ParseMessageWithOptions(input []byte, options *ParseMessageOptions) (*Messa
 		}
 		m.Context.Decoder = enc.NewDecoder()
 	}
+	if err := m.selectSchema(options); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
@@ -673,14 +751,23 @@ func isExpectedSegment(s Token, expected string, d *Delimiters) bool {
 	return err == nil && name == expected
 }
 
+// Parse returns a pointer to the parsed representation of the first segment
+// of the specified segmentType, using the types of the version of the message.
+// If no schema is registered with RegisterSchema for the version in MSH-12, the
+// types of DefaultVersion are used.
 func (m *Message) Parse(name string) (interface{}, error) {
-	return m.parse(name)
-}
-
-// parse returns a pointer to the parsed representation of the first segment
-// of the specified segmentType.
-func (m *Message) parse(name string) (interface{}, error) {
-	t, ok := Types[name]
+	return m.ParseWithTypes(m.types(), name)
+}
+
+// ParseWithTypes returns a pointer to the parsed representation of the first
+// segment of the specified segmentType, using the given types. This is used by
+// the typed accessors, eg PID(), to parse the segments with the types of their
+// own version of the HL7 specification. Unlike Parse, it doesn't depend on the
+// schemas registered with RegisterSchema, which only decide the types of Parse
+// and ParseAll: the version in MSH-12 falls back to DefaultVersion if it has
+// no registered schema.
+func (m *Message) ParseWithTypes(types map[string]reflect.Type, name string) (interface{}, error) {
+	t, ok := lookupType(types, name)
 	if !ok {
 		return nil, &BadSegmentError{name}
 	}
@@ -698,9 +785,15 @@ func (m *Message) parse(name string) (interface{}, error) {
 }
 
 // ParseAll returns a slice of pointers to the parsed representations of every
-// segment of the specified segmentType.
+// segment of the specified segmentType, using the types of the version of the message.
 func (m *Message) ParseAll(name string) (interface{}, error) {
-	t, ok := Types[name]
+	return m.ParseAllWithTypes(m.types(), name)
+}
+
+// ParseAllWithTypes returns a slice of pointers to the parsed representations
+// of every segment of the specified segmentType, using the given types.
+func (m *Message) ParseAllWithTypes(types map[string]reflect.Type, name string) (interface{}, error) {
+	t, ok := lookupType(types, name)
 	if !ok {
 		return nil, &BadSegmentError{name}
 	}
@@ -747,7 +840,7 @@ func (m *Message) All() ([]interface{}, error) {
 			v = append(v, &GenericHL7Segment{s.Value})
 			continue
 		}
-		t, ok := Types[name]
+		t, ok := lookupType(m.types(), name)
 		if !ok {
 			errs = append(errs, *s.Error(&BadSegmentError{name}))
 			continue
@@ -908,6 +1001,17 @@ func MarshalSegments(segments []Segment, c *Context) ([]byte, error) {
 	return result, nil
 }
 
+// MarshalMessageER7 returns the ER7 encoding of the message, ie, its segments separated by
+// SegmentTerminator, eg to send a message after changing it with Set or SetSegment.
+// The segments are not parsed, so they are kept as they are in the message.
+func MarshalMessageER7(m *Message) []byte {
+	segments := make([][]byte, len(m.Segments))
+	for i, s := range m.Segments {
+		segments[i] = s.Value
+	}
+	return bytes.Join(segments, []byte{SegmentTerminator})
+}
+
 func marshalCompositeValue(v reflect.Value, c *Context) ([]byte, error) {
 	var err error
 	end := endOfFieldsWithValues(v)
@@ -1152,14 +1256,10 @@ func appendOther(dst reflect.Value, s stack, v reflect.Value) {
 // ParseMessageType will return a message type struct containing all segments
 // that were matched against its fields.
 func (m *Message) ParseMessageType() (interface{}, error) {
-	name, err := m.messageTypeName()
+	t, err := m.messageType()
 	if err != nil {
 		return nil, err
 	}
-	t, ok := Types[name]
-	if !ok {
-		return nil, &BadMessageTypeError{Name: name}
-	}
 
 	segments, err := m.All()
 	if err != nil {
@@ -1167,8 +1267,31 @@ func (m *Message) ParseMessageType() (interface{}, error) {
 	}
 
 	result := reflect.New(t)
+	matchSegments(result, segments, nil)
+	return result.Interface(), nil
+}
+
+// messageType returns the message type struct that corresponds to this message, via the type
+// specified in the MSH segment.
+func (m *Message) messageType() (reflect.Type, error) {
+	name, err := m.messageTypeName()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := m.types()[name]
+	if !ok {
+		return nil, &BadMessageTypeError{Name: name}
+	}
+	return t, nil
+}
+
+// matchSegments assigns the segments, in order, to the fields of the message type struct that
+// result points to. The segments that don't match any field are appended to the Other fields.
+// If matched is not nil, it is called every time a segment is assigned to a field, with the stack
+// of the field and the index of the segment.
+func matchSegments(result reflect.Value, segments []interface{}, matched func(s stack, i int)) {
 	i := 0
-	s := []entry{{reflect.PtrTo(t), 0, false}}
+	s := []entry{{result.Type(), 0, false}}
 	traverse(s, func(s stack) stack {
 
 		top := s[len(s)-1]
@@ -1185,6 +1308,9 @@ func (m *Message) ParseMessageType() (interface{}, error) {
 					// the type again.
 					s[j].repeat = s[j].t.Kind() == reflect.Slice
 				}
+				if matched != nil {
+					matched(s, i)
+				}
 				i++
 				return s
 			}
@@ -1196,5 +1322,4 @@ func (m *Message) ParseMessageType() (interface{}, error) {
 			i++
 		}
 	})
-	return result.Interface(), nil
 }
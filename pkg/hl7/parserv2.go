@@ -30,7 +30,7 @@ func ParseMessageV2(input []byte) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	t, ok := Types[name+"v2"]
+	t, ok := m.types()[name+"v2"]
 	if !ok {
 		return nil, &BadMessageTypeError{Name: name}
 	}
@@ -40,7 +40,7 @@ func ParseMessageV2(input []byte) (interface{}, error) {
 	}
 
 	result := reflect.New(t)
-	fillGroup(result, segments)
+	fillGroup(result, segments, m.followSets())
 	return result.Interface(), nil
 }
 
@@ -54,7 +54,7 @@ func ParseMessageV2(input []byte) (interface{}, error) {
 // If the segment can't be assigned to any following field, it's discarded.
 // TODO: Return an error if required segments are missing
 // TODO: Communicate skipped segments to the caller
-func fillGroup(g reflect.Value, segments []interface{}) []interface{} {
+func fillGroup(g reflect.Value, segments []interface{}, followSets map[string]StringSet) []interface{} {
 	g = g.Elem()
 	for i := 0; i < g.NumField() && len(segments) > 0; {
 		f := g.Field(i)
@@ -74,7 +74,7 @@ func fillGroup(g reflect.Value, segments []interface{}) []interface{} {
 				i++
 				segments = segments[1:]
 			} else {
-				fs, ok := FollowSets[followSetKey(g, i)]
+				fs, ok := followSets[followSetKey(g, i)]
 				if !ok || !fs[st.Elem().Name()] {
 					segments = segments[1:]
 				} else {
@@ -83,7 +83,7 @@ func fillGroup(g reflect.Value, segments []interface{}) []interface{} {
 			}
 		} else if f.Type().Kind() == reflect.Slice {
 			new := reflect.New(f.Type().Elem().Elem()) // Elem.Elem -> []*Group to Group
-			remaining := fillGroup(new, segments)
+			remaining := fillGroup(new, segments, followSets)
 			if len(remaining) < len(segments) {
 				f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
 				existing := f
@@ -97,7 +97,7 @@ func fillGroup(g reflect.Value, segments []interface{}) []interface{} {
 			}
 		} else {
 			new := reflect.New(f.Type().Elem()) // Elem -> *Group to Group
-			remaining := fillGroup(new, segments)
+			remaining := fillGroup(new, segments, followSets)
 			if len(remaining) < len(segments) {
 				f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
 				f.Set(new)
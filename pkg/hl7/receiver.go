@@ -0,0 +1,216 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/sample"
+	"github.com/pkg/errors"
+)
+
+// MLLPReceiverOptions contains optional parameters to NewMLLPReceiver.
+type MLLPReceiverOptions struct {
+	// AckCodes is the distribution of acknowledgment codes to reply with.
+	// Each value must be an AckCode. If empty, every message is acknowledged with AA.
+	AckCodes sample.DiscreteDistribution
+	// MinLatency and MaxLatency are the bounds of the time the receiver waits before
+	// replying to each message. The actual latency is picked uniformly at random.
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	// Output is where the received messages are written to. If nil, messages are discarded.
+	Output Sender
+}
+
+// NewMLLPReceiverOptions returns a MLLPReceiverOptions that accepts all messages immediately
+// and writes them to the standard output.
+func NewMLLPReceiverOptions() *MLLPReceiverOptions {
+	return &MLLPReceiverOptions{
+		Output: NewStdoutSender(),
+	}
+}
+
+// MLLPReceiver listens for MLLP connections and acknowledges the messages it receives.
+// It can be used as a local stand-in for a system that receives HL7 messages.
+type MLLPReceiver struct {
+	ln      net.Listener
+	options *MLLPReceiverOptions
+	// mu guards the fields below and the output.
+	mu        sync.Mutex
+	count     int
+	conns     map[net.Conn]bool
+	closed    bool
+	waitConns sync.WaitGroup
+}
+
+// NewMLLPReceiver returns a MLLPReceiver that listens on the given address.
+// Call Serve to start accepting connections.
+func NewMLLPReceiver(address string, options *MLLPReceiverOptions) (*MLLPReceiver, error) {
+	if options.MaxLatency < options.MinLatency {
+		return nil, errors.Errorf("max latency %v is smaller than min latency %v", options.MaxLatency, options.MinLatency)
+	}
+	for _, wv := range options.AckCodes.WeightedValues {
+		code, ok := wv.Value.(AckCode)
+		if !ok || !ackCodes[code] {
+			return nil, errors.Errorf("invalid acknowledgment code %v", wv.Value)
+		}
+	}
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot listen on tcp address %s", address)
+	}
+	return &MLLPReceiver{
+		ln:      ln,
+		options: options,
+		conns:   map[net.Conn]bool{},
+	}, nil
+}
+
+// Addr returns the address the receiver is listening on.
+func (r *MLLPReceiver) Addr() net.Addr {
+	return r.ln.Addr()
+}
+
+// Serve accepts connections and handles them until the context is done or the receiver is closed.
+// Each connection is handled in its own goroutine.
+func (r *MLLPReceiver) Serve(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		r.Close()
+	}()
+	for {
+		conn, err := r.ln.Accept()
+		if err != nil {
+			r.mu.Lock()
+			closed := r.closed
+			r.mu.Unlock()
+			if closed {
+				return nil
+			}
+			return errors.Wrap(err, "cannot accept connection")
+		}
+		r.mu.Lock()
+		r.conns[conn] = true
+		r.mu.Unlock()
+		r.waitConns.Add(1)
+		go func() {
+			defer r.waitConns.Done()
+			r.handle(conn)
+		}()
+	}
+}
+
+func (r *MLLPReceiver) handle(conn net.Conn) {
+	logLocal := log.WithField("remote_address", conn.RemoteAddr().String())
+	logLocal.Info("Accepted MLLP connection")
+	defer func() {
+		conn.Close()
+		r.mu.Lock()
+		delete(r.conns, conn)
+		r.mu.Unlock()
+		logLocal.Info("Closed MLLP connection")
+	}()
+	client := NewMLLPClient(conn)
+	for {
+		message, err := client.Read()
+		if err != nil {
+			if errors.Cause(err) != io.EOF {
+				logLocal.WithError(err).Warning("Cannot read message")
+			}
+			return
+		}
+		ack, err := r.receive(message)
+		if err != nil {
+			logLocal.WithError(err).Error("Cannot handle message")
+			return
+		}
+		if err := client.Write(ack); err != nil {
+			logLocal.WithError(err).Warning("Cannot write ack")
+			return
+		}
+	}
+}
+
+// receive writes the message to the output and returns the acknowledgment to send back.
+func (r *MLLPReceiver) receive(message []byte) ([]byte, error) {
+	r.mu.Lock()
+	r.count++
+	controlID := fmt.Sprintf("ACK%d", r.count)
+	if r.options.Output != nil {
+		if err := r.options.Output.Send(message); err != nil {
+			r.mu.Unlock()
+			return nil, errors.Wrap(err, "cannot write received message")
+		}
+	}
+	r.mu.Unlock()
+
+	code := AckApplicationAccept
+	text := ""
+	m, err := ParseMessage(message)
+	if err != nil {
+		log.WithError(err).Warning("Received a message that cannot be parsed")
+		code = AckApplicationReject
+		text = "Message cannot be parsed"
+	} else if c, ok := r.options.AckCodes.Random().(AckCode); ok {
+		code = c
+	}
+
+	if latency := r.latency(); latency > 0 {
+		time.Sleep(latency)
+	}
+	return BuildAck(m, code, text, controlID, time.Now())
+}
+
+func (r *MLLPReceiver) latency() time.Duration {
+	spread := r.options.MaxLatency - r.options.MinLatency
+	if spread <= 0 {
+		return r.options.MinLatency
+	}
+	return r.options.MinLatency + time.Duration(rand.Int63n(int64(spread)))
+}
+
+// Close stops listening for connections, closes the open connections and the output.
+// It prints the number of messages that have been received.
+func (r *MLLPReceiver) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	for c := range r.conns {
+		c.Close()
+	}
+	r.mu.Unlock()
+
+	err := r.ln.Close()
+	r.waitConns.Wait()
+	log.Infof("Messages received by the MLLPReceiver: %d", r.count)
+	if err != nil {
+		return errors.Wrap(err, "closing mllp receiver listener")
+	}
+	if r.options.Output != nil {
+		if err := r.options.Output.Close(); err != nil {
+			return errors.Wrap(err, "closing mllp receiver output")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/sample"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMLLPReceiver(t *testing.T) {
+	tests := []struct {
+		name        string
+		ackCodes    sample.DiscreteDistribution
+		message     string
+		wantCode    AckCode
+		wantControl string
+	}{{
+		name:        "accepts by default",
+		message:     sentMessage,
+		wantCode:    AckApplicationAccept,
+		wantControl: "1234",
+	}, {
+		name:        "configured code",
+		ackCodes:    sample.DiscreteDistribution{WeightedValues: []sample.WeightedValue{{Value: AckApplicationError, Frequency: 1}}},
+		message:     sentMessage,
+		wantCode:    AckApplicationError,
+		wantControl: "1234",
+	}, {
+		name:     "message that cannot be parsed is rejected",
+		message:  "not_a_message",
+		wantCode: AckApplicationReject,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output := &recordingSender{}
+			options := &MLLPReceiverOptions{AckCodes: tc.ackCodes, Output: output}
+			r, err := NewMLLPReceiver(":0", options)
+			if err != nil {
+				t.Fatalf("NewMLLPReceiver(%q, %+v) failed with %v", ":0", options, err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			served := make(chan error, 1)
+			go func() { served <- r.Serve(ctx) }()
+
+			conn, err := net.Dial("tcp", r.Addr().String())
+			if err != nil {
+				t.Fatalf("net.Dial(%q) failed with %v", r.Addr().String(), err)
+			}
+			defer conn.Close()
+			client := NewMLLPClient(conn)
+			if err := client.Write([]byte(tc.message)); err != nil {
+				t.Fatalf("client.Write(%q) failed with %v", tc.message, err)
+			}
+			ackB, err := client.Read()
+			if err != nil {
+				t.Fatalf("client.Read() failed with %v", err)
+			}
+			ack, err := ParseAck(ackB)
+			if err != nil {
+				t.Fatalf("ParseAck(%q) failed with %v", ackB, err)
+			}
+			if ack.Code != tc.wantCode {
+				t.Errorf("ack.Code got %q, want %q", ack.Code, tc.wantCode)
+			}
+			if ack.ControlID != tc.wantControl {
+				t.Errorf("ack.ControlID got %q, want %q", ack.ControlID, tc.wantControl)
+			}
+
+			cancel()
+			if err := <-served; err != nil {
+				t.Errorf("Serve() got err=%v, want nil", err)
+			}
+			if diff := cmp.Diff([]string{tc.message}, output.messages); diff != "" {
+				t.Errorf("received messages got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMLLPReceiver_Latency(t *testing.T) {
+	options := &MLLPReceiverOptions{MinLatency: 50 * time.Millisecond, MaxLatency: 100 * time.Millisecond}
+	r, err := NewMLLPReceiver(":0", options)
+	if err != nil {
+		t.Fatalf("NewMLLPReceiver(%q, %+v) failed with %v", ":0", options, err)
+	}
+	defer r.Close()
+	go r.Serve(context.Background())
+
+	conn, err := net.Dial("tcp", r.Addr().String())
+	if err != nil {
+		t.Fatalf("net.Dial(%q) failed with %v", r.Addr().String(), err)
+	}
+	defer conn.Close()
+	client := NewMLLPClient(conn)
+	start := time.Now()
+	if err := client.Write([]byte(sentMessage)); err != nil {
+		t.Fatalf("client.Write(%q) failed with %v", sentMessage, err)
+	}
+	if _, err := client.Read(); err != nil {
+		t.Fatalf("client.Read() failed with %v", err)
+	}
+	if got := time.Since(start); got < options.MinLatency {
+		t.Errorf("ack received after %v, want at least %v", got, options.MinLatency)
+	}
+}
+
+func TestNewMLLPReceiver_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *MLLPReceiverOptions
+	}{{
+		name:    "max latency smaller than min latency",
+		options: &MLLPReceiverOptions{MinLatency: time.Second, MaxLatency: time.Millisecond},
+	}, {
+		name: "invalid ack code",
+		options: &MLLPReceiverOptions{
+			AckCodes: sample.DiscreteDistribution{WeightedValues: []sample.WeightedValue{{Value: AckCode("XX"), Frequency: 1}}},
+		},
+	}, {
+		name: "value is not an ack code",
+		options: &MLLPReceiverOptions{
+			AckCodes: sample.DiscreteDistribution{WeightedValues: []sample.WeightedValue{{Value: "AA", Frequency: 1}}},
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewMLLPReceiver(":0", tc.options); err == nil {
+				t.Errorf("NewMLLPReceiver(%q, %+v) got nil err, want non-nil err", ":0", tc.options)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Indexes of fields in an MSH segment split by the field separator. The field separator itself
+	// is MSH-1, so MSH-n is at index n-1.
+	mshDateTimeIndex  = 6
+	mshControlIDIndex = 9
+)
+
+// ReplayOptions contains optional parameters to Replay.
+type ReplayOptions struct {
+	// Speed is how many times faster than originally the messages are sent. The original gap
+	// between two messages is the difference between their MSH-7 timestamps. For instance, if Speed
+	// is 60, messages that were originally sent one minute apart are sent one second apart.
+	// If 0, messages are sent as fast as possible.
+	Speed float64
+	// MaxGap is the maximum time to wait between two messages. If 0, there is no maximum.
+	MaxGap time.Duration
+	// RewriteDateTime is whether to set MSH-7 to the time the message is sent.
+	RewriteDateTime bool
+	// RewriteControlID is whether to set MSH-10 to a new message control ID, so that the replayed
+	// messages are not considered duplicates of the original ones.
+	RewriteControlID bool
+}
+
+// NewReplayOptions returns the default ReplayOptions, which reproduce the original gaps between
+// messages and don't modify the messages.
+func NewReplayOptions() *ReplayOptions {
+	return &ReplayOptions{Speed: 1}
+}
+
+// replayer sends recorded messages again.
+type replayer struct {
+	sender  Sender
+	options *ReplayOptions
+	now     func() time.Time
+	sleep   func(context.Context, time.Duration) error
+}
+
+// Replay sends the given messages with the sender, in order, reproducing the gaps between them
+// according to their MSH-7 timestamps and options. Messages with a missing or invalid MSH-7 are
+// sent straight after the previous one.
+// Replay stops at the first message that cannot be sent, or when ctx is done, and returns the
+// number of messages that were sent.
+func Replay(ctx context.Context, sender Sender, messages [][]byte, options *ReplayOptions) (int, error) {
+	r := &replayer{sender: sender, options: options, now: time.Now, sleep: sleepWithContext}
+	return r.replay(ctx, messages)
+}
+
+func (r *replayer) replay(ctx context.Context, messages [][]byte) (int, error) {
+	if r.options.Speed < 0 || r.options.MaxGap < 0 {
+		return 0, errors.New("replay speed and maximum gap must not be negative")
+	}
+	start := r.now()
+	var previous time.Time
+	var lastSent time.Time
+	for i, m := range messages {
+		if t, ok := messageDateTime(m); ok {
+			if !previous.IsZero() {
+				if err := r.sleep(ctx, r.wait(t.Sub(previous), r.now().Sub(lastSent))); err != nil {
+					return i, err
+				}
+			}
+			previous = t
+		}
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		sent := m
+		if r.options.RewriteDateTime || r.options.RewriteControlID {
+			var err error
+			if sent, err = r.rewrite(m, start, i+1); err != nil {
+				return i, errors.Wrapf(err, "cannot rewrite message %d", i+1)
+			}
+		}
+		if err := r.sender.Send(sent); err != nil {
+			return i, errors.Wrapf(err, "cannot send message %d", i+1)
+		}
+		lastSent = r.now()
+	}
+	return len(messages), nil
+}
+
+// wait returns how long to wait before sending a message that was originally sent gap after the
+// previous one, given that elapsed has passed since the previous message was sent.
+func (r *replayer) wait(gap, elapsed time.Duration) time.Duration {
+	if r.options.Speed == 0 || gap <= 0 {
+		return 0
+	}
+	d := time.Duration(float64(gap) / r.options.Speed)
+	if r.options.MaxGap > 0 && d > r.options.MaxGap {
+		d = r.options.MaxGap
+	}
+	return d - elapsed
+}
+
+// rewrite returns the message with MSH-7 and MSH-10 rewritten according to the options.
+// The new message control IDs are made of the time the replay started and the number of the
+// message, so that they are unique across replays.
+func (r *replayer) rewrite(message []byte, start time.Time, n int) ([]byte, error) {
+	segments := splitSegments(message)
+	if len(segments) == 0 || !bytes.HasPrefix(segments[0], []byte("MSH")) || len(segments[0]) < 4 {
+		return nil, errors.New("the message doesn't start with an MSH segment")
+	}
+	msh := segments[0]
+	fields := bytes.Split(msh, msh[3:4])
+	for len(fields) <= mshControlIDIndex {
+		fields = append(fields, nil)
+	}
+	if r.options.RewriteDateTime {
+		fields[mshDateTimeIndex] = []byte(r.now().In(batchLocation()).Format(batchTimeLayout))
+	}
+	if r.options.RewriteControlID {
+		fields[mshControlIDIndex] = []byte(fmt.Sprintf("%s%d", start.In(batchLocation()).Format(batchTimeLayout), n))
+	}
+	segments[0] = bytes.Join(fields, msh[3:4])
+	return bytes.Join(segments, []byte{SegmentTerminator}), nil
+}
+
+// messageDateTime returns the MSH-7 timestamp of the message, and whether it could be parsed.
+// Only the MSH segment is parsed, so that messages that are otherwise invalid can still be replayed
+// with the right timing.
+func messageDateTime(message []byte) (time.Time, bool) {
+	segments := splitSegments(message)
+	if len(segments) == 0 {
+		return time.Time{}, false
+	}
+	options := NewParseMessageOptions()
+	options.TimezoneLoc = batchLocation()
+	m, err := ParseMessageWithOptions(segments[0], options)
+	if err != nil || m.msh.DateTimeOfMessage == nil || m.msh.DateTimeOfMessage.IsHL7Null {
+		return time.Time{}, false
+	}
+	t := m.msh.DateTimeOfMessage.Time
+	return t, !t.IsZero()
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
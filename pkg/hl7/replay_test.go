@@ -0,0 +1,136 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var replayMessages = [][]byte{
+	[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A01|1|T|2.3\rPID|1"),
+	[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120100||ADT^A02|2|T|2.3\rPID|1"),
+	[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|||ADT^A03|3|T|2.3\rPID|1"),
+	[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101130100||ADT^A03|4|T|2.3\rPID|1"),
+}
+
+// fakeTimer implements the now and sleep functions of a replayer, and records the sleeps.
+type fakeTimer struct {
+	t      time.Time
+	sleeps []time.Duration
+}
+
+func (f *fakeTimer) now() time.Time {
+	return f.t
+}
+
+func (f *fakeTimer) sleep(_ context.Context, d time.Duration) error {
+	f.sleeps = append(f.sleeps, d)
+	if d > 0 {
+		f.t = f.t.Add(d)
+	}
+	return nil
+}
+
+func TestReplay_Timing(t *testing.T) {
+	defer func(l *time.Location) { Location = l }(Location)
+	Location = time.UTC
+
+	cases := []struct {
+		name       string
+		options    *ReplayOptions
+		wantSleeps []time.Duration
+	}{{
+		name:       "original speed",
+		options:    NewReplayOptions(),
+		wantSleeps: []time.Duration{time.Minute, time.Hour},
+	}, {
+		name:       "faster",
+		options:    &ReplayOptions{Speed: 60},
+		wantSleeps: []time.Duration{time.Second, time.Minute},
+	}, {
+		name:       "maximum gap",
+		options:    &ReplayOptions{Speed: 1, MaxGap: 10 * time.Minute},
+		wantSleeps: []time.Duration{time.Minute, 10 * time.Minute},
+	}, {
+		name:       "as fast as possible",
+		options:    &ReplayOptions{Speed: 0},
+		wantSleeps: []time.Duration{0, 0},
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			timer := &fakeTimer{t: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)}
+			s := &recordingSender{}
+			r := &replayer{sender: s, options: tc.options, now: timer.now, sleep: timer.sleep}
+			n, err := r.replay(context.Background(), replayMessages)
+			if err != nil {
+				t.Fatalf("replay() failed with %v", err)
+			}
+			if got, want := n, len(replayMessages); got != want {
+				t.Errorf("replay() got %d messages sent, want %d", got, want)
+			}
+			if got, want := len(s.messages), len(replayMessages); got != want {
+				t.Errorf("len(messages) got %d, want %d", got, want)
+			}
+			if diff := cmp.Diff(tc.wantSleeps, timer.sleeps); diff != "" {
+				t.Errorf("sleeps diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplay_Rewrite(t *testing.T) {
+	defer func(l *time.Location) { Location = l }(Location)
+	Location = time.UTC
+
+	timer := &fakeTimer{t: time.Date(2020, 5, 1, 10, 30, 0, 0, time.UTC)}
+	s := &recordingSender{}
+	options := &ReplayOptions{RewriteDateTime: true, RewriteControlID: true}
+	r := &replayer{sender: s, options: options, now: timer.now, sleep: timer.sleep}
+	messages := [][]byte{
+		[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A01|1|T|2.3\nPID|1\n"),
+		[]byte("MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120100"),
+	}
+	if _, err := r.replay(context.Background(), messages); err != nil {
+		t.Fatalf("replay() failed with %v", err)
+	}
+	want := []string{
+		"MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200501103000||ADT^A01|202005011030001|T|2.3\rPID|1",
+		"MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200501103000|||202005011030002",
+	}
+	if diff := cmp.Diff(want, s.messages); diff != "" {
+		t.Errorf("messages diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestReplay_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if n, err := Replay(ctx, &recordingSender{}, replayMessages, NewReplayOptions()); err == nil || n != 0 {
+		t.Errorf("Replay() with a cancelled context got %d, %v; want 0 and an error", n, err)
+	}
+
+	if _, err := Replay(context.Background(), &recordingSender{}, replayMessages, &ReplayOptions{Speed: -1}); err == nil {
+		t.Error("Replay() with a negative speed got nil error, want error")
+	}
+
+	options := &ReplayOptions{RewriteControlID: true}
+	if _, err := Replay(context.Background(), &recordingSender{}, [][]byte{[]byte("PID|1")}, options); err == nil {
+		t.Error("Replay() with a message without MSH got nil error, want error")
+	}
+}
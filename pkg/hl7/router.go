@@ -0,0 +1,178 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Metadata contains information about a message that is not part of the message itself.
+type Metadata struct {
+	// PathwayName is the name of the pathway that generated the message.
+	PathwayName string
+}
+
+// MetadataSender is a Sender that can also use information about the message that is not
+// part of the message itself.
+type MetadataSender interface {
+	Sender
+	SendWithMetadata([]byte, Metadata) error
+}
+
+// Route defines which messages are sent to a destination.
+// A message matches the route if it matches all of the criteria that are not empty.
+// Each criterion is a list of values, and the message matches it if it matches any of them.
+type Route struct {
+	// MessageTypes are message types as in MSH-9, eg: "ADT", or "ADT^A01" to also match the trigger event.
+	MessageTypes []string `yaml:"message_types"`
+	// SendingFacilities are values of MSH-4.1.
+	SendingFacilities []string `yaml:"sending_facilities"`
+	// PathwayNames are names of the pathways that generated the message.
+	// Only messages sent with SendWithMetadata can match this criterion.
+	PathwayNames []string `yaml:"pathway_names"`
+	// Destination is the name of the sender the matching messages are sent to.
+	Destination string `yaml:"destination"`
+}
+
+// routingFields are the fields of a message used for routing.
+type routingFields struct {
+	messageType     string
+	triggerEvent    string
+	sendingFacility string
+	pathwayName     string
+}
+
+func (r Route) matches(f routingFields) bool {
+	if len(r.MessageTypes) > 0 && !anyMatches(r.MessageTypes, func(t string) bool {
+		parts := strings.SplitN(t, "^", 2)
+		if !strings.EqualFold(parts[0], f.messageType) {
+			return false
+		}
+		return len(parts) == 1 || strings.EqualFold(parts[1], f.triggerEvent)
+	}) {
+		return false
+	}
+	if len(r.SendingFacilities) > 0 && !anyMatches(r.SendingFacilities, func(s string) bool { return s == f.sendingFacility }) {
+		return false
+	}
+	if len(r.PathwayNames) > 0 && !anyMatches(r.PathwayNames, func(p string) bool { return p == f.pathwayName }) {
+		return false
+	}
+	return true
+}
+
+func anyMatches(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoutingSender is a Sender that sends each message to one of several named senders,
+// depending on the first route that the message matches.
+type RoutingSender struct {
+	senders            map[string]Sender
+	routes             []Route
+	defaultDestination string
+}
+
+// NewRoutingSender returns a RoutingSender that sends messages to the given senders according
+// to the routes. Routes are evaluated in order and the first one that matches is used.
+// Messages that don't match any route are sent to defaultDestination. If defaultDestination is
+// empty, sending a message that doesn't match any route results in an error.
+func NewRoutingSender(senders map[string]Sender, routes []Route, defaultDestination string) (*RoutingSender, error) {
+	if len(senders) == 0 {
+		return nil, errors.New("a routing sender needs at least one destination")
+	}
+	for i, r := range routes {
+		if _, ok := senders[r.Destination]; !ok {
+			return nil, errors.Errorf("route %d has an unknown destination %q; known destinations: [%s]", i, r.Destination, strings.Join(destinationNames(senders), ", "))
+		}
+	}
+	if _, ok := senders[defaultDestination]; defaultDestination != "" && !ok {
+		return nil, errors.Errorf("unknown default destination %q; known destinations: [%s]", defaultDestination, strings.Join(destinationNames(senders), ", "))
+	}
+	return &RoutingSender{
+		senders:            senders,
+		routes:             routes,
+		defaultDestination: defaultDestination,
+	}, nil
+}
+
+// Send sends the message to the destination of the first route it matches.
+// Routes that depend on the pathway name never match.
+func (s *RoutingSender) Send(message []byte) error {
+	return s.SendWithMetadata(message, Metadata{})
+}
+
+// SendWithMetadata sends the message to the destination of the first route it matches.
+func (s *RoutingSender) SendWithMetadata(message []byte, metadata Metadata) error {
+	f := routingFields{pathwayName: metadata.PathwayName}
+	if m, err := ParseMessage(message); err != nil {
+		log.WithError(err).Warning("Cannot parse message to route it; only routes that match any message type and sending facility apply")
+	} else {
+		if mt := m.msh.MessageType; mt != nil {
+			f.messageType = mt.MessageCode.String()
+			f.triggerEvent = mt.TriggerEvent.String()
+		}
+		if sf := m.msh.SendingFacility; sf != nil {
+			f.sendingFacility = sf.NamespaceID.String()
+		}
+	}
+
+	destination := s.defaultDestination
+	for _, r := range s.routes {
+		if r.matches(f) {
+			destination = r.Destination
+			break
+		}
+	}
+	if destination == "" {
+		return errors.Errorf("no route for message with type %s^%s, sending facility %q and pathway %q", f.messageType, f.triggerEvent, f.sendingFacility, f.pathwayName)
+	}
+	sender := s.senders[destination]
+	if ms, ok := sender.(MetadataSender); ok {
+		return errors.Wrapf(ms.SendWithMetadata(message, metadata), "cannot send message to destination %q", destination)
+	}
+	return errors.Wrapf(sender.Send(message), "cannot send message to destination %q", destination)
+}
+
+// Close closes all the destination senders.
+func (s *RoutingSender) Close() error {
+	var errs []string
+	for _, name := range destinationNames(s.senders) {
+		if err := s.senders[name].Close(); err != nil {
+			errs = append(errs, errors.Wrapf(err, "closing destination %q", name).Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func destinationNames(senders map[string]Sender) []string {
+	var names []string
+	for n := range senders {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"testing"
+)
+
+const (
+	routedADT = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ADT^A01|1|T|2.3"
+	routedORU = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ORU^R01|2|T|2.3"
+	routedMDM = "MSH|^~\\&|SIMHOSP|OTHER|RAPP|RFAC|20200101000000||MDM^T02|3|T|2.3"
+	routedA03 = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ADT^A03|4|T|2.3"
+)
+
+func TestRoutingSender(t *testing.T) {
+	routes := []Route{
+		{MessageTypes: []string{"ADT^A03"}, PathwayNames: []string{"discharge"}, Destination: "discharges"},
+		{MessageTypes: []string{"adt"}, Destination: "adt"},
+		{MessageTypes: []string{"ORU", "ORM"}, Destination: "results"},
+		{SendingFacilities: []string{"OTHER"}, Destination: "other"},
+	}
+
+	tests := []struct {
+		name     string
+		message  string
+		metadata Metadata
+		want     string
+	}{
+		{name: "message type", message: routedADT, want: "adt"},
+		{name: "one of several message types", message: routedORU, want: "results"},
+		{name: "sending facility", message: routedMDM, want: "other"},
+		{name: "message type and pathway", message: routedA03, metadata: Metadata{PathwayName: "discharge"}, want: "discharges"},
+		{name: "message type and different pathway", message: routedA03, metadata: Metadata{PathwayName: "admission"}, want: "adt"},
+		{name: "no route matches", message: "not_a_message", want: "default"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			senders := map[string]Sender{}
+			for _, d := range []string{"discharges", "adt", "results", "other", "default"} {
+				senders[d] = &recordingSender{}
+			}
+			s, err := NewRoutingSender(senders, routes, "default")
+			if err != nil {
+				t.Fatalf("NewRoutingSender() failed with %v", err)
+			}
+			if err := s.SendWithMetadata([]byte(tc.message), tc.metadata); err != nil {
+				t.Fatalf("SendWithMetadata(%q, %+v) failed with %v", tc.message, tc.metadata, err)
+			}
+			for name, sender := range senders {
+				got := len(sender.(*recordingSender).messages)
+				want := 0
+				if name == tc.want {
+					want = 1
+				}
+				if got != want {
+					t.Errorf("destination %q got %d messages, want %d", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRoutingSender_NoDefault(t *testing.T) {
+	senders := map[string]Sender{"adt": &recordingSender{}}
+	s, err := NewRoutingSender(senders, []Route{{MessageTypes: []string{"ADT"}, Destination: "adt"}}, "")
+	if err != nil {
+		t.Fatalf("NewRoutingSender() failed with %v", err)
+	}
+	if err := s.Send([]byte(routedADT)); err != nil {
+		t.Errorf("Send(%q) failed with %v", routedADT, err)
+	}
+	if err := s.Send([]byte(routedORU)); err == nil {
+		t.Errorf("Send(%q) with no matching route and no default got nil err, want non-nil err", routedORU)
+	}
+}
+
+func TestNewRoutingSender_Error(t *testing.T) {
+	senders := map[string]Sender{"adt": &recordingSender{}}
+	tests := []struct {
+		name               string
+		senders            map[string]Sender
+		routes             []Route
+		defaultDestination string
+	}{
+		{name: "no senders", senders: map[string]Sender{}},
+		{name: "unknown route destination", senders: senders, routes: []Route{{Destination: "results"}}},
+		{name: "unknown default destination", senders: senders, defaultDestination: "results"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewRoutingSender(tc.senders, tc.routes, tc.defaultDestination); err == nil {
+				t.Error("NewRoutingSender() got nil err, want non-nil err")
+			}
+		})
+	}
+}
@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.1.0.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v210
 
 import "reflect"
 
@@ -1791,7 +1791,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -1801,7 +1801,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -1811,7 +1811,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -1821,7 +1821,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -1831,7 +1831,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -1841,7 +1841,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -1851,7 +1851,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -1861,7 +1861,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -1871,7 +1871,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -1881,7 +1881,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -1891,7 +1891,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -1901,7 +1901,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -1911,7 +1911,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -1921,7 +1921,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -1931,7 +1931,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -1941,7 +1941,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -1951,7 +1951,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -1961,7 +1961,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -1971,7 +1971,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -1981,7 +1981,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -1991,7 +1991,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -2001,7 +2001,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -2011,7 +2011,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -2021,7 +2021,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -2031,7 +2031,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -2041,7 +2041,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -2051,7 +2051,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -2061,7 +2061,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -2071,7 +2071,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -2081,7 +2081,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -2091,7 +2091,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -2101,7 +2101,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -2111,7 +2111,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -2121,7 +2121,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -2131,7 +2131,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -2141,7 +2141,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -2151,7 +2151,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -2162,259 +2162,259 @@ func (m *Message) URS() (*URS, error) {
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
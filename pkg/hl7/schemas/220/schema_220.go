@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.2.0.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v220
 
 import "reflect"
 
@@ -3865,7 +3865,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -3875,7 +3875,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -3885,7 +3885,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // AL1 returns the first AL1 segment within the message, or nil if there isn't one.
 func (m *Message) AL1() (*AL1, error) {
-	ps, err := m.Parse("AL1")
+	ps, err := m.ParseWithTypes(Types, "AL1")
 	pst, ok := ps.(*AL1)
 	if ok {
 		return pst, err
@@ -3895,7 +3895,7 @@ func (m *Message) AL1() (*AL1, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -3905,7 +3905,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -3915,7 +3915,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -3925,7 +3925,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -3935,7 +3935,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -3945,7 +3945,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -3955,7 +3955,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -3965,7 +3965,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -3975,7 +3975,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -3985,7 +3985,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -3995,7 +3995,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -4005,7 +4005,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -4015,7 +4015,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -4025,7 +4025,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // IN2 returns the first IN2 segment within the message, or nil if there isn't one.
 func (m *Message) IN2() (*IN2, error) {
-	ps, err := m.Parse("IN2")
+	ps, err := m.ParseWithTypes(Types, "IN2")
 	pst, ok := ps.(*IN2)
 	if ok {
 		return pst, err
@@ -4035,7 +4035,7 @@ func (m *Message) IN2() (*IN2, error) {
 
 // IN3 returns the first IN3 segment within the message, or nil if there isn't one.
 func (m *Message) IN3() (*IN3, error) {
-	ps, err := m.Parse("IN3")
+	ps, err := m.ParseWithTypes(Types, "IN3")
 	pst, ok := ps.(*IN3)
 	if ok {
 		return pst, err
@@ -4045,7 +4045,7 @@ func (m *Message) IN3() (*IN3, error) {
 
 // MFA returns the first MFA segment within the message, or nil if there isn't one.
 func (m *Message) MFA() (*MFA, error) {
-	ps, err := m.Parse("MFA")
+	ps, err := m.ParseWithTypes(Types, "MFA")
 	pst, ok := ps.(*MFA)
 	if ok {
 		return pst, err
@@ -4055,7 +4055,7 @@ func (m *Message) MFA() (*MFA, error) {
 
 // MFE returns the first MFE segment within the message, or nil if there isn't one.
 func (m *Message) MFE() (*MFE, error) {
-	ps, err := m.Parse("MFE")
+	ps, err := m.ParseWithTypes(Types, "MFE")
 	pst, ok := ps.(*MFE)
 	if ok {
 		return pst, err
@@ -4065,7 +4065,7 @@ func (m *Message) MFE() (*MFE, error) {
 
 // MFI returns the first MFI segment within the message, or nil if there isn't one.
 func (m *Message) MFI() (*MFI, error) {
-	ps, err := m.Parse("MFI")
+	ps, err := m.ParseWithTypes(Types, "MFI")
 	pst, ok := ps.(*MFI)
 	if ok {
 		return pst, err
@@ -4075,7 +4075,7 @@ func (m *Message) MFI() (*MFI, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -4085,7 +4085,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -4095,7 +4095,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -4105,7 +4105,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -4115,7 +4115,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -4125,7 +4125,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -4135,7 +4135,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -4145,7 +4145,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -4155,7 +4155,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -4165,7 +4165,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -4175,7 +4175,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -4185,7 +4185,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ODS returns the first ODS segment within the message, or nil if there isn't one.
 func (m *Message) ODS() (*ODS, error) {
-	ps, err := m.Parse("ODS")
+	ps, err := m.ParseWithTypes(Types, "ODS")
 	pst, ok := ps.(*ODS)
 	if ok {
 		return pst, err
@@ -4195,7 +4195,7 @@ func (m *Message) ODS() (*ODS, error) {
 
 // ODT returns the first ODT segment within the message, or nil if there isn't one.
 func (m *Message) ODT() (*ODT, error) {
-	ps, err := m.Parse("ODT")
+	ps, err := m.ParseWithTypes(Types, "ODT")
 	pst, ok := ps.(*ODT)
 	if ok {
 		return pst, err
@@ -4205,7 +4205,7 @@ func (m *Message) ODT() (*ODT, error) {
 
 // OM1 returns the first OM1 segment within the message, or nil if there isn't one.
 func (m *Message) OM1() (*OM1, error) {
-	ps, err := m.Parse("OM1")
+	ps, err := m.ParseWithTypes(Types, "OM1")
 	pst, ok := ps.(*OM1)
 	if ok {
 		return pst, err
@@ -4215,7 +4215,7 @@ func (m *Message) OM1() (*OM1, error) {
 
 // OM2 returns the first OM2 segment within the message, or nil if there isn't one.
 func (m *Message) OM2() (*OM2, error) {
-	ps, err := m.Parse("OM2")
+	ps, err := m.ParseWithTypes(Types, "OM2")
 	pst, ok := ps.(*OM2)
 	if ok {
 		return pst, err
@@ -4225,7 +4225,7 @@ func (m *Message) OM2() (*OM2, error) {
 
 // OM3 returns the first OM3 segment within the message, or nil if there isn't one.
 func (m *Message) OM3() (*OM3, error) {
-	ps, err := m.Parse("OM3")
+	ps, err := m.ParseWithTypes(Types, "OM3")
 	pst, ok := ps.(*OM3)
 	if ok {
 		return pst, err
@@ -4235,7 +4235,7 @@ func (m *Message) OM3() (*OM3, error) {
 
 // OM4 returns the first OM4 segment within the message, or nil if there isn't one.
 func (m *Message) OM4() (*OM4, error) {
-	ps, err := m.Parse("OM4")
+	ps, err := m.ParseWithTypes(Types, "OM4")
 	pst, ok := ps.(*OM4)
 	if ok {
 		return pst, err
@@ -4245,7 +4245,7 @@ func (m *Message) OM4() (*OM4, error) {
 
 // OM5 returns the first OM5 segment within the message, or nil if there isn't one.
 func (m *Message) OM5() (*OM5, error) {
-	ps, err := m.Parse("OM5")
+	ps, err := m.ParseWithTypes(Types, "OM5")
 	pst, ok := ps.(*OM5)
 	if ok {
 		return pst, err
@@ -4255,7 +4255,7 @@ func (m *Message) OM5() (*OM5, error) {
 
 // OM6 returns the first OM6 segment within the message, or nil if there isn't one.
 func (m *Message) OM6() (*OM6, error) {
-	ps, err := m.Parse("OM6")
+	ps, err := m.ParseWithTypes(Types, "OM6")
 	pst, ok := ps.(*OM6)
 	if ok {
 		return pst, err
@@ -4265,7 +4265,7 @@ func (m *Message) OM6() (*OM6, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -4275,7 +4275,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -4285,7 +4285,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -4295,7 +4295,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -4305,7 +4305,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PRA returns the first PRA segment within the message, or nil if there isn't one.
 func (m *Message) PRA() (*PRA, error) {
-	ps, err := m.Parse("PRA")
+	ps, err := m.ParseWithTypes(Types, "PRA")
 	pst, ok := ps.(*PRA)
 	if ok {
 		return pst, err
@@ -4315,7 +4315,7 @@ func (m *Message) PRA() (*PRA, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -4325,7 +4325,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // PV2 returns the first PV2 segment within the message, or nil if there isn't one.
 func (m *Message) PV2() (*PV2, error) {
-	ps, err := m.Parse("PV2")
+	ps, err := m.ParseWithTypes(Types, "PV2")
 	pst, ok := ps.(*PV2)
 	if ok {
 		return pst, err
@@ -4335,7 +4335,7 @@ func (m *Message) PV2() (*PV2, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -4345,7 +4345,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -4355,7 +4355,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // RQ1 returns the first RQ1 segment within the message, or nil if there isn't one.
 func (m *Message) RQ1() (*RQ1, error) {
-	ps, err := m.Parse("RQ1")
+	ps, err := m.ParseWithTypes(Types, "RQ1")
 	pst, ok := ps.(*RQ1)
 	if ok {
 		return pst, err
@@ -4365,7 +4365,7 @@ func (m *Message) RQ1() (*RQ1, error) {
 
 // RQD returns the first RQD segment within the message, or nil if there isn't one.
 func (m *Message) RQD() (*RQD, error) {
-	ps, err := m.Parse("RQD")
+	ps, err := m.ParseWithTypes(Types, "RQD")
 	pst, ok := ps.(*RQD)
 	if ok {
 		return pst, err
@@ -4375,7 +4375,7 @@ func (m *Message) RQD() (*RQD, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -4385,7 +4385,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // RXA returns the first RXA segment within the message, or nil if there isn't one.
 func (m *Message) RXA() (*RXA, error) {
-	ps, err := m.Parse("RXA")
+	ps, err := m.ParseWithTypes(Types, "RXA")
 	pst, ok := ps.(*RXA)
 	if ok {
 		return pst, err
@@ -4395,7 +4395,7 @@ func (m *Message) RXA() (*RXA, error) {
 
 // RXC returns the first RXC segment within the message, or nil if there isn't one.
 func (m *Message) RXC() (*RXC, error) {
-	ps, err := m.Parse("RXC")
+	ps, err := m.ParseWithTypes(Types, "RXC")
 	pst, ok := ps.(*RXC)
 	if ok {
 		return pst, err
@@ -4405,7 +4405,7 @@ func (m *Message) RXC() (*RXC, error) {
 
 // RXD returns the first RXD segment within the message, or nil if there isn't one.
 func (m *Message) RXD() (*RXD, error) {
-	ps, err := m.Parse("RXD")
+	ps, err := m.ParseWithTypes(Types, "RXD")
 	pst, ok := ps.(*RXD)
 	if ok {
 		return pst, err
@@ -4415,7 +4415,7 @@ func (m *Message) RXD() (*RXD, error) {
 
 // RXE returns the first RXE segment within the message, or nil if there isn't one.
 func (m *Message) RXE() (*RXE, error) {
-	ps, err := m.Parse("RXE")
+	ps, err := m.ParseWithTypes(Types, "RXE")
 	pst, ok := ps.(*RXE)
 	if ok {
 		return pst, err
@@ -4425,7 +4425,7 @@ func (m *Message) RXE() (*RXE, error) {
 
 // RXG returns the first RXG segment within the message, or nil if there isn't one.
 func (m *Message) RXG() (*RXG, error) {
-	ps, err := m.Parse("RXG")
+	ps, err := m.ParseWithTypes(Types, "RXG")
 	pst, ok := ps.(*RXG)
 	if ok {
 		return pst, err
@@ -4435,7 +4435,7 @@ func (m *Message) RXG() (*RXG, error) {
 
 // RXO returns the first RXO segment within the message, or nil if there isn't one.
 func (m *Message) RXO() (*RXO, error) {
-	ps, err := m.Parse("RXO")
+	ps, err := m.ParseWithTypes(Types, "RXO")
 	pst, ok := ps.(*RXO)
 	if ok {
 		return pst, err
@@ -4445,7 +4445,7 @@ func (m *Message) RXO() (*RXO, error) {
 
 // RXR returns the first RXR segment within the message, or nil if there isn't one.
 func (m *Message) RXR() (*RXR, error) {
-	ps, err := m.Parse("RXR")
+	ps, err := m.ParseWithTypes(Types, "RXR")
 	pst, ok := ps.(*RXR)
 	if ok {
 		return pst, err
@@ -4455,7 +4455,7 @@ func (m *Message) RXR() (*RXR, error) {
 
 // STF returns the first STF segment within the message, or nil if there isn't one.
 func (m *Message) STF() (*STF, error) {
-	ps, err := m.Parse("STF")
+	ps, err := m.ParseWithTypes(Types, "STF")
 	pst, ok := ps.(*STF)
 	if ok {
 		return pst, err
@@ -4465,7 +4465,7 @@ func (m *Message) STF() (*STF, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -4475,7 +4475,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // UB2 returns the first UB2 segment within the message, or nil if there isn't one.
 func (m *Message) UB2() (*UB2, error) {
-	ps, err := m.Parse("UB2")
+	ps, err := m.ParseWithTypes(Types, "UB2")
 	pst, ok := ps.(*UB2)
 	if ok {
 		return pst, err
@@ -4485,7 +4485,7 @@ func (m *Message) UB2() (*UB2, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -4495,7 +4495,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -4506,448 +4506,448 @@ func (m *Message) URS() (*URS, error) {
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllAL1 returns a slice containing all AL1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAL1() ([]*AL1, error) {
-	pss, err := m.ParseAll("AL1")
+	pss, err := m.ParseAllWithTypes(Types, "AL1")
 	return pss.([]*AL1), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllIN2 returns a slice containing all IN2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN2() ([]*IN2, error) {
-	pss, err := m.ParseAll("IN2")
+	pss, err := m.ParseAllWithTypes(Types, "IN2")
 	return pss.([]*IN2), err
 }
 
 // AllIN3 returns a slice containing all IN3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN3() ([]*IN3, error) {
-	pss, err := m.ParseAll("IN3")
+	pss, err := m.ParseAllWithTypes(Types, "IN3")
 	return pss.([]*IN3), err
 }
 
 // AllMFA returns a slice containing all MFA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFA() ([]*MFA, error) {
-	pss, err := m.ParseAll("MFA")
+	pss, err := m.ParseAllWithTypes(Types, "MFA")
 	return pss.([]*MFA), err
 }
 
 // AllMFE returns a slice containing all MFE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFE() ([]*MFE, error) {
-	pss, err := m.ParseAll("MFE")
+	pss, err := m.ParseAllWithTypes(Types, "MFE")
 	return pss.([]*MFE), err
 }
 
 // AllMFI returns a slice containing all MFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFI() ([]*MFI, error) {
-	pss, err := m.ParseAll("MFI")
+	pss, err := m.ParseAllWithTypes(Types, "MFI")
 	return pss.([]*MFI), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllODS returns a slice containing all ODS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODS() ([]*ODS, error) {
-	pss, err := m.ParseAll("ODS")
+	pss, err := m.ParseAllWithTypes(Types, "ODS")
 	return pss.([]*ODS), err
 }
 
 // AllODT returns a slice containing all ODT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODT() ([]*ODT, error) {
-	pss, err := m.ParseAll("ODT")
+	pss, err := m.ParseAllWithTypes(Types, "ODT")
 	return pss.([]*ODT), err
 }
 
 // AllOM1 returns a slice containing all OM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM1() ([]*OM1, error) {
-	pss, err := m.ParseAll("OM1")
+	pss, err := m.ParseAllWithTypes(Types, "OM1")
 	return pss.([]*OM1), err
 }
 
 // AllOM2 returns a slice containing all OM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM2() ([]*OM2, error) {
-	pss, err := m.ParseAll("OM2")
+	pss, err := m.ParseAllWithTypes(Types, "OM2")
 	return pss.([]*OM2), err
 }
 
 // AllOM3 returns a slice containing all OM3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM3() ([]*OM3, error) {
-	pss, err := m.ParseAll("OM3")
+	pss, err := m.ParseAllWithTypes(Types, "OM3")
 	return pss.([]*OM3), err
 }
 
 // AllOM4 returns a slice containing all OM4 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM4() ([]*OM4, error) {
-	pss, err := m.ParseAll("OM4")
+	pss, err := m.ParseAllWithTypes(Types, "OM4")
 	return pss.([]*OM4), err
 }
 
 // AllOM5 returns a slice containing all OM5 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM5() ([]*OM5, error) {
-	pss, err := m.ParseAll("OM5")
+	pss, err := m.ParseAllWithTypes(Types, "OM5")
 	return pss.([]*OM5), err
 }
 
 // AllOM6 returns a slice containing all OM6 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM6() ([]*OM6, error) {
-	pss, err := m.ParseAll("OM6")
+	pss, err := m.ParseAllWithTypes(Types, "OM6")
 	return pss.([]*OM6), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPRA returns a slice containing all PRA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRA() ([]*PRA, error) {
-	pss, err := m.ParseAll("PRA")
+	pss, err := m.ParseAllWithTypes(Types, "PRA")
 	return pss.([]*PRA), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllPV2 returns a slice containing all PV2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV2() ([]*PV2, error) {
-	pss, err := m.ParseAll("PV2")
+	pss, err := m.ParseAllWithTypes(Types, "PV2")
 	return pss.([]*PV2), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllRQ1 returns a slice containing all RQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQ1() ([]*RQ1, error) {
-	pss, err := m.ParseAll("RQ1")
+	pss, err := m.ParseAllWithTypes(Types, "RQ1")
 	return pss.([]*RQ1), err
 }
 
 // AllRQD returns a slice containing all RQD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQD() ([]*RQD, error) {
-	pss, err := m.ParseAll("RQD")
+	pss, err := m.ParseAllWithTypes(Types, "RQD")
 	return pss.([]*RQD), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllRXA returns a slice containing all RXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXA() ([]*RXA, error) {
-	pss, err := m.ParseAll("RXA")
+	pss, err := m.ParseAllWithTypes(Types, "RXA")
 	return pss.([]*RXA), err
 }
 
 // AllRXC returns a slice containing all RXC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXC() ([]*RXC, error) {
-	pss, err := m.ParseAll("RXC")
+	pss, err := m.ParseAllWithTypes(Types, "RXC")
 	return pss.([]*RXC), err
 }
 
 // AllRXD returns a slice containing all RXD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXD() ([]*RXD, error) {
-	pss, err := m.ParseAll("RXD")
+	pss, err := m.ParseAllWithTypes(Types, "RXD")
 	return pss.([]*RXD), err
 }
 
 // AllRXE returns a slice containing all RXE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXE() ([]*RXE, error) {
-	pss, err := m.ParseAll("RXE")
+	pss, err := m.ParseAllWithTypes(Types, "RXE")
 	return pss.([]*RXE), err
 }
 
 // AllRXG returns a slice containing all RXG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXG() ([]*RXG, error) {
-	pss, err := m.ParseAll("RXG")
+	pss, err := m.ParseAllWithTypes(Types, "RXG")
 	return pss.([]*RXG), err
 }
 
 // AllRXO returns a slice containing all RXO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXO() ([]*RXO, error) {
-	pss, err := m.ParseAll("RXO")
+	pss, err := m.ParseAllWithTypes(Types, "RXO")
 	return pss.([]*RXO), err
 }
 
 // AllRXR returns a slice containing all RXR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXR() ([]*RXR, error) {
-	pss, err := m.ParseAll("RXR")
+	pss, err := m.ParseAllWithTypes(Types, "RXR")
 	return pss.([]*RXR), err
 }
 
 // AllSTF returns a slice containing all STF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTF() ([]*STF, error) {
-	pss, err := m.ParseAll("STF")
+	pss, err := m.ParseAllWithTypes(Types, "STF")
 	return pss.([]*STF), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllUB2 returns a slice containing all UB2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB2() ([]*UB2, error) {
-	pss, err := m.ParseAll("UB2")
+	pss, err := m.ParseAllWithTypes(Types, "UB2")
 	return pss.([]*UB2), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
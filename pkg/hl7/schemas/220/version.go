@@ -0,0 +1,112 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v220 contains the types that represent the HL7 messages, segments and values of
+// version 2.2 of the HL7v2 specification.
+//
+// Importing this package doesn't register its schema: call Register so that the messages with
+// version 2.2 in MSH-12 are parsed with these types. The typed accessors of Message, eg PID(),
+// return the types in this package.
+package v220
+
+import (
+	"sync"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/pkg/errors"
+)
+
+// Version is the version of the HL7 specification of the types in this package.
+const Version = "2.2"
+
+// The primitive data types and the helper types are defined in package hl7, as they are shared by
+// all the versions of the HL7 specification.
+type (
+	Any        = hl7.Any
+	CM         = hl7.CM
+	DT         = hl7.DT
+	Delimiters = hl7.Delimiters
+	FT         = hl7.FT
+	ID         = hl7.ID
+	IS         = hl7.IS
+	NM         = hl7.NM
+	SI         = hl7.SI
+	ST         = hl7.ST
+	StringSet  = hl7.StringSet
+	TN         = hl7.TN
+	TS         = hl7.TS
+	TX         = hl7.TX
+)
+
+// The HL7 2.2 schema refers to these data types and segments without defining them, so their
+// definitions from hl7.DefaultVersion are used.
+type (
+	CK  = hl7.CK
+	CN  = hl7.CN
+	CQ  = hl7.CQ
+	CTI = hl7.CTI
+	CWE = hl7.CWE
+	EI  = hl7.EI
+	EIP = hl7.EIP
+	MOC = hl7.MOC
+	NDL = hl7.NDL
+	PD1 = hl7.PD1
+	PRL = hl7.PRL
+	SPS = hl7.SPS
+	XAD = hl7.XAD
+	XCN = hl7.XCN
+	XON = hl7.XON
+	XTN = hl7.XTN
+)
+
+// Message is an HL7 message whose typed accessors, eg PID(), parse the segments with the types in
+// this package. The accessors of hl7.Message always return the types of hl7.DefaultVersion, so
+// this is the type to use to access the segments of messages of this version.
+type Message struct {
+	*hl7.Message
+}
+
+// NewMessage returns the given message, which must have been parsed with the schema of this
+// version, with the typed accessors of this package.
+func NewMessage(m *hl7.Message) (*Message, error) {
+	if v := m.Version(); v != Version {
+		return nil, errors.Errorf("message is parsed with the schema of version %s, not %s", v, Version)
+	}
+	return &Message{m}, nil
+}
+
+// ParseMessage parses the given HL7 message with the types in this package, regardless of the
+// version in its MSH-12.
+func ParseMessage(input []byte) (*Message, error) {
+	Register()
+	options := hl7.NewParseMessageOptions()
+	options.Version = Version
+	options.ForceVersion = true
+	m, err := hl7.ParseMessageWithOptions(input, options)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{m}, nil
+}
+
+var registerOnce sync.Once
+
+// Register registers the schema of this version with hl7.RegisterSchema, so that the messages
+// with this version in MSH-12 are parsed with the types in this package. It can be called more
+// than once.
+func Register() {
+	registerOnce.Do(func() {
+		hl7.RegisterSchema(Version, &hl7.Schema{Types: Types, FollowSets: FollowSets})
+	})
+}
@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.3.1.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v231
 
 import "reflect"
 
@@ -12136,7 +12136,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -12146,7 +12146,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -12156,7 +12156,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // AIG returns the first AIG segment within the message, or nil if there isn't one.
 func (m *Message) AIG() (*AIG, error) {
-	ps, err := m.Parse("AIG")
+	ps, err := m.ParseWithTypes(Types, "AIG")
 	pst, ok := ps.(*AIG)
 	if ok {
 		return pst, err
@@ -12166,7 +12166,7 @@ func (m *Message) AIG() (*AIG, error) {
 
 // AIL returns the first AIL segment within the message, or nil if there isn't one.
 func (m *Message) AIL() (*AIL, error) {
-	ps, err := m.Parse("AIL")
+	ps, err := m.ParseWithTypes(Types, "AIL")
 	pst, ok := ps.(*AIL)
 	if ok {
 		return pst, err
@@ -12176,7 +12176,7 @@ func (m *Message) AIL() (*AIL, error) {
 
 // AIP returns the first AIP segment within the message, or nil if there isn't one.
 func (m *Message) AIP() (*AIP, error) {
-	ps, err := m.Parse("AIP")
+	ps, err := m.ParseWithTypes(Types, "AIP")
 	pst, ok := ps.(*AIP)
 	if ok {
 		return pst, err
@@ -12186,7 +12186,7 @@ func (m *Message) AIP() (*AIP, error) {
 
 // AIS returns the first AIS segment within the message, or nil if there isn't one.
 func (m *Message) AIS() (*AIS, error) {
-	ps, err := m.Parse("AIS")
+	ps, err := m.ParseWithTypes(Types, "AIS")
 	pst, ok := ps.(*AIS)
 	if ok {
 		return pst, err
@@ -12196,7 +12196,7 @@ func (m *Message) AIS() (*AIS, error) {
 
 // AL1 returns the first AL1 segment within the message, or nil if there isn't one.
 func (m *Message) AL1() (*AL1, error) {
-	ps, err := m.Parse("AL1")
+	ps, err := m.ParseWithTypes(Types, "AL1")
 	pst, ok := ps.(*AL1)
 	if ok {
 		return pst, err
@@ -12206,7 +12206,7 @@ func (m *Message) AL1() (*AL1, error) {
 
 // APR returns the first APR segment within the message, or nil if there isn't one.
 func (m *Message) APR() (*APR, error) {
-	ps, err := m.Parse("APR")
+	ps, err := m.ParseWithTypes(Types, "APR")
 	pst, ok := ps.(*APR)
 	if ok {
 		return pst, err
@@ -12216,7 +12216,7 @@ func (m *Message) APR() (*APR, error) {
 
 // ARQ returns the first ARQ segment within the message, or nil if there isn't one.
 func (m *Message) ARQ() (*ARQ, error) {
-	ps, err := m.Parse("ARQ")
+	ps, err := m.ParseWithTypes(Types, "ARQ")
 	pst, ok := ps.(*ARQ)
 	if ok {
 		return pst, err
@@ -12226,7 +12226,7 @@ func (m *Message) ARQ() (*ARQ, error) {
 
 // AUT returns the first AUT segment within the message, or nil if there isn't one.
 func (m *Message) AUT() (*AUT, error) {
-	ps, err := m.Parse("AUT")
+	ps, err := m.ParseWithTypes(Types, "AUT")
 	pst, ok := ps.(*AUT)
 	if ok {
 		return pst, err
@@ -12236,7 +12236,7 @@ func (m *Message) AUT() (*AUT, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -12246,7 +12246,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -12256,7 +12256,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -12266,7 +12266,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // CDM returns the first CDM segment within the message, or nil if there isn't one.
 func (m *Message) CDM() (*CDM, error) {
-	ps, err := m.Parse("CDM")
+	ps, err := m.ParseWithTypes(Types, "CDM")
 	pst, ok := ps.(*CDM)
 	if ok {
 		return pst, err
@@ -12276,7 +12276,7 @@ func (m *Message) CDM() (*CDM, error) {
 
 // CM0 returns the first CM0 segment within the message, or nil if there isn't one.
 func (m *Message) CM0() (*CM0, error) {
-	ps, err := m.Parse("CM0")
+	ps, err := m.ParseWithTypes(Types, "CM0")
 	pst, ok := ps.(*CM0)
 	if ok {
 		return pst, err
@@ -12286,7 +12286,7 @@ func (m *Message) CM0() (*CM0, error) {
 
 // CM1 returns the first CM1 segment within the message, or nil if there isn't one.
 func (m *Message) CM1() (*CM1, error) {
-	ps, err := m.Parse("CM1")
+	ps, err := m.ParseWithTypes(Types, "CM1")
 	pst, ok := ps.(*CM1)
 	if ok {
 		return pst, err
@@ -12296,7 +12296,7 @@ func (m *Message) CM1() (*CM1, error) {
 
 // CM2 returns the first CM2 segment within the message, or nil if there isn't one.
 func (m *Message) CM2() (*CM2, error) {
-	ps, err := m.Parse("CM2")
+	ps, err := m.ParseWithTypes(Types, "CM2")
 	pst, ok := ps.(*CM2)
 	if ok {
 		return pst, err
@@ -12306,7 +12306,7 @@ func (m *Message) CM2() (*CM2, error) {
 
 // CSP returns the first CSP segment within the message, or nil if there isn't one.
 func (m *Message) CSP() (*CSP, error) {
-	ps, err := m.Parse("CSP")
+	ps, err := m.ParseWithTypes(Types, "CSP")
 	pst, ok := ps.(*CSP)
 	if ok {
 		return pst, err
@@ -12316,7 +12316,7 @@ func (m *Message) CSP() (*CSP, error) {
 
 // CSR returns the first CSR segment within the message, or nil if there isn't one.
 func (m *Message) CSR() (*CSR, error) {
-	ps, err := m.Parse("CSR")
+	ps, err := m.ParseWithTypes(Types, "CSR")
 	pst, ok := ps.(*CSR)
 	if ok {
 		return pst, err
@@ -12326,7 +12326,7 @@ func (m *Message) CSR() (*CSR, error) {
 
 // CSS returns the first CSS segment within the message, or nil if there isn't one.
 func (m *Message) CSS() (*CSS, error) {
-	ps, err := m.Parse("CSS")
+	ps, err := m.ParseWithTypes(Types, "CSS")
 	pst, ok := ps.(*CSS)
 	if ok {
 		return pst, err
@@ -12336,7 +12336,7 @@ func (m *Message) CSS() (*CSS, error) {
 
 // CTD returns the first CTD segment within the message, or nil if there isn't one.
 func (m *Message) CTD() (*CTD, error) {
-	ps, err := m.Parse("CTD")
+	ps, err := m.ParseWithTypes(Types, "CTD")
 	pst, ok := ps.(*CTD)
 	if ok {
 		return pst, err
@@ -12346,7 +12346,7 @@ func (m *Message) CTD() (*CTD, error) {
 
 // CTI returns the first CTI segment within the message, or nil if there isn't one.
 func (m *Message) CTI() (*CTI, error) {
-	ps, err := m.Parse("CTI")
+	ps, err := m.ParseWithTypes(Types, "CTI")
 	pst, ok := ps.(*CTI)
 	if ok {
 		return pst, err
@@ -12356,7 +12356,7 @@ func (m *Message) CTI() (*CTI, error) {
 
 // DB1 returns the first DB1 segment within the message, or nil if there isn't one.
 func (m *Message) DB1() (*DB1, error) {
-	ps, err := m.Parse("DB1")
+	ps, err := m.ParseWithTypes(Types, "DB1")
 	pst, ok := ps.(*DB1)
 	if ok {
 		return pst, err
@@ -12366,7 +12366,7 @@ func (m *Message) DB1() (*DB1, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -12376,7 +12376,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DRG returns the first DRG segment within the message, or nil if there isn't one.
 func (m *Message) DRG() (*DRG, error) {
-	ps, err := m.Parse("DRG")
+	ps, err := m.ParseWithTypes(Types, "DRG")
 	pst, ok := ps.(*DRG)
 	if ok {
 		return pst, err
@@ -12386,7 +12386,7 @@ func (m *Message) DRG() (*DRG, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -12396,7 +12396,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -12406,7 +12406,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // EQL returns the first EQL segment within the message, or nil if there isn't one.
 func (m *Message) EQL() (*EQL, error) {
-	ps, err := m.Parse("EQL")
+	ps, err := m.ParseWithTypes(Types, "EQL")
 	pst, ok := ps.(*EQL)
 	if ok {
 		return pst, err
@@ -12416,7 +12416,7 @@ func (m *Message) EQL() (*EQL, error) {
 
 // ERQ returns the first ERQ segment within the message, or nil if there isn't one.
 func (m *Message) ERQ() (*ERQ, error) {
-	ps, err := m.Parse("ERQ")
+	ps, err := m.ParseWithTypes(Types, "ERQ")
 	pst, ok := ps.(*ERQ)
 	if ok {
 		return pst, err
@@ -12426,7 +12426,7 @@ func (m *Message) ERQ() (*ERQ, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -12436,7 +12436,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -12446,7 +12446,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FAC returns the first FAC segment within the message, or nil if there isn't one.
 func (m *Message) FAC() (*FAC, error) {
-	ps, err := m.Parse("FAC")
+	ps, err := m.ParseWithTypes(Types, "FAC")
 	pst, ok := ps.(*FAC)
 	if ok {
 		return pst, err
@@ -12456,7 +12456,7 @@ func (m *Message) FAC() (*FAC, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -12466,7 +12466,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -12476,7 +12476,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -12486,7 +12486,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GOL returns the first GOL segment within the message, or nil if there isn't one.
 func (m *Message) GOL() (*GOL, error) {
-	ps, err := m.Parse("GOL")
+	ps, err := m.ParseWithTypes(Types, "GOL")
 	pst, ok := ps.(*GOL)
 	if ok {
 		return pst, err
@@ -12496,7 +12496,7 @@ func (m *Message) GOL() (*GOL, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -12506,7 +12506,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -12516,7 +12516,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // IN2 returns the first IN2 segment within the message, or nil if there isn't one.
 func (m *Message) IN2() (*IN2, error) {
-	ps, err := m.Parse("IN2")
+	ps, err := m.ParseWithTypes(Types, "IN2")
 	pst, ok := ps.(*IN2)
 	if ok {
 		return pst, err
@@ -12526,7 +12526,7 @@ func (m *Message) IN2() (*IN2, error) {
 
 // IN3 returns the first IN3 segment within the message, or nil if there isn't one.
 func (m *Message) IN3() (*IN3, error) {
-	ps, err := m.Parse("IN3")
+	ps, err := m.ParseWithTypes(Types, "IN3")
 	pst, ok := ps.(*IN3)
 	if ok {
 		return pst, err
@@ -12536,7 +12536,7 @@ func (m *Message) IN3() (*IN3, error) {
 
 // LCC returns the first LCC segment within the message, or nil if there isn't one.
 func (m *Message) LCC() (*LCC, error) {
-	ps, err := m.Parse("LCC")
+	ps, err := m.ParseWithTypes(Types, "LCC")
 	pst, ok := ps.(*LCC)
 	if ok {
 		return pst, err
@@ -12546,7 +12546,7 @@ func (m *Message) LCC() (*LCC, error) {
 
 // LCH returns the first LCH segment within the message, or nil if there isn't one.
 func (m *Message) LCH() (*LCH, error) {
-	ps, err := m.Parse("LCH")
+	ps, err := m.ParseWithTypes(Types, "LCH")
 	pst, ok := ps.(*LCH)
 	if ok {
 		return pst, err
@@ -12556,7 +12556,7 @@ func (m *Message) LCH() (*LCH, error) {
 
 // LDP returns the first LDP segment within the message, or nil if there isn't one.
 func (m *Message) LDP() (*LDP, error) {
-	ps, err := m.Parse("LDP")
+	ps, err := m.ParseWithTypes(Types, "LDP")
 	pst, ok := ps.(*LDP)
 	if ok {
 		return pst, err
@@ -12566,7 +12566,7 @@ func (m *Message) LDP() (*LDP, error) {
 
 // LOC returns the first LOC segment within the message, or nil if there isn't one.
 func (m *Message) LOC() (*LOC, error) {
-	ps, err := m.Parse("LOC")
+	ps, err := m.ParseWithTypes(Types, "LOC")
 	pst, ok := ps.(*LOC)
 	if ok {
 		return pst, err
@@ -12576,7 +12576,7 @@ func (m *Message) LOC() (*LOC, error) {
 
 // LRL returns the first LRL segment within the message, or nil if there isn't one.
 func (m *Message) LRL() (*LRL, error) {
-	ps, err := m.Parse("LRL")
+	ps, err := m.ParseWithTypes(Types, "LRL")
 	pst, ok := ps.(*LRL)
 	if ok {
 		return pst, err
@@ -12586,7 +12586,7 @@ func (m *Message) LRL() (*LRL, error) {
 
 // MFA returns the first MFA segment within the message, or nil if there isn't one.
 func (m *Message) MFA() (*MFA, error) {
-	ps, err := m.Parse("MFA")
+	ps, err := m.ParseWithTypes(Types, "MFA")
 	pst, ok := ps.(*MFA)
 	if ok {
 		return pst, err
@@ -12596,7 +12596,7 @@ func (m *Message) MFA() (*MFA, error) {
 
 // MFE returns the first MFE segment within the message, or nil if there isn't one.
 func (m *Message) MFE() (*MFE, error) {
-	ps, err := m.Parse("MFE")
+	ps, err := m.ParseWithTypes(Types, "MFE")
 	pst, ok := ps.(*MFE)
 	if ok {
 		return pst, err
@@ -12606,7 +12606,7 @@ func (m *Message) MFE() (*MFE, error) {
 
 // MFI returns the first MFI segment within the message, or nil if there isn't one.
 func (m *Message) MFI() (*MFI, error) {
-	ps, err := m.Parse("MFI")
+	ps, err := m.ParseWithTypes(Types, "MFI")
 	pst, ok := ps.(*MFI)
 	if ok {
 		return pst, err
@@ -12616,7 +12616,7 @@ func (m *Message) MFI() (*MFI, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -12626,7 +12626,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -12636,7 +12636,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -12646,7 +12646,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -12656,7 +12656,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -12666,7 +12666,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -12676,7 +12676,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -12686,7 +12686,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -12696,7 +12696,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -12706,7 +12706,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -12716,7 +12716,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -12726,7 +12726,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ODS returns the first ODS segment within the message, or nil if there isn't one.
 func (m *Message) ODS() (*ODS, error) {
-	ps, err := m.Parse("ODS")
+	ps, err := m.ParseWithTypes(Types, "ODS")
 	pst, ok := ps.(*ODS)
 	if ok {
 		return pst, err
@@ -12736,7 +12736,7 @@ func (m *Message) ODS() (*ODS, error) {
 
 // ODT returns the first ODT segment within the message, or nil if there isn't one.
 func (m *Message) ODT() (*ODT, error) {
-	ps, err := m.Parse("ODT")
+	ps, err := m.ParseWithTypes(Types, "ODT")
 	pst, ok := ps.(*ODT)
 	if ok {
 		return pst, err
@@ -12746,7 +12746,7 @@ func (m *Message) ODT() (*ODT, error) {
 
 // OM1 returns the first OM1 segment within the message, or nil if there isn't one.
 func (m *Message) OM1() (*OM1, error) {
-	ps, err := m.Parse("OM1")
+	ps, err := m.ParseWithTypes(Types, "OM1")
 	pst, ok := ps.(*OM1)
 	if ok {
 		return pst, err
@@ -12756,7 +12756,7 @@ func (m *Message) OM1() (*OM1, error) {
 
 // OM2 returns the first OM2 segment within the message, or nil if there isn't one.
 func (m *Message) OM2() (*OM2, error) {
-	ps, err := m.Parse("OM2")
+	ps, err := m.ParseWithTypes(Types, "OM2")
 	pst, ok := ps.(*OM2)
 	if ok {
 		return pst, err
@@ -12766,7 +12766,7 @@ func (m *Message) OM2() (*OM2, error) {
 
 // OM3 returns the first OM3 segment within the message, or nil if there isn't one.
 func (m *Message) OM3() (*OM3, error) {
-	ps, err := m.Parse("OM3")
+	ps, err := m.ParseWithTypes(Types, "OM3")
 	pst, ok := ps.(*OM3)
 	if ok {
 		return pst, err
@@ -12776,7 +12776,7 @@ func (m *Message) OM3() (*OM3, error) {
 
 // OM4 returns the first OM4 segment within the message, or nil if there isn't one.
 func (m *Message) OM4() (*OM4, error) {
-	ps, err := m.Parse("OM4")
+	ps, err := m.ParseWithTypes(Types, "OM4")
 	pst, ok := ps.(*OM4)
 	if ok {
 		return pst, err
@@ -12786,7 +12786,7 @@ func (m *Message) OM4() (*OM4, error) {
 
 // OM5 returns the first OM5 segment within the message, or nil if there isn't one.
 func (m *Message) OM5() (*OM5, error) {
-	ps, err := m.Parse("OM5")
+	ps, err := m.ParseWithTypes(Types, "OM5")
 	pst, ok := ps.(*OM5)
 	if ok {
 		return pst, err
@@ -12796,7 +12796,7 @@ func (m *Message) OM5() (*OM5, error) {
 
 // OM6 returns the first OM6 segment within the message, or nil if there isn't one.
 func (m *Message) OM6() (*OM6, error) {
-	ps, err := m.Parse("OM6")
+	ps, err := m.ParseWithTypes(Types, "OM6")
 	pst, ok := ps.(*OM6)
 	if ok {
 		return pst, err
@@ -12806,7 +12806,7 @@ func (m *Message) OM6() (*OM6, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -12816,7 +12816,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -12826,7 +12826,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // PCR returns the first PCR segment within the message, or nil if there isn't one.
 func (m *Message) PCR() (*PCR, error) {
-	ps, err := m.Parse("PCR")
+	ps, err := m.ParseWithTypes(Types, "PCR")
 	pst, ok := ps.(*PCR)
 	if ok {
 		return pst, err
@@ -12836,7 +12836,7 @@ func (m *Message) PCR() (*PCR, error) {
 
 // PD1 returns the first PD1 segment within the message, or nil if there isn't one.
 func (m *Message) PD1() (*PD1, error) {
-	ps, err := m.Parse("PD1")
+	ps, err := m.ParseWithTypes(Types, "PD1")
 	pst, ok := ps.(*PD1)
 	if ok {
 		return pst, err
@@ -12846,7 +12846,7 @@ func (m *Message) PD1() (*PD1, error) {
 
 // PDC returns the first PDC segment within the message, or nil if there isn't one.
 func (m *Message) PDC() (*PDC, error) {
-	ps, err := m.Parse("PDC")
+	ps, err := m.ParseWithTypes(Types, "PDC")
 	pst, ok := ps.(*PDC)
 	if ok {
 		return pst, err
@@ -12856,7 +12856,7 @@ func (m *Message) PDC() (*PDC, error) {
 
 // PEO returns the first PEO segment within the message, or nil if there isn't one.
 func (m *Message) PEO() (*PEO, error) {
-	ps, err := m.Parse("PEO")
+	ps, err := m.ParseWithTypes(Types, "PEO")
 	pst, ok := ps.(*PEO)
 	if ok {
 		return pst, err
@@ -12866,7 +12866,7 @@ func (m *Message) PEO() (*PEO, error) {
 
 // PES returns the first PES segment within the message, or nil if there isn't one.
 func (m *Message) PES() (*PES, error) {
-	ps, err := m.Parse("PES")
+	ps, err := m.ParseWithTypes(Types, "PES")
 	pst, ok := ps.(*PES)
 	if ok {
 		return pst, err
@@ -12876,7 +12876,7 @@ func (m *Message) PES() (*PES, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -12886,7 +12886,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -12896,7 +12896,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PRA returns the first PRA segment within the message, or nil if there isn't one.
 func (m *Message) PRA() (*PRA, error) {
-	ps, err := m.Parse("PRA")
+	ps, err := m.ParseWithTypes(Types, "PRA")
 	pst, ok := ps.(*PRA)
 	if ok {
 		return pst, err
@@ -12906,7 +12906,7 @@ func (m *Message) PRA() (*PRA, error) {
 
 // PRB returns the first PRB segment within the message, or nil if there isn't one.
 func (m *Message) PRB() (*PRB, error) {
-	ps, err := m.Parse("PRB")
+	ps, err := m.ParseWithTypes(Types, "PRB")
 	pst, ok := ps.(*PRB)
 	if ok {
 		return pst, err
@@ -12916,7 +12916,7 @@ func (m *Message) PRB() (*PRB, error) {
 
 // PRC returns the first PRC segment within the message, or nil if there isn't one.
 func (m *Message) PRC() (*PRC, error) {
-	ps, err := m.Parse("PRC")
+	ps, err := m.ParseWithTypes(Types, "PRC")
 	pst, ok := ps.(*PRC)
 	if ok {
 		return pst, err
@@ -12926,7 +12926,7 @@ func (m *Message) PRC() (*PRC, error) {
 
 // PRD returns the first PRD segment within the message, or nil if there isn't one.
 func (m *Message) PRD() (*PRD, error) {
-	ps, err := m.Parse("PRD")
+	ps, err := m.ParseWithTypes(Types, "PRD")
 	pst, ok := ps.(*PRD)
 	if ok {
 		return pst, err
@@ -12936,7 +12936,7 @@ func (m *Message) PRD() (*PRD, error) {
 
 // PSH returns the first PSH segment within the message, or nil if there isn't one.
 func (m *Message) PSH() (*PSH, error) {
-	ps, err := m.Parse("PSH")
+	ps, err := m.ParseWithTypes(Types, "PSH")
 	pst, ok := ps.(*PSH)
 	if ok {
 		return pst, err
@@ -12946,7 +12946,7 @@ func (m *Message) PSH() (*PSH, error) {
 
 // PTH returns the first PTH segment within the message, or nil if there isn't one.
 func (m *Message) PTH() (*PTH, error) {
-	ps, err := m.Parse("PTH")
+	ps, err := m.ParseWithTypes(Types, "PTH")
 	pst, ok := ps.(*PTH)
 	if ok {
 		return pst, err
@@ -12956,7 +12956,7 @@ func (m *Message) PTH() (*PTH, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -12966,7 +12966,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // PV2 returns the first PV2 segment within the message, or nil if there isn't one.
 func (m *Message) PV2() (*PV2, error) {
-	ps, err := m.Parse("PV2")
+	ps, err := m.ParseWithTypes(Types, "PV2")
 	pst, ok := ps.(*PV2)
 	if ok {
 		return pst, err
@@ -12976,7 +12976,7 @@ func (m *Message) PV2() (*PV2, error) {
 
 // QAK returns the first QAK segment within the message, or nil if there isn't one.
 func (m *Message) QAK() (*QAK, error) {
-	ps, err := m.Parse("QAK")
+	ps, err := m.ParseWithTypes(Types, "QAK")
 	pst, ok := ps.(*QAK)
 	if ok {
 		return pst, err
@@ -12986,7 +12986,7 @@ func (m *Message) QAK() (*QAK, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -12996,7 +12996,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -13006,7 +13006,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // RDF returns the first RDF segment within the message, or nil if there isn't one.
 func (m *Message) RDF() (*RDF, error) {
-	ps, err := m.Parse("RDF")
+	ps, err := m.ParseWithTypes(Types, "RDF")
 	pst, ok := ps.(*RDF)
 	if ok {
 		return pst, err
@@ -13016,7 +13016,7 @@ func (m *Message) RDF() (*RDF, error) {
 
 // RDT returns the first RDT segment within the message, or nil if there isn't one.
 func (m *Message) RDT() (*RDT, error) {
-	ps, err := m.Parse("RDT")
+	ps, err := m.ParseWithTypes(Types, "RDT")
 	pst, ok := ps.(*RDT)
 	if ok {
 		return pst, err
@@ -13026,7 +13026,7 @@ func (m *Message) RDT() (*RDT, error) {
 
 // RF1 returns the first RF1 segment within the message, or nil if there isn't one.
 func (m *Message) RF1() (*RF1, error) {
-	ps, err := m.Parse("RF1")
+	ps, err := m.ParseWithTypes(Types, "RF1")
 	pst, ok := ps.(*RF1)
 	if ok {
 		return pst, err
@@ -13036,7 +13036,7 @@ func (m *Message) RF1() (*RF1, error) {
 
 // RGS returns the first RGS segment within the message, or nil if there isn't one.
 func (m *Message) RGS() (*RGS, error) {
-	ps, err := m.Parse("RGS")
+	ps, err := m.ParseWithTypes(Types, "RGS")
 	pst, ok := ps.(*RGS)
 	if ok {
 		return pst, err
@@ -13046,7 +13046,7 @@ func (m *Message) RGS() (*RGS, error) {
 
 // ROL returns the first ROL segment within the message, or nil if there isn't one.
 func (m *Message) ROL() (*ROL, error) {
-	ps, err := m.Parse("ROL")
+	ps, err := m.ParseWithTypes(Types, "ROL")
 	pst, ok := ps.(*ROL)
 	if ok {
 		return pst, err
@@ -13056,7 +13056,7 @@ func (m *Message) ROL() (*ROL, error) {
 
 // RQ1 returns the first RQ1 segment within the message, or nil if there isn't one.
 func (m *Message) RQ1() (*RQ1, error) {
-	ps, err := m.Parse("RQ1")
+	ps, err := m.ParseWithTypes(Types, "RQ1")
 	pst, ok := ps.(*RQ1)
 	if ok {
 		return pst, err
@@ -13066,7 +13066,7 @@ func (m *Message) RQ1() (*RQ1, error) {
 
 // RQD returns the first RQD segment within the message, or nil if there isn't one.
 func (m *Message) RQD() (*RQD, error) {
-	ps, err := m.Parse("RQD")
+	ps, err := m.ParseWithTypes(Types, "RQD")
 	pst, ok := ps.(*RQD)
 	if ok {
 		return pst, err
@@ -13076,7 +13076,7 @@ func (m *Message) RQD() (*RQD, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -13086,7 +13086,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // RXA returns the first RXA segment within the message, or nil if there isn't one.
 func (m *Message) RXA() (*RXA, error) {
-	ps, err := m.Parse("RXA")
+	ps, err := m.ParseWithTypes(Types, "RXA")
 	pst, ok := ps.(*RXA)
 	if ok {
 		return pst, err
@@ -13096,7 +13096,7 @@ func (m *Message) RXA() (*RXA, error) {
 
 // RXC returns the first RXC segment within the message, or nil if there isn't one.
 func (m *Message) RXC() (*RXC, error) {
-	ps, err := m.Parse("RXC")
+	ps, err := m.ParseWithTypes(Types, "RXC")
 	pst, ok := ps.(*RXC)
 	if ok {
 		return pst, err
@@ -13106,7 +13106,7 @@ func (m *Message) RXC() (*RXC, error) {
 
 // RXD returns the first RXD segment within the message, or nil if there isn't one.
 func (m *Message) RXD() (*RXD, error) {
-	ps, err := m.Parse("RXD")
+	ps, err := m.ParseWithTypes(Types, "RXD")
 	pst, ok := ps.(*RXD)
 	if ok {
 		return pst, err
@@ -13116,7 +13116,7 @@ func (m *Message) RXD() (*RXD, error) {
 
 // RXE returns the first RXE segment within the message, or nil if there isn't one.
 func (m *Message) RXE() (*RXE, error) {
-	ps, err := m.Parse("RXE")
+	ps, err := m.ParseWithTypes(Types, "RXE")
 	pst, ok := ps.(*RXE)
 	if ok {
 		return pst, err
@@ -13126,7 +13126,7 @@ func (m *Message) RXE() (*RXE, error) {
 
 // RXG returns the first RXG segment within the message, or nil if there isn't one.
 func (m *Message) RXG() (*RXG, error) {
-	ps, err := m.Parse("RXG")
+	ps, err := m.ParseWithTypes(Types, "RXG")
 	pst, ok := ps.(*RXG)
 	if ok {
 		return pst, err
@@ -13136,7 +13136,7 @@ func (m *Message) RXG() (*RXG, error) {
 
 // RXO returns the first RXO segment within the message, or nil if there isn't one.
 func (m *Message) RXO() (*RXO, error) {
-	ps, err := m.Parse("RXO")
+	ps, err := m.ParseWithTypes(Types, "RXO")
 	pst, ok := ps.(*RXO)
 	if ok {
 		return pst, err
@@ -13146,7 +13146,7 @@ func (m *Message) RXO() (*RXO, error) {
 
 // RXR returns the first RXR segment within the message, or nil if there isn't one.
 func (m *Message) RXR() (*RXR, error) {
-	ps, err := m.Parse("RXR")
+	ps, err := m.ParseWithTypes(Types, "RXR")
 	pst, ok := ps.(*RXR)
 	if ok {
 		return pst, err
@@ -13156,7 +13156,7 @@ func (m *Message) RXR() (*RXR, error) {
 
 // SCH returns the first SCH segment within the message, or nil if there isn't one.
 func (m *Message) SCH() (*SCH, error) {
-	ps, err := m.Parse("SCH")
+	ps, err := m.ParseWithTypes(Types, "SCH")
 	pst, ok := ps.(*SCH)
 	if ok {
 		return pst, err
@@ -13166,7 +13166,7 @@ func (m *Message) SCH() (*SCH, error) {
 
 // SPR returns the first SPR segment within the message, or nil if there isn't one.
 func (m *Message) SPR() (*SPR, error) {
-	ps, err := m.Parse("SPR")
+	ps, err := m.ParseWithTypes(Types, "SPR")
 	pst, ok := ps.(*SPR)
 	if ok {
 		return pst, err
@@ -13176,7 +13176,7 @@ func (m *Message) SPR() (*SPR, error) {
 
 // STF returns the first STF segment within the message, or nil if there isn't one.
 func (m *Message) STF() (*STF, error) {
-	ps, err := m.Parse("STF")
+	ps, err := m.ParseWithTypes(Types, "STF")
 	pst, ok := ps.(*STF)
 	if ok {
 		return pst, err
@@ -13186,7 +13186,7 @@ func (m *Message) STF() (*STF, error) {
 
 // TXA returns the first TXA segment within the message, or nil if there isn't one.
 func (m *Message) TXA() (*TXA, error) {
-	ps, err := m.Parse("TXA")
+	ps, err := m.ParseWithTypes(Types, "TXA")
 	pst, ok := ps.(*TXA)
 	if ok {
 		return pst, err
@@ -13196,7 +13196,7 @@ func (m *Message) TXA() (*TXA, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -13206,7 +13206,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // UB2 returns the first UB2 segment within the message, or nil if there isn't one.
 func (m *Message) UB2() (*UB2, error) {
-	ps, err := m.Parse("UB2")
+	ps, err := m.ParseWithTypes(Types, "UB2")
 	pst, ok := ps.(*UB2)
 	if ok {
 		return pst, err
@@ -13216,7 +13216,7 @@ func (m *Message) UB2() (*UB2, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -13226,7 +13226,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -13236,7 +13236,7 @@ func (m *Message) URS() (*URS, error) {
 
 // VAR returns the first VAR segment within the message, or nil if there isn't one.
 func (m *Message) VAR() (*VAR, error) {
-	ps, err := m.Parse("VAR")
+	ps, err := m.ParseWithTypes(Types, "VAR")
 	pst, ok := ps.(*VAR)
 	if ok {
 		return pst, err
@@ -13246,7 +13246,7 @@ func (m *Message) VAR() (*VAR, error) {
 
 // VTQ returns the first VTQ segment within the message, or nil if there isn't one.
 func (m *Message) VTQ() (*VTQ, error) {
-	ps, err := m.Parse("VTQ")
+	ps, err := m.ParseWithTypes(Types, "VTQ")
 	pst, ok := ps.(*VTQ)
 	if ok {
 		return pst, err
@@ -13257,784 +13257,784 @@ func (m *Message) VTQ() (*VTQ, error) {
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllAIG returns a slice containing all AIG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIG() ([]*AIG, error) {
-	pss, err := m.ParseAll("AIG")
+	pss, err := m.ParseAllWithTypes(Types, "AIG")
 	return pss.([]*AIG), err
 }
 
 // AllAIL returns a slice containing all AIL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIL() ([]*AIL, error) {
-	pss, err := m.ParseAll("AIL")
+	pss, err := m.ParseAllWithTypes(Types, "AIL")
 	return pss.([]*AIL), err
 }
 
 // AllAIP returns a slice containing all AIP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIP() ([]*AIP, error) {
-	pss, err := m.ParseAll("AIP")
+	pss, err := m.ParseAllWithTypes(Types, "AIP")
 	return pss.([]*AIP), err
 }
 
 // AllAIS returns a slice containing all AIS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIS() ([]*AIS, error) {
-	pss, err := m.ParseAll("AIS")
+	pss, err := m.ParseAllWithTypes(Types, "AIS")
 	return pss.([]*AIS), err
 }
 
 // AllAL1 returns a slice containing all AL1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAL1() ([]*AL1, error) {
-	pss, err := m.ParseAll("AL1")
+	pss, err := m.ParseAllWithTypes(Types, "AL1")
 	return pss.([]*AL1), err
 }
 
 // AllAPR returns a slice containing all APR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAPR() ([]*APR, error) {
-	pss, err := m.ParseAll("APR")
+	pss, err := m.ParseAllWithTypes(Types, "APR")
 	return pss.([]*APR), err
 }
 
 // AllARQ returns a slice containing all ARQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARQ() ([]*ARQ, error) {
-	pss, err := m.ParseAll("ARQ")
+	pss, err := m.ParseAllWithTypes(Types, "ARQ")
 	return pss.([]*ARQ), err
 }
 
 // AllAUT returns a slice containing all AUT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAUT() ([]*AUT, error) {
-	pss, err := m.ParseAll("AUT")
+	pss, err := m.ParseAllWithTypes(Types, "AUT")
 	return pss.([]*AUT), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllCDM returns a slice containing all CDM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCDM() ([]*CDM, error) {
-	pss, err := m.ParseAll("CDM")
+	pss, err := m.ParseAllWithTypes(Types, "CDM")
 	return pss.([]*CDM), err
 }
 
 // AllCM0 returns a slice containing all CM0 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM0() ([]*CM0, error) {
-	pss, err := m.ParseAll("CM0")
+	pss, err := m.ParseAllWithTypes(Types, "CM0")
 	return pss.([]*CM0), err
 }
 
 // AllCM1 returns a slice containing all CM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM1() ([]*CM1, error) {
-	pss, err := m.ParseAll("CM1")
+	pss, err := m.ParseAllWithTypes(Types, "CM1")
 	return pss.([]*CM1), err
 }
 
 // AllCM2 returns a slice containing all CM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM2() ([]*CM2, error) {
-	pss, err := m.ParseAll("CM2")
+	pss, err := m.ParseAllWithTypes(Types, "CM2")
 	return pss.([]*CM2), err
 }
 
 // AllCSP returns a slice containing all CSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSP() ([]*CSP, error) {
-	pss, err := m.ParseAll("CSP")
+	pss, err := m.ParseAllWithTypes(Types, "CSP")
 	return pss.([]*CSP), err
 }
 
 // AllCSR returns a slice containing all CSR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSR() ([]*CSR, error) {
-	pss, err := m.ParseAll("CSR")
+	pss, err := m.ParseAllWithTypes(Types, "CSR")
 	return pss.([]*CSR), err
 }
 
 // AllCSS returns a slice containing all CSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSS() ([]*CSS, error) {
-	pss, err := m.ParseAll("CSS")
+	pss, err := m.ParseAllWithTypes(Types, "CSS")
 	return pss.([]*CSS), err
 }
 
 // AllCTD returns a slice containing all CTD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTD() ([]*CTD, error) {
-	pss, err := m.ParseAll("CTD")
+	pss, err := m.ParseAllWithTypes(Types, "CTD")
 	return pss.([]*CTD), err
 }
 
 // AllCTI returns a slice containing all CTI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTI() ([]*CTI, error) {
-	pss, err := m.ParseAll("CTI")
+	pss, err := m.ParseAllWithTypes(Types, "CTI")
 	return pss.([]*CTI), err
 }
 
 // AllDB1 returns a slice containing all DB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDB1() ([]*DB1, error) {
-	pss, err := m.ParseAll("DB1")
+	pss, err := m.ParseAllWithTypes(Types, "DB1")
 	return pss.([]*DB1), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDRG returns a slice containing all DRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDRG() ([]*DRG, error) {
-	pss, err := m.ParseAll("DRG")
+	pss, err := m.ParseAllWithTypes(Types, "DRG")
 	return pss.([]*DRG), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllEQL returns a slice containing all EQL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQL() ([]*EQL, error) {
-	pss, err := m.ParseAll("EQL")
+	pss, err := m.ParseAllWithTypes(Types, "EQL")
 	return pss.([]*EQL), err
 }
 
 // AllERQ returns a slice containing all ERQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERQ() ([]*ERQ, error) {
-	pss, err := m.ParseAll("ERQ")
+	pss, err := m.ParseAllWithTypes(Types, "ERQ")
 	return pss.([]*ERQ), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFAC returns a slice containing all FAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFAC() ([]*FAC, error) {
-	pss, err := m.ParseAll("FAC")
+	pss, err := m.ParseAllWithTypes(Types, "FAC")
 	return pss.([]*FAC), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGOL returns a slice containing all GOL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGOL() ([]*GOL, error) {
-	pss, err := m.ParseAll("GOL")
+	pss, err := m.ParseAllWithTypes(Types, "GOL")
 	return pss.([]*GOL), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllIN2 returns a slice containing all IN2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN2() ([]*IN2, error) {
-	pss, err := m.ParseAll("IN2")
+	pss, err := m.ParseAllWithTypes(Types, "IN2")
 	return pss.([]*IN2), err
 }
 
 // AllIN3 returns a slice containing all IN3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN3() ([]*IN3, error) {
-	pss, err := m.ParseAll("IN3")
+	pss, err := m.ParseAllWithTypes(Types, "IN3")
 	return pss.([]*IN3), err
 }
 
 // AllLCC returns a slice containing all LCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCC() ([]*LCC, error) {
-	pss, err := m.ParseAll("LCC")
+	pss, err := m.ParseAllWithTypes(Types, "LCC")
 	return pss.([]*LCC), err
 }
 
 // AllLCH returns a slice containing all LCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCH() ([]*LCH, error) {
-	pss, err := m.ParseAll("LCH")
+	pss, err := m.ParseAllWithTypes(Types, "LCH")
 	return pss.([]*LCH), err
 }
 
 // AllLDP returns a slice containing all LDP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLDP() ([]*LDP, error) {
-	pss, err := m.ParseAll("LDP")
+	pss, err := m.ParseAllWithTypes(Types, "LDP")
 	return pss.([]*LDP), err
 }
 
 // AllLOC returns a slice containing all LOC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLOC() ([]*LOC, error) {
-	pss, err := m.ParseAll("LOC")
+	pss, err := m.ParseAllWithTypes(Types, "LOC")
 	return pss.([]*LOC), err
 }
 
 // AllLRL returns a slice containing all LRL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLRL() ([]*LRL, error) {
-	pss, err := m.ParseAll("LRL")
+	pss, err := m.ParseAllWithTypes(Types, "LRL")
 	return pss.([]*LRL), err
 }
 
 // AllMFA returns a slice containing all MFA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFA() ([]*MFA, error) {
-	pss, err := m.ParseAll("MFA")
+	pss, err := m.ParseAllWithTypes(Types, "MFA")
 	return pss.([]*MFA), err
 }
 
 // AllMFE returns a slice containing all MFE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFE() ([]*MFE, error) {
-	pss, err := m.ParseAll("MFE")
+	pss, err := m.ParseAllWithTypes(Types, "MFE")
 	return pss.([]*MFE), err
 }
 
 // AllMFI returns a slice containing all MFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFI() ([]*MFI, error) {
-	pss, err := m.ParseAll("MFI")
+	pss, err := m.ParseAllWithTypes(Types, "MFI")
 	return pss.([]*MFI), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllODS returns a slice containing all ODS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODS() ([]*ODS, error) {
-	pss, err := m.ParseAll("ODS")
+	pss, err := m.ParseAllWithTypes(Types, "ODS")
 	return pss.([]*ODS), err
 }
 
 // AllODT returns a slice containing all ODT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODT() ([]*ODT, error) {
-	pss, err := m.ParseAll("ODT")
+	pss, err := m.ParseAllWithTypes(Types, "ODT")
 	return pss.([]*ODT), err
 }
 
 // AllOM1 returns a slice containing all OM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM1() ([]*OM1, error) {
-	pss, err := m.ParseAll("OM1")
+	pss, err := m.ParseAllWithTypes(Types, "OM1")
 	return pss.([]*OM1), err
 }
 
 // AllOM2 returns a slice containing all OM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM2() ([]*OM2, error) {
-	pss, err := m.ParseAll("OM2")
+	pss, err := m.ParseAllWithTypes(Types, "OM2")
 	return pss.([]*OM2), err
 }
 
 // AllOM3 returns a slice containing all OM3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM3() ([]*OM3, error) {
-	pss, err := m.ParseAll("OM3")
+	pss, err := m.ParseAllWithTypes(Types, "OM3")
 	return pss.([]*OM3), err
 }
 
 // AllOM4 returns a slice containing all OM4 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM4() ([]*OM4, error) {
-	pss, err := m.ParseAll("OM4")
+	pss, err := m.ParseAllWithTypes(Types, "OM4")
 	return pss.([]*OM4), err
 }
 
 // AllOM5 returns a slice containing all OM5 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM5() ([]*OM5, error) {
-	pss, err := m.ParseAll("OM5")
+	pss, err := m.ParseAllWithTypes(Types, "OM5")
 	return pss.([]*OM5), err
 }
 
 // AllOM6 returns a slice containing all OM6 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM6() ([]*OM6, error) {
-	pss, err := m.ParseAll("OM6")
+	pss, err := m.ParseAllWithTypes(Types, "OM6")
 	return pss.([]*OM6), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllPCR returns a slice containing all PCR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCR() ([]*PCR, error) {
-	pss, err := m.ParseAll("PCR")
+	pss, err := m.ParseAllWithTypes(Types, "PCR")
 	return pss.([]*PCR), err
 }
 
 // AllPD1 returns a slice containing all PD1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPD1() ([]*PD1, error) {
-	pss, err := m.ParseAll("PD1")
+	pss, err := m.ParseAllWithTypes(Types, "PD1")
 	return pss.([]*PD1), err
 }
 
 // AllPDC returns a slice containing all PDC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDC() ([]*PDC, error) {
-	pss, err := m.ParseAll("PDC")
+	pss, err := m.ParseAllWithTypes(Types, "PDC")
 	return pss.([]*PDC), err
 }
 
 // AllPEO returns a slice containing all PEO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPEO() ([]*PEO, error) {
-	pss, err := m.ParseAll("PEO")
+	pss, err := m.ParseAllWithTypes(Types, "PEO")
 	return pss.([]*PEO), err
 }
 
 // AllPES returns a slice containing all PES segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPES() ([]*PES, error) {
-	pss, err := m.ParseAll("PES")
+	pss, err := m.ParseAllWithTypes(Types, "PES")
 	return pss.([]*PES), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPRA returns a slice containing all PRA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRA() ([]*PRA, error) {
-	pss, err := m.ParseAll("PRA")
+	pss, err := m.ParseAllWithTypes(Types, "PRA")
 	return pss.([]*PRA), err
 }
 
 // AllPRB returns a slice containing all PRB segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRB() ([]*PRB, error) {
-	pss, err := m.ParseAll("PRB")
+	pss, err := m.ParseAllWithTypes(Types, "PRB")
 	return pss.([]*PRB), err
 }
 
 // AllPRC returns a slice containing all PRC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRC() ([]*PRC, error) {
-	pss, err := m.ParseAll("PRC")
+	pss, err := m.ParseAllWithTypes(Types, "PRC")
 	return pss.([]*PRC), err
 }
 
 // AllPRD returns a slice containing all PRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRD() ([]*PRD, error) {
-	pss, err := m.ParseAll("PRD")
+	pss, err := m.ParseAllWithTypes(Types, "PRD")
 	return pss.([]*PRD), err
 }
 
 // AllPSH returns a slice containing all PSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSH() ([]*PSH, error) {
-	pss, err := m.ParseAll("PSH")
+	pss, err := m.ParseAllWithTypes(Types, "PSH")
 	return pss.([]*PSH), err
 }
 
 // AllPTH returns a slice containing all PTH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPTH() ([]*PTH, error) {
-	pss, err := m.ParseAll("PTH")
+	pss, err := m.ParseAllWithTypes(Types, "PTH")
 	return pss.([]*PTH), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllPV2 returns a slice containing all PV2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV2() ([]*PV2, error) {
-	pss, err := m.ParseAll("PV2")
+	pss, err := m.ParseAllWithTypes(Types, "PV2")
 	return pss.([]*PV2), err
 }
 
 // AllQAK returns a slice containing all QAK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQAK() ([]*QAK, error) {
-	pss, err := m.ParseAll("QAK")
+	pss, err := m.ParseAllWithTypes(Types, "QAK")
 	return pss.([]*QAK), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllRDF returns a slice containing all RDF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDF() ([]*RDF, error) {
-	pss, err := m.ParseAll("RDF")
+	pss, err := m.ParseAllWithTypes(Types, "RDF")
 	return pss.([]*RDF), err
 }
 
 // AllRDT returns a slice containing all RDT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDT() ([]*RDT, error) {
-	pss, err := m.ParseAll("RDT")
+	pss, err := m.ParseAllWithTypes(Types, "RDT")
 	return pss.([]*RDT), err
 }
 
 // AllRF1 returns a slice containing all RF1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRF1() ([]*RF1, error) {
-	pss, err := m.ParseAll("RF1")
+	pss, err := m.ParseAllWithTypes(Types, "RF1")
 	return pss.([]*RF1), err
 }
 
 // AllRGS returns a slice containing all RGS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRGS() ([]*RGS, error) {
-	pss, err := m.ParseAll("RGS")
+	pss, err := m.ParseAllWithTypes(Types, "RGS")
 	return pss.([]*RGS), err
 }
 
 // AllROL returns a slice containing all ROL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllROL() ([]*ROL, error) {
-	pss, err := m.ParseAll("ROL")
+	pss, err := m.ParseAllWithTypes(Types, "ROL")
 	return pss.([]*ROL), err
 }
 
 // AllRQ1 returns a slice containing all RQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQ1() ([]*RQ1, error) {
-	pss, err := m.ParseAll("RQ1")
+	pss, err := m.ParseAllWithTypes(Types, "RQ1")
 	return pss.([]*RQ1), err
 }
 
 // AllRQD returns a slice containing all RQD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQD() ([]*RQD, error) {
-	pss, err := m.ParseAll("RQD")
+	pss, err := m.ParseAllWithTypes(Types, "RQD")
 	return pss.([]*RQD), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllRXA returns a slice containing all RXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXA() ([]*RXA, error) {
-	pss, err := m.ParseAll("RXA")
+	pss, err := m.ParseAllWithTypes(Types, "RXA")
 	return pss.([]*RXA), err
 }
 
 // AllRXC returns a slice containing all RXC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXC() ([]*RXC, error) {
-	pss, err := m.ParseAll("RXC")
+	pss, err := m.ParseAllWithTypes(Types, "RXC")
 	return pss.([]*RXC), err
 }
 
 // AllRXD returns a slice containing all RXD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXD() ([]*RXD, error) {
-	pss, err := m.ParseAll("RXD")
+	pss, err := m.ParseAllWithTypes(Types, "RXD")
 	return pss.([]*RXD), err
 }
 
 // AllRXE returns a slice containing all RXE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXE() ([]*RXE, error) {
-	pss, err := m.ParseAll("RXE")
+	pss, err := m.ParseAllWithTypes(Types, "RXE")
 	return pss.([]*RXE), err
 }
 
 // AllRXG returns a slice containing all RXG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXG() ([]*RXG, error) {
-	pss, err := m.ParseAll("RXG")
+	pss, err := m.ParseAllWithTypes(Types, "RXG")
 	return pss.([]*RXG), err
 }
 
 // AllRXO returns a slice containing all RXO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXO() ([]*RXO, error) {
-	pss, err := m.ParseAll("RXO")
+	pss, err := m.ParseAllWithTypes(Types, "RXO")
 	return pss.([]*RXO), err
 }
 
 // AllRXR returns a slice containing all RXR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXR() ([]*RXR, error) {
-	pss, err := m.ParseAll("RXR")
+	pss, err := m.ParseAllWithTypes(Types, "RXR")
 	return pss.([]*RXR), err
 }
 
 // AllSCH returns a slice containing all SCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCH() ([]*SCH, error) {
-	pss, err := m.ParseAll("SCH")
+	pss, err := m.ParseAllWithTypes(Types, "SCH")
 	return pss.([]*SCH), err
 }
 
 // AllSPR returns a slice containing all SPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPR() ([]*SPR, error) {
-	pss, err := m.ParseAll("SPR")
+	pss, err := m.ParseAllWithTypes(Types, "SPR")
 	return pss.([]*SPR), err
 }
 
 // AllSTF returns a slice containing all STF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTF() ([]*STF, error) {
-	pss, err := m.ParseAll("STF")
+	pss, err := m.ParseAllWithTypes(Types, "STF")
 	return pss.([]*STF), err
 }
 
 // AllTXA returns a slice containing all TXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTXA() ([]*TXA, error) {
-	pss, err := m.ParseAll("TXA")
+	pss, err := m.ParseAllWithTypes(Types, "TXA")
 	return pss.([]*TXA), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllUB2 returns a slice containing all UB2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB2() ([]*UB2, error) {
-	pss, err := m.ParseAll("UB2")
+	pss, err := m.ParseAllWithTypes(Types, "UB2")
 	return pss.([]*UB2), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
 // AllVAR returns a slice containing all VAR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVAR() ([]*VAR, error) {
-	pss, err := m.ParseAll("VAR")
+	pss, err := m.ParseAllWithTypes(Types, "VAR")
 	return pss.([]*VAR), err
 }
 
 // AllVTQ returns a slice containing all VTQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVTQ() ([]*VTQ, error) {
-	pss, err := m.ParseAll("VTQ")
+	pss, err := m.ParseAllWithTypes(Types, "VTQ")
 	return pss.([]*VTQ), err
 }
 
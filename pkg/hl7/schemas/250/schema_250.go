@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.5.0.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v250
 
 import "reflect"
 
@@ -20390,7 +20390,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ABS returns the first ABS segment within the message, or nil if there isn't one.
 func (m *Message) ABS() (*ABS, error) {
-	ps, err := m.Parse("ABS")
+	ps, err := m.ParseWithTypes(Types, "ABS")
 	pst, ok := ps.(*ABS)
 	if ok {
 		return pst, err
@@ -20400,7 +20400,7 @@ func (m *Message) ABS() (*ABS, error) {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -20410,7 +20410,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -20420,7 +20420,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // AFF returns the first AFF segment within the message, or nil if there isn't one.
 func (m *Message) AFF() (*AFF, error) {
-	ps, err := m.Parse("AFF")
+	ps, err := m.ParseWithTypes(Types, "AFF")
 	pst, ok := ps.(*AFF)
 	if ok {
 		return pst, err
@@ -20430,7 +20430,7 @@ func (m *Message) AFF() (*AFF, error) {
 
 // AIG returns the first AIG segment within the message, or nil if there isn't one.
 func (m *Message) AIG() (*AIG, error) {
-	ps, err := m.Parse("AIG")
+	ps, err := m.ParseWithTypes(Types, "AIG")
 	pst, ok := ps.(*AIG)
 	if ok {
 		return pst, err
@@ -20440,7 +20440,7 @@ func (m *Message) AIG() (*AIG, error) {
 
 // AIL returns the first AIL segment within the message, or nil if there isn't one.
 func (m *Message) AIL() (*AIL, error) {
-	ps, err := m.Parse("AIL")
+	ps, err := m.ParseWithTypes(Types, "AIL")
 	pst, ok := ps.(*AIL)
 	if ok {
 		return pst, err
@@ -20450,7 +20450,7 @@ func (m *Message) AIL() (*AIL, error) {
 
 // AIP returns the first AIP segment within the message, or nil if there isn't one.
 func (m *Message) AIP() (*AIP, error) {
-	ps, err := m.Parse("AIP")
+	ps, err := m.ParseWithTypes(Types, "AIP")
 	pst, ok := ps.(*AIP)
 	if ok {
 		return pst, err
@@ -20460,7 +20460,7 @@ func (m *Message) AIP() (*AIP, error) {
 
 // AIS returns the first AIS segment within the message, or nil if there isn't one.
 func (m *Message) AIS() (*AIS, error) {
-	ps, err := m.Parse("AIS")
+	ps, err := m.ParseWithTypes(Types, "AIS")
 	pst, ok := ps.(*AIS)
 	if ok {
 		return pst, err
@@ -20470,7 +20470,7 @@ func (m *Message) AIS() (*AIS, error) {
 
 // AL1 returns the first AL1 segment within the message, or nil if there isn't one.
 func (m *Message) AL1() (*AL1, error) {
-	ps, err := m.Parse("AL1")
+	ps, err := m.ParseWithTypes(Types, "AL1")
 	pst, ok := ps.(*AL1)
 	if ok {
 		return pst, err
@@ -20480,7 +20480,7 @@ func (m *Message) AL1() (*AL1, error) {
 
 // APR returns the first APR segment within the message, or nil if there isn't one.
 func (m *Message) APR() (*APR, error) {
-	ps, err := m.Parse("APR")
+	ps, err := m.ParseWithTypes(Types, "APR")
 	pst, ok := ps.(*APR)
 	if ok {
 		return pst, err
@@ -20490,7 +20490,7 @@ func (m *Message) APR() (*APR, error) {
 
 // ARQ returns the first ARQ segment within the message, or nil if there isn't one.
 func (m *Message) ARQ() (*ARQ, error) {
-	ps, err := m.Parse("ARQ")
+	ps, err := m.ParseWithTypes(Types, "ARQ")
 	pst, ok := ps.(*ARQ)
 	if ok {
 		return pst, err
@@ -20500,7 +20500,7 @@ func (m *Message) ARQ() (*ARQ, error) {
 
 // AUT returns the first AUT segment within the message, or nil if there isn't one.
 func (m *Message) AUT() (*AUT, error) {
-	ps, err := m.Parse("AUT")
+	ps, err := m.ParseWithTypes(Types, "AUT")
 	pst, ok := ps.(*AUT)
 	if ok {
 		return pst, err
@@ -20510,7 +20510,7 @@ func (m *Message) AUT() (*AUT, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -20520,7 +20520,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLC returns the first BLC segment within the message, or nil if there isn't one.
 func (m *Message) BLC() (*BLC, error) {
-	ps, err := m.Parse("BLC")
+	ps, err := m.ParseWithTypes(Types, "BLC")
 	pst, ok := ps.(*BLC)
 	if ok {
 		return pst, err
@@ -20530,7 +20530,7 @@ func (m *Message) BLC() (*BLC, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -20540,7 +20540,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BPO returns the first BPO segment within the message, or nil if there isn't one.
 func (m *Message) BPO() (*BPO, error) {
-	ps, err := m.Parse("BPO")
+	ps, err := m.ParseWithTypes(Types, "BPO")
 	pst, ok := ps.(*BPO)
 	if ok {
 		return pst, err
@@ -20550,7 +20550,7 @@ func (m *Message) BPO() (*BPO, error) {
 
 // BPX returns the first BPX segment within the message, or nil if there isn't one.
 func (m *Message) BPX() (*BPX, error) {
-	ps, err := m.Parse("BPX")
+	ps, err := m.ParseWithTypes(Types, "BPX")
 	pst, ok := ps.(*BPX)
 	if ok {
 		return pst, err
@@ -20560,7 +20560,7 @@ func (m *Message) BPX() (*BPX, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -20570,7 +20570,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // BTX returns the first BTX segment within the message, or nil if there isn't one.
 func (m *Message) BTX() (*BTX, error) {
-	ps, err := m.Parse("BTX")
+	ps, err := m.ParseWithTypes(Types, "BTX")
 	pst, ok := ps.(*BTX)
 	if ok {
 		return pst, err
@@ -20580,7 +20580,7 @@ func (m *Message) BTX() (*BTX, error) {
 
 // CDM returns the first CDM segment within the message, or nil if there isn't one.
 func (m *Message) CDM() (*CDM, error) {
-	ps, err := m.Parse("CDM")
+	ps, err := m.ParseWithTypes(Types, "CDM")
 	pst, ok := ps.(*CDM)
 	if ok {
 		return pst, err
@@ -20590,7 +20590,7 @@ func (m *Message) CDM() (*CDM, error) {
 
 // CER returns the first CER segment within the message, or nil if there isn't one.
 func (m *Message) CER() (*CER, error) {
-	ps, err := m.Parse("CER")
+	ps, err := m.ParseWithTypes(Types, "CER")
 	pst, ok := ps.(*CER)
 	if ok {
 		return pst, err
@@ -20600,7 +20600,7 @@ func (m *Message) CER() (*CER, error) {
 
 // CM0 returns the first CM0 segment within the message, or nil if there isn't one.
 func (m *Message) CM0() (*CM0, error) {
-	ps, err := m.Parse("CM0")
+	ps, err := m.ParseWithTypes(Types, "CM0")
 	pst, ok := ps.(*CM0)
 	if ok {
 		return pst, err
@@ -20610,7 +20610,7 @@ func (m *Message) CM0() (*CM0, error) {
 
 // CM1 returns the first CM1 segment within the message, or nil if there isn't one.
 func (m *Message) CM1() (*CM1, error) {
-	ps, err := m.Parse("CM1")
+	ps, err := m.ParseWithTypes(Types, "CM1")
 	pst, ok := ps.(*CM1)
 	if ok {
 		return pst, err
@@ -20620,7 +20620,7 @@ func (m *Message) CM1() (*CM1, error) {
 
 // CM2 returns the first CM2 segment within the message, or nil if there isn't one.
 func (m *Message) CM2() (*CM2, error) {
-	ps, err := m.Parse("CM2")
+	ps, err := m.ParseWithTypes(Types, "CM2")
 	pst, ok := ps.(*CM2)
 	if ok {
 		return pst, err
@@ -20630,7 +20630,7 @@ func (m *Message) CM2() (*CM2, error) {
 
 // CNS returns the first CNS segment within the message, or nil if there isn't one.
 func (m *Message) CNS() (*CNS, error) {
-	ps, err := m.Parse("CNS")
+	ps, err := m.ParseWithTypes(Types, "CNS")
 	pst, ok := ps.(*CNS)
 	if ok {
 		return pst, err
@@ -20640,7 +20640,7 @@ func (m *Message) CNS() (*CNS, error) {
 
 // CON returns the first CON segment within the message, or nil if there isn't one.
 func (m *Message) CON() (*CON, error) {
-	ps, err := m.Parse("CON")
+	ps, err := m.ParseWithTypes(Types, "CON")
 	pst, ok := ps.(*CON)
 	if ok {
 		return pst, err
@@ -20650,7 +20650,7 @@ func (m *Message) CON() (*CON, error) {
 
 // CSP returns the first CSP segment within the message, or nil if there isn't one.
 func (m *Message) CSP() (*CSP, error) {
-	ps, err := m.Parse("CSP")
+	ps, err := m.ParseWithTypes(Types, "CSP")
 	pst, ok := ps.(*CSP)
 	if ok {
 		return pst, err
@@ -20660,7 +20660,7 @@ func (m *Message) CSP() (*CSP, error) {
 
 // CSR returns the first CSR segment within the message, or nil if there isn't one.
 func (m *Message) CSR() (*CSR, error) {
-	ps, err := m.Parse("CSR")
+	ps, err := m.ParseWithTypes(Types, "CSR")
 	pst, ok := ps.(*CSR)
 	if ok {
 		return pst, err
@@ -20670,7 +20670,7 @@ func (m *Message) CSR() (*CSR, error) {
 
 // CSS returns the first CSS segment within the message, or nil if there isn't one.
 func (m *Message) CSS() (*CSS, error) {
-	ps, err := m.Parse("CSS")
+	ps, err := m.ParseWithTypes(Types, "CSS")
 	pst, ok := ps.(*CSS)
 	if ok {
 		return pst, err
@@ -20680,7 +20680,7 @@ func (m *Message) CSS() (*CSS, error) {
 
 // CTD returns the first CTD segment within the message, or nil if there isn't one.
 func (m *Message) CTD() (*CTD, error) {
-	ps, err := m.Parse("CTD")
+	ps, err := m.ParseWithTypes(Types, "CTD")
 	pst, ok := ps.(*CTD)
 	if ok {
 		return pst, err
@@ -20690,7 +20690,7 @@ func (m *Message) CTD() (*CTD, error) {
 
 // CTI returns the first CTI segment within the message, or nil if there isn't one.
 func (m *Message) CTI() (*CTI, error) {
-	ps, err := m.Parse("CTI")
+	ps, err := m.ParseWithTypes(Types, "CTI")
 	pst, ok := ps.(*CTI)
 	if ok {
 		return pst, err
@@ -20700,7 +20700,7 @@ func (m *Message) CTI() (*CTI, error) {
 
 // DB1 returns the first DB1 segment within the message, or nil if there isn't one.
 func (m *Message) DB1() (*DB1, error) {
-	ps, err := m.Parse("DB1")
+	ps, err := m.ParseWithTypes(Types, "DB1")
 	pst, ok := ps.(*DB1)
 	if ok {
 		return pst, err
@@ -20710,7 +20710,7 @@ func (m *Message) DB1() (*DB1, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -20720,7 +20720,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DRG returns the first DRG segment within the message, or nil if there isn't one.
 func (m *Message) DRG() (*DRG, error) {
-	ps, err := m.Parse("DRG")
+	ps, err := m.ParseWithTypes(Types, "DRG")
 	pst, ok := ps.(*DRG)
 	if ok {
 		return pst, err
@@ -20730,7 +20730,7 @@ func (m *Message) DRG() (*DRG, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -20740,7 +20740,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -20750,7 +20750,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // ECD returns the first ECD segment within the message, or nil if there isn't one.
 func (m *Message) ECD() (*ECD, error) {
-	ps, err := m.Parse("ECD")
+	ps, err := m.ParseWithTypes(Types, "ECD")
 	pst, ok := ps.(*ECD)
 	if ok {
 		return pst, err
@@ -20760,7 +20760,7 @@ func (m *Message) ECD() (*ECD, error) {
 
 // ECR returns the first ECR segment within the message, or nil if there isn't one.
 func (m *Message) ECR() (*ECR, error) {
-	ps, err := m.Parse("ECR")
+	ps, err := m.ParseWithTypes(Types, "ECR")
 	pst, ok := ps.(*ECR)
 	if ok {
 		return pst, err
@@ -20770,7 +20770,7 @@ func (m *Message) ECR() (*ECR, error) {
 
 // EDU returns the first EDU segment within the message, or nil if there isn't one.
 func (m *Message) EDU() (*EDU, error) {
-	ps, err := m.Parse("EDU")
+	ps, err := m.ParseWithTypes(Types, "EDU")
 	pst, ok := ps.(*EDU)
 	if ok {
 		return pst, err
@@ -20780,7 +20780,7 @@ func (m *Message) EDU() (*EDU, error) {
 
 // EQL returns the first EQL segment within the message, or nil if there isn't one.
 func (m *Message) EQL() (*EQL, error) {
-	ps, err := m.Parse("EQL")
+	ps, err := m.ParseWithTypes(Types, "EQL")
 	pst, ok := ps.(*EQL)
 	if ok {
 		return pst, err
@@ -20790,7 +20790,7 @@ func (m *Message) EQL() (*EQL, error) {
 
 // EQP returns the first EQP segment within the message, or nil if there isn't one.
 func (m *Message) EQP() (*EQP, error) {
-	ps, err := m.Parse("EQP")
+	ps, err := m.ParseWithTypes(Types, "EQP")
 	pst, ok := ps.(*EQP)
 	if ok {
 		return pst, err
@@ -20800,7 +20800,7 @@ func (m *Message) EQP() (*EQP, error) {
 
 // EQU returns the first EQU segment within the message, or nil if there isn't one.
 func (m *Message) EQU() (*EQU, error) {
-	ps, err := m.Parse("EQU")
+	ps, err := m.ParseWithTypes(Types, "EQU")
 	pst, ok := ps.(*EQU)
 	if ok {
 		return pst, err
@@ -20810,7 +20810,7 @@ func (m *Message) EQU() (*EQU, error) {
 
 // ERQ returns the first ERQ segment within the message, or nil if there isn't one.
 func (m *Message) ERQ() (*ERQ, error) {
-	ps, err := m.Parse("ERQ")
+	ps, err := m.ParseWithTypes(Types, "ERQ")
 	pst, ok := ps.(*ERQ)
 	if ok {
 		return pst, err
@@ -20820,7 +20820,7 @@ func (m *Message) ERQ() (*ERQ, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -20830,7 +20830,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -20840,7 +20840,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FAC returns the first FAC segment within the message, or nil if there isn't one.
 func (m *Message) FAC() (*FAC, error) {
-	ps, err := m.Parse("FAC")
+	ps, err := m.ParseWithTypes(Types, "FAC")
 	pst, ok := ps.(*FAC)
 	if ok {
 		return pst, err
@@ -20850,7 +20850,7 @@ func (m *Message) FAC() (*FAC, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -20860,7 +20860,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -20870,7 +20870,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -20880,7 +20880,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GOL returns the first GOL segment within the message, or nil if there isn't one.
 func (m *Message) GOL() (*GOL, error) {
-	ps, err := m.Parse("GOL")
+	ps, err := m.ParseWithTypes(Types, "GOL")
 	pst, ok := ps.(*GOL)
 	if ok {
 		return pst, err
@@ -20890,7 +20890,7 @@ func (m *Message) GOL() (*GOL, error) {
 
 // GP1 returns the first GP1 segment within the message, or nil if there isn't one.
 func (m *Message) GP1() (*GP1, error) {
-	ps, err := m.Parse("GP1")
+	ps, err := m.ParseWithTypes(Types, "GP1")
 	pst, ok := ps.(*GP1)
 	if ok {
 		return pst, err
@@ -20900,7 +20900,7 @@ func (m *Message) GP1() (*GP1, error) {
 
 // GP2 returns the first GP2 segment within the message, or nil if there isn't one.
 func (m *Message) GP2() (*GP2, error) {
-	ps, err := m.Parse("GP2")
+	ps, err := m.ParseWithTypes(Types, "GP2")
 	pst, ok := ps.(*GP2)
 	if ok {
 		return pst, err
@@ -20910,7 +20910,7 @@ func (m *Message) GP2() (*GP2, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -20920,7 +20920,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IAM returns the first IAM segment within the message, or nil if there isn't one.
 func (m *Message) IAM() (*IAM, error) {
-	ps, err := m.Parse("IAM")
+	ps, err := m.ParseWithTypes(Types, "IAM")
 	pst, ok := ps.(*IAM)
 	if ok {
 		return pst, err
@@ -20930,7 +20930,7 @@ func (m *Message) IAM() (*IAM, error) {
 
 // IIM returns the first IIM segment within the message, or nil if there isn't one.
 func (m *Message) IIM() (*IIM, error) {
-	ps, err := m.Parse("IIM")
+	ps, err := m.ParseWithTypes(Types, "IIM")
 	pst, ok := ps.(*IIM)
 	if ok {
 		return pst, err
@@ -20940,7 +20940,7 @@ func (m *Message) IIM() (*IIM, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -20950,7 +20950,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // IN2 returns the first IN2 segment within the message, or nil if there isn't one.
 func (m *Message) IN2() (*IN2, error) {
-	ps, err := m.Parse("IN2")
+	ps, err := m.ParseWithTypes(Types, "IN2")
 	pst, ok := ps.(*IN2)
 	if ok {
 		return pst, err
@@ -20960,7 +20960,7 @@ func (m *Message) IN2() (*IN2, error) {
 
 // IN3 returns the first IN3 segment within the message, or nil if there isn't one.
 func (m *Message) IN3() (*IN3, error) {
-	ps, err := m.Parse("IN3")
+	ps, err := m.ParseWithTypes(Types, "IN3")
 	pst, ok := ps.(*IN3)
 	if ok {
 		return pst, err
@@ -20970,7 +20970,7 @@ func (m *Message) IN3() (*IN3, error) {
 
 // INV returns the first INV segment within the message, or nil if there isn't one.
 func (m *Message) INV() (*INV, error) {
-	ps, err := m.Parse("INV")
+	ps, err := m.ParseWithTypes(Types, "INV")
 	pst, ok := ps.(*INV)
 	if ok {
 		return pst, err
@@ -20980,7 +20980,7 @@ func (m *Message) INV() (*INV, error) {
 
 // IPC returns the first IPC segment within the message, or nil if there isn't one.
 func (m *Message) IPC() (*IPC, error) {
-	ps, err := m.Parse("IPC")
+	ps, err := m.ParseWithTypes(Types, "IPC")
 	pst, ok := ps.(*IPC)
 	if ok {
 		return pst, err
@@ -20990,7 +20990,7 @@ func (m *Message) IPC() (*IPC, error) {
 
 // ISD returns the first ISD segment within the message, or nil if there isn't one.
 func (m *Message) ISD() (*ISD, error) {
-	ps, err := m.Parse("ISD")
+	ps, err := m.ParseWithTypes(Types, "ISD")
 	pst, ok := ps.(*ISD)
 	if ok {
 		return pst, err
@@ -21000,7 +21000,7 @@ func (m *Message) ISD() (*ISD, error) {
 
 // LAN returns the first LAN segment within the message, or nil if there isn't one.
 func (m *Message) LAN() (*LAN, error) {
-	ps, err := m.Parse("LAN")
+	ps, err := m.ParseWithTypes(Types, "LAN")
 	pst, ok := ps.(*LAN)
 	if ok {
 		return pst, err
@@ -21010,7 +21010,7 @@ func (m *Message) LAN() (*LAN, error) {
 
 // LCC returns the first LCC segment within the message, or nil if there isn't one.
 func (m *Message) LCC() (*LCC, error) {
-	ps, err := m.Parse("LCC")
+	ps, err := m.ParseWithTypes(Types, "LCC")
 	pst, ok := ps.(*LCC)
 	if ok {
 		return pst, err
@@ -21020,7 +21020,7 @@ func (m *Message) LCC() (*LCC, error) {
 
 // LCH returns the first LCH segment within the message, or nil if there isn't one.
 func (m *Message) LCH() (*LCH, error) {
-	ps, err := m.Parse("LCH")
+	ps, err := m.ParseWithTypes(Types, "LCH")
 	pst, ok := ps.(*LCH)
 	if ok {
 		return pst, err
@@ -21030,7 +21030,7 @@ func (m *Message) LCH() (*LCH, error) {
 
 // LDP returns the first LDP segment within the message, or nil if there isn't one.
 func (m *Message) LDP() (*LDP, error) {
-	ps, err := m.Parse("LDP")
+	ps, err := m.ParseWithTypes(Types, "LDP")
 	pst, ok := ps.(*LDP)
 	if ok {
 		return pst, err
@@ -21040,7 +21040,7 @@ func (m *Message) LDP() (*LDP, error) {
 
 // LOC returns the first LOC segment within the message, or nil if there isn't one.
 func (m *Message) LOC() (*LOC, error) {
-	ps, err := m.Parse("LOC")
+	ps, err := m.ParseWithTypes(Types, "LOC")
 	pst, ok := ps.(*LOC)
 	if ok {
 		return pst, err
@@ -21050,7 +21050,7 @@ func (m *Message) LOC() (*LOC, error) {
 
 // LRL returns the first LRL segment within the message, or nil if there isn't one.
 func (m *Message) LRL() (*LRL, error) {
-	ps, err := m.Parse("LRL")
+	ps, err := m.ParseWithTypes(Types, "LRL")
 	pst, ok := ps.(*LRL)
 	if ok {
 		return pst, err
@@ -21060,7 +21060,7 @@ func (m *Message) LRL() (*LRL, error) {
 
 // MFA returns the first MFA segment within the message, or nil if there isn't one.
 func (m *Message) MFA() (*MFA, error) {
-	ps, err := m.Parse("MFA")
+	ps, err := m.ParseWithTypes(Types, "MFA")
 	pst, ok := ps.(*MFA)
 	if ok {
 		return pst, err
@@ -21070,7 +21070,7 @@ func (m *Message) MFA() (*MFA, error) {
 
 // MFE returns the first MFE segment within the message, or nil if there isn't one.
 func (m *Message) MFE() (*MFE, error) {
-	ps, err := m.Parse("MFE")
+	ps, err := m.ParseWithTypes(Types, "MFE")
 	pst, ok := ps.(*MFE)
 	if ok {
 		return pst, err
@@ -21080,7 +21080,7 @@ func (m *Message) MFE() (*MFE, error) {
 
 // MFI returns the first MFI segment within the message, or nil if there isn't one.
 func (m *Message) MFI() (*MFI, error) {
-	ps, err := m.Parse("MFI")
+	ps, err := m.ParseWithTypes(Types, "MFI")
 	pst, ok := ps.(*MFI)
 	if ok {
 		return pst, err
@@ -21090,7 +21090,7 @@ func (m *Message) MFI() (*MFI, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -21100,7 +21100,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -21110,7 +21110,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -21120,7 +21120,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -21130,7 +21130,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NDS returns the first NDS segment within the message, or nil if there isn't one.
 func (m *Message) NDS() (*NDS, error) {
-	ps, err := m.Parse("NDS")
+	ps, err := m.ParseWithTypes(Types, "NDS")
 	pst, ok := ps.(*NDS)
 	if ok {
 		return pst, err
@@ -21140,7 +21140,7 @@ func (m *Message) NDS() (*NDS, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -21150,7 +21150,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -21160,7 +21160,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -21170,7 +21170,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -21180,7 +21180,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -21190,7 +21190,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -21200,7 +21200,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -21210,7 +21210,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ODS returns the first ODS segment within the message, or nil if there isn't one.
 func (m *Message) ODS() (*ODS, error) {
-	ps, err := m.Parse("ODS")
+	ps, err := m.ParseWithTypes(Types, "ODS")
 	pst, ok := ps.(*ODS)
 	if ok {
 		return pst, err
@@ -21220,7 +21220,7 @@ func (m *Message) ODS() (*ODS, error) {
 
 // ODT returns the first ODT segment within the message, or nil if there isn't one.
 func (m *Message) ODT() (*ODT, error) {
-	ps, err := m.Parse("ODT")
+	ps, err := m.ParseWithTypes(Types, "ODT")
 	pst, ok := ps.(*ODT)
 	if ok {
 		return pst, err
@@ -21230,7 +21230,7 @@ func (m *Message) ODT() (*ODT, error) {
 
 // OM1 returns the first OM1 segment within the message, or nil if there isn't one.
 func (m *Message) OM1() (*OM1, error) {
-	ps, err := m.Parse("OM1")
+	ps, err := m.ParseWithTypes(Types, "OM1")
 	pst, ok := ps.(*OM1)
 	if ok {
 		return pst, err
@@ -21240,7 +21240,7 @@ func (m *Message) OM1() (*OM1, error) {
 
 // OM2 returns the first OM2 segment within the message, or nil if there isn't one.
 func (m *Message) OM2() (*OM2, error) {
-	ps, err := m.Parse("OM2")
+	ps, err := m.ParseWithTypes(Types, "OM2")
 	pst, ok := ps.(*OM2)
 	if ok {
 		return pst, err
@@ -21250,7 +21250,7 @@ func (m *Message) OM2() (*OM2, error) {
 
 // OM3 returns the first OM3 segment within the message, or nil if there isn't one.
 func (m *Message) OM3() (*OM3, error) {
-	ps, err := m.Parse("OM3")
+	ps, err := m.ParseWithTypes(Types, "OM3")
 	pst, ok := ps.(*OM3)
 	if ok {
 		return pst, err
@@ -21260,7 +21260,7 @@ func (m *Message) OM3() (*OM3, error) {
 
 // OM4 returns the first OM4 segment within the message, or nil if there isn't one.
 func (m *Message) OM4() (*OM4, error) {
-	ps, err := m.Parse("OM4")
+	ps, err := m.ParseWithTypes(Types, "OM4")
 	pst, ok := ps.(*OM4)
 	if ok {
 		return pst, err
@@ -21270,7 +21270,7 @@ func (m *Message) OM4() (*OM4, error) {
 
 // OM5 returns the first OM5 segment within the message, or nil if there isn't one.
 func (m *Message) OM5() (*OM5, error) {
-	ps, err := m.Parse("OM5")
+	ps, err := m.ParseWithTypes(Types, "OM5")
 	pst, ok := ps.(*OM5)
 	if ok {
 		return pst, err
@@ -21280,7 +21280,7 @@ func (m *Message) OM5() (*OM5, error) {
 
 // OM6 returns the first OM6 segment within the message, or nil if there isn't one.
 func (m *Message) OM6() (*OM6, error) {
-	ps, err := m.Parse("OM6")
+	ps, err := m.ParseWithTypes(Types, "OM6")
 	pst, ok := ps.(*OM6)
 	if ok {
 		return pst, err
@@ -21290,7 +21290,7 @@ func (m *Message) OM6() (*OM6, error) {
 
 // OM7 returns the first OM7 segment within the message, or nil if there isn't one.
 func (m *Message) OM7() (*OM7, error) {
-	ps, err := m.Parse("OM7")
+	ps, err := m.ParseWithTypes(Types, "OM7")
 	pst, ok := ps.(*OM7)
 	if ok {
 		return pst, err
@@ -21300,7 +21300,7 @@ func (m *Message) OM7() (*OM7, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -21310,7 +21310,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORG returns the first ORG segment within the message, or nil if there isn't one.
 func (m *Message) ORG() (*ORG, error) {
-	ps, err := m.Parse("ORG")
+	ps, err := m.ParseWithTypes(Types, "ORG")
 	pst, ok := ps.(*ORG)
 	if ok {
 		return pst, err
@@ -21320,7 +21320,7 @@ func (m *Message) ORG() (*ORG, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -21330,7 +21330,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // OVR returns the first OVR segment within the message, or nil if there isn't one.
 func (m *Message) OVR() (*OVR, error) {
-	ps, err := m.Parse("OVR")
+	ps, err := m.ParseWithTypes(Types, "OVR")
 	pst, ok := ps.(*OVR)
 	if ok {
 		return pst, err
@@ -21340,7 +21340,7 @@ func (m *Message) OVR() (*OVR, error) {
 
 // PCR returns the first PCR segment within the message, or nil if there isn't one.
 func (m *Message) PCR() (*PCR, error) {
-	ps, err := m.Parse("PCR")
+	ps, err := m.ParseWithTypes(Types, "PCR")
 	pst, ok := ps.(*PCR)
 	if ok {
 		return pst, err
@@ -21350,7 +21350,7 @@ func (m *Message) PCR() (*PCR, error) {
 
 // PD1 returns the first PD1 segment within the message, or nil if there isn't one.
 func (m *Message) PD1() (*PD1, error) {
-	ps, err := m.Parse("PD1")
+	ps, err := m.ParseWithTypes(Types, "PD1")
 	pst, ok := ps.(*PD1)
 	if ok {
 		return pst, err
@@ -21360,7 +21360,7 @@ func (m *Message) PD1() (*PD1, error) {
 
 // PDA returns the first PDA segment within the message, or nil if there isn't one.
 func (m *Message) PDA() (*PDA, error) {
-	ps, err := m.Parse("PDA")
+	ps, err := m.ParseWithTypes(Types, "PDA")
 	pst, ok := ps.(*PDA)
 	if ok {
 		return pst, err
@@ -21370,7 +21370,7 @@ func (m *Message) PDA() (*PDA, error) {
 
 // PDC returns the first PDC segment within the message, or nil if there isn't one.
 func (m *Message) PDC() (*PDC, error) {
-	ps, err := m.Parse("PDC")
+	ps, err := m.ParseWithTypes(Types, "PDC")
 	pst, ok := ps.(*PDC)
 	if ok {
 		return pst, err
@@ -21380,7 +21380,7 @@ func (m *Message) PDC() (*PDC, error) {
 
 // PEO returns the first PEO segment within the message, or nil if there isn't one.
 func (m *Message) PEO() (*PEO, error) {
-	ps, err := m.Parse("PEO")
+	ps, err := m.ParseWithTypes(Types, "PEO")
 	pst, ok := ps.(*PEO)
 	if ok {
 		return pst, err
@@ -21390,7 +21390,7 @@ func (m *Message) PEO() (*PEO, error) {
 
 // PES returns the first PES segment within the message, or nil if there isn't one.
 func (m *Message) PES() (*PES, error) {
-	ps, err := m.Parse("PES")
+	ps, err := m.ParseWithTypes(Types, "PES")
 	pst, ok := ps.(*PES)
 	if ok {
 		return pst, err
@@ -21400,7 +21400,7 @@ func (m *Message) PES() (*PES, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -21410,7 +21410,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -21420,7 +21420,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PRA returns the first PRA segment within the message, or nil if there isn't one.
 func (m *Message) PRA() (*PRA, error) {
-	ps, err := m.Parse("PRA")
+	ps, err := m.ParseWithTypes(Types, "PRA")
 	pst, ok := ps.(*PRA)
 	if ok {
 		return pst, err
@@ -21430,7 +21430,7 @@ func (m *Message) PRA() (*PRA, error) {
 
 // PRB returns the first PRB segment within the message, or nil if there isn't one.
 func (m *Message) PRB() (*PRB, error) {
-	ps, err := m.Parse("PRB")
+	ps, err := m.ParseWithTypes(Types, "PRB")
 	pst, ok := ps.(*PRB)
 	if ok {
 		return pst, err
@@ -21440,7 +21440,7 @@ func (m *Message) PRB() (*PRB, error) {
 
 // PRC returns the first PRC segment within the message, or nil if there isn't one.
 func (m *Message) PRC() (*PRC, error) {
-	ps, err := m.Parse("PRC")
+	ps, err := m.ParseWithTypes(Types, "PRC")
 	pst, ok := ps.(*PRC)
 	if ok {
 		return pst, err
@@ -21450,7 +21450,7 @@ func (m *Message) PRC() (*PRC, error) {
 
 // PRD returns the first PRD segment within the message, or nil if there isn't one.
 func (m *Message) PRD() (*PRD, error) {
-	ps, err := m.Parse("PRD")
+	ps, err := m.ParseWithTypes(Types, "PRD")
 	pst, ok := ps.(*PRD)
 	if ok {
 		return pst, err
@@ -21460,7 +21460,7 @@ func (m *Message) PRD() (*PRD, error) {
 
 // PSH returns the first PSH segment within the message, or nil if there isn't one.
 func (m *Message) PSH() (*PSH, error) {
-	ps, err := m.Parse("PSH")
+	ps, err := m.ParseWithTypes(Types, "PSH")
 	pst, ok := ps.(*PSH)
 	if ok {
 		return pst, err
@@ -21470,7 +21470,7 @@ func (m *Message) PSH() (*PSH, error) {
 
 // PTH returns the first PTH segment within the message, or nil if there isn't one.
 func (m *Message) PTH() (*PTH, error) {
-	ps, err := m.Parse("PTH")
+	ps, err := m.ParseWithTypes(Types, "PTH")
 	pst, ok := ps.(*PTH)
 	if ok {
 		return pst, err
@@ -21480,7 +21480,7 @@ func (m *Message) PTH() (*PTH, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -21490,7 +21490,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // PV2 returns the first PV2 segment within the message, or nil if there isn't one.
 func (m *Message) PV2() (*PV2, error) {
-	ps, err := m.Parse("PV2")
+	ps, err := m.ParseWithTypes(Types, "PV2")
 	pst, ok := ps.(*PV2)
 	if ok {
 		return pst, err
@@ -21500,7 +21500,7 @@ func (m *Message) PV2() (*PV2, error) {
 
 // QAK returns the first QAK segment within the message, or nil if there isn't one.
 func (m *Message) QAK() (*QAK, error) {
-	ps, err := m.Parse("QAK")
+	ps, err := m.ParseWithTypes(Types, "QAK")
 	pst, ok := ps.(*QAK)
 	if ok {
 		return pst, err
@@ -21510,7 +21510,7 @@ func (m *Message) QAK() (*QAK, error) {
 
 // QID returns the first QID segment within the message, or nil if there isn't one.
 func (m *Message) QID() (*QID, error) {
-	ps, err := m.Parse("QID")
+	ps, err := m.ParseWithTypes(Types, "QID")
 	pst, ok := ps.(*QID)
 	if ok {
 		return pst, err
@@ -21520,7 +21520,7 @@ func (m *Message) QID() (*QID, error) {
 
 // QPD returns the first QPD segment within the message, or nil if there isn't one.
 func (m *Message) QPD() (*QPD, error) {
-	ps, err := m.Parse("QPD")
+	ps, err := m.ParseWithTypes(Types, "QPD")
 	pst, ok := ps.(*QPD)
 	if ok {
 		return pst, err
@@ -21530,7 +21530,7 @@ func (m *Message) QPD() (*QPD, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -21540,7 +21540,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -21550,7 +21550,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // QRI returns the first QRI segment within the message, or nil if there isn't one.
 func (m *Message) QRI() (*QRI, error) {
-	ps, err := m.Parse("QRI")
+	ps, err := m.ParseWithTypes(Types, "QRI")
 	pst, ok := ps.(*QRI)
 	if ok {
 		return pst, err
@@ -21560,7 +21560,7 @@ func (m *Message) QRI() (*QRI, error) {
 
 // RCP returns the first RCP segment within the message, or nil if there isn't one.
 func (m *Message) RCP() (*RCP, error) {
-	ps, err := m.Parse("RCP")
+	ps, err := m.ParseWithTypes(Types, "RCP")
 	pst, ok := ps.(*RCP)
 	if ok {
 		return pst, err
@@ -21570,7 +21570,7 @@ func (m *Message) RCP() (*RCP, error) {
 
 // RDF returns the first RDF segment within the message, or nil if there isn't one.
 func (m *Message) RDF() (*RDF, error) {
-	ps, err := m.Parse("RDF")
+	ps, err := m.ParseWithTypes(Types, "RDF")
 	pst, ok := ps.(*RDF)
 	if ok {
 		return pst, err
@@ -21580,7 +21580,7 @@ func (m *Message) RDF() (*RDF, error) {
 
 // RDT returns the first RDT segment within the message, or nil if there isn't one.
 func (m *Message) RDT() (*RDT, error) {
-	ps, err := m.Parse("RDT")
+	ps, err := m.ParseWithTypes(Types, "RDT")
 	pst, ok := ps.(*RDT)
 	if ok {
 		return pst, err
@@ -21590,7 +21590,7 @@ func (m *Message) RDT() (*RDT, error) {
 
 // RF1 returns the first RF1 segment within the message, or nil if there isn't one.
 func (m *Message) RF1() (*RF1, error) {
-	ps, err := m.Parse("RF1")
+	ps, err := m.ParseWithTypes(Types, "RF1")
 	pst, ok := ps.(*RF1)
 	if ok {
 		return pst, err
@@ -21600,7 +21600,7 @@ func (m *Message) RF1() (*RF1, error) {
 
 // RGS returns the first RGS segment within the message, or nil if there isn't one.
 func (m *Message) RGS() (*RGS, error) {
-	ps, err := m.Parse("RGS")
+	ps, err := m.ParseWithTypes(Types, "RGS")
 	pst, ok := ps.(*RGS)
 	if ok {
 		return pst, err
@@ -21610,7 +21610,7 @@ func (m *Message) RGS() (*RGS, error) {
 
 // RMI returns the first RMI segment within the message, or nil if there isn't one.
 func (m *Message) RMI() (*RMI, error) {
-	ps, err := m.Parse("RMI")
+	ps, err := m.ParseWithTypes(Types, "RMI")
 	pst, ok := ps.(*RMI)
 	if ok {
 		return pst, err
@@ -21620,7 +21620,7 @@ func (m *Message) RMI() (*RMI, error) {
 
 // ROL returns the first ROL segment within the message, or nil if there isn't one.
 func (m *Message) ROL() (*ROL, error) {
-	ps, err := m.Parse("ROL")
+	ps, err := m.ParseWithTypes(Types, "ROL")
 	pst, ok := ps.(*ROL)
 	if ok {
 		return pst, err
@@ -21630,7 +21630,7 @@ func (m *Message) ROL() (*ROL, error) {
 
 // RQ1 returns the first RQ1 segment within the message, or nil if there isn't one.
 func (m *Message) RQ1() (*RQ1, error) {
-	ps, err := m.Parse("RQ1")
+	ps, err := m.ParseWithTypes(Types, "RQ1")
 	pst, ok := ps.(*RQ1)
 	if ok {
 		return pst, err
@@ -21640,7 +21640,7 @@ func (m *Message) RQ1() (*RQ1, error) {
 
 // RQD returns the first RQD segment within the message, or nil if there isn't one.
 func (m *Message) RQD() (*RQD, error) {
-	ps, err := m.Parse("RQD")
+	ps, err := m.ParseWithTypes(Types, "RQD")
 	pst, ok := ps.(*RQD)
 	if ok {
 		return pst, err
@@ -21650,7 +21650,7 @@ func (m *Message) RQD() (*RQD, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -21660,7 +21660,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // RXA returns the first RXA segment within the message, or nil if there isn't one.
 func (m *Message) RXA() (*RXA, error) {
-	ps, err := m.Parse("RXA")
+	ps, err := m.ParseWithTypes(Types, "RXA")
 	pst, ok := ps.(*RXA)
 	if ok {
 		return pst, err
@@ -21670,7 +21670,7 @@ func (m *Message) RXA() (*RXA, error) {
 
 // RXC returns the first RXC segment within the message, or nil if there isn't one.
 func (m *Message) RXC() (*RXC, error) {
-	ps, err := m.Parse("RXC")
+	ps, err := m.ParseWithTypes(Types, "RXC")
 	pst, ok := ps.(*RXC)
 	if ok {
 		return pst, err
@@ -21680,7 +21680,7 @@ func (m *Message) RXC() (*RXC, error) {
 
 // RXD returns the first RXD segment within the message, or nil if there isn't one.
 func (m *Message) RXD() (*RXD, error) {
-	ps, err := m.Parse("RXD")
+	ps, err := m.ParseWithTypes(Types, "RXD")
 	pst, ok := ps.(*RXD)
 	if ok {
 		return pst, err
@@ -21690,7 +21690,7 @@ func (m *Message) RXD() (*RXD, error) {
 
 // RXE returns the first RXE segment within the message, or nil if there isn't one.
 func (m *Message) RXE() (*RXE, error) {
-	ps, err := m.Parse("RXE")
+	ps, err := m.ParseWithTypes(Types, "RXE")
 	pst, ok := ps.(*RXE)
 	if ok {
 		return pst, err
@@ -21700,7 +21700,7 @@ func (m *Message) RXE() (*RXE, error) {
 
 // RXG returns the first RXG segment within the message, or nil if there isn't one.
 func (m *Message) RXG() (*RXG, error) {
-	ps, err := m.Parse("RXG")
+	ps, err := m.ParseWithTypes(Types, "RXG")
 	pst, ok := ps.(*RXG)
 	if ok {
 		return pst, err
@@ -21710,7 +21710,7 @@ func (m *Message) RXG() (*RXG, error) {
 
 // RXO returns the first RXO segment within the message, or nil if there isn't one.
 func (m *Message) RXO() (*RXO, error) {
-	ps, err := m.Parse("RXO")
+	ps, err := m.ParseWithTypes(Types, "RXO")
 	pst, ok := ps.(*RXO)
 	if ok {
 		return pst, err
@@ -21720,7 +21720,7 @@ func (m *Message) RXO() (*RXO, error) {
 
 // RXR returns the first RXR segment within the message, or nil if there isn't one.
 func (m *Message) RXR() (*RXR, error) {
-	ps, err := m.Parse("RXR")
+	ps, err := m.ParseWithTypes(Types, "RXR")
 	pst, ok := ps.(*RXR)
 	if ok {
 		return pst, err
@@ -21730,7 +21730,7 @@ func (m *Message) RXR() (*RXR, error) {
 
 // SAC returns the first SAC segment within the message, or nil if there isn't one.
 func (m *Message) SAC() (*SAC, error) {
-	ps, err := m.Parse("SAC")
+	ps, err := m.ParseWithTypes(Types, "SAC")
 	pst, ok := ps.(*SAC)
 	if ok {
 		return pst, err
@@ -21740,7 +21740,7 @@ func (m *Message) SAC() (*SAC, error) {
 
 // SCH returns the first SCH segment within the message, or nil if there isn't one.
 func (m *Message) SCH() (*SCH, error) {
-	ps, err := m.Parse("SCH")
+	ps, err := m.ParseWithTypes(Types, "SCH")
 	pst, ok := ps.(*SCH)
 	if ok {
 		return pst, err
@@ -21750,7 +21750,7 @@ func (m *Message) SCH() (*SCH, error) {
 
 // SFT returns the first SFT segment within the message, or nil if there isn't one.
 func (m *Message) SFT() (*SFT, error) {
-	ps, err := m.Parse("SFT")
+	ps, err := m.ParseWithTypes(Types, "SFT")
 	pst, ok := ps.(*SFT)
 	if ok {
 		return pst, err
@@ -21760,7 +21760,7 @@ func (m *Message) SFT() (*SFT, error) {
 
 // SID returns the first SID segment within the message, or nil if there isn't one.
 func (m *Message) SID() (*SID, error) {
-	ps, err := m.Parse("SID")
+	ps, err := m.ParseWithTypes(Types, "SID")
 	pst, ok := ps.(*SID)
 	if ok {
 		return pst, err
@@ -21770,7 +21770,7 @@ func (m *Message) SID() (*SID, error) {
 
 // SPM returns the first SPM segment within the message, or nil if there isn't one.
 func (m *Message) SPM() (*SPM, error) {
-	ps, err := m.Parse("SPM")
+	ps, err := m.ParseWithTypes(Types, "SPM")
 	pst, ok := ps.(*SPM)
 	if ok {
 		return pst, err
@@ -21780,7 +21780,7 @@ func (m *Message) SPM() (*SPM, error) {
 
 // SPR returns the first SPR segment within the message, or nil if there isn't one.
 func (m *Message) SPR() (*SPR, error) {
-	ps, err := m.Parse("SPR")
+	ps, err := m.ParseWithTypes(Types, "SPR")
 	pst, ok := ps.(*SPR)
 	if ok {
 		return pst, err
@@ -21790,7 +21790,7 @@ func (m *Message) SPR() (*SPR, error) {
 
 // STF returns the first STF segment within the message, or nil if there isn't one.
 func (m *Message) STF() (*STF, error) {
-	ps, err := m.Parse("STF")
+	ps, err := m.ParseWithTypes(Types, "STF")
 	pst, ok := ps.(*STF)
 	if ok {
 		return pst, err
@@ -21800,7 +21800,7 @@ func (m *Message) STF() (*STF, error) {
 
 // TCC returns the first TCC segment within the message, or nil if there isn't one.
 func (m *Message) TCC() (*TCC, error) {
-	ps, err := m.Parse("TCC")
+	ps, err := m.ParseWithTypes(Types, "TCC")
 	pst, ok := ps.(*TCC)
 	if ok {
 		return pst, err
@@ -21810,7 +21810,7 @@ func (m *Message) TCC() (*TCC, error) {
 
 // TCD returns the first TCD segment within the message, or nil if there isn't one.
 func (m *Message) TCD() (*TCD, error) {
-	ps, err := m.Parse("TCD")
+	ps, err := m.ParseWithTypes(Types, "TCD")
 	pst, ok := ps.(*TCD)
 	if ok {
 		return pst, err
@@ -21820,7 +21820,7 @@ func (m *Message) TCD() (*TCD, error) {
 
 // TQ1 returns the first TQ1 segment within the message, or nil if there isn't one.
 func (m *Message) TQ1() (*TQ1, error) {
-	ps, err := m.Parse("TQ1")
+	ps, err := m.ParseWithTypes(Types, "TQ1")
 	pst, ok := ps.(*TQ1)
 	if ok {
 		return pst, err
@@ -21830,7 +21830,7 @@ func (m *Message) TQ1() (*TQ1, error) {
 
 // TQ2 returns the first TQ2 segment within the message, or nil if there isn't one.
 func (m *Message) TQ2() (*TQ2, error) {
-	ps, err := m.Parse("TQ2")
+	ps, err := m.ParseWithTypes(Types, "TQ2")
 	pst, ok := ps.(*TQ2)
 	if ok {
 		return pst, err
@@ -21840,7 +21840,7 @@ func (m *Message) TQ2() (*TQ2, error) {
 
 // TXA returns the first TXA segment within the message, or nil if there isn't one.
 func (m *Message) TXA() (*TXA, error) {
-	ps, err := m.Parse("TXA")
+	ps, err := m.ParseWithTypes(Types, "TXA")
 	pst, ok := ps.(*TXA)
 	if ok {
 		return pst, err
@@ -21850,7 +21850,7 @@ func (m *Message) TXA() (*TXA, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -21860,7 +21860,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // UB2 returns the first UB2 segment within the message, or nil if there isn't one.
 func (m *Message) UB2() (*UB2, error) {
-	ps, err := m.Parse("UB2")
+	ps, err := m.ParseWithTypes(Types, "UB2")
 	pst, ok := ps.(*UB2)
 	if ok {
 		return pst, err
@@ -21870,7 +21870,7 @@ func (m *Message) UB2() (*UB2, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -21880,7 +21880,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -21890,7 +21890,7 @@ func (m *Message) URS() (*URS, error) {
 
 // VAR returns the first VAR segment within the message, or nil if there isn't one.
 func (m *Message) VAR() (*VAR, error) {
-	ps, err := m.Parse("VAR")
+	ps, err := m.ParseWithTypes(Types, "VAR")
 	pst, ok := ps.(*VAR)
 	if ok {
 		return pst, err
@@ -21900,7 +21900,7 @@ func (m *Message) VAR() (*VAR, error) {
 
 // VTQ returns the first VTQ segment within the message, or nil if there isn't one.
 func (m *Message) VTQ() (*VTQ, error) {
-	ps, err := m.Parse("VTQ")
+	ps, err := m.ParseWithTypes(Types, "VTQ")
 	pst, ok := ps.(*VTQ)
 	if ok {
 		return pst, err
@@ -21911,1064 +21911,1064 @@ func (m *Message) VTQ() (*VTQ, error) {
 // AllABS returns a slice containing all ABS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllABS() ([]*ABS, error) {
-	pss, err := m.ParseAll("ABS")
+	pss, err := m.ParseAllWithTypes(Types, "ABS")
 	return pss.([]*ABS), err
 }
 
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllAFF returns a slice containing all AFF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAFF() ([]*AFF, error) {
-	pss, err := m.ParseAll("AFF")
+	pss, err := m.ParseAllWithTypes(Types, "AFF")
 	return pss.([]*AFF), err
 }
 
 // AllAIG returns a slice containing all AIG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIG() ([]*AIG, error) {
-	pss, err := m.ParseAll("AIG")
+	pss, err := m.ParseAllWithTypes(Types, "AIG")
 	return pss.([]*AIG), err
 }
 
 // AllAIL returns a slice containing all AIL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIL() ([]*AIL, error) {
-	pss, err := m.ParseAll("AIL")
+	pss, err := m.ParseAllWithTypes(Types, "AIL")
 	return pss.([]*AIL), err
 }
 
 // AllAIP returns a slice containing all AIP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIP() ([]*AIP, error) {
-	pss, err := m.ParseAll("AIP")
+	pss, err := m.ParseAllWithTypes(Types, "AIP")
 	return pss.([]*AIP), err
 }
 
 // AllAIS returns a slice containing all AIS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIS() ([]*AIS, error) {
-	pss, err := m.ParseAll("AIS")
+	pss, err := m.ParseAllWithTypes(Types, "AIS")
 	return pss.([]*AIS), err
 }
 
 // AllAL1 returns a slice containing all AL1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAL1() ([]*AL1, error) {
-	pss, err := m.ParseAll("AL1")
+	pss, err := m.ParseAllWithTypes(Types, "AL1")
 	return pss.([]*AL1), err
 }
 
 // AllAPR returns a slice containing all APR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAPR() ([]*APR, error) {
-	pss, err := m.ParseAll("APR")
+	pss, err := m.ParseAllWithTypes(Types, "APR")
 	return pss.([]*APR), err
 }
 
 // AllARQ returns a slice containing all ARQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARQ() ([]*ARQ, error) {
-	pss, err := m.ParseAll("ARQ")
+	pss, err := m.ParseAllWithTypes(Types, "ARQ")
 	return pss.([]*ARQ), err
 }
 
 // AllAUT returns a slice containing all AUT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAUT() ([]*AUT, error) {
-	pss, err := m.ParseAll("AUT")
+	pss, err := m.ParseAllWithTypes(Types, "AUT")
 	return pss.([]*AUT), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLC returns a slice containing all BLC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLC() ([]*BLC, error) {
-	pss, err := m.ParseAll("BLC")
+	pss, err := m.ParseAllWithTypes(Types, "BLC")
 	return pss.([]*BLC), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBPO returns a slice containing all BPO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPO() ([]*BPO, error) {
-	pss, err := m.ParseAll("BPO")
+	pss, err := m.ParseAllWithTypes(Types, "BPO")
 	return pss.([]*BPO), err
 }
 
 // AllBPX returns a slice containing all BPX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPX() ([]*BPX, error) {
-	pss, err := m.ParseAll("BPX")
+	pss, err := m.ParseAllWithTypes(Types, "BPX")
 	return pss.([]*BPX), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllBTX returns a slice containing all BTX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTX() ([]*BTX, error) {
-	pss, err := m.ParseAll("BTX")
+	pss, err := m.ParseAllWithTypes(Types, "BTX")
 	return pss.([]*BTX), err
 }
 
 // AllCDM returns a slice containing all CDM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCDM() ([]*CDM, error) {
-	pss, err := m.ParseAll("CDM")
+	pss, err := m.ParseAllWithTypes(Types, "CDM")
 	return pss.([]*CDM), err
 }
 
 // AllCER returns a slice containing all CER segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCER() ([]*CER, error) {
-	pss, err := m.ParseAll("CER")
+	pss, err := m.ParseAllWithTypes(Types, "CER")
 	return pss.([]*CER), err
 }
 
 // AllCM0 returns a slice containing all CM0 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM0() ([]*CM0, error) {
-	pss, err := m.ParseAll("CM0")
+	pss, err := m.ParseAllWithTypes(Types, "CM0")
 	return pss.([]*CM0), err
 }
 
 // AllCM1 returns a slice containing all CM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM1() ([]*CM1, error) {
-	pss, err := m.ParseAll("CM1")
+	pss, err := m.ParseAllWithTypes(Types, "CM1")
 	return pss.([]*CM1), err
 }
 
 // AllCM2 returns a slice containing all CM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM2() ([]*CM2, error) {
-	pss, err := m.ParseAll("CM2")
+	pss, err := m.ParseAllWithTypes(Types, "CM2")
 	return pss.([]*CM2), err
 }
 
 // AllCNS returns a slice containing all CNS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCNS() ([]*CNS, error) {
-	pss, err := m.ParseAll("CNS")
+	pss, err := m.ParseAllWithTypes(Types, "CNS")
 	return pss.([]*CNS), err
 }
 
 // AllCON returns a slice containing all CON segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCON() ([]*CON, error) {
-	pss, err := m.ParseAll("CON")
+	pss, err := m.ParseAllWithTypes(Types, "CON")
 	return pss.([]*CON), err
 }
 
 // AllCSP returns a slice containing all CSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSP() ([]*CSP, error) {
-	pss, err := m.ParseAll("CSP")
+	pss, err := m.ParseAllWithTypes(Types, "CSP")
 	return pss.([]*CSP), err
 }
 
 // AllCSR returns a slice containing all CSR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSR() ([]*CSR, error) {
-	pss, err := m.ParseAll("CSR")
+	pss, err := m.ParseAllWithTypes(Types, "CSR")
 	return pss.([]*CSR), err
 }
 
 // AllCSS returns a slice containing all CSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSS() ([]*CSS, error) {
-	pss, err := m.ParseAll("CSS")
+	pss, err := m.ParseAllWithTypes(Types, "CSS")
 	return pss.([]*CSS), err
 }
 
 // AllCTD returns a slice containing all CTD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTD() ([]*CTD, error) {
-	pss, err := m.ParseAll("CTD")
+	pss, err := m.ParseAllWithTypes(Types, "CTD")
 	return pss.([]*CTD), err
 }
 
 // AllCTI returns a slice containing all CTI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTI() ([]*CTI, error) {
-	pss, err := m.ParseAll("CTI")
+	pss, err := m.ParseAllWithTypes(Types, "CTI")
 	return pss.([]*CTI), err
 }
 
 // AllDB1 returns a slice containing all DB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDB1() ([]*DB1, error) {
-	pss, err := m.ParseAll("DB1")
+	pss, err := m.ParseAllWithTypes(Types, "DB1")
 	return pss.([]*DB1), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDRG returns a slice containing all DRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDRG() ([]*DRG, error) {
-	pss, err := m.ParseAll("DRG")
+	pss, err := m.ParseAllWithTypes(Types, "DRG")
 	return pss.([]*DRG), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllECD returns a slice containing all ECD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECD() ([]*ECD, error) {
-	pss, err := m.ParseAll("ECD")
+	pss, err := m.ParseAllWithTypes(Types, "ECD")
 	return pss.([]*ECD), err
 }
 
 // AllECR returns a slice containing all ECR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECR() ([]*ECR, error) {
-	pss, err := m.ParseAll("ECR")
+	pss, err := m.ParseAllWithTypes(Types, "ECR")
 	return pss.([]*ECR), err
 }
 
 // AllEDU returns a slice containing all EDU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEDU() ([]*EDU, error) {
-	pss, err := m.ParseAll("EDU")
+	pss, err := m.ParseAllWithTypes(Types, "EDU")
 	return pss.([]*EDU), err
 }
 
 // AllEQL returns a slice containing all EQL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQL() ([]*EQL, error) {
-	pss, err := m.ParseAll("EQL")
+	pss, err := m.ParseAllWithTypes(Types, "EQL")
 	return pss.([]*EQL), err
 }
 
 // AllEQP returns a slice containing all EQP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQP() ([]*EQP, error) {
-	pss, err := m.ParseAll("EQP")
+	pss, err := m.ParseAllWithTypes(Types, "EQP")
 	return pss.([]*EQP), err
 }
 
 // AllEQU returns a slice containing all EQU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQU() ([]*EQU, error) {
-	pss, err := m.ParseAll("EQU")
+	pss, err := m.ParseAllWithTypes(Types, "EQU")
 	return pss.([]*EQU), err
 }
 
 // AllERQ returns a slice containing all ERQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERQ() ([]*ERQ, error) {
-	pss, err := m.ParseAll("ERQ")
+	pss, err := m.ParseAllWithTypes(Types, "ERQ")
 	return pss.([]*ERQ), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFAC returns a slice containing all FAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFAC() ([]*FAC, error) {
-	pss, err := m.ParseAll("FAC")
+	pss, err := m.ParseAllWithTypes(Types, "FAC")
 	return pss.([]*FAC), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGOL returns a slice containing all GOL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGOL() ([]*GOL, error) {
-	pss, err := m.ParseAll("GOL")
+	pss, err := m.ParseAllWithTypes(Types, "GOL")
 	return pss.([]*GOL), err
 }
 
 // AllGP1 returns a slice containing all GP1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP1() ([]*GP1, error) {
-	pss, err := m.ParseAll("GP1")
+	pss, err := m.ParseAllWithTypes(Types, "GP1")
 	return pss.([]*GP1), err
 }
 
 // AllGP2 returns a slice containing all GP2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP2() ([]*GP2, error) {
-	pss, err := m.ParseAll("GP2")
+	pss, err := m.ParseAllWithTypes(Types, "GP2")
 	return pss.([]*GP2), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIAM returns a slice containing all IAM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIAM() ([]*IAM, error) {
-	pss, err := m.ParseAll("IAM")
+	pss, err := m.ParseAllWithTypes(Types, "IAM")
 	return pss.([]*IAM), err
 }
 
 // AllIIM returns a slice containing all IIM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIIM() ([]*IIM, error) {
-	pss, err := m.ParseAll("IIM")
+	pss, err := m.ParseAllWithTypes(Types, "IIM")
 	return pss.([]*IIM), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllIN2 returns a slice containing all IN2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN2() ([]*IN2, error) {
-	pss, err := m.ParseAll("IN2")
+	pss, err := m.ParseAllWithTypes(Types, "IN2")
 	return pss.([]*IN2), err
 }
 
 // AllIN3 returns a slice containing all IN3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN3() ([]*IN3, error) {
-	pss, err := m.ParseAll("IN3")
+	pss, err := m.ParseAllWithTypes(Types, "IN3")
 	return pss.([]*IN3), err
 }
 
 // AllINV returns a slice containing all INV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllINV() ([]*INV, error) {
-	pss, err := m.ParseAll("INV")
+	pss, err := m.ParseAllWithTypes(Types, "INV")
 	return pss.([]*INV), err
 }
 
 // AllIPC returns a slice containing all IPC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIPC() ([]*IPC, error) {
-	pss, err := m.ParseAll("IPC")
+	pss, err := m.ParseAllWithTypes(Types, "IPC")
 	return pss.([]*IPC), err
 }
 
 // AllISD returns a slice containing all ISD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllISD() ([]*ISD, error) {
-	pss, err := m.ParseAll("ISD")
+	pss, err := m.ParseAllWithTypes(Types, "ISD")
 	return pss.([]*ISD), err
 }
 
 // AllLAN returns a slice containing all LAN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLAN() ([]*LAN, error) {
-	pss, err := m.ParseAll("LAN")
+	pss, err := m.ParseAllWithTypes(Types, "LAN")
 	return pss.([]*LAN), err
 }
 
 // AllLCC returns a slice containing all LCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCC() ([]*LCC, error) {
-	pss, err := m.ParseAll("LCC")
+	pss, err := m.ParseAllWithTypes(Types, "LCC")
 	return pss.([]*LCC), err
 }
 
 // AllLCH returns a slice containing all LCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCH() ([]*LCH, error) {
-	pss, err := m.ParseAll("LCH")
+	pss, err := m.ParseAllWithTypes(Types, "LCH")
 	return pss.([]*LCH), err
 }
 
 // AllLDP returns a slice containing all LDP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLDP() ([]*LDP, error) {
-	pss, err := m.ParseAll("LDP")
+	pss, err := m.ParseAllWithTypes(Types, "LDP")
 	return pss.([]*LDP), err
 }
 
 // AllLOC returns a slice containing all LOC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLOC() ([]*LOC, error) {
-	pss, err := m.ParseAll("LOC")
+	pss, err := m.ParseAllWithTypes(Types, "LOC")
 	return pss.([]*LOC), err
 }
 
 // AllLRL returns a slice containing all LRL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLRL() ([]*LRL, error) {
-	pss, err := m.ParseAll("LRL")
+	pss, err := m.ParseAllWithTypes(Types, "LRL")
 	return pss.([]*LRL), err
 }
 
 // AllMFA returns a slice containing all MFA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFA() ([]*MFA, error) {
-	pss, err := m.ParseAll("MFA")
+	pss, err := m.ParseAllWithTypes(Types, "MFA")
 	return pss.([]*MFA), err
 }
 
 // AllMFE returns a slice containing all MFE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFE() ([]*MFE, error) {
-	pss, err := m.ParseAll("MFE")
+	pss, err := m.ParseAllWithTypes(Types, "MFE")
 	return pss.([]*MFE), err
 }
 
 // AllMFI returns a slice containing all MFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFI() ([]*MFI, error) {
-	pss, err := m.ParseAll("MFI")
+	pss, err := m.ParseAllWithTypes(Types, "MFI")
 	return pss.([]*MFI), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNDS returns a slice containing all NDS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNDS() ([]*NDS, error) {
-	pss, err := m.ParseAll("NDS")
+	pss, err := m.ParseAllWithTypes(Types, "NDS")
 	return pss.([]*NDS), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllODS returns a slice containing all ODS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODS() ([]*ODS, error) {
-	pss, err := m.ParseAll("ODS")
+	pss, err := m.ParseAllWithTypes(Types, "ODS")
 	return pss.([]*ODS), err
 }
 
 // AllODT returns a slice containing all ODT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODT() ([]*ODT, error) {
-	pss, err := m.ParseAll("ODT")
+	pss, err := m.ParseAllWithTypes(Types, "ODT")
 	return pss.([]*ODT), err
 }
 
 // AllOM1 returns a slice containing all OM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM1() ([]*OM1, error) {
-	pss, err := m.ParseAll("OM1")
+	pss, err := m.ParseAllWithTypes(Types, "OM1")
 	return pss.([]*OM1), err
 }
 
 // AllOM2 returns a slice containing all OM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM2() ([]*OM2, error) {
-	pss, err := m.ParseAll("OM2")
+	pss, err := m.ParseAllWithTypes(Types, "OM2")
 	return pss.([]*OM2), err
 }
 
 // AllOM3 returns a slice containing all OM3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM3() ([]*OM3, error) {
-	pss, err := m.ParseAll("OM3")
+	pss, err := m.ParseAllWithTypes(Types, "OM3")
 	return pss.([]*OM3), err
 }
 
 // AllOM4 returns a slice containing all OM4 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM4() ([]*OM4, error) {
-	pss, err := m.ParseAll("OM4")
+	pss, err := m.ParseAllWithTypes(Types, "OM4")
 	return pss.([]*OM4), err
 }
 
 // AllOM5 returns a slice containing all OM5 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM5() ([]*OM5, error) {
-	pss, err := m.ParseAll("OM5")
+	pss, err := m.ParseAllWithTypes(Types, "OM5")
 	return pss.([]*OM5), err
 }
 
 // AllOM6 returns a slice containing all OM6 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM6() ([]*OM6, error) {
-	pss, err := m.ParseAll("OM6")
+	pss, err := m.ParseAllWithTypes(Types, "OM6")
 	return pss.([]*OM6), err
 }
 
 // AllOM7 returns a slice containing all OM7 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM7() ([]*OM7, error) {
-	pss, err := m.ParseAll("OM7")
+	pss, err := m.ParseAllWithTypes(Types, "OM7")
 	return pss.([]*OM7), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORG returns a slice containing all ORG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORG() ([]*ORG, error) {
-	pss, err := m.ParseAll("ORG")
+	pss, err := m.ParseAllWithTypes(Types, "ORG")
 	return pss.([]*ORG), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllOVR returns a slice containing all OVR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOVR() ([]*OVR, error) {
-	pss, err := m.ParseAll("OVR")
+	pss, err := m.ParseAllWithTypes(Types, "OVR")
 	return pss.([]*OVR), err
 }
 
 // AllPCR returns a slice containing all PCR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCR() ([]*PCR, error) {
-	pss, err := m.ParseAll("PCR")
+	pss, err := m.ParseAllWithTypes(Types, "PCR")
 	return pss.([]*PCR), err
 }
 
 // AllPD1 returns a slice containing all PD1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPD1() ([]*PD1, error) {
-	pss, err := m.ParseAll("PD1")
+	pss, err := m.ParseAllWithTypes(Types, "PD1")
 	return pss.([]*PD1), err
 }
 
 // AllPDA returns a slice containing all PDA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDA() ([]*PDA, error) {
-	pss, err := m.ParseAll("PDA")
+	pss, err := m.ParseAllWithTypes(Types, "PDA")
 	return pss.([]*PDA), err
 }
 
 // AllPDC returns a slice containing all PDC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDC() ([]*PDC, error) {
-	pss, err := m.ParseAll("PDC")
+	pss, err := m.ParseAllWithTypes(Types, "PDC")
 	return pss.([]*PDC), err
 }
 
 // AllPEO returns a slice containing all PEO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPEO() ([]*PEO, error) {
-	pss, err := m.ParseAll("PEO")
+	pss, err := m.ParseAllWithTypes(Types, "PEO")
 	return pss.([]*PEO), err
 }
 
 // AllPES returns a slice containing all PES segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPES() ([]*PES, error) {
-	pss, err := m.ParseAll("PES")
+	pss, err := m.ParseAllWithTypes(Types, "PES")
 	return pss.([]*PES), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPRA returns a slice containing all PRA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRA() ([]*PRA, error) {
-	pss, err := m.ParseAll("PRA")
+	pss, err := m.ParseAllWithTypes(Types, "PRA")
 	return pss.([]*PRA), err
 }
 
 // AllPRB returns a slice containing all PRB segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRB() ([]*PRB, error) {
-	pss, err := m.ParseAll("PRB")
+	pss, err := m.ParseAllWithTypes(Types, "PRB")
 	return pss.([]*PRB), err
 }
 
 // AllPRC returns a slice containing all PRC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRC() ([]*PRC, error) {
-	pss, err := m.ParseAll("PRC")
+	pss, err := m.ParseAllWithTypes(Types, "PRC")
 	return pss.([]*PRC), err
 }
 
 // AllPRD returns a slice containing all PRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRD() ([]*PRD, error) {
-	pss, err := m.ParseAll("PRD")
+	pss, err := m.ParseAllWithTypes(Types, "PRD")
 	return pss.([]*PRD), err
 }
 
 // AllPSH returns a slice containing all PSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSH() ([]*PSH, error) {
-	pss, err := m.ParseAll("PSH")
+	pss, err := m.ParseAllWithTypes(Types, "PSH")
 	return pss.([]*PSH), err
 }
 
 // AllPTH returns a slice containing all PTH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPTH() ([]*PTH, error) {
-	pss, err := m.ParseAll("PTH")
+	pss, err := m.ParseAllWithTypes(Types, "PTH")
 	return pss.([]*PTH), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllPV2 returns a slice containing all PV2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV2() ([]*PV2, error) {
-	pss, err := m.ParseAll("PV2")
+	pss, err := m.ParseAllWithTypes(Types, "PV2")
 	return pss.([]*PV2), err
 }
 
 // AllQAK returns a slice containing all QAK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQAK() ([]*QAK, error) {
-	pss, err := m.ParseAll("QAK")
+	pss, err := m.ParseAllWithTypes(Types, "QAK")
 	return pss.([]*QAK), err
 }
 
 // AllQID returns a slice containing all QID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQID() ([]*QID, error) {
-	pss, err := m.ParseAll("QID")
+	pss, err := m.ParseAllWithTypes(Types, "QID")
 	return pss.([]*QID), err
 }
 
 // AllQPD returns a slice containing all QPD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQPD() ([]*QPD, error) {
-	pss, err := m.ParseAll("QPD")
+	pss, err := m.ParseAllWithTypes(Types, "QPD")
 	return pss.([]*QPD), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllQRI returns a slice containing all QRI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRI() ([]*QRI, error) {
-	pss, err := m.ParseAll("QRI")
+	pss, err := m.ParseAllWithTypes(Types, "QRI")
 	return pss.([]*QRI), err
 }
 
 // AllRCP returns a slice containing all RCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRCP() ([]*RCP, error) {
-	pss, err := m.ParseAll("RCP")
+	pss, err := m.ParseAllWithTypes(Types, "RCP")
 	return pss.([]*RCP), err
 }
 
 // AllRDF returns a slice containing all RDF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDF() ([]*RDF, error) {
-	pss, err := m.ParseAll("RDF")
+	pss, err := m.ParseAllWithTypes(Types, "RDF")
 	return pss.([]*RDF), err
 }
 
 // AllRDT returns a slice containing all RDT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDT() ([]*RDT, error) {
-	pss, err := m.ParseAll("RDT")
+	pss, err := m.ParseAllWithTypes(Types, "RDT")
 	return pss.([]*RDT), err
 }
 
 // AllRF1 returns a slice containing all RF1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRF1() ([]*RF1, error) {
-	pss, err := m.ParseAll("RF1")
+	pss, err := m.ParseAllWithTypes(Types, "RF1")
 	return pss.([]*RF1), err
 }
 
 // AllRGS returns a slice containing all RGS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRGS() ([]*RGS, error) {
-	pss, err := m.ParseAll("RGS")
+	pss, err := m.ParseAllWithTypes(Types, "RGS")
 	return pss.([]*RGS), err
 }
 
 // AllRMI returns a slice containing all RMI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRMI() ([]*RMI, error) {
-	pss, err := m.ParseAll("RMI")
+	pss, err := m.ParseAllWithTypes(Types, "RMI")
 	return pss.([]*RMI), err
 }
 
 // AllROL returns a slice containing all ROL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllROL() ([]*ROL, error) {
-	pss, err := m.ParseAll("ROL")
+	pss, err := m.ParseAllWithTypes(Types, "ROL")
 	return pss.([]*ROL), err
 }
 
 // AllRQ1 returns a slice containing all RQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQ1() ([]*RQ1, error) {
-	pss, err := m.ParseAll("RQ1")
+	pss, err := m.ParseAllWithTypes(Types, "RQ1")
 	return pss.([]*RQ1), err
 }
 
 // AllRQD returns a slice containing all RQD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQD() ([]*RQD, error) {
-	pss, err := m.ParseAll("RQD")
+	pss, err := m.ParseAllWithTypes(Types, "RQD")
 	return pss.([]*RQD), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllRXA returns a slice containing all RXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXA() ([]*RXA, error) {
-	pss, err := m.ParseAll("RXA")
+	pss, err := m.ParseAllWithTypes(Types, "RXA")
 	return pss.([]*RXA), err
 }
 
 // AllRXC returns a slice containing all RXC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXC() ([]*RXC, error) {
-	pss, err := m.ParseAll("RXC")
+	pss, err := m.ParseAllWithTypes(Types, "RXC")
 	return pss.([]*RXC), err
 }
 
 // AllRXD returns a slice containing all RXD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXD() ([]*RXD, error) {
-	pss, err := m.ParseAll("RXD")
+	pss, err := m.ParseAllWithTypes(Types, "RXD")
 	return pss.([]*RXD), err
 }
 
 // AllRXE returns a slice containing all RXE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXE() ([]*RXE, error) {
-	pss, err := m.ParseAll("RXE")
+	pss, err := m.ParseAllWithTypes(Types, "RXE")
 	return pss.([]*RXE), err
 }
 
 // AllRXG returns a slice containing all RXG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXG() ([]*RXG, error) {
-	pss, err := m.ParseAll("RXG")
+	pss, err := m.ParseAllWithTypes(Types, "RXG")
 	return pss.([]*RXG), err
 }
 
 // AllRXO returns a slice containing all RXO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXO() ([]*RXO, error) {
-	pss, err := m.ParseAll("RXO")
+	pss, err := m.ParseAllWithTypes(Types, "RXO")
 	return pss.([]*RXO), err
 }
 
 // AllRXR returns a slice containing all RXR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXR() ([]*RXR, error) {
-	pss, err := m.ParseAll("RXR")
+	pss, err := m.ParseAllWithTypes(Types, "RXR")
 	return pss.([]*RXR), err
 }
 
 // AllSAC returns a slice containing all SAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSAC() ([]*SAC, error) {
-	pss, err := m.ParseAll("SAC")
+	pss, err := m.ParseAllWithTypes(Types, "SAC")
 	return pss.([]*SAC), err
 }
 
 // AllSCH returns a slice containing all SCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCH() ([]*SCH, error) {
-	pss, err := m.ParseAll("SCH")
+	pss, err := m.ParseAllWithTypes(Types, "SCH")
 	return pss.([]*SCH), err
 }
 
 // AllSFT returns a slice containing all SFT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSFT() ([]*SFT, error) {
-	pss, err := m.ParseAll("SFT")
+	pss, err := m.ParseAllWithTypes(Types, "SFT")
 	return pss.([]*SFT), err
 }
 
 // AllSID returns a slice containing all SID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSID() ([]*SID, error) {
-	pss, err := m.ParseAll("SID")
+	pss, err := m.ParseAllWithTypes(Types, "SID")
 	return pss.([]*SID), err
 }
 
 // AllSPM returns a slice containing all SPM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPM() ([]*SPM, error) {
-	pss, err := m.ParseAll("SPM")
+	pss, err := m.ParseAllWithTypes(Types, "SPM")
 	return pss.([]*SPM), err
 }
 
 // AllSPR returns a slice containing all SPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPR() ([]*SPR, error) {
-	pss, err := m.ParseAll("SPR")
+	pss, err := m.ParseAllWithTypes(Types, "SPR")
 	return pss.([]*SPR), err
 }
 
 // AllSTF returns a slice containing all STF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTF() ([]*STF, error) {
-	pss, err := m.ParseAll("STF")
+	pss, err := m.ParseAllWithTypes(Types, "STF")
 	return pss.([]*STF), err
 }
 
 // AllTCC returns a slice containing all TCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCC() ([]*TCC, error) {
-	pss, err := m.ParseAll("TCC")
+	pss, err := m.ParseAllWithTypes(Types, "TCC")
 	return pss.([]*TCC), err
 }
 
 // AllTCD returns a slice containing all TCD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCD() ([]*TCD, error) {
-	pss, err := m.ParseAll("TCD")
+	pss, err := m.ParseAllWithTypes(Types, "TCD")
 	return pss.([]*TCD), err
 }
 
 // AllTQ1 returns a slice containing all TQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ1() ([]*TQ1, error) {
-	pss, err := m.ParseAll("TQ1")
+	pss, err := m.ParseAllWithTypes(Types, "TQ1")
 	return pss.([]*TQ1), err
 }
 
 // AllTQ2 returns a slice containing all TQ2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ2() ([]*TQ2, error) {
-	pss, err := m.ParseAll("TQ2")
+	pss, err := m.ParseAllWithTypes(Types, "TQ2")
 	return pss.([]*TQ2), err
 }
 
 // AllTXA returns a slice containing all TXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTXA() ([]*TXA, error) {
-	pss, err := m.ParseAll("TXA")
+	pss, err := m.ParseAllWithTypes(Types, "TXA")
 	return pss.([]*TXA), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllUB2 returns a slice containing all UB2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB2() ([]*UB2, error) {
-	pss, err := m.ParseAll("UB2")
+	pss, err := m.ParseAllWithTypes(Types, "UB2")
 	return pss.([]*UB2), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
 // AllVAR returns a slice containing all VAR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVAR() ([]*VAR, error) {
-	pss, err := m.ParseAll("VAR")
+	pss, err := m.ParseAllWithTypes(Types, "VAR")
 	return pss.([]*VAR), err
 }
 
 // AllVTQ returns a slice containing all VTQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVTQ() ([]*VTQ, error) {
-	pss, err := m.ParseAll("VTQ")
+	pss, err := m.ParseAllWithTypes(Types, "VTQ")
 	return pss.([]*VTQ), err
 }
 
@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.6.0.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v260
 
 import "reflect"
 
@@ -3391,54 +3391,53 @@ func (s *ODT) SegmentName() string {
 // OM1 represents the corresponding HL7 segment.
 // Definition from HL7 2.6
 type OM1 struct {
-	SequenceNumberTestObservationMasterFile                *NM   `hl7:"true,Sequence Number - Test/Observation Master File"`          // OM1-1
-	ProducerSServiceTestObservationID                      *CWE  `hl7:"true,Producer'S Service/Test/Observation ID"`                  // OM1-2
-	PermittedDataTypes                                     []ID  `hl7:"false,Permitted Data Types"`                                   // OM1-3
-	SpecimenRequired                                       *ID   `hl7:"true,Specimen Required"`                                       // OM1-4
-	ProducerID                                             *CWE  `hl7:"true,Producer ID"`                                             // OM1-5
-	ObservationDescription                                 *TX   `hl7:"false,Observation Description"`                                // OM1-6
-	OtherServiceTestObservationIDsForTheObservation        *CWE  `hl7:"false,Other Service/Test/Observation IDs For The Observation"` // OM1-7
-	OtherNames                                             []ST  `hl7:"true,Other Names"`                                             // OM1-8
-	PreferredReportNameForTheObservation                   *ST   `hl7:"false,Preferred Report Name For The Observation"`              // OM1-9
-	PreferredShortNameOrMnemonicForObservation             *ST   `hl7:"false,Preferred Short Name Or Mnemonic For Observation"`       // OM1-10
-	PreferredLongNameForTheObservation                     *ST   `hl7:"false,Preferred Long Name For The Observation"`                // OM1-11
-	Orderability                                           *ID   `hl7:"false,Orderability"`                                           // OM1-12
-	IdentityOfInstrumentUsedToPerformThisStudy             []CWE `hl7:"false,Identity Of Instrument Used To Perform This Study"`      // OM1-13
-	CodedRepresentationOfMethod                            []CWE `hl7:"false,Coded Representation Of Method"`                         // OM1-14
-	PortableDeviceIndicator                                *ID   `hl7:"false,Portable Device Indicator"`                              // OM1-15
-	ObservationProducingDepartmentSection                  []CWE `hl7:"false,Observation Producing Department/Section"`               // OM1-16
-	TelephoneNumberOfSection                               *XTN  `hl7:"false,Telephone Number Of Section"`                            // OM1-17
-	NatureOfServiceTestObservation                         *IS   `hl7:"true,Nature Of Service/Test/Observation"`                      // OM1-18
-	ReportSubheader                                        *CWE  `hl7:"false,Report Subheader"`                                       // OM1-19
-	ReportDisplayOrder                                     *ST   `hl7:"false,Report Display Order"`                                   // OM1-20
-	DateTimeStampForAnyChangeInDefinitionForTheObservation *DTM  `hl7:"false,Date/Time Stamp For Any Change In Definition For The Observation
-        "` // OM1-21
-	EffectiveDateTimeOfChange                     *DTM  `hl7:"false,Effective Date/Time Of Change"`                      // OM1-22
-	TypicalTurnAroundTime                         *NM   `hl7:"false,Typical Turn-Around Time"`                           // OM1-23
-	ProcessingTime                                *NM   `hl7:"false,Processing Time"`                                    // OM1-24
-	ProcessingPriority                            []ID  `hl7:"false,Processing Priority"`                                // OM1-25
-	ReportingPriority                             *ID   `hl7:"false,Reporting Priority"`                                 // OM1-26
-	OutsideSiteSWhereObservationMayBePerformed    []CWE `hl7:"false,Outside Site(S) Where Observation May Be Performed"` // OM1-27
-	AddressOfOutsideSiteS                         []XAD `hl7:"false,Address Of Outside Site(S)"`                         // OM1-28
-	PhoneNumberOfOutsideSite                      *XTN  `hl7:"false,Phone Number Of Outside Site"`                       // OM1-29
-	ConfidentialityCode                           *CWE  `hl7:"false,Confidentiality Code"`                               // OM1-30
-	ObservationsRequiredToInterpretTheObservation *CWE  `hl7:"false,Observations Required To Interpret The Observation"` // OM1-31
-	InterpretationOfObservations                  *TX   `hl7:"false,Interpretation Of Observations"`                     // OM1-32
-	ContraindicationsToObservations               *CWE  `hl7:"false,Contraindications To Observations"`                  // OM1-33
-	ReflexTestsObservations                       []CWE `hl7:"false,Reflex Tests/Observations"`                          // OM1-34
-	RulesThatTriggerReflexTesting                 *TX   `hl7:"false,Rules That Trigger Reflex Testing"`                  // OM1-35
-	FixedCannedMessage                            *CWE  `hl7:"false,Fixed Canned Message"`                               // OM1-36
-	PatientPreparation                            *TX   `hl7:"false,Patient Preparation"`                                // OM1-37
-	ProcedureMedication                           *CWE  `hl7:"false,Procedure Medication"`                               // OM1-38
-	FactorsThatMayAffectTheObservation            *TX   `hl7:"false,Factors That May Affect The Observation"`            // OM1-39
-	ServiceTestObservationPerformanceSchedule     []ST  `hl7:"false,Service/Test/Observation Performance Schedule"`      // OM1-40
-	DescriptionOfTestMethods                      *TX   `hl7:"false,Description Of Test Methods"`                        // OM1-41
-	KindOfQuantityObserved                        *CWE  `hl7:"false,Kind Of Quantity Observed"`                          // OM1-42
-	PointVersusInterval                           *CWE  `hl7:"false,Point Versus Interval"`                              // OM1-43
-	ChallengeInformation                          *TX   `hl7:"false,Challenge Information"`                              // OM1-44
-	RelationshipModifier                          *CWE  `hl7:"false,Relationship Modifier"`                              // OM1-45
-	TargetAnatomicSiteOfTest                      *CWE  `hl7:"false,Target Anatomic Site Of Test"`                       // OM1-46
-	ModalityOfImagingMeasurement                  *CWE  `hl7:"false,Modality Of Imaging Measurement"`                    // OM1-47
+	SequenceNumberTestObservationMasterFile                *NM   `hl7:"true,Sequence Number - Test/Observation Master File"`                    // OM1-1
+	ProducerSServiceTestObservationID                      *CWE  `hl7:"true,Producer'S Service/Test/Observation ID"`                            // OM1-2
+	PermittedDataTypes                                     []ID  `hl7:"false,Permitted Data Types"`                                             // OM1-3
+	SpecimenRequired                                       *ID   `hl7:"true,Specimen Required"`                                                 // OM1-4
+	ProducerID                                             *CWE  `hl7:"true,Producer ID"`                                                       // OM1-5
+	ObservationDescription                                 *TX   `hl7:"false,Observation Description"`                                          // OM1-6
+	OtherServiceTestObservationIDsForTheObservation        *CWE  `hl7:"false,Other Service/Test/Observation IDs For The Observation"`           // OM1-7
+	OtherNames                                             []ST  `hl7:"true,Other Names"`                                                       // OM1-8
+	PreferredReportNameForTheObservation                   *ST   `hl7:"false,Preferred Report Name For The Observation"`                        // OM1-9
+	PreferredShortNameOrMnemonicForObservation             *ST   `hl7:"false,Preferred Short Name Or Mnemonic For Observation"`                 // OM1-10
+	PreferredLongNameForTheObservation                     *ST   `hl7:"false,Preferred Long Name For The Observation"`                          // OM1-11
+	Orderability                                           *ID   `hl7:"false,Orderability"`                                                     // OM1-12
+	IdentityOfInstrumentUsedToPerformThisStudy             []CWE `hl7:"false,Identity Of Instrument Used To Perform This Study"`                // OM1-13
+	CodedRepresentationOfMethod                            []CWE `hl7:"false,Coded Representation Of Method"`                                   // OM1-14
+	PortableDeviceIndicator                                *ID   `hl7:"false,Portable Device Indicator"`                                        // OM1-15
+	ObservationProducingDepartmentSection                  []CWE `hl7:"false,Observation Producing Department/Section"`                         // OM1-16
+	TelephoneNumberOfSection                               *XTN  `hl7:"false,Telephone Number Of Section"`                                      // OM1-17
+	NatureOfServiceTestObservation                         *IS   `hl7:"true,Nature Of Service/Test/Observation"`                                // OM1-18
+	ReportSubheader                                        *CWE  `hl7:"false,Report Subheader"`                                                 // OM1-19
+	ReportDisplayOrder                                     *ST   `hl7:"false,Report Display Order"`                                             // OM1-20
+	DateTimeStampForAnyChangeInDefinitionForTheObservation *DTM  `hl7:"false,Date/Time Stamp For Any Change In Definition For The Observation"` // OM1-21
+	EffectiveDateTimeOfChange                              *DTM  `hl7:"false,Effective Date/Time Of Change"`                                    // OM1-22
+	TypicalTurnAroundTime                                  *NM   `hl7:"false,Typical Turn-Around Time"`                                         // OM1-23
+	ProcessingTime                                         *NM   `hl7:"false,Processing Time"`                                                  // OM1-24
+	ProcessingPriority                                     []ID  `hl7:"false,Processing Priority"`                                              // OM1-25
+	ReportingPriority                                      *ID   `hl7:"false,Reporting Priority"`                                               // OM1-26
+	OutsideSiteSWhereObservationMayBePerformed             []CWE `hl7:"false,Outside Site(S) Where Observation May Be Performed"`               // OM1-27
+	AddressOfOutsideSiteS                                  []XAD `hl7:"false,Address Of Outside Site(S)"`                                       // OM1-28
+	PhoneNumberOfOutsideSite                               *XTN  `hl7:"false,Phone Number Of Outside Site"`                                     // OM1-29
+	ConfidentialityCode                                    *CWE  `hl7:"false,Confidentiality Code"`                                             // OM1-30
+	ObservationsRequiredToInterpretTheObservation          *CWE  `hl7:"false,Observations Required To Interpret The Observation"`               // OM1-31
+	InterpretationOfObservations                           *TX   `hl7:"false,Interpretation Of Observations"`                                   // OM1-32
+	ContraindicationsToObservations                        *CWE  `hl7:"false,Contraindications To Observations"`                                // OM1-33
+	ReflexTestsObservations                                []CWE `hl7:"false,Reflex Tests/Observations"`                                        // OM1-34
+	RulesThatTriggerReflexTesting                          *TX   `hl7:"false,Rules That Trigger Reflex Testing"`                                // OM1-35
+	FixedCannedMessage                                     *CWE  `hl7:"false,Fixed Canned Message"`                                             // OM1-36
+	PatientPreparation                                     *TX   `hl7:"false,Patient Preparation"`                                              // OM1-37
+	ProcedureMedication                                    *CWE  `hl7:"false,Procedure Medication"`                                             // OM1-38
+	FactorsThatMayAffectTheObservation                     *TX   `hl7:"false,Factors That May Affect The Observation"`                          // OM1-39
+	ServiceTestObservationPerformanceSchedule              []ST  `hl7:"false,Service/Test/Observation Performance Schedule"`                    // OM1-40
+	DescriptionOfTestMethods                               *TX   `hl7:"false,Description Of Test Methods"`                                      // OM1-41
+	KindOfQuantityObserved                                 *CWE  `hl7:"false,Kind Of Quantity Observed"`                                        // OM1-42
+	PointVersusInterval                                    *CWE  `hl7:"false,Point Versus Interval"`                                            // OM1-43
+	ChallengeInformation                                   *TX   `hl7:"false,Challenge Information"`                                            // OM1-44
+	RelationshipModifier                                   *CWE  `hl7:"false,Relationship Modifier"`                                            // OM1-45
+	TargetAnatomicSiteOfTest                               *CWE  `hl7:"false,Target Anatomic Site Of Test"`                                     // OM1-46
+	ModalityOfImagingMeasurement                           *CWE  `hl7:"false,Modality Of Imaging Measurement"`                                  // OM1-47
 }
 
 func (s *OM1) SegmentName() string {
@@ -3448,17 +3447,16 @@ func (s *OM1) SegmentName() string {
 // OM2 represents the corresponding HL7 segment.
 // Definition from HL7 2.6
 type OM2 struct {
-	SequenceNumberTestObservationMasterFile                 *NM   `hl7:"false,Sequence Number - Test/Observation Master File"` // OM2-1
-	UnitsOfMeasure                                          *CWE  `hl7:"false,Units Of Measure"`                               // OM2-2
-	RangeOfDecimalPrecision                                 []NM  `hl7:"false,Range Of Decimal Precision"`                     // OM2-3
-	CorrespondingSIUnitsOfMeasure                           *CWE  `hl7:"false,Corresponding SI Units Of Measure"`              // OM2-4
-	SIConversionFactor                                      *TX   `hl7:"false,SI Conversion Factor"`                           // OM2-5
-	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Reference (Normal) Range For Ordinal And Continuous Observations
-        "` // OM2-6
-	CriticalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Critical Range For Ordinal And Continuous Observations"` // OM2-7
-	AbsoluteRangeForOrdinalAndContinuousObservations *RFR  `hl7:"false,Absolute Range For Ordinal And Continuous Observations"` // OM2-8
-	DeltaCheckCriteria                               []DLT `hl7:"false,Delta Check Criteria"`                                   // OM2-9
-	MinimumMeaningfulIncrements                      *NM   `hl7:"false,Minimum Meaningful Increments"`                          // OM2-10
+	SequenceNumberTestObservationMasterFile                 *NM   `hl7:"false,Sequence Number - Test/Observation Master File"`                   // OM2-1
+	UnitsOfMeasure                                          *CWE  `hl7:"false,Units Of Measure"`                                                 // OM2-2
+	RangeOfDecimalPrecision                                 []NM  `hl7:"false,Range Of Decimal Precision"`                                       // OM2-3
+	CorrespondingSIUnitsOfMeasure                           *CWE  `hl7:"false,Corresponding SI Units Of Measure"`                                // OM2-4
+	SIConversionFactor                                      *TX   `hl7:"false,SI Conversion Factor"`                                             // OM2-5
+	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Reference (Normal) Range For Ordinal And Continuous Observations"` // OM2-6
+	CriticalRangeForOrdinalAndContinuousObservations        []RFR `hl7:"false,Critical Range For Ordinal And Continuous Observations"`           // OM2-7
+	AbsoluteRangeForOrdinalAndContinuousObservations        *RFR  `hl7:"false,Absolute Range For Ordinal And Continuous Observations"`           // OM2-8
+	DeltaCheckCriteria                                      []DLT `hl7:"false,Delta Check Criteria"`                                             // OM2-9
+	MinimumMeaningfulIncrements                             *NM   `hl7:"false,Minimum Meaningful Increments"`                                    // OM2-10
 }
 
 func (s *OM2) SegmentName() string {
@@ -4671,40 +4669,39 @@ func (s *RXC) SegmentName() string {
 // RXD represents the corresponding HL7 segment.
 // Definition from HL7 2.6
 type RXD struct {
-	DispenseSubIDCounter                                    *NM   `hl7:"true,Dispense Sub-ID Counter"`      // RXD-1
-	DispenseGiveCode                                        *CWE  `hl7:"true,Dispense/Give Code"`           // RXD-2
-	DateTimeDispensed                                       *DTM  `hl7:"true,Date/Time Dispensed"`          // RXD-3
-	ActualDispenseAmount                                    *NM   `hl7:"true,Actual Dispense Amount"`       // RXD-4
-	ActualDispenseUnits                                     *CWE  `hl7:"false,Actual Dispense Units"`       // RXD-5
-	ActualDosageForm                                        *CWE  `hl7:"false,Actual Dosage Form"`          // RXD-6
-	PrescriptionNumber                                      *ST   `hl7:"true,Prescription Number"`          // RXD-7
-	NumberOfRefillsRemaining                                *NM   `hl7:"false,Number Of Refills Remaining"` // RXD-8
-	DispenseNotes                                           []ST  `hl7:"false,Dispense Notes"`              // RXD-9
-	DispensingProvider                                      []XCN `hl7:"false,Dispensing Provider"`         // RXD-10
-	SubstitutionStatus                                      *ID   `hl7:"false,Substitution Status"`         // RXD-11
-	TotalDailyDose                                          *CQ   `hl7:"false,Total Daily Dose"`            // RXD-12
-	DispenseToLocation                                      *LA2  `hl7:"false,Dispense-To Location"`        // RXD-13
-	NeedsHumanReview                                        *ID   `hl7:"false,Needs Human Review"`          // RXD-14
-	PharmacyTreatmentSupplierSSpecialDispensingInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Dispensing Instructions
-        "` // RXD-15
-	ActualStrength                *NM   `hl7:"false,Actual Strength"`                   // RXD-16
-	ActualStrengthUnit            *CWE  `hl7:"false,Actual Strength Unit"`              // RXD-17
-	SubstanceLotNumber            []ST  `hl7:"false,Substance Lot Number"`              // RXD-18
-	SubstanceExpirationDate       []DTM `hl7:"false,Substance Expiration Date"`         // RXD-19
-	SubstanceManufacturerName     []CWE `hl7:"false,Substance Manufacturer Name"`       // RXD-20
-	Indication                    []CWE `hl7:"false,Indication"`                        // RXD-21
-	DispensePackageSize           *NM   `hl7:"false,Dispense Package Size"`             // RXD-22
-	DispensePackageSizeUnit       *CWE  `hl7:"false,Dispense Package Size Unit"`        // RXD-23
-	DispensePackageMethod         *ID   `hl7:"false,Dispense Package Method"`           // RXD-24
-	SupplementaryCode             []CWE `hl7:"false,Supplementary Code"`                // RXD-25
-	InitiatingLocation            *CWE  `hl7:"false,Initiating Location"`               // RXD-26
-	PackagingAssemblyLocation     *CWE  `hl7:"false,Packaging/Assembly Location"`       // RXD-27
-	ActualDrugStrengthVolume      *NM   `hl7:"false,Actual Drug Strength Volume"`       // RXD-28
-	ActualDrugStrengthVolumeUnits *CWE  `hl7:"false,Actual Drug Strength Volume Units"` // RXD-29
-	DispenseToPharmacy            *CWE  `hl7:"false,Dispense To Pharmacy"`              // RXD-30
-	DispenseToPharmacyAddress     *XAD  `hl7:"false,Dispense To Pharmacy Address"`      // RXD-31
-	PharmacyOrderType             *ID   `hl7:"false,Pharmacy Order Type"`               // RXD-32
-	DispenseType                  *CWE  `hl7:"false,Dispense Type"`                     // RXD-33
+	DispenseSubIDCounter                                    *NM   `hl7:"true,Dispense Sub-ID Counter"`                                        // RXD-1
+	DispenseGiveCode                                        *CWE  `hl7:"true,Dispense/Give Code"`                                             // RXD-2
+	DateTimeDispensed                                       *DTM  `hl7:"true,Date/Time Dispensed"`                                            // RXD-3
+	ActualDispenseAmount                                    *NM   `hl7:"true,Actual Dispense Amount"`                                         // RXD-4
+	ActualDispenseUnits                                     *CWE  `hl7:"false,Actual Dispense Units"`                                         // RXD-5
+	ActualDosageForm                                        *CWE  `hl7:"false,Actual Dosage Form"`                                            // RXD-6
+	PrescriptionNumber                                      *ST   `hl7:"true,Prescription Number"`                                            // RXD-7
+	NumberOfRefillsRemaining                                *NM   `hl7:"false,Number Of Refills Remaining"`                                   // RXD-8
+	DispenseNotes                                           []ST  `hl7:"false,Dispense Notes"`                                                // RXD-9
+	DispensingProvider                                      []XCN `hl7:"false,Dispensing Provider"`                                           // RXD-10
+	SubstitutionStatus                                      *ID   `hl7:"false,Substitution Status"`                                           // RXD-11
+	TotalDailyDose                                          *CQ   `hl7:"false,Total Daily Dose"`                                              // RXD-12
+	DispenseToLocation                                      *LA2  `hl7:"false,Dispense-To Location"`                                          // RXD-13
+	NeedsHumanReview                                        *ID   `hl7:"false,Needs Human Review"`                                            // RXD-14
+	PharmacyTreatmentSupplierSSpecialDispensingInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Dispensing Instructions"` // RXD-15
+	ActualStrength                                          *NM   `hl7:"false,Actual Strength"`                                               // RXD-16
+	ActualStrengthUnit                                      *CWE  `hl7:"false,Actual Strength Unit"`                                          // RXD-17
+	SubstanceLotNumber                                      []ST  `hl7:"false,Substance Lot Number"`                                          // RXD-18
+	SubstanceExpirationDate                                 []DTM `hl7:"false,Substance Expiration Date"`                                     // RXD-19
+	SubstanceManufacturerName                               []CWE `hl7:"false,Substance Manufacturer Name"`                                   // RXD-20
+	Indication                                              []CWE `hl7:"false,Indication"`                                                    // RXD-21
+	DispensePackageSize                                     *NM   `hl7:"false,Dispense Package Size"`                                         // RXD-22
+	DispensePackageSizeUnit                                 *CWE  `hl7:"false,Dispense Package Size Unit"`                                    // RXD-23
+	DispensePackageMethod                                   *ID   `hl7:"false,Dispense Package Method"`                                       // RXD-24
+	SupplementaryCode                                       []CWE `hl7:"false,Supplementary Code"`                                            // RXD-25
+	InitiatingLocation                                      *CWE  `hl7:"false,Initiating Location"`                                           // RXD-26
+	PackagingAssemblyLocation                               *CWE  `hl7:"false,Packaging/Assembly Location"`                                   // RXD-27
+	ActualDrugStrengthVolume                                *NM   `hl7:"false,Actual Drug Strength Volume"`                                   // RXD-28
+	ActualDrugStrengthVolumeUnits                           *CWE  `hl7:"false,Actual Drug Strength Volume Units"`                             // RXD-29
+	DispenseToPharmacy                                      *CWE  `hl7:"false,Dispense To Pharmacy"`                                          // RXD-30
+	DispenseToPharmacyAddress                               *XAD  `hl7:"false,Dispense To Pharmacy Address"`                                  // RXD-31
+	PharmacyOrderType                                       *ID   `hl7:"false,Pharmacy Order Type"`                                           // RXD-32
+	DispenseType                                            *CWE  `hl7:"false,Dispense Type"`                                                 // RXD-33
 }
 
 func (s *RXD) SegmentName() string {
@@ -4714,51 +4711,50 @@ func (s *RXD) SegmentName() string {
 // RXE represents the corresponding HL7 segment.
 // Definition from HL7 2.6
 type RXE struct {
-	QuantityTiming                                          *TQ   `hl7:"false,Quantity/Timing"`                             // RXE-1
-	GiveCode                                                *CWE  `hl7:"true,Give Code"`                                    // RXE-2
-	GiveAmountMinimum                                       *NM   `hl7:"true,Give Amount - Minimum"`                        // RXE-3
-	GiveAmountMaximum                                       *NM   `hl7:"false,Give Amount - Maximum"`                       // RXE-4
-	GiveUnits                                               *CWE  `hl7:"true,Give Units"`                                   // RXE-5
-	GiveDosageForm                                          *CWE  `hl7:"false,Give Dosage Form"`                            // RXE-6
-	ProviderSAdministrationInstructions                     []CWE `hl7:"false,Provider'S Administration Instructions"`      // RXE-7
-	DeliverToLocation                                       *LA1  `hl7:"false,Deliver-To Location"`                         // RXE-8
-	SubstitutionStatus                                      *ID   `hl7:"false,Substitution Status"`                         // RXE-9
-	DispenseAmount                                          *NM   `hl7:"false,Dispense Amount"`                             // RXE-10
-	DispenseUnits                                           *CWE  `hl7:"false,Dispense Units"`                              // RXE-11
-	NumberOfRefills                                         *NM   `hl7:"false,Number Of Refills"`                           // RXE-12
-	OrderingProviderSDEANumber                              []XCN `hl7:"false,Ordering Provider'S DEA Number"`              // RXE-13
-	PharmacistTreatmentSupplierSVerifierID                  []XCN `hl7:"false,Pharmacist/Treatment Supplier'S Verifier ID"` // RXE-14
-	PrescriptionNumber                                      *ST   `hl7:"false,Prescription Number"`                         // RXE-15
-	NumberOfRefillsRemaining                                *NM   `hl7:"false,Number Of Refills Remaining"`                 // RXE-16
-	NumberOfRefillsDosesDispensed                           *NM   `hl7:"false,Number Of Refills/Doses Dispensed"`           // RXE-17
-	DTOfMostRecentRefillOrDoseDispensed                     *DTM  `hl7:"false,D/T Of Most Recent Refill Or Dose Dispensed"` // RXE-18
-	TotalDailyDose                                          *CQ   `hl7:"false,Total Daily Dose"`                            // RXE-19
-	NeedsHumanReview                                        *ID   `hl7:"false,Needs Human Review"`                          // RXE-20
-	PharmacyTreatmentSupplierSSpecialDispensingInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Dispensing Instructions
-        "` // RXE-21
-	GivePerTimeUnit                    *ST   `hl7:"false,Give Per (Time Unit)"`                 // RXE-22
-	GiveRateAmount                     *ST   `hl7:"false,Give Rate Amount"`                     // RXE-23
-	GiveRateUnits                      *CWE  `hl7:"false,Give Rate Units"`                      // RXE-24
-	GiveStrength                       *NM   `hl7:"false,Give Strength"`                        // RXE-25
-	GiveStrengthUnits                  *CWE  `hl7:"false,Give Strength Units"`                  // RXE-26
-	GiveIndication                     []CWE `hl7:"false,Give Indication"`                      // RXE-27
-	DispensePackageSize                *NM   `hl7:"false,Dispense Package Size"`                // RXE-28
-	DispensePackageSizeUnit            *CWE  `hl7:"false,Dispense Package Size Unit"`           // RXE-29
-	DispensePackageMethod              *ID   `hl7:"false,Dispense Package Method"`              // RXE-30
-	SupplementaryCode                  []CWE `hl7:"false,Supplementary Code"`                   // RXE-31
-	OriginalOrderDateTime              *DTM  `hl7:"false,Original Order Date/Time"`             // RXE-32
-	GiveDrugStrengthVolume             *NM   `hl7:"false,Give Drug Strength Volume"`            // RXE-33
-	GiveDrugStrengthVolumeUnits        *CWE  `hl7:"false,Give Drug Strength Volume Units"`      // RXE-34
-	ControlledSubstanceSchedule        *CWE  `hl7:"false,Controlled Substance Schedule"`        // RXE-35
-	FormularyStatus                    *ID   `hl7:"false,Formulary Status"`                     // RXE-36
-	PharmaceuticalSubstanceAlternative []CWE `hl7:"false,Pharmaceutical Substance Alternative"` // RXE-37
-	PharmacyOfMostRecentFill           *CWE  `hl7:"false,Pharmacy Of Most Recent Fill"`         // RXE-38
-	InitialDispenseAmount              *NM   `hl7:"false,Initial Dispense Amount"`              // RXE-39
-	DispensingPharmacy                 *CWE  `hl7:"false,Dispensing Pharmacy"`                  // RXE-40
-	DispensingPharmacyAddress          *XAD  `hl7:"false,Dispensing Pharmacy Address"`          // RXE-41
-	DeliverToPatientLocation           *PL   `hl7:"false,Deliver-To Patient Location"`          // RXE-42
-	DeliverToAddress                   *XAD  `hl7:"false,Deliver-To Address"`                   // RXE-43
-	PharmacyOrderType                  *ID   `hl7:"false,Pharmacy Order Type"`                  // RXE-44
+	QuantityTiming                                          *TQ   `hl7:"false,Quantity/Timing"`                                               // RXE-1
+	GiveCode                                                *CWE  `hl7:"true,Give Code"`                                                      // RXE-2
+	GiveAmountMinimum                                       *NM   `hl7:"true,Give Amount - Minimum"`                                          // RXE-3
+	GiveAmountMaximum                                       *NM   `hl7:"false,Give Amount - Maximum"`                                         // RXE-4
+	GiveUnits                                               *CWE  `hl7:"true,Give Units"`                                                     // RXE-5
+	GiveDosageForm                                          *CWE  `hl7:"false,Give Dosage Form"`                                              // RXE-6
+	ProviderSAdministrationInstructions                     []CWE `hl7:"false,Provider'S Administration Instructions"`                        // RXE-7
+	DeliverToLocation                                       *LA1  `hl7:"false,Deliver-To Location"`                                           // RXE-8
+	SubstitutionStatus                                      *ID   `hl7:"false,Substitution Status"`                                           // RXE-9
+	DispenseAmount                                          *NM   `hl7:"false,Dispense Amount"`                                               // RXE-10
+	DispenseUnits                                           *CWE  `hl7:"false,Dispense Units"`                                                // RXE-11
+	NumberOfRefills                                         *NM   `hl7:"false,Number Of Refills"`                                             // RXE-12
+	OrderingProviderSDEANumber                              []XCN `hl7:"false,Ordering Provider'S DEA Number"`                                // RXE-13
+	PharmacistTreatmentSupplierSVerifierID                  []XCN `hl7:"false,Pharmacist/Treatment Supplier'S Verifier ID"`                   // RXE-14
+	PrescriptionNumber                                      *ST   `hl7:"false,Prescription Number"`                                           // RXE-15
+	NumberOfRefillsRemaining                                *NM   `hl7:"false,Number Of Refills Remaining"`                                   // RXE-16
+	NumberOfRefillsDosesDispensed                           *NM   `hl7:"false,Number Of Refills/Doses Dispensed"`                             // RXE-17
+	DTOfMostRecentRefillOrDoseDispensed                     *DTM  `hl7:"false,D/T Of Most Recent Refill Or Dose Dispensed"`                   // RXE-18
+	TotalDailyDose                                          *CQ   `hl7:"false,Total Daily Dose"`                                              // RXE-19
+	NeedsHumanReview                                        *ID   `hl7:"false,Needs Human Review"`                                            // RXE-20
+	PharmacyTreatmentSupplierSSpecialDispensingInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Dispensing Instructions"` // RXE-21
+	GivePerTimeUnit                                         *ST   `hl7:"false,Give Per (Time Unit)"`                                          // RXE-22
+	GiveRateAmount                                          *ST   `hl7:"false,Give Rate Amount"`                                              // RXE-23
+	GiveRateUnits                                           *CWE  `hl7:"false,Give Rate Units"`                                               // RXE-24
+	GiveStrength                                            *NM   `hl7:"false,Give Strength"`                                                 // RXE-25
+	GiveStrengthUnits                                       *CWE  `hl7:"false,Give Strength Units"`                                           // RXE-26
+	GiveIndication                                          []CWE `hl7:"false,Give Indication"`                                               // RXE-27
+	DispensePackageSize                                     *NM   `hl7:"false,Dispense Package Size"`                                         // RXE-28
+	DispensePackageSizeUnit                                 *CWE  `hl7:"false,Dispense Package Size Unit"`                                    // RXE-29
+	DispensePackageMethod                                   *ID   `hl7:"false,Dispense Package Method"`                                       // RXE-30
+	SupplementaryCode                                       []CWE `hl7:"false,Supplementary Code"`                                            // RXE-31
+	OriginalOrderDateTime                                   *DTM  `hl7:"false,Original Order Date/Time"`                                      // RXE-32
+	GiveDrugStrengthVolume                                  *NM   `hl7:"false,Give Drug Strength Volume"`                                     // RXE-33
+	GiveDrugStrengthVolumeUnits                             *CWE  `hl7:"false,Give Drug Strength Volume Units"`                               // RXE-34
+	ControlledSubstanceSchedule                             *CWE  `hl7:"false,Controlled Substance Schedule"`                                 // RXE-35
+	FormularyStatus                                         *ID   `hl7:"false,Formulary Status"`                                              // RXE-36
+	PharmaceuticalSubstanceAlternative                      []CWE `hl7:"false,Pharmaceutical Substance Alternative"`                          // RXE-37
+	PharmacyOfMostRecentFill                                *CWE  `hl7:"false,Pharmacy Of Most Recent Fill"`                                  // RXE-38
+	InitialDispenseAmount                                   *NM   `hl7:"false,Initial Dispense Amount"`                                       // RXE-39
+	DispensingPharmacy                                      *CWE  `hl7:"false,Dispensing Pharmacy"`                                           // RXE-40
+	DispensingPharmacyAddress                               *XAD  `hl7:"false,Dispensing Pharmacy Address"`                                   // RXE-41
+	DeliverToPatientLocation                                *PL   `hl7:"false,Deliver-To Patient Location"`                                   // RXE-42
+	DeliverToAddress                                        *XAD  `hl7:"false,Deliver-To Address"`                                            // RXE-43
+	PharmacyOrderType                                       *ID   `hl7:"false,Pharmacy Order Type"`                                           // RXE-44
 }
 
 func (s *RXE) SegmentName() string {
@@ -4768,37 +4764,36 @@ func (s *RXE) SegmentName() string {
 // RXG represents the corresponding HL7 segment.
 // Definition from HL7 2.6
 type RXG struct {
-	GiveSubIDCounter                                            *NM   `hl7:"true,Give Sub-ID Counter"`      // RXG-1
-	DispenseSubIDCounter                                        *NM   `hl7:"false,Dispense Sub-ID Counter"` // RXG-2
-	QuantityTiming                                              *TQ   `hl7:"false,Quantity/Timing"`         // RXG-3
-	GiveCode                                                    *CWE  `hl7:"true,Give Code"`                // RXG-4
-	GiveAmountMinimum                                           *NM   `hl7:"true,Give Amount - Minimum"`    // RXG-5
-	GiveAmountMaximum                                           *NM   `hl7:"false,Give Amount - Maximum"`   // RXG-6
-	GiveUnits                                                   *CWE  `hl7:"true,Give Units"`               // RXG-7
-	GiveDosageForm                                              *CWE  `hl7:"false,Give Dosage Form"`        // RXG-8
-	AdministrationNotes                                         []CWE `hl7:"false,Administration Notes"`    // RXG-9
-	SubstitutionStatus                                          *ID   `hl7:"false,Substitution Status"`     // RXG-10
-	DispenseToLocation                                          *LA2  `hl7:"false,Dispense-To Location"`    // RXG-11
-	NeedsHumanReview                                            *ID   `hl7:"false,Needs Human Review"`      // RXG-12
-	PharmacyTreatmentSupplierSSpecialAdministrationInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Administration Instructions
-        "` // RXG-13
-	GivePerTimeUnit             *ST   `hl7:"false,Give Per (Time Unit)"`            // RXG-14
-	GiveRateAmount              *ST   `hl7:"false,Give Rate Amount"`                // RXG-15
-	GiveRateUnits               *CWE  `hl7:"false,Give Rate Units"`                 // RXG-16
-	GiveStrength                *NM   `hl7:"false,Give Strength"`                   // RXG-17
-	GiveStrengthUnits           *CWE  `hl7:"false,Give Strength Units"`             // RXG-18
-	SubstanceLotNumber          []ST  `hl7:"false,Substance Lot Number"`            // RXG-19
-	SubstanceExpirationDate     []DTM `hl7:"false,Substance Expiration Date"`       // RXG-20
-	SubstanceManufacturerName   []CWE `hl7:"false,Substance Manufacturer Name"`     // RXG-21
-	Indication                  []CWE `hl7:"false,Indication"`                      // RXG-22
-	GiveDrugStrengthVolume      *NM   `hl7:"false,Give Drug Strength Volume"`       // RXG-23
-	GiveDrugStrengthVolumeUnits *CWE  `hl7:"false,Give Drug Strength Volume Units"` // RXG-24
-	GiveBarcodeIdentifier       *CWE  `hl7:"false,Give Barcode Identifier"`         // RXG-25
-	PharmacyOrderType           *ID   `hl7:"false,Pharmacy Order Type"`             // RXG-26
-	DispenseToPharmacy          *CWE  `hl7:"false,Dispense To Pharmacy"`            // RXG-27
-	DispenseToPharmacyAddress   *XAD  `hl7:"false,Dispense To Pharmacy Address"`    // RXG-28
-	DeliverToPatientLocation    *PL   `hl7:"false,Deliver-To Patient Location"`     // RXG-29
-	DeliverToAddress            *XAD  `hl7:"false,Deliver-To Address"`              // RXG-30
+	GiveSubIDCounter                                            *NM   `hl7:"true,Give Sub-ID Counter"`                                                // RXG-1
+	DispenseSubIDCounter                                        *NM   `hl7:"false,Dispense Sub-ID Counter"`                                           // RXG-2
+	QuantityTiming                                              *TQ   `hl7:"false,Quantity/Timing"`                                                   // RXG-3
+	GiveCode                                                    *CWE  `hl7:"true,Give Code"`                                                          // RXG-4
+	GiveAmountMinimum                                           *NM   `hl7:"true,Give Amount - Minimum"`                                              // RXG-5
+	GiveAmountMaximum                                           *NM   `hl7:"false,Give Amount - Maximum"`                                             // RXG-6
+	GiveUnits                                                   *CWE  `hl7:"true,Give Units"`                                                         // RXG-7
+	GiveDosageForm                                              *CWE  `hl7:"false,Give Dosage Form"`                                                  // RXG-8
+	AdministrationNotes                                         []CWE `hl7:"false,Administration Notes"`                                              // RXG-9
+	SubstitutionStatus                                          *ID   `hl7:"false,Substitution Status"`                                               // RXG-10
+	DispenseToLocation                                          *LA2  `hl7:"false,Dispense-To Location"`                                              // RXG-11
+	NeedsHumanReview                                            *ID   `hl7:"false,Needs Human Review"`                                                // RXG-12
+	PharmacyTreatmentSupplierSSpecialAdministrationInstructions []CWE `hl7:"false,Pharmacy/Treatment Supplier'S Special Administration Instructions"` // RXG-13
+	GivePerTimeUnit                                             *ST   `hl7:"false,Give Per (Time Unit)"`                                              // RXG-14
+	GiveRateAmount                                              *ST   `hl7:"false,Give Rate Amount"`                                                  // RXG-15
+	GiveRateUnits                                               *CWE  `hl7:"false,Give Rate Units"`                                                   // RXG-16
+	GiveStrength                                                *NM   `hl7:"false,Give Strength"`                                                     // RXG-17
+	GiveStrengthUnits                                           *CWE  `hl7:"false,Give Strength Units"`                                               // RXG-18
+	SubstanceLotNumber                                          []ST  `hl7:"false,Substance Lot Number"`                                              // RXG-19
+	SubstanceExpirationDate                                     []DTM `hl7:"false,Substance Expiration Date"`                                         // RXG-20
+	SubstanceManufacturerName                                   []CWE `hl7:"false,Substance Manufacturer Name"`                                       // RXG-21
+	Indication                                                  []CWE `hl7:"false,Indication"`                                                        // RXG-22
+	GiveDrugStrengthVolume                                      *NM   `hl7:"false,Give Drug Strength Volume"`                                         // RXG-23
+	GiveDrugStrengthVolumeUnits                                 *CWE  `hl7:"false,Give Drug Strength Volume Units"`                                   // RXG-24
+	GiveBarcodeIdentifier                                       *CWE  `hl7:"false,Give Barcode Identifier"`                                           // RXG-25
+	PharmacyOrderType                                           *ID   `hl7:"false,Pharmacy Order Type"`                                               // RXG-26
+	DispenseToPharmacy                                          *CWE  `hl7:"false,Dispense To Pharmacy"`                                              // RXG-27
+	DispenseToPharmacyAddress                                   *XAD  `hl7:"false,Dispense To Pharmacy Address"`                                      // RXG-28
+	DeliverToPatientLocation                                    *PL   `hl7:"false,Deliver-To Patient Location"`                                       // RXG-29
+	DeliverToAddress                                            *XAD  `hl7:"false,Deliver-To Address"`                                                // RXG-30
 }
 
 func (s *RXG) SegmentName() string {
@@ -22940,7 +22935,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ABS returns the first ABS segment within the message, or nil if there isn't one.
 func (m *Message) ABS() (*ABS, error) {
-	ps, err := m.Parse("ABS")
+	ps, err := m.ParseWithTypes(Types, "ABS")
 	pst, ok := ps.(*ABS)
 	if ok {
 		return pst, err
@@ -22950,7 +22945,7 @@ func (m *Message) ABS() (*ABS, error) {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -22960,7 +22955,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -22970,7 +22965,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // ADJ returns the first ADJ segment within the message, or nil if there isn't one.
 func (m *Message) ADJ() (*ADJ, error) {
-	ps, err := m.Parse("ADJ")
+	ps, err := m.ParseWithTypes(Types, "ADJ")
 	pst, ok := ps.(*ADJ)
 	if ok {
 		return pst, err
@@ -22980,7 +22975,7 @@ func (m *Message) ADJ() (*ADJ, error) {
 
 // AFF returns the first AFF segment within the message, or nil if there isn't one.
 func (m *Message) AFF() (*AFF, error) {
-	ps, err := m.Parse("AFF")
+	ps, err := m.ParseWithTypes(Types, "AFF")
 	pst, ok := ps.(*AFF)
 	if ok {
 		return pst, err
@@ -22990,7 +22985,7 @@ func (m *Message) AFF() (*AFF, error) {
 
 // AIG returns the first AIG segment within the message, or nil if there isn't one.
 func (m *Message) AIG() (*AIG, error) {
-	ps, err := m.Parse("AIG")
+	ps, err := m.ParseWithTypes(Types, "AIG")
 	pst, ok := ps.(*AIG)
 	if ok {
 		return pst, err
@@ -23000,7 +22995,7 @@ func (m *Message) AIG() (*AIG, error) {
 
 // AIL returns the first AIL segment within the message, or nil if there isn't one.
 func (m *Message) AIL() (*AIL, error) {
-	ps, err := m.Parse("AIL")
+	ps, err := m.ParseWithTypes(Types, "AIL")
 	pst, ok := ps.(*AIL)
 	if ok {
 		return pst, err
@@ -23010,7 +23005,7 @@ func (m *Message) AIL() (*AIL, error) {
 
 // AIP returns the first AIP segment within the message, or nil if there isn't one.
 func (m *Message) AIP() (*AIP, error) {
-	ps, err := m.Parse("AIP")
+	ps, err := m.ParseWithTypes(Types, "AIP")
 	pst, ok := ps.(*AIP)
 	if ok {
 		return pst, err
@@ -23020,7 +23015,7 @@ func (m *Message) AIP() (*AIP, error) {
 
 // AIS returns the first AIS segment within the message, or nil if there isn't one.
 func (m *Message) AIS() (*AIS, error) {
-	ps, err := m.Parse("AIS")
+	ps, err := m.ParseWithTypes(Types, "AIS")
 	pst, ok := ps.(*AIS)
 	if ok {
 		return pst, err
@@ -23030,7 +23025,7 @@ func (m *Message) AIS() (*AIS, error) {
 
 // AL1 returns the first AL1 segment within the message, or nil if there isn't one.
 func (m *Message) AL1() (*AL1, error) {
-	ps, err := m.Parse("AL1")
+	ps, err := m.ParseWithTypes(Types, "AL1")
 	pst, ok := ps.(*AL1)
 	if ok {
 		return pst, err
@@ -23040,7 +23035,7 @@ func (m *Message) AL1() (*AL1, error) {
 
 // APR returns the first APR segment within the message, or nil if there isn't one.
 func (m *Message) APR() (*APR, error) {
-	ps, err := m.Parse("APR")
+	ps, err := m.ParseWithTypes(Types, "APR")
 	pst, ok := ps.(*APR)
 	if ok {
 		return pst, err
@@ -23050,7 +23045,7 @@ func (m *Message) APR() (*APR, error) {
 
 // ARQ returns the first ARQ segment within the message, or nil if there isn't one.
 func (m *Message) ARQ() (*ARQ, error) {
-	ps, err := m.Parse("ARQ")
+	ps, err := m.ParseWithTypes(Types, "ARQ")
 	pst, ok := ps.(*ARQ)
 	if ok {
 		return pst, err
@@ -23060,7 +23055,7 @@ func (m *Message) ARQ() (*ARQ, error) {
 
 // ARV returns the first ARV segment within the message, or nil if there isn't one.
 func (m *Message) ARV() (*ARV, error) {
-	ps, err := m.Parse("ARV")
+	ps, err := m.ParseWithTypes(Types, "ARV")
 	pst, ok := ps.(*ARV)
 	if ok {
 		return pst, err
@@ -23070,7 +23065,7 @@ func (m *Message) ARV() (*ARV, error) {
 
 // AUT returns the first AUT segment within the message, or nil if there isn't one.
 func (m *Message) AUT() (*AUT, error) {
-	ps, err := m.Parse("AUT")
+	ps, err := m.ParseWithTypes(Types, "AUT")
 	pst, ok := ps.(*AUT)
 	if ok {
 		return pst, err
@@ -23080,7 +23075,7 @@ func (m *Message) AUT() (*AUT, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -23090,7 +23085,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLC returns the first BLC segment within the message, or nil if there isn't one.
 func (m *Message) BLC() (*BLC, error) {
-	ps, err := m.Parse("BLC")
+	ps, err := m.ParseWithTypes(Types, "BLC")
 	pst, ok := ps.(*BLC)
 	if ok {
 		return pst, err
@@ -23100,7 +23095,7 @@ func (m *Message) BLC() (*BLC, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -23110,7 +23105,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BPO returns the first BPO segment within the message, or nil if there isn't one.
 func (m *Message) BPO() (*BPO, error) {
-	ps, err := m.Parse("BPO")
+	ps, err := m.ParseWithTypes(Types, "BPO")
 	pst, ok := ps.(*BPO)
 	if ok {
 		return pst, err
@@ -23120,7 +23115,7 @@ func (m *Message) BPO() (*BPO, error) {
 
 // BPX returns the first BPX segment within the message, or nil if there isn't one.
 func (m *Message) BPX() (*BPX, error) {
-	ps, err := m.Parse("BPX")
+	ps, err := m.ParseWithTypes(Types, "BPX")
 	pst, ok := ps.(*BPX)
 	if ok {
 		return pst, err
@@ -23130,7 +23125,7 @@ func (m *Message) BPX() (*BPX, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -23140,7 +23135,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // BTX returns the first BTX segment within the message, or nil if there isn't one.
 func (m *Message) BTX() (*BTX, error) {
-	ps, err := m.Parse("BTX")
+	ps, err := m.ParseWithTypes(Types, "BTX")
 	pst, ok := ps.(*BTX)
 	if ok {
 		return pst, err
@@ -23150,7 +23145,7 @@ func (m *Message) BTX() (*BTX, error) {
 
 // CDM returns the first CDM segment within the message, or nil if there isn't one.
 func (m *Message) CDM() (*CDM, error) {
-	ps, err := m.Parse("CDM")
+	ps, err := m.ParseWithTypes(Types, "CDM")
 	pst, ok := ps.(*CDM)
 	if ok {
 		return pst, err
@@ -23160,7 +23155,7 @@ func (m *Message) CDM() (*CDM, error) {
 
 // CER returns the first CER segment within the message, or nil if there isn't one.
 func (m *Message) CER() (*CER, error) {
-	ps, err := m.Parse("CER")
+	ps, err := m.ParseWithTypes(Types, "CER")
 	pst, ok := ps.(*CER)
 	if ok {
 		return pst, err
@@ -23170,7 +23165,7 @@ func (m *Message) CER() (*CER, error) {
 
 // CM0 returns the first CM0 segment within the message, or nil if there isn't one.
 func (m *Message) CM0() (*CM0, error) {
-	ps, err := m.Parse("CM0")
+	ps, err := m.ParseWithTypes(Types, "CM0")
 	pst, ok := ps.(*CM0)
 	if ok {
 		return pst, err
@@ -23180,7 +23175,7 @@ func (m *Message) CM0() (*CM0, error) {
 
 // CM1 returns the first CM1 segment within the message, or nil if there isn't one.
 func (m *Message) CM1() (*CM1, error) {
-	ps, err := m.Parse("CM1")
+	ps, err := m.ParseWithTypes(Types, "CM1")
 	pst, ok := ps.(*CM1)
 	if ok {
 		return pst, err
@@ -23190,7 +23185,7 @@ func (m *Message) CM1() (*CM1, error) {
 
 // CM2 returns the first CM2 segment within the message, or nil if there isn't one.
 func (m *Message) CM2() (*CM2, error) {
-	ps, err := m.Parse("CM2")
+	ps, err := m.ParseWithTypes(Types, "CM2")
 	pst, ok := ps.(*CM2)
 	if ok {
 		return pst, err
@@ -23200,7 +23195,7 @@ func (m *Message) CM2() (*CM2, error) {
 
 // CNS returns the first CNS segment within the message, or nil if there isn't one.
 func (m *Message) CNS() (*CNS, error) {
-	ps, err := m.Parse("CNS")
+	ps, err := m.ParseWithTypes(Types, "CNS")
 	pst, ok := ps.(*CNS)
 	if ok {
 		return pst, err
@@ -23210,7 +23205,7 @@ func (m *Message) CNS() (*CNS, error) {
 
 // CON returns the first CON segment within the message, or nil if there isn't one.
 func (m *Message) CON() (*CON, error) {
-	ps, err := m.Parse("CON")
+	ps, err := m.ParseWithTypes(Types, "CON")
 	pst, ok := ps.(*CON)
 	if ok {
 		return pst, err
@@ -23220,7 +23215,7 @@ func (m *Message) CON() (*CON, error) {
 
 // CSP returns the first CSP segment within the message, or nil if there isn't one.
 func (m *Message) CSP() (*CSP, error) {
-	ps, err := m.Parse("CSP")
+	ps, err := m.ParseWithTypes(Types, "CSP")
 	pst, ok := ps.(*CSP)
 	if ok {
 		return pst, err
@@ -23230,7 +23225,7 @@ func (m *Message) CSP() (*CSP, error) {
 
 // CSR returns the first CSR segment within the message, or nil if there isn't one.
 func (m *Message) CSR() (*CSR, error) {
-	ps, err := m.Parse("CSR")
+	ps, err := m.ParseWithTypes(Types, "CSR")
 	pst, ok := ps.(*CSR)
 	if ok {
 		return pst, err
@@ -23240,7 +23235,7 @@ func (m *Message) CSR() (*CSR, error) {
 
 // CSS returns the first CSS segment within the message, or nil if there isn't one.
 func (m *Message) CSS() (*CSS, error) {
-	ps, err := m.Parse("CSS")
+	ps, err := m.ParseWithTypes(Types, "CSS")
 	pst, ok := ps.(*CSS)
 	if ok {
 		return pst, err
@@ -23250,7 +23245,7 @@ func (m *Message) CSS() (*CSS, error) {
 
 // CTD returns the first CTD segment within the message, or nil if there isn't one.
 func (m *Message) CTD() (*CTD, error) {
-	ps, err := m.Parse("CTD")
+	ps, err := m.ParseWithTypes(Types, "CTD")
 	pst, ok := ps.(*CTD)
 	if ok {
 		return pst, err
@@ -23260,7 +23255,7 @@ func (m *Message) CTD() (*CTD, error) {
 
 // CTI returns the first CTI segment within the message, or nil if there isn't one.
 func (m *Message) CTI() (*CTI, error) {
-	ps, err := m.Parse("CTI")
+	ps, err := m.ParseWithTypes(Types, "CTI")
 	pst, ok := ps.(*CTI)
 	if ok {
 		return pst, err
@@ -23270,7 +23265,7 @@ func (m *Message) CTI() (*CTI, error) {
 
 // DB1 returns the first DB1 segment within the message, or nil if there isn't one.
 func (m *Message) DB1() (*DB1, error) {
-	ps, err := m.Parse("DB1")
+	ps, err := m.ParseWithTypes(Types, "DB1")
 	pst, ok := ps.(*DB1)
 	if ok {
 		return pst, err
@@ -23280,7 +23275,7 @@ func (m *Message) DB1() (*DB1, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -23290,7 +23285,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DMI returns the first DMI segment within the message, or nil if there isn't one.
 func (m *Message) DMI() (*DMI, error) {
-	ps, err := m.Parse("DMI")
+	ps, err := m.ParseWithTypes(Types, "DMI")
 	pst, ok := ps.(*DMI)
 	if ok {
 		return pst, err
@@ -23300,7 +23295,7 @@ func (m *Message) DMI() (*DMI, error) {
 
 // DRG returns the first DRG segment within the message, or nil if there isn't one.
 func (m *Message) DRG() (*DRG, error) {
-	ps, err := m.Parse("DRG")
+	ps, err := m.ParseWithTypes(Types, "DRG")
 	pst, ok := ps.(*DRG)
 	if ok {
 		return pst, err
@@ -23310,7 +23305,7 @@ func (m *Message) DRG() (*DRG, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -23320,7 +23315,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -23330,7 +23325,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // ECD returns the first ECD segment within the message, or nil if there isn't one.
 func (m *Message) ECD() (*ECD, error) {
-	ps, err := m.Parse("ECD")
+	ps, err := m.ParseWithTypes(Types, "ECD")
 	pst, ok := ps.(*ECD)
 	if ok {
 		return pst, err
@@ -23340,7 +23335,7 @@ func (m *Message) ECD() (*ECD, error) {
 
 // ECR returns the first ECR segment within the message, or nil if there isn't one.
 func (m *Message) ECR() (*ECR, error) {
-	ps, err := m.Parse("ECR")
+	ps, err := m.ParseWithTypes(Types, "ECR")
 	pst, ok := ps.(*ECR)
 	if ok {
 		return pst, err
@@ -23350,7 +23345,7 @@ func (m *Message) ECR() (*ECR, error) {
 
 // EDU returns the first EDU segment within the message, or nil if there isn't one.
 func (m *Message) EDU() (*EDU, error) {
-	ps, err := m.Parse("EDU")
+	ps, err := m.ParseWithTypes(Types, "EDU")
 	pst, ok := ps.(*EDU)
 	if ok {
 		return pst, err
@@ -23360,7 +23355,7 @@ func (m *Message) EDU() (*EDU, error) {
 
 // EQL returns the first EQL segment within the message, or nil if there isn't one.
 func (m *Message) EQL() (*EQL, error) {
-	ps, err := m.Parse("EQL")
+	ps, err := m.ParseWithTypes(Types, "EQL")
 	pst, ok := ps.(*EQL)
 	if ok {
 		return pst, err
@@ -23370,7 +23365,7 @@ func (m *Message) EQL() (*EQL, error) {
 
 // EQP returns the first EQP segment within the message, or nil if there isn't one.
 func (m *Message) EQP() (*EQP, error) {
-	ps, err := m.Parse("EQP")
+	ps, err := m.ParseWithTypes(Types, "EQP")
 	pst, ok := ps.(*EQP)
 	if ok {
 		return pst, err
@@ -23380,7 +23375,7 @@ func (m *Message) EQP() (*EQP, error) {
 
 // EQU returns the first EQU segment within the message, or nil if there isn't one.
 func (m *Message) EQU() (*EQU, error) {
-	ps, err := m.Parse("EQU")
+	ps, err := m.ParseWithTypes(Types, "EQU")
 	pst, ok := ps.(*EQU)
 	if ok {
 		return pst, err
@@ -23390,7 +23385,7 @@ func (m *Message) EQU() (*EQU, error) {
 
 // ERQ returns the first ERQ segment within the message, or nil if there isn't one.
 func (m *Message) ERQ() (*ERQ, error) {
-	ps, err := m.Parse("ERQ")
+	ps, err := m.ParseWithTypes(Types, "ERQ")
 	pst, ok := ps.(*ERQ)
 	if ok {
 		return pst, err
@@ -23400,7 +23395,7 @@ func (m *Message) ERQ() (*ERQ, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -23410,7 +23405,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -23420,7 +23415,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FAC returns the first FAC segment within the message, or nil if there isn't one.
 func (m *Message) FAC() (*FAC, error) {
-	ps, err := m.Parse("FAC")
+	ps, err := m.ParseWithTypes(Types, "FAC")
 	pst, ok := ps.(*FAC)
 	if ok {
 		return pst, err
@@ -23430,7 +23425,7 @@ func (m *Message) FAC() (*FAC, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -23440,7 +23435,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -23450,7 +23445,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -23460,7 +23455,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GOL returns the first GOL segment within the message, or nil if there isn't one.
 func (m *Message) GOL() (*GOL, error) {
-	ps, err := m.Parse("GOL")
+	ps, err := m.ParseWithTypes(Types, "GOL")
 	pst, ok := ps.(*GOL)
 	if ok {
 		return pst, err
@@ -23470,7 +23465,7 @@ func (m *Message) GOL() (*GOL, error) {
 
 // GP1 returns the first GP1 segment within the message, or nil if there isn't one.
 func (m *Message) GP1() (*GP1, error) {
-	ps, err := m.Parse("GP1")
+	ps, err := m.ParseWithTypes(Types, "GP1")
 	pst, ok := ps.(*GP1)
 	if ok {
 		return pst, err
@@ -23480,7 +23475,7 @@ func (m *Message) GP1() (*GP1, error) {
 
 // GP2 returns the first GP2 segment within the message, or nil if there isn't one.
 func (m *Message) GP2() (*GP2, error) {
-	ps, err := m.Parse("GP2")
+	ps, err := m.ParseWithTypes(Types, "GP2")
 	pst, ok := ps.(*GP2)
 	if ok {
 		return pst, err
@@ -23490,7 +23485,7 @@ func (m *Message) GP2() (*GP2, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -23500,7 +23495,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IAM returns the first IAM segment within the message, or nil if there isn't one.
 func (m *Message) IAM() (*IAM, error) {
-	ps, err := m.Parse("IAM")
+	ps, err := m.ParseWithTypes(Types, "IAM")
 	pst, ok := ps.(*IAM)
 	if ok {
 		return pst, err
@@ -23510,7 +23505,7 @@ func (m *Message) IAM() (*IAM, error) {
 
 // IIM returns the first IIM segment within the message, or nil if there isn't one.
 func (m *Message) IIM() (*IIM, error) {
-	ps, err := m.Parse("IIM")
+	ps, err := m.ParseWithTypes(Types, "IIM")
 	pst, ok := ps.(*IIM)
 	if ok {
 		return pst, err
@@ -23520,7 +23515,7 @@ func (m *Message) IIM() (*IIM, error) {
 
 // ILT returns the first ILT segment within the message, or nil if there isn't one.
 func (m *Message) ILT() (*ILT, error) {
-	ps, err := m.Parse("ILT")
+	ps, err := m.ParseWithTypes(Types, "ILT")
 	pst, ok := ps.(*ILT)
 	if ok {
 		return pst, err
@@ -23530,7 +23525,7 @@ func (m *Message) ILT() (*ILT, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -23540,7 +23535,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // IN2 returns the first IN2 segment within the message, or nil if there isn't one.
 func (m *Message) IN2() (*IN2, error) {
-	ps, err := m.Parse("IN2")
+	ps, err := m.ParseWithTypes(Types, "IN2")
 	pst, ok := ps.(*IN2)
 	if ok {
 		return pst, err
@@ -23550,7 +23545,7 @@ func (m *Message) IN2() (*IN2, error) {
 
 // IN3 returns the first IN3 segment within the message, or nil if there isn't one.
 func (m *Message) IN3() (*IN3, error) {
-	ps, err := m.Parse("IN3")
+	ps, err := m.ParseWithTypes(Types, "IN3")
 	pst, ok := ps.(*IN3)
 	if ok {
 		return pst, err
@@ -23560,7 +23555,7 @@ func (m *Message) IN3() (*IN3, error) {
 
 // INV returns the first INV segment within the message, or nil if there isn't one.
 func (m *Message) INV() (*INV, error) {
-	ps, err := m.Parse("INV")
+	ps, err := m.ParseWithTypes(Types, "INV")
 	pst, ok := ps.(*INV)
 	if ok {
 		return pst, err
@@ -23570,7 +23565,7 @@ func (m *Message) INV() (*INV, error) {
 
 // IPC returns the first IPC segment within the message, or nil if there isn't one.
 func (m *Message) IPC() (*IPC, error) {
-	ps, err := m.Parse("IPC")
+	ps, err := m.ParseWithTypes(Types, "IPC")
 	pst, ok := ps.(*IPC)
 	if ok {
 		return pst, err
@@ -23580,7 +23575,7 @@ func (m *Message) IPC() (*IPC, error) {
 
 // IPR returns the first IPR segment within the message, or nil if there isn't one.
 func (m *Message) IPR() (*IPR, error) {
-	ps, err := m.Parse("IPR")
+	ps, err := m.ParseWithTypes(Types, "IPR")
 	pst, ok := ps.(*IPR)
 	if ok {
 		return pst, err
@@ -23590,7 +23585,7 @@ func (m *Message) IPR() (*IPR, error) {
 
 // ISD returns the first ISD segment within the message, or nil if there isn't one.
 func (m *Message) ISD() (*ISD, error) {
-	ps, err := m.Parse("ISD")
+	ps, err := m.ParseWithTypes(Types, "ISD")
 	pst, ok := ps.(*ISD)
 	if ok {
 		return pst, err
@@ -23600,7 +23595,7 @@ func (m *Message) ISD() (*ISD, error) {
 
 // ITM returns the first ITM segment within the message, or nil if there isn't one.
 func (m *Message) ITM() (*ITM, error) {
-	ps, err := m.Parse("ITM")
+	ps, err := m.ParseWithTypes(Types, "ITM")
 	pst, ok := ps.(*ITM)
 	if ok {
 		return pst, err
@@ -23610,7 +23605,7 @@ func (m *Message) ITM() (*ITM, error) {
 
 // IVC returns the first IVC segment within the message, or nil if there isn't one.
 func (m *Message) IVC() (*IVC, error) {
-	ps, err := m.Parse("IVC")
+	ps, err := m.ParseWithTypes(Types, "IVC")
 	pst, ok := ps.(*IVC)
 	if ok {
 		return pst, err
@@ -23620,7 +23615,7 @@ func (m *Message) IVC() (*IVC, error) {
 
 // IVT returns the first IVT segment within the message, or nil if there isn't one.
 func (m *Message) IVT() (*IVT, error) {
-	ps, err := m.Parse("IVT")
+	ps, err := m.ParseWithTypes(Types, "IVT")
 	pst, ok := ps.(*IVT)
 	if ok {
 		return pst, err
@@ -23630,7 +23625,7 @@ func (m *Message) IVT() (*IVT, error) {
 
 // LAN returns the first LAN segment within the message, or nil if there isn't one.
 func (m *Message) LAN() (*LAN, error) {
-	ps, err := m.Parse("LAN")
+	ps, err := m.ParseWithTypes(Types, "LAN")
 	pst, ok := ps.(*LAN)
 	if ok {
 		return pst, err
@@ -23640,7 +23635,7 @@ func (m *Message) LAN() (*LAN, error) {
 
 // LCC returns the first LCC segment within the message, or nil if there isn't one.
 func (m *Message) LCC() (*LCC, error) {
-	ps, err := m.Parse("LCC")
+	ps, err := m.ParseWithTypes(Types, "LCC")
 	pst, ok := ps.(*LCC)
 	if ok {
 		return pst, err
@@ -23650,7 +23645,7 @@ func (m *Message) LCC() (*LCC, error) {
 
 // LCH returns the first LCH segment within the message, or nil if there isn't one.
 func (m *Message) LCH() (*LCH, error) {
-	ps, err := m.Parse("LCH")
+	ps, err := m.ParseWithTypes(Types, "LCH")
 	pst, ok := ps.(*LCH)
 	if ok {
 		return pst, err
@@ -23660,7 +23655,7 @@ func (m *Message) LCH() (*LCH, error) {
 
 // LDP returns the first LDP segment within the message, or nil if there isn't one.
 func (m *Message) LDP() (*LDP, error) {
-	ps, err := m.Parse("LDP")
+	ps, err := m.ParseWithTypes(Types, "LDP")
 	pst, ok := ps.(*LDP)
 	if ok {
 		return pst, err
@@ -23670,7 +23665,7 @@ func (m *Message) LDP() (*LDP, error) {
 
 // LOC returns the first LOC segment within the message, or nil if there isn't one.
 func (m *Message) LOC() (*LOC, error) {
-	ps, err := m.Parse("LOC")
+	ps, err := m.ParseWithTypes(Types, "LOC")
 	pst, ok := ps.(*LOC)
 	if ok {
 		return pst, err
@@ -23680,7 +23675,7 @@ func (m *Message) LOC() (*LOC, error) {
 
 // LRL returns the first LRL segment within the message, or nil if there isn't one.
 func (m *Message) LRL() (*LRL, error) {
-	ps, err := m.Parse("LRL")
+	ps, err := m.ParseWithTypes(Types, "LRL")
 	pst, ok := ps.(*LRL)
 	if ok {
 		return pst, err
@@ -23690,7 +23685,7 @@ func (m *Message) LRL() (*LRL, error) {
 
 // MFA returns the first MFA segment within the message, or nil if there isn't one.
 func (m *Message) MFA() (*MFA, error) {
-	ps, err := m.Parse("MFA")
+	ps, err := m.ParseWithTypes(Types, "MFA")
 	pst, ok := ps.(*MFA)
 	if ok {
 		return pst, err
@@ -23700,7 +23695,7 @@ func (m *Message) MFA() (*MFA, error) {
 
 // MFE returns the first MFE segment within the message, or nil if there isn't one.
 func (m *Message) MFE() (*MFE, error) {
-	ps, err := m.Parse("MFE")
+	ps, err := m.ParseWithTypes(Types, "MFE")
 	pst, ok := ps.(*MFE)
 	if ok {
 		return pst, err
@@ -23710,7 +23705,7 @@ func (m *Message) MFE() (*MFE, error) {
 
 // MFI returns the first MFI segment within the message, or nil if there isn't one.
 func (m *Message) MFI() (*MFI, error) {
-	ps, err := m.Parse("MFI")
+	ps, err := m.ParseWithTypes(Types, "MFI")
 	pst, ok := ps.(*MFI)
 	if ok {
 		return pst, err
@@ -23720,7 +23715,7 @@ func (m *Message) MFI() (*MFI, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -23730,7 +23725,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -23740,7 +23735,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -23750,7 +23745,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -23760,7 +23755,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NDS returns the first NDS segment within the message, or nil if there isn't one.
 func (m *Message) NDS() (*NDS, error) {
-	ps, err := m.Parse("NDS")
+	ps, err := m.ParseWithTypes(Types, "NDS")
 	pst, ok := ps.(*NDS)
 	if ok {
 		return pst, err
@@ -23770,7 +23765,7 @@ func (m *Message) NDS() (*NDS, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -23780,7 +23775,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -23790,7 +23785,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -23800,7 +23795,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -23810,7 +23805,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -23820,7 +23815,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -23830,7 +23825,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -23840,7 +23835,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ODS returns the first ODS segment within the message, or nil if there isn't one.
 func (m *Message) ODS() (*ODS, error) {
-	ps, err := m.Parse("ODS")
+	ps, err := m.ParseWithTypes(Types, "ODS")
 	pst, ok := ps.(*ODS)
 	if ok {
 		return pst, err
@@ -23850,7 +23845,7 @@ func (m *Message) ODS() (*ODS, error) {
 
 // ODT returns the first ODT segment within the message, or nil if there isn't one.
 func (m *Message) ODT() (*ODT, error) {
-	ps, err := m.Parse("ODT")
+	ps, err := m.ParseWithTypes(Types, "ODT")
 	pst, ok := ps.(*ODT)
 	if ok {
 		return pst, err
@@ -23860,7 +23855,7 @@ func (m *Message) ODT() (*ODT, error) {
 
 // OM1 returns the first OM1 segment within the message, or nil if there isn't one.
 func (m *Message) OM1() (*OM1, error) {
-	ps, err := m.Parse("OM1")
+	ps, err := m.ParseWithTypes(Types, "OM1")
 	pst, ok := ps.(*OM1)
 	if ok {
 		return pst, err
@@ -23870,7 +23865,7 @@ func (m *Message) OM1() (*OM1, error) {
 
 // OM2 returns the first OM2 segment within the message, or nil if there isn't one.
 func (m *Message) OM2() (*OM2, error) {
-	ps, err := m.Parse("OM2")
+	ps, err := m.ParseWithTypes(Types, "OM2")
 	pst, ok := ps.(*OM2)
 	if ok {
 		return pst, err
@@ -23880,7 +23875,7 @@ func (m *Message) OM2() (*OM2, error) {
 
 // OM3 returns the first OM3 segment within the message, or nil if there isn't one.
 func (m *Message) OM3() (*OM3, error) {
-	ps, err := m.Parse("OM3")
+	ps, err := m.ParseWithTypes(Types, "OM3")
 	pst, ok := ps.(*OM3)
 	if ok {
 		return pst, err
@@ -23890,7 +23885,7 @@ func (m *Message) OM3() (*OM3, error) {
 
 // OM4 returns the first OM4 segment within the message, or nil if there isn't one.
 func (m *Message) OM4() (*OM4, error) {
-	ps, err := m.Parse("OM4")
+	ps, err := m.ParseWithTypes(Types, "OM4")
 	pst, ok := ps.(*OM4)
 	if ok {
 		return pst, err
@@ -23900,7 +23895,7 @@ func (m *Message) OM4() (*OM4, error) {
 
 // OM5 returns the first OM5 segment within the message, or nil if there isn't one.
 func (m *Message) OM5() (*OM5, error) {
-	ps, err := m.Parse("OM5")
+	ps, err := m.ParseWithTypes(Types, "OM5")
 	pst, ok := ps.(*OM5)
 	if ok {
 		return pst, err
@@ -23910,7 +23905,7 @@ func (m *Message) OM5() (*OM5, error) {
 
 // OM6 returns the first OM6 segment within the message, or nil if there isn't one.
 func (m *Message) OM6() (*OM6, error) {
-	ps, err := m.Parse("OM6")
+	ps, err := m.ParseWithTypes(Types, "OM6")
 	pst, ok := ps.(*OM6)
 	if ok {
 		return pst, err
@@ -23920,7 +23915,7 @@ func (m *Message) OM6() (*OM6, error) {
 
 // OM7 returns the first OM7 segment within the message, or nil if there isn't one.
 func (m *Message) OM7() (*OM7, error) {
-	ps, err := m.Parse("OM7")
+	ps, err := m.ParseWithTypes(Types, "OM7")
 	pst, ok := ps.(*OM7)
 	if ok {
 		return pst, err
@@ -23930,7 +23925,7 @@ func (m *Message) OM7() (*OM7, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -23940,7 +23935,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORG returns the first ORG segment within the message, or nil if there isn't one.
 func (m *Message) ORG() (*ORG, error) {
-	ps, err := m.Parse("ORG")
+	ps, err := m.ParseWithTypes(Types, "ORG")
 	pst, ok := ps.(*ORG)
 	if ok {
 		return pst, err
@@ -23950,7 +23945,7 @@ func (m *Message) ORG() (*ORG, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -23960,7 +23955,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // OVR returns the first OVR segment within the message, or nil if there isn't one.
 func (m *Message) OVR() (*OVR, error) {
-	ps, err := m.Parse("OVR")
+	ps, err := m.ParseWithTypes(Types, "OVR")
 	pst, ok := ps.(*OVR)
 	if ok {
 		return pst, err
@@ -23970,7 +23965,7 @@ func (m *Message) OVR() (*OVR, error) {
 
 // PCE returns the first PCE segment within the message, or nil if there isn't one.
 func (m *Message) PCE() (*PCE, error) {
-	ps, err := m.Parse("PCE")
+	ps, err := m.ParseWithTypes(Types, "PCE")
 	pst, ok := ps.(*PCE)
 	if ok {
 		return pst, err
@@ -23980,7 +23975,7 @@ func (m *Message) PCE() (*PCE, error) {
 
 // PCR returns the first PCR segment within the message, or nil if there isn't one.
 func (m *Message) PCR() (*PCR, error) {
-	ps, err := m.Parse("PCR")
+	ps, err := m.ParseWithTypes(Types, "PCR")
 	pst, ok := ps.(*PCR)
 	if ok {
 		return pst, err
@@ -23990,7 +23985,7 @@ func (m *Message) PCR() (*PCR, error) {
 
 // PD1 returns the first PD1 segment within the message, or nil if there isn't one.
 func (m *Message) PD1() (*PD1, error) {
-	ps, err := m.Parse("PD1")
+	ps, err := m.ParseWithTypes(Types, "PD1")
 	pst, ok := ps.(*PD1)
 	if ok {
 		return pst, err
@@ -24000,7 +23995,7 @@ func (m *Message) PD1() (*PD1, error) {
 
 // PDA returns the first PDA segment within the message, or nil if there isn't one.
 func (m *Message) PDA() (*PDA, error) {
-	ps, err := m.Parse("PDA")
+	ps, err := m.ParseWithTypes(Types, "PDA")
 	pst, ok := ps.(*PDA)
 	if ok {
 		return pst, err
@@ -24010,7 +24005,7 @@ func (m *Message) PDA() (*PDA, error) {
 
 // PDC returns the first PDC segment within the message, or nil if there isn't one.
 func (m *Message) PDC() (*PDC, error) {
-	ps, err := m.Parse("PDC")
+	ps, err := m.ParseWithTypes(Types, "PDC")
 	pst, ok := ps.(*PDC)
 	if ok {
 		return pst, err
@@ -24020,7 +24015,7 @@ func (m *Message) PDC() (*PDC, error) {
 
 // PEO returns the first PEO segment within the message, or nil if there isn't one.
 func (m *Message) PEO() (*PEO, error) {
-	ps, err := m.Parse("PEO")
+	ps, err := m.ParseWithTypes(Types, "PEO")
 	pst, ok := ps.(*PEO)
 	if ok {
 		return pst, err
@@ -24030,7 +24025,7 @@ func (m *Message) PEO() (*PEO, error) {
 
 // PES returns the first PES segment within the message, or nil if there isn't one.
 func (m *Message) PES() (*PES, error) {
-	ps, err := m.Parse("PES")
+	ps, err := m.ParseWithTypes(Types, "PES")
 	pst, ok := ps.(*PES)
 	if ok {
 		return pst, err
@@ -24040,7 +24035,7 @@ func (m *Message) PES() (*PES, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -24050,7 +24045,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PKG returns the first PKG segment within the message, or nil if there isn't one.
 func (m *Message) PKG() (*PKG, error) {
-	ps, err := m.Parse("PKG")
+	ps, err := m.ParseWithTypes(Types, "PKG")
 	pst, ok := ps.(*PKG)
 	if ok {
 		return pst, err
@@ -24060,7 +24055,7 @@ func (m *Message) PKG() (*PKG, error) {
 
 // PMT returns the first PMT segment within the message, or nil if there isn't one.
 func (m *Message) PMT() (*PMT, error) {
-	ps, err := m.Parse("PMT")
+	ps, err := m.ParseWithTypes(Types, "PMT")
 	pst, ok := ps.(*PMT)
 	if ok {
 		return pst, err
@@ -24070,7 +24065,7 @@ func (m *Message) PMT() (*PMT, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -24080,7 +24075,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PRA returns the first PRA segment within the message, or nil if there isn't one.
 func (m *Message) PRA() (*PRA, error) {
-	ps, err := m.Parse("PRA")
+	ps, err := m.ParseWithTypes(Types, "PRA")
 	pst, ok := ps.(*PRA)
 	if ok {
 		return pst, err
@@ -24090,7 +24085,7 @@ func (m *Message) PRA() (*PRA, error) {
 
 // PRB returns the first PRB segment within the message, or nil if there isn't one.
 func (m *Message) PRB() (*PRB, error) {
-	ps, err := m.Parse("PRB")
+	ps, err := m.ParseWithTypes(Types, "PRB")
 	pst, ok := ps.(*PRB)
 	if ok {
 		return pst, err
@@ -24100,7 +24095,7 @@ func (m *Message) PRB() (*PRB, error) {
 
 // PRC returns the first PRC segment within the message, or nil if there isn't one.
 func (m *Message) PRC() (*PRC, error) {
-	ps, err := m.Parse("PRC")
+	ps, err := m.ParseWithTypes(Types, "PRC")
 	pst, ok := ps.(*PRC)
 	if ok {
 		return pst, err
@@ -24110,7 +24105,7 @@ func (m *Message) PRC() (*PRC, error) {
 
 // PRD returns the first PRD segment within the message, or nil if there isn't one.
 func (m *Message) PRD() (*PRD, error) {
-	ps, err := m.Parse("PRD")
+	ps, err := m.ParseWithTypes(Types, "PRD")
 	pst, ok := ps.(*PRD)
 	if ok {
 		return pst, err
@@ -24120,7 +24115,7 @@ func (m *Message) PRD() (*PRD, error) {
 
 // PSG returns the first PSG segment within the message, or nil if there isn't one.
 func (m *Message) PSG() (*PSG, error) {
-	ps, err := m.Parse("PSG")
+	ps, err := m.ParseWithTypes(Types, "PSG")
 	pst, ok := ps.(*PSG)
 	if ok {
 		return pst, err
@@ -24130,7 +24125,7 @@ func (m *Message) PSG() (*PSG, error) {
 
 // PSH returns the first PSH segment within the message, or nil if there isn't one.
 func (m *Message) PSH() (*PSH, error) {
-	ps, err := m.Parse("PSH")
+	ps, err := m.ParseWithTypes(Types, "PSH")
 	pst, ok := ps.(*PSH)
 	if ok {
 		return pst, err
@@ -24140,7 +24135,7 @@ func (m *Message) PSH() (*PSH, error) {
 
 // PSL returns the first PSL segment within the message, or nil if there isn't one.
 func (m *Message) PSL() (*PSL, error) {
-	ps, err := m.Parse("PSL")
+	ps, err := m.ParseWithTypes(Types, "PSL")
 	pst, ok := ps.(*PSL)
 	if ok {
 		return pst, err
@@ -24150,7 +24145,7 @@ func (m *Message) PSL() (*PSL, error) {
 
 // PSS returns the first PSS segment within the message, or nil if there isn't one.
 func (m *Message) PSS() (*PSS, error) {
-	ps, err := m.Parse("PSS")
+	ps, err := m.ParseWithTypes(Types, "PSS")
 	pst, ok := ps.(*PSS)
 	if ok {
 		return pst, err
@@ -24160,7 +24155,7 @@ func (m *Message) PSS() (*PSS, error) {
 
 // PTH returns the first PTH segment within the message, or nil if there isn't one.
 func (m *Message) PTH() (*PTH, error) {
-	ps, err := m.Parse("PTH")
+	ps, err := m.ParseWithTypes(Types, "PTH")
 	pst, ok := ps.(*PTH)
 	if ok {
 		return pst, err
@@ -24170,7 +24165,7 @@ func (m *Message) PTH() (*PTH, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -24180,7 +24175,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // PV2 returns the first PV2 segment within the message, or nil if there isn't one.
 func (m *Message) PV2() (*PV2, error) {
-	ps, err := m.Parse("PV2")
+	ps, err := m.ParseWithTypes(Types, "PV2")
 	pst, ok := ps.(*PV2)
 	if ok {
 		return pst, err
@@ -24190,7 +24185,7 @@ func (m *Message) PV2() (*PV2, error) {
 
 // PYE returns the first PYE segment within the message, or nil if there isn't one.
 func (m *Message) PYE() (*PYE, error) {
-	ps, err := m.Parse("PYE")
+	ps, err := m.ParseWithTypes(Types, "PYE")
 	pst, ok := ps.(*PYE)
 	if ok {
 		return pst, err
@@ -24200,7 +24195,7 @@ func (m *Message) PYE() (*PYE, error) {
 
 // QAK returns the first QAK segment within the message, or nil if there isn't one.
 func (m *Message) QAK() (*QAK, error) {
-	ps, err := m.Parse("QAK")
+	ps, err := m.ParseWithTypes(Types, "QAK")
 	pst, ok := ps.(*QAK)
 	if ok {
 		return pst, err
@@ -24210,7 +24205,7 @@ func (m *Message) QAK() (*QAK, error) {
 
 // QID returns the first QID segment within the message, or nil if there isn't one.
 func (m *Message) QID() (*QID, error) {
-	ps, err := m.Parse("QID")
+	ps, err := m.ParseWithTypes(Types, "QID")
 	pst, ok := ps.(*QID)
 	if ok {
 		return pst, err
@@ -24220,7 +24215,7 @@ func (m *Message) QID() (*QID, error) {
 
 // QPD returns the first QPD segment within the message, or nil if there isn't one.
 func (m *Message) QPD() (*QPD, error) {
-	ps, err := m.Parse("QPD")
+	ps, err := m.ParseWithTypes(Types, "QPD")
 	pst, ok := ps.(*QPD)
 	if ok {
 		return pst, err
@@ -24230,7 +24225,7 @@ func (m *Message) QPD() (*QPD, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -24240,7 +24235,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -24250,7 +24245,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // QRI returns the first QRI segment within the message, or nil if there isn't one.
 func (m *Message) QRI() (*QRI, error) {
-	ps, err := m.Parse("QRI")
+	ps, err := m.ParseWithTypes(Types, "QRI")
 	pst, ok := ps.(*QRI)
 	if ok {
 		return pst, err
@@ -24260,7 +24255,7 @@ func (m *Message) QRI() (*QRI, error) {
 
 // RCP returns the first RCP segment within the message, or nil if there isn't one.
 func (m *Message) RCP() (*RCP, error) {
-	ps, err := m.Parse("RCP")
+	ps, err := m.ParseWithTypes(Types, "RCP")
 	pst, ok := ps.(*RCP)
 	if ok {
 		return pst, err
@@ -24270,7 +24265,7 @@ func (m *Message) RCP() (*RCP, error) {
 
 // RDF returns the first RDF segment within the message, or nil if there isn't one.
 func (m *Message) RDF() (*RDF, error) {
-	ps, err := m.Parse("RDF")
+	ps, err := m.ParseWithTypes(Types, "RDF")
 	pst, ok := ps.(*RDF)
 	if ok {
 		return pst, err
@@ -24280,7 +24275,7 @@ func (m *Message) RDF() (*RDF, error) {
 
 // RDT returns the first RDT segment within the message, or nil if there isn't one.
 func (m *Message) RDT() (*RDT, error) {
-	ps, err := m.Parse("RDT")
+	ps, err := m.ParseWithTypes(Types, "RDT")
 	pst, ok := ps.(*RDT)
 	if ok {
 		return pst, err
@@ -24290,7 +24285,7 @@ func (m *Message) RDT() (*RDT, error) {
 
 // REL returns the first REL segment within the message, or nil if there isn't one.
 func (m *Message) REL() (*REL, error) {
-	ps, err := m.Parse("REL")
+	ps, err := m.ParseWithTypes(Types, "REL")
 	pst, ok := ps.(*REL)
 	if ok {
 		return pst, err
@@ -24300,7 +24295,7 @@ func (m *Message) REL() (*REL, error) {
 
 // RF1 returns the first RF1 segment within the message, or nil if there isn't one.
 func (m *Message) RF1() (*RF1, error) {
-	ps, err := m.Parse("RF1")
+	ps, err := m.ParseWithTypes(Types, "RF1")
 	pst, ok := ps.(*RF1)
 	if ok {
 		return pst, err
@@ -24310,7 +24305,7 @@ func (m *Message) RF1() (*RF1, error) {
 
 // RFI returns the first RFI segment within the message, or nil if there isn't one.
 func (m *Message) RFI() (*RFI, error) {
-	ps, err := m.Parse("RFI")
+	ps, err := m.ParseWithTypes(Types, "RFI")
 	pst, ok := ps.(*RFI)
 	if ok {
 		return pst, err
@@ -24320,7 +24315,7 @@ func (m *Message) RFI() (*RFI, error) {
 
 // RGS returns the first RGS segment within the message, or nil if there isn't one.
 func (m *Message) RGS() (*RGS, error) {
-	ps, err := m.Parse("RGS")
+	ps, err := m.ParseWithTypes(Types, "RGS")
 	pst, ok := ps.(*RGS)
 	if ok {
 		return pst, err
@@ -24330,7 +24325,7 @@ func (m *Message) RGS() (*RGS, error) {
 
 // RMI returns the first RMI segment within the message, or nil if there isn't one.
 func (m *Message) RMI() (*RMI, error) {
-	ps, err := m.Parse("RMI")
+	ps, err := m.ParseWithTypes(Types, "RMI")
 	pst, ok := ps.(*RMI)
 	if ok {
 		return pst, err
@@ -24340,7 +24335,7 @@ func (m *Message) RMI() (*RMI, error) {
 
 // ROL returns the first ROL segment within the message, or nil if there isn't one.
 func (m *Message) ROL() (*ROL, error) {
-	ps, err := m.Parse("ROL")
+	ps, err := m.ParseWithTypes(Types, "ROL")
 	pst, ok := ps.(*ROL)
 	if ok {
 		return pst, err
@@ -24350,7 +24345,7 @@ func (m *Message) ROL() (*ROL, error) {
 
 // RQ1 returns the first RQ1 segment within the message, or nil if there isn't one.
 func (m *Message) RQ1() (*RQ1, error) {
-	ps, err := m.Parse("RQ1")
+	ps, err := m.ParseWithTypes(Types, "RQ1")
 	pst, ok := ps.(*RQ1)
 	if ok {
 		return pst, err
@@ -24360,7 +24355,7 @@ func (m *Message) RQ1() (*RQ1, error) {
 
 // RQD returns the first RQD segment within the message, or nil if there isn't one.
 func (m *Message) RQD() (*RQD, error) {
-	ps, err := m.Parse("RQD")
+	ps, err := m.ParseWithTypes(Types, "RQD")
 	pst, ok := ps.(*RQD)
 	if ok {
 		return pst, err
@@ -24370,7 +24365,7 @@ func (m *Message) RQD() (*RQD, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -24380,7 +24375,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // RXA returns the first RXA segment within the message, or nil if there isn't one.
 func (m *Message) RXA() (*RXA, error) {
-	ps, err := m.Parse("RXA")
+	ps, err := m.ParseWithTypes(Types, "RXA")
 	pst, ok := ps.(*RXA)
 	if ok {
 		return pst, err
@@ -24390,7 +24385,7 @@ func (m *Message) RXA() (*RXA, error) {
 
 // RXC returns the first RXC segment within the message, or nil if there isn't one.
 func (m *Message) RXC() (*RXC, error) {
-	ps, err := m.Parse("RXC")
+	ps, err := m.ParseWithTypes(Types, "RXC")
 	pst, ok := ps.(*RXC)
 	if ok {
 		return pst, err
@@ -24400,7 +24395,7 @@ func (m *Message) RXC() (*RXC, error) {
 
 // RXD returns the first RXD segment within the message, or nil if there isn't one.
 func (m *Message) RXD() (*RXD, error) {
-	ps, err := m.Parse("RXD")
+	ps, err := m.ParseWithTypes(Types, "RXD")
 	pst, ok := ps.(*RXD)
 	if ok {
 		return pst, err
@@ -24410,7 +24405,7 @@ func (m *Message) RXD() (*RXD, error) {
 
 // RXE returns the first RXE segment within the message, or nil if there isn't one.
 func (m *Message) RXE() (*RXE, error) {
-	ps, err := m.Parse("RXE")
+	ps, err := m.ParseWithTypes(Types, "RXE")
 	pst, ok := ps.(*RXE)
 	if ok {
 		return pst, err
@@ -24420,7 +24415,7 @@ func (m *Message) RXE() (*RXE, error) {
 
 // RXG returns the first RXG segment within the message, or nil if there isn't one.
 func (m *Message) RXG() (*RXG, error) {
-	ps, err := m.Parse("RXG")
+	ps, err := m.ParseWithTypes(Types, "RXG")
 	pst, ok := ps.(*RXG)
 	if ok {
 		return pst, err
@@ -24430,7 +24425,7 @@ func (m *Message) RXG() (*RXG, error) {
 
 // RXO returns the first RXO segment within the message, or nil if there isn't one.
 func (m *Message) RXO() (*RXO, error) {
-	ps, err := m.Parse("RXO")
+	ps, err := m.ParseWithTypes(Types, "RXO")
 	pst, ok := ps.(*RXO)
 	if ok {
 		return pst, err
@@ -24440,7 +24435,7 @@ func (m *Message) RXO() (*RXO, error) {
 
 // RXR returns the first RXR segment within the message, or nil if there isn't one.
 func (m *Message) RXR() (*RXR, error) {
-	ps, err := m.Parse("RXR")
+	ps, err := m.ParseWithTypes(Types, "RXR")
 	pst, ok := ps.(*RXR)
 	if ok {
 		return pst, err
@@ -24450,7 +24445,7 @@ func (m *Message) RXR() (*RXR, error) {
 
 // SAC returns the first SAC segment within the message, or nil if there isn't one.
 func (m *Message) SAC() (*SAC, error) {
-	ps, err := m.Parse("SAC")
+	ps, err := m.ParseWithTypes(Types, "SAC")
 	pst, ok := ps.(*SAC)
 	if ok {
 		return pst, err
@@ -24460,7 +24455,7 @@ func (m *Message) SAC() (*SAC, error) {
 
 // SCD returns the first SCD segment within the message, or nil if there isn't one.
 func (m *Message) SCD() (*SCD, error) {
-	ps, err := m.Parse("SCD")
+	ps, err := m.ParseWithTypes(Types, "SCD")
 	pst, ok := ps.(*SCD)
 	if ok {
 		return pst, err
@@ -24470,7 +24465,7 @@ func (m *Message) SCD() (*SCD, error) {
 
 // SCH returns the first SCH segment within the message, or nil if there isn't one.
 func (m *Message) SCH() (*SCH, error) {
-	ps, err := m.Parse("SCH")
+	ps, err := m.ParseWithTypes(Types, "SCH")
 	pst, ok := ps.(*SCH)
 	if ok {
 		return pst, err
@@ -24480,7 +24475,7 @@ func (m *Message) SCH() (*SCH, error) {
 
 // SCP returns the first SCP segment within the message, or nil if there isn't one.
 func (m *Message) SCP() (*SCP, error) {
-	ps, err := m.Parse("SCP")
+	ps, err := m.ParseWithTypes(Types, "SCP")
 	pst, ok := ps.(*SCP)
 	if ok {
 		return pst, err
@@ -24490,7 +24485,7 @@ func (m *Message) SCP() (*SCP, error) {
 
 // SDD returns the first SDD segment within the message, or nil if there isn't one.
 func (m *Message) SDD() (*SDD, error) {
-	ps, err := m.Parse("SDD")
+	ps, err := m.ParseWithTypes(Types, "SDD")
 	pst, ok := ps.(*SDD)
 	if ok {
 		return pst, err
@@ -24500,7 +24495,7 @@ func (m *Message) SDD() (*SDD, error) {
 
 // SFT returns the first SFT segment within the message, or nil if there isn't one.
 func (m *Message) SFT() (*SFT, error) {
-	ps, err := m.Parse("SFT")
+	ps, err := m.ParseWithTypes(Types, "SFT")
 	pst, ok := ps.(*SFT)
 	if ok {
 		return pst, err
@@ -24510,7 +24505,7 @@ func (m *Message) SFT() (*SFT, error) {
 
 // SID returns the first SID segment within the message, or nil if there isn't one.
 func (m *Message) SID() (*SID, error) {
-	ps, err := m.Parse("SID")
+	ps, err := m.ParseWithTypes(Types, "SID")
 	pst, ok := ps.(*SID)
 	if ok {
 		return pst, err
@@ -24520,7 +24515,7 @@ func (m *Message) SID() (*SID, error) {
 
 // SLT returns the first SLT segment within the message, or nil if there isn't one.
 func (m *Message) SLT() (*SLT, error) {
-	ps, err := m.Parse("SLT")
+	ps, err := m.ParseWithTypes(Types, "SLT")
 	pst, ok := ps.(*SLT)
 	if ok {
 		return pst, err
@@ -24530,7 +24525,7 @@ func (m *Message) SLT() (*SLT, error) {
 
 // SPM returns the first SPM segment within the message, or nil if there isn't one.
 func (m *Message) SPM() (*SPM, error) {
-	ps, err := m.Parse("SPM")
+	ps, err := m.ParseWithTypes(Types, "SPM")
 	pst, ok := ps.(*SPM)
 	if ok {
 		return pst, err
@@ -24540,7 +24535,7 @@ func (m *Message) SPM() (*SPM, error) {
 
 // SPR returns the first SPR segment within the message, or nil if there isn't one.
 func (m *Message) SPR() (*SPR, error) {
-	ps, err := m.Parse("SPR")
+	ps, err := m.ParseWithTypes(Types, "SPR")
 	pst, ok := ps.(*SPR)
 	if ok {
 		return pst, err
@@ -24550,7 +24545,7 @@ func (m *Message) SPR() (*SPR, error) {
 
 // STF returns the first STF segment within the message, or nil if there isn't one.
 func (m *Message) STF() (*STF, error) {
-	ps, err := m.Parse("STF")
+	ps, err := m.ParseWithTypes(Types, "STF")
 	pst, ok := ps.(*STF)
 	if ok {
 		return pst, err
@@ -24560,7 +24555,7 @@ func (m *Message) STF() (*STF, error) {
 
 // STZ returns the first STZ segment within the message, or nil if there isn't one.
 func (m *Message) STZ() (*STZ, error) {
-	ps, err := m.Parse("STZ")
+	ps, err := m.ParseWithTypes(Types, "STZ")
 	pst, ok := ps.(*STZ)
 	if ok {
 		return pst, err
@@ -24570,7 +24565,7 @@ func (m *Message) STZ() (*STZ, error) {
 
 // TCC returns the first TCC segment within the message, or nil if there isn't one.
 func (m *Message) TCC() (*TCC, error) {
-	ps, err := m.Parse("TCC")
+	ps, err := m.ParseWithTypes(Types, "TCC")
 	pst, ok := ps.(*TCC)
 	if ok {
 		return pst, err
@@ -24580,7 +24575,7 @@ func (m *Message) TCC() (*TCC, error) {
 
 // TCD returns the first TCD segment within the message, or nil if there isn't one.
 func (m *Message) TCD() (*TCD, error) {
-	ps, err := m.Parse("TCD")
+	ps, err := m.ParseWithTypes(Types, "TCD")
 	pst, ok := ps.(*TCD)
 	if ok {
 		return pst, err
@@ -24590,7 +24585,7 @@ func (m *Message) TCD() (*TCD, error) {
 
 // TQ1 returns the first TQ1 segment within the message, or nil if there isn't one.
 func (m *Message) TQ1() (*TQ1, error) {
-	ps, err := m.Parse("TQ1")
+	ps, err := m.ParseWithTypes(Types, "TQ1")
 	pst, ok := ps.(*TQ1)
 	if ok {
 		return pst, err
@@ -24600,7 +24595,7 @@ func (m *Message) TQ1() (*TQ1, error) {
 
 // TQ2 returns the first TQ2 segment within the message, or nil if there isn't one.
 func (m *Message) TQ2() (*TQ2, error) {
-	ps, err := m.Parse("TQ2")
+	ps, err := m.ParseWithTypes(Types, "TQ2")
 	pst, ok := ps.(*TQ2)
 	if ok {
 		return pst, err
@@ -24610,7 +24605,7 @@ func (m *Message) TQ2() (*TQ2, error) {
 
 // TXA returns the first TXA segment within the message, or nil if there isn't one.
 func (m *Message) TXA() (*TXA, error) {
-	ps, err := m.Parse("TXA")
+	ps, err := m.ParseWithTypes(Types, "TXA")
 	pst, ok := ps.(*TXA)
 	if ok {
 		return pst, err
@@ -24620,7 +24615,7 @@ func (m *Message) TXA() (*TXA, error) {
 
 // UAC returns the first UAC segment within the message, or nil if there isn't one.
 func (m *Message) UAC() (*UAC, error) {
-	ps, err := m.Parse("UAC")
+	ps, err := m.ParseWithTypes(Types, "UAC")
 	pst, ok := ps.(*UAC)
 	if ok {
 		return pst, err
@@ -24630,7 +24625,7 @@ func (m *Message) UAC() (*UAC, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -24640,7 +24635,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // UB2 returns the first UB2 segment within the message, or nil if there isn't one.
 func (m *Message) UB2() (*UB2, error) {
-	ps, err := m.Parse("UB2")
+	ps, err := m.ParseWithTypes(Types, "UB2")
 	pst, ok := ps.(*UB2)
 	if ok {
 		return pst, err
@@ -24650,7 +24645,7 @@ func (m *Message) UB2() (*UB2, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -24660,7 +24655,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -24670,7 +24665,7 @@ func (m *Message) URS() (*URS, error) {
 
 // VAR returns the first VAR segment within the message, or nil if there isn't one.
 func (m *Message) VAR() (*VAR, error) {
-	ps, err := m.Parse("VAR")
+	ps, err := m.ParseWithTypes(Types, "VAR")
 	pst, ok := ps.(*VAR)
 	if ok {
 		return pst, err
@@ -24680,7 +24675,7 @@ func (m *Message) VAR() (*VAR, error) {
 
 // VND returns the first VND segment within the message, or nil if there isn't one.
 func (m *Message) VND() (*VND, error) {
-	ps, err := m.Parse("VND")
+	ps, err := m.ParseWithTypes(Types, "VND")
 	pst, ok := ps.(*VND)
 	if ok {
 		return pst, err
@@ -24690,7 +24685,7 @@ func (m *Message) VND() (*VND, error) {
 
 // VTQ returns the first VTQ segment within the message, or nil if there isn't one.
 func (m *Message) VTQ() (*VTQ, error) {
-	ps, err := m.Parse("VTQ")
+	ps, err := m.ParseWithTypes(Types, "VTQ")
 	pst, ok := ps.(*VTQ)
 	if ok {
 		return pst, err
@@ -24700,7 +24695,7 @@ func (m *Message) VTQ() (*VTQ, error) {
 
 // ZL7 returns the first ZL7 segment within the message, or nil if there isn't one.
 func (m *Message) ZL7() (*ZL7, error) {
-	ps, err := m.Parse("ZL7")
+	ps, err := m.ParseWithTypes(Types, "ZL7")
 	pst, ok := ps.(*ZL7)
 	if ok {
 		return pst, err
@@ -24711,1239 +24706,1239 @@ func (m *Message) ZL7() (*ZL7, error) {
 // AllABS returns a slice containing all ABS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllABS() ([]*ABS, error) {
-	pss, err := m.ParseAll("ABS")
+	pss, err := m.ParseAllWithTypes(Types, "ABS")
 	return pss.([]*ABS), err
 }
 
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllADJ returns a slice containing all ADJ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADJ() ([]*ADJ, error) {
-	pss, err := m.ParseAll("ADJ")
+	pss, err := m.ParseAllWithTypes(Types, "ADJ")
 	return pss.([]*ADJ), err
 }
 
 // AllAFF returns a slice containing all AFF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAFF() ([]*AFF, error) {
-	pss, err := m.ParseAll("AFF")
+	pss, err := m.ParseAllWithTypes(Types, "AFF")
 	return pss.([]*AFF), err
 }
 
 // AllAIG returns a slice containing all AIG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIG() ([]*AIG, error) {
-	pss, err := m.ParseAll("AIG")
+	pss, err := m.ParseAllWithTypes(Types, "AIG")
 	return pss.([]*AIG), err
 }
 
 // AllAIL returns a slice containing all AIL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIL() ([]*AIL, error) {
-	pss, err := m.ParseAll("AIL")
+	pss, err := m.ParseAllWithTypes(Types, "AIL")
 	return pss.([]*AIL), err
 }
 
 // AllAIP returns a slice containing all AIP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIP() ([]*AIP, error) {
-	pss, err := m.ParseAll("AIP")
+	pss, err := m.ParseAllWithTypes(Types, "AIP")
 	return pss.([]*AIP), err
 }
 
 // AllAIS returns a slice containing all AIS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIS() ([]*AIS, error) {
-	pss, err := m.ParseAll("AIS")
+	pss, err := m.ParseAllWithTypes(Types, "AIS")
 	return pss.([]*AIS), err
 }
 
 // AllAL1 returns a slice containing all AL1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAL1() ([]*AL1, error) {
-	pss, err := m.ParseAll("AL1")
+	pss, err := m.ParseAllWithTypes(Types, "AL1")
 	return pss.([]*AL1), err
 }
 
 // AllAPR returns a slice containing all APR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAPR() ([]*APR, error) {
-	pss, err := m.ParseAll("APR")
+	pss, err := m.ParseAllWithTypes(Types, "APR")
 	return pss.([]*APR), err
 }
 
 // AllARQ returns a slice containing all ARQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARQ() ([]*ARQ, error) {
-	pss, err := m.ParseAll("ARQ")
+	pss, err := m.ParseAllWithTypes(Types, "ARQ")
 	return pss.([]*ARQ), err
 }
 
 // AllARV returns a slice containing all ARV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARV() ([]*ARV, error) {
-	pss, err := m.ParseAll("ARV")
+	pss, err := m.ParseAllWithTypes(Types, "ARV")
 	return pss.([]*ARV), err
 }
 
 // AllAUT returns a slice containing all AUT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAUT() ([]*AUT, error) {
-	pss, err := m.ParseAll("AUT")
+	pss, err := m.ParseAllWithTypes(Types, "AUT")
 	return pss.([]*AUT), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLC returns a slice containing all BLC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLC() ([]*BLC, error) {
-	pss, err := m.ParseAll("BLC")
+	pss, err := m.ParseAllWithTypes(Types, "BLC")
 	return pss.([]*BLC), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBPO returns a slice containing all BPO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPO() ([]*BPO, error) {
-	pss, err := m.ParseAll("BPO")
+	pss, err := m.ParseAllWithTypes(Types, "BPO")
 	return pss.([]*BPO), err
 }
 
 // AllBPX returns a slice containing all BPX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPX() ([]*BPX, error) {
-	pss, err := m.ParseAll("BPX")
+	pss, err := m.ParseAllWithTypes(Types, "BPX")
 	return pss.([]*BPX), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllBTX returns a slice containing all BTX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTX() ([]*BTX, error) {
-	pss, err := m.ParseAll("BTX")
+	pss, err := m.ParseAllWithTypes(Types, "BTX")
 	return pss.([]*BTX), err
 }
 
 // AllCDM returns a slice containing all CDM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCDM() ([]*CDM, error) {
-	pss, err := m.ParseAll("CDM")
+	pss, err := m.ParseAllWithTypes(Types, "CDM")
 	return pss.([]*CDM), err
 }
 
 // AllCER returns a slice containing all CER segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCER() ([]*CER, error) {
-	pss, err := m.ParseAll("CER")
+	pss, err := m.ParseAllWithTypes(Types, "CER")
 	return pss.([]*CER), err
 }
 
 // AllCM0 returns a slice containing all CM0 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM0() ([]*CM0, error) {
-	pss, err := m.ParseAll("CM0")
+	pss, err := m.ParseAllWithTypes(Types, "CM0")
 	return pss.([]*CM0), err
 }
 
 // AllCM1 returns a slice containing all CM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM1() ([]*CM1, error) {
-	pss, err := m.ParseAll("CM1")
+	pss, err := m.ParseAllWithTypes(Types, "CM1")
 	return pss.([]*CM1), err
 }
 
 // AllCM2 returns a slice containing all CM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM2() ([]*CM2, error) {
-	pss, err := m.ParseAll("CM2")
+	pss, err := m.ParseAllWithTypes(Types, "CM2")
 	return pss.([]*CM2), err
 }
 
 // AllCNS returns a slice containing all CNS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCNS() ([]*CNS, error) {
-	pss, err := m.ParseAll("CNS")
+	pss, err := m.ParseAllWithTypes(Types, "CNS")
 	return pss.([]*CNS), err
 }
 
 // AllCON returns a slice containing all CON segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCON() ([]*CON, error) {
-	pss, err := m.ParseAll("CON")
+	pss, err := m.ParseAllWithTypes(Types, "CON")
 	return pss.([]*CON), err
 }
 
 // AllCSP returns a slice containing all CSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSP() ([]*CSP, error) {
-	pss, err := m.ParseAll("CSP")
+	pss, err := m.ParseAllWithTypes(Types, "CSP")
 	return pss.([]*CSP), err
 }
 
 // AllCSR returns a slice containing all CSR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSR() ([]*CSR, error) {
-	pss, err := m.ParseAll("CSR")
+	pss, err := m.ParseAllWithTypes(Types, "CSR")
 	return pss.([]*CSR), err
 }
 
 // AllCSS returns a slice containing all CSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSS() ([]*CSS, error) {
-	pss, err := m.ParseAll("CSS")
+	pss, err := m.ParseAllWithTypes(Types, "CSS")
 	return pss.([]*CSS), err
 }
 
 // AllCTD returns a slice containing all CTD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTD() ([]*CTD, error) {
-	pss, err := m.ParseAll("CTD")
+	pss, err := m.ParseAllWithTypes(Types, "CTD")
 	return pss.([]*CTD), err
 }
 
 // AllCTI returns a slice containing all CTI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTI() ([]*CTI, error) {
-	pss, err := m.ParseAll("CTI")
+	pss, err := m.ParseAllWithTypes(Types, "CTI")
 	return pss.([]*CTI), err
 }
 
 // AllDB1 returns a slice containing all DB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDB1() ([]*DB1, error) {
-	pss, err := m.ParseAll("DB1")
+	pss, err := m.ParseAllWithTypes(Types, "DB1")
 	return pss.([]*DB1), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDMI returns a slice containing all DMI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDMI() ([]*DMI, error) {
-	pss, err := m.ParseAll("DMI")
+	pss, err := m.ParseAllWithTypes(Types, "DMI")
 	return pss.([]*DMI), err
 }
 
 // AllDRG returns a slice containing all DRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDRG() ([]*DRG, error) {
-	pss, err := m.ParseAll("DRG")
+	pss, err := m.ParseAllWithTypes(Types, "DRG")
 	return pss.([]*DRG), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllECD returns a slice containing all ECD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECD() ([]*ECD, error) {
-	pss, err := m.ParseAll("ECD")
+	pss, err := m.ParseAllWithTypes(Types, "ECD")
 	return pss.([]*ECD), err
 }
 
 // AllECR returns a slice containing all ECR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECR() ([]*ECR, error) {
-	pss, err := m.ParseAll("ECR")
+	pss, err := m.ParseAllWithTypes(Types, "ECR")
 	return pss.([]*ECR), err
 }
 
 // AllEDU returns a slice containing all EDU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEDU() ([]*EDU, error) {
-	pss, err := m.ParseAll("EDU")
+	pss, err := m.ParseAllWithTypes(Types, "EDU")
 	return pss.([]*EDU), err
 }
 
 // AllEQL returns a slice containing all EQL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQL() ([]*EQL, error) {
-	pss, err := m.ParseAll("EQL")
+	pss, err := m.ParseAllWithTypes(Types, "EQL")
 	return pss.([]*EQL), err
 }
 
 // AllEQP returns a slice containing all EQP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQP() ([]*EQP, error) {
-	pss, err := m.ParseAll("EQP")
+	pss, err := m.ParseAllWithTypes(Types, "EQP")
 	return pss.([]*EQP), err
 }
 
 // AllEQU returns a slice containing all EQU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQU() ([]*EQU, error) {
-	pss, err := m.ParseAll("EQU")
+	pss, err := m.ParseAllWithTypes(Types, "EQU")
 	return pss.([]*EQU), err
 }
 
 // AllERQ returns a slice containing all ERQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERQ() ([]*ERQ, error) {
-	pss, err := m.ParseAll("ERQ")
+	pss, err := m.ParseAllWithTypes(Types, "ERQ")
 	return pss.([]*ERQ), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFAC returns a slice containing all FAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFAC() ([]*FAC, error) {
-	pss, err := m.ParseAll("FAC")
+	pss, err := m.ParseAllWithTypes(Types, "FAC")
 	return pss.([]*FAC), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGOL returns a slice containing all GOL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGOL() ([]*GOL, error) {
-	pss, err := m.ParseAll("GOL")
+	pss, err := m.ParseAllWithTypes(Types, "GOL")
 	return pss.([]*GOL), err
 }
 
 // AllGP1 returns a slice containing all GP1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP1() ([]*GP1, error) {
-	pss, err := m.ParseAll("GP1")
+	pss, err := m.ParseAllWithTypes(Types, "GP1")
 	return pss.([]*GP1), err
 }
 
 // AllGP2 returns a slice containing all GP2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP2() ([]*GP2, error) {
-	pss, err := m.ParseAll("GP2")
+	pss, err := m.ParseAllWithTypes(Types, "GP2")
 	return pss.([]*GP2), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIAM returns a slice containing all IAM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIAM() ([]*IAM, error) {
-	pss, err := m.ParseAll("IAM")
+	pss, err := m.ParseAllWithTypes(Types, "IAM")
 	return pss.([]*IAM), err
 }
 
 // AllIIM returns a slice containing all IIM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIIM() ([]*IIM, error) {
-	pss, err := m.ParseAll("IIM")
+	pss, err := m.ParseAllWithTypes(Types, "IIM")
 	return pss.([]*IIM), err
 }
 
 // AllILT returns a slice containing all ILT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllILT() ([]*ILT, error) {
-	pss, err := m.ParseAll("ILT")
+	pss, err := m.ParseAllWithTypes(Types, "ILT")
 	return pss.([]*ILT), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllIN2 returns a slice containing all IN2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN2() ([]*IN2, error) {
-	pss, err := m.ParseAll("IN2")
+	pss, err := m.ParseAllWithTypes(Types, "IN2")
 	return pss.([]*IN2), err
 }
 
 // AllIN3 returns a slice containing all IN3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN3() ([]*IN3, error) {
-	pss, err := m.ParseAll("IN3")
+	pss, err := m.ParseAllWithTypes(Types, "IN3")
 	return pss.([]*IN3), err
 }
 
 // AllINV returns a slice containing all INV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllINV() ([]*INV, error) {
-	pss, err := m.ParseAll("INV")
+	pss, err := m.ParseAllWithTypes(Types, "INV")
 	return pss.([]*INV), err
 }
 
 // AllIPC returns a slice containing all IPC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIPC() ([]*IPC, error) {
-	pss, err := m.ParseAll("IPC")
+	pss, err := m.ParseAllWithTypes(Types, "IPC")
 	return pss.([]*IPC), err
 }
 
 // AllIPR returns a slice containing all IPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIPR() ([]*IPR, error) {
-	pss, err := m.ParseAll("IPR")
+	pss, err := m.ParseAllWithTypes(Types, "IPR")
 	return pss.([]*IPR), err
 }
 
 // AllISD returns a slice containing all ISD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllISD() ([]*ISD, error) {
-	pss, err := m.ParseAll("ISD")
+	pss, err := m.ParseAllWithTypes(Types, "ISD")
 	return pss.([]*ISD), err
 }
 
 // AllITM returns a slice containing all ITM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllITM() ([]*ITM, error) {
-	pss, err := m.ParseAll("ITM")
+	pss, err := m.ParseAllWithTypes(Types, "ITM")
 	return pss.([]*ITM), err
 }
 
 // AllIVC returns a slice containing all IVC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIVC() ([]*IVC, error) {
-	pss, err := m.ParseAll("IVC")
+	pss, err := m.ParseAllWithTypes(Types, "IVC")
 	return pss.([]*IVC), err
 }
 
 // AllIVT returns a slice containing all IVT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIVT() ([]*IVT, error) {
-	pss, err := m.ParseAll("IVT")
+	pss, err := m.ParseAllWithTypes(Types, "IVT")
 	return pss.([]*IVT), err
 }
 
 // AllLAN returns a slice containing all LAN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLAN() ([]*LAN, error) {
-	pss, err := m.ParseAll("LAN")
+	pss, err := m.ParseAllWithTypes(Types, "LAN")
 	return pss.([]*LAN), err
 }
 
 // AllLCC returns a slice containing all LCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCC() ([]*LCC, error) {
-	pss, err := m.ParseAll("LCC")
+	pss, err := m.ParseAllWithTypes(Types, "LCC")
 	return pss.([]*LCC), err
 }
 
 // AllLCH returns a slice containing all LCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCH() ([]*LCH, error) {
-	pss, err := m.ParseAll("LCH")
+	pss, err := m.ParseAllWithTypes(Types, "LCH")
 	return pss.([]*LCH), err
 }
 
 // AllLDP returns a slice containing all LDP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLDP() ([]*LDP, error) {
-	pss, err := m.ParseAll("LDP")
+	pss, err := m.ParseAllWithTypes(Types, "LDP")
 	return pss.([]*LDP), err
 }
 
 // AllLOC returns a slice containing all LOC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLOC() ([]*LOC, error) {
-	pss, err := m.ParseAll("LOC")
+	pss, err := m.ParseAllWithTypes(Types, "LOC")
 	return pss.([]*LOC), err
 }
 
 // AllLRL returns a slice containing all LRL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLRL() ([]*LRL, error) {
-	pss, err := m.ParseAll("LRL")
+	pss, err := m.ParseAllWithTypes(Types, "LRL")
 	return pss.([]*LRL), err
 }
 
 // AllMFA returns a slice containing all MFA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFA() ([]*MFA, error) {
-	pss, err := m.ParseAll("MFA")
+	pss, err := m.ParseAllWithTypes(Types, "MFA")
 	return pss.([]*MFA), err
 }
 
 // AllMFE returns a slice containing all MFE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFE() ([]*MFE, error) {
-	pss, err := m.ParseAll("MFE")
+	pss, err := m.ParseAllWithTypes(Types, "MFE")
 	return pss.([]*MFE), err
 }
 
 // AllMFI returns a slice containing all MFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFI() ([]*MFI, error) {
-	pss, err := m.ParseAll("MFI")
+	pss, err := m.ParseAllWithTypes(Types, "MFI")
 	return pss.([]*MFI), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNDS returns a slice containing all NDS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNDS() ([]*NDS, error) {
-	pss, err := m.ParseAll("NDS")
+	pss, err := m.ParseAllWithTypes(Types, "NDS")
 	return pss.([]*NDS), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllODS returns a slice containing all ODS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODS() ([]*ODS, error) {
-	pss, err := m.ParseAll("ODS")
+	pss, err := m.ParseAllWithTypes(Types, "ODS")
 	return pss.([]*ODS), err
 }
 
 // AllODT returns a slice containing all ODT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODT() ([]*ODT, error) {
-	pss, err := m.ParseAll("ODT")
+	pss, err := m.ParseAllWithTypes(Types, "ODT")
 	return pss.([]*ODT), err
 }
 
 // AllOM1 returns a slice containing all OM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM1() ([]*OM1, error) {
-	pss, err := m.ParseAll("OM1")
+	pss, err := m.ParseAllWithTypes(Types, "OM1")
 	return pss.([]*OM1), err
 }
 
 // AllOM2 returns a slice containing all OM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM2() ([]*OM2, error) {
-	pss, err := m.ParseAll("OM2")
+	pss, err := m.ParseAllWithTypes(Types, "OM2")
 	return pss.([]*OM2), err
 }
 
 // AllOM3 returns a slice containing all OM3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM3() ([]*OM3, error) {
-	pss, err := m.ParseAll("OM3")
+	pss, err := m.ParseAllWithTypes(Types, "OM3")
 	return pss.([]*OM3), err
 }
 
 // AllOM4 returns a slice containing all OM4 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM4() ([]*OM4, error) {
-	pss, err := m.ParseAll("OM4")
+	pss, err := m.ParseAllWithTypes(Types, "OM4")
 	return pss.([]*OM4), err
 }
 
 // AllOM5 returns a slice containing all OM5 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM5() ([]*OM5, error) {
-	pss, err := m.ParseAll("OM5")
+	pss, err := m.ParseAllWithTypes(Types, "OM5")
 	return pss.([]*OM5), err
 }
 
 // AllOM6 returns a slice containing all OM6 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM6() ([]*OM6, error) {
-	pss, err := m.ParseAll("OM6")
+	pss, err := m.ParseAllWithTypes(Types, "OM6")
 	return pss.([]*OM6), err
 }
 
 // AllOM7 returns a slice containing all OM7 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM7() ([]*OM7, error) {
-	pss, err := m.ParseAll("OM7")
+	pss, err := m.ParseAllWithTypes(Types, "OM7")
 	return pss.([]*OM7), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORG returns a slice containing all ORG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORG() ([]*ORG, error) {
-	pss, err := m.ParseAll("ORG")
+	pss, err := m.ParseAllWithTypes(Types, "ORG")
 	return pss.([]*ORG), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllOVR returns a slice containing all OVR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOVR() ([]*OVR, error) {
-	pss, err := m.ParseAll("OVR")
+	pss, err := m.ParseAllWithTypes(Types, "OVR")
 	return pss.([]*OVR), err
 }
 
 // AllPCE returns a slice containing all PCE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCE() ([]*PCE, error) {
-	pss, err := m.ParseAll("PCE")
+	pss, err := m.ParseAllWithTypes(Types, "PCE")
 	return pss.([]*PCE), err
 }
 
 // AllPCR returns a slice containing all PCR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCR() ([]*PCR, error) {
-	pss, err := m.ParseAll("PCR")
+	pss, err := m.ParseAllWithTypes(Types, "PCR")
 	return pss.([]*PCR), err
 }
 
 // AllPD1 returns a slice containing all PD1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPD1() ([]*PD1, error) {
-	pss, err := m.ParseAll("PD1")
+	pss, err := m.ParseAllWithTypes(Types, "PD1")
 	return pss.([]*PD1), err
 }
 
 // AllPDA returns a slice containing all PDA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDA() ([]*PDA, error) {
-	pss, err := m.ParseAll("PDA")
+	pss, err := m.ParseAllWithTypes(Types, "PDA")
 	return pss.([]*PDA), err
 }
 
 // AllPDC returns a slice containing all PDC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDC() ([]*PDC, error) {
-	pss, err := m.ParseAll("PDC")
+	pss, err := m.ParseAllWithTypes(Types, "PDC")
 	return pss.([]*PDC), err
 }
 
 // AllPEO returns a slice containing all PEO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPEO() ([]*PEO, error) {
-	pss, err := m.ParseAll("PEO")
+	pss, err := m.ParseAllWithTypes(Types, "PEO")
 	return pss.([]*PEO), err
 }
 
 // AllPES returns a slice containing all PES segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPES() ([]*PES, error) {
-	pss, err := m.ParseAll("PES")
+	pss, err := m.ParseAllWithTypes(Types, "PES")
 	return pss.([]*PES), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPKG returns a slice containing all PKG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPKG() ([]*PKG, error) {
-	pss, err := m.ParseAll("PKG")
+	pss, err := m.ParseAllWithTypes(Types, "PKG")
 	return pss.([]*PKG), err
 }
 
 // AllPMT returns a slice containing all PMT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPMT() ([]*PMT, error) {
-	pss, err := m.ParseAll("PMT")
+	pss, err := m.ParseAllWithTypes(Types, "PMT")
 	return pss.([]*PMT), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPRA returns a slice containing all PRA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRA() ([]*PRA, error) {
-	pss, err := m.ParseAll("PRA")
+	pss, err := m.ParseAllWithTypes(Types, "PRA")
 	return pss.([]*PRA), err
 }
 
 // AllPRB returns a slice containing all PRB segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRB() ([]*PRB, error) {
-	pss, err := m.ParseAll("PRB")
+	pss, err := m.ParseAllWithTypes(Types, "PRB")
 	return pss.([]*PRB), err
 }
 
 // AllPRC returns a slice containing all PRC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRC() ([]*PRC, error) {
-	pss, err := m.ParseAll("PRC")
+	pss, err := m.ParseAllWithTypes(Types, "PRC")
 	return pss.([]*PRC), err
 }
 
 // AllPRD returns a slice containing all PRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRD() ([]*PRD, error) {
-	pss, err := m.ParseAll("PRD")
+	pss, err := m.ParseAllWithTypes(Types, "PRD")
 	return pss.([]*PRD), err
 }
 
 // AllPSG returns a slice containing all PSG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSG() ([]*PSG, error) {
-	pss, err := m.ParseAll("PSG")
+	pss, err := m.ParseAllWithTypes(Types, "PSG")
 	return pss.([]*PSG), err
 }
 
 // AllPSH returns a slice containing all PSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSH() ([]*PSH, error) {
-	pss, err := m.ParseAll("PSH")
+	pss, err := m.ParseAllWithTypes(Types, "PSH")
 	return pss.([]*PSH), err
 }
 
 // AllPSL returns a slice containing all PSL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSL() ([]*PSL, error) {
-	pss, err := m.ParseAll("PSL")
+	pss, err := m.ParseAllWithTypes(Types, "PSL")
 	return pss.([]*PSL), err
 }
 
 // AllPSS returns a slice containing all PSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSS() ([]*PSS, error) {
-	pss, err := m.ParseAll("PSS")
+	pss, err := m.ParseAllWithTypes(Types, "PSS")
 	return pss.([]*PSS), err
 }
 
 // AllPTH returns a slice containing all PTH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPTH() ([]*PTH, error) {
-	pss, err := m.ParseAll("PTH")
+	pss, err := m.ParseAllWithTypes(Types, "PTH")
 	return pss.([]*PTH), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllPV2 returns a slice containing all PV2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV2() ([]*PV2, error) {
-	pss, err := m.ParseAll("PV2")
+	pss, err := m.ParseAllWithTypes(Types, "PV2")
 	return pss.([]*PV2), err
 }
 
 // AllPYE returns a slice containing all PYE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPYE() ([]*PYE, error) {
-	pss, err := m.ParseAll("PYE")
+	pss, err := m.ParseAllWithTypes(Types, "PYE")
 	return pss.([]*PYE), err
 }
 
 // AllQAK returns a slice containing all QAK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQAK() ([]*QAK, error) {
-	pss, err := m.ParseAll("QAK")
+	pss, err := m.ParseAllWithTypes(Types, "QAK")
 	return pss.([]*QAK), err
 }
 
 // AllQID returns a slice containing all QID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQID() ([]*QID, error) {
-	pss, err := m.ParseAll("QID")
+	pss, err := m.ParseAllWithTypes(Types, "QID")
 	return pss.([]*QID), err
 }
 
 // AllQPD returns a slice containing all QPD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQPD() ([]*QPD, error) {
-	pss, err := m.ParseAll("QPD")
+	pss, err := m.ParseAllWithTypes(Types, "QPD")
 	return pss.([]*QPD), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllQRI returns a slice containing all QRI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRI() ([]*QRI, error) {
-	pss, err := m.ParseAll("QRI")
+	pss, err := m.ParseAllWithTypes(Types, "QRI")
 	return pss.([]*QRI), err
 }
 
 // AllRCP returns a slice containing all RCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRCP() ([]*RCP, error) {
-	pss, err := m.ParseAll("RCP")
+	pss, err := m.ParseAllWithTypes(Types, "RCP")
 	return pss.([]*RCP), err
 }
 
 // AllRDF returns a slice containing all RDF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDF() ([]*RDF, error) {
-	pss, err := m.ParseAll("RDF")
+	pss, err := m.ParseAllWithTypes(Types, "RDF")
 	return pss.([]*RDF), err
 }
 
 // AllRDT returns a slice containing all RDT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDT() ([]*RDT, error) {
-	pss, err := m.ParseAll("RDT")
+	pss, err := m.ParseAllWithTypes(Types, "RDT")
 	return pss.([]*RDT), err
 }
 
 // AllREL returns a slice containing all REL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllREL() ([]*REL, error) {
-	pss, err := m.ParseAll("REL")
+	pss, err := m.ParseAllWithTypes(Types, "REL")
 	return pss.([]*REL), err
 }
 
 // AllRF1 returns a slice containing all RF1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRF1() ([]*RF1, error) {
-	pss, err := m.ParseAll("RF1")
+	pss, err := m.ParseAllWithTypes(Types, "RF1")
 	return pss.([]*RF1), err
 }
 
 // AllRFI returns a slice containing all RFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRFI() ([]*RFI, error) {
-	pss, err := m.ParseAll("RFI")
+	pss, err := m.ParseAllWithTypes(Types, "RFI")
 	return pss.([]*RFI), err
 }
 
 // AllRGS returns a slice containing all RGS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRGS() ([]*RGS, error) {
-	pss, err := m.ParseAll("RGS")
+	pss, err := m.ParseAllWithTypes(Types, "RGS")
 	return pss.([]*RGS), err
 }
 
 // AllRMI returns a slice containing all RMI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRMI() ([]*RMI, error) {
-	pss, err := m.ParseAll("RMI")
+	pss, err := m.ParseAllWithTypes(Types, "RMI")
 	return pss.([]*RMI), err
 }
 
 // AllROL returns a slice containing all ROL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllROL() ([]*ROL, error) {
-	pss, err := m.ParseAll("ROL")
+	pss, err := m.ParseAllWithTypes(Types, "ROL")
 	return pss.([]*ROL), err
 }
 
 // AllRQ1 returns a slice containing all RQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQ1() ([]*RQ1, error) {
-	pss, err := m.ParseAll("RQ1")
+	pss, err := m.ParseAllWithTypes(Types, "RQ1")
 	return pss.([]*RQ1), err
 }
 
 // AllRQD returns a slice containing all RQD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQD() ([]*RQD, error) {
-	pss, err := m.ParseAll("RQD")
+	pss, err := m.ParseAllWithTypes(Types, "RQD")
 	return pss.([]*RQD), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllRXA returns a slice containing all RXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXA() ([]*RXA, error) {
-	pss, err := m.ParseAll("RXA")
+	pss, err := m.ParseAllWithTypes(Types, "RXA")
 	return pss.([]*RXA), err
 }
 
 // AllRXC returns a slice containing all RXC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXC() ([]*RXC, error) {
-	pss, err := m.ParseAll("RXC")
+	pss, err := m.ParseAllWithTypes(Types, "RXC")
 	return pss.([]*RXC), err
 }
 
 // AllRXD returns a slice containing all RXD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXD() ([]*RXD, error) {
-	pss, err := m.ParseAll("RXD")
+	pss, err := m.ParseAllWithTypes(Types, "RXD")
 	return pss.([]*RXD), err
 }
 
 // AllRXE returns a slice containing all RXE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXE() ([]*RXE, error) {
-	pss, err := m.ParseAll("RXE")
+	pss, err := m.ParseAllWithTypes(Types, "RXE")
 	return pss.([]*RXE), err
 }
 
 // AllRXG returns a slice containing all RXG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXG() ([]*RXG, error) {
-	pss, err := m.ParseAll("RXG")
+	pss, err := m.ParseAllWithTypes(Types, "RXG")
 	return pss.([]*RXG), err
 }
 
 // AllRXO returns a slice containing all RXO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXO() ([]*RXO, error) {
-	pss, err := m.ParseAll("RXO")
+	pss, err := m.ParseAllWithTypes(Types, "RXO")
 	return pss.([]*RXO), err
 }
 
 // AllRXR returns a slice containing all RXR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXR() ([]*RXR, error) {
-	pss, err := m.ParseAll("RXR")
+	pss, err := m.ParseAllWithTypes(Types, "RXR")
 	return pss.([]*RXR), err
 }
 
 // AllSAC returns a slice containing all SAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSAC() ([]*SAC, error) {
-	pss, err := m.ParseAll("SAC")
+	pss, err := m.ParseAllWithTypes(Types, "SAC")
 	return pss.([]*SAC), err
 }
 
 // AllSCD returns a slice containing all SCD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCD() ([]*SCD, error) {
-	pss, err := m.ParseAll("SCD")
+	pss, err := m.ParseAllWithTypes(Types, "SCD")
 	return pss.([]*SCD), err
 }
 
 // AllSCH returns a slice containing all SCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCH() ([]*SCH, error) {
-	pss, err := m.ParseAll("SCH")
+	pss, err := m.ParseAllWithTypes(Types, "SCH")
 	return pss.([]*SCH), err
 }
 
 // AllSCP returns a slice containing all SCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCP() ([]*SCP, error) {
-	pss, err := m.ParseAll("SCP")
+	pss, err := m.ParseAllWithTypes(Types, "SCP")
 	return pss.([]*SCP), err
 }
 
 // AllSDD returns a slice containing all SDD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSDD() ([]*SDD, error) {
-	pss, err := m.ParseAll("SDD")
+	pss, err := m.ParseAllWithTypes(Types, "SDD")
 	return pss.([]*SDD), err
 }
 
 // AllSFT returns a slice containing all SFT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSFT() ([]*SFT, error) {
-	pss, err := m.ParseAll("SFT")
+	pss, err := m.ParseAllWithTypes(Types, "SFT")
 	return pss.([]*SFT), err
 }
 
 // AllSID returns a slice containing all SID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSID() ([]*SID, error) {
-	pss, err := m.ParseAll("SID")
+	pss, err := m.ParseAllWithTypes(Types, "SID")
 	return pss.([]*SID), err
 }
 
 // AllSLT returns a slice containing all SLT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSLT() ([]*SLT, error) {
-	pss, err := m.ParseAll("SLT")
+	pss, err := m.ParseAllWithTypes(Types, "SLT")
 	return pss.([]*SLT), err
 }
 
 // AllSPM returns a slice containing all SPM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPM() ([]*SPM, error) {
-	pss, err := m.ParseAll("SPM")
+	pss, err := m.ParseAllWithTypes(Types, "SPM")
 	return pss.([]*SPM), err
 }
 
 // AllSPR returns a slice containing all SPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPR() ([]*SPR, error) {
-	pss, err := m.ParseAll("SPR")
+	pss, err := m.ParseAllWithTypes(Types, "SPR")
 	return pss.([]*SPR), err
 }
 
 // AllSTF returns a slice containing all STF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTF() ([]*STF, error) {
-	pss, err := m.ParseAll("STF")
+	pss, err := m.ParseAllWithTypes(Types, "STF")
 	return pss.([]*STF), err
 }
 
 // AllSTZ returns a slice containing all STZ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTZ() ([]*STZ, error) {
-	pss, err := m.ParseAll("STZ")
+	pss, err := m.ParseAllWithTypes(Types, "STZ")
 	return pss.([]*STZ), err
 }
 
 // AllTCC returns a slice containing all TCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCC() ([]*TCC, error) {
-	pss, err := m.ParseAll("TCC")
+	pss, err := m.ParseAllWithTypes(Types, "TCC")
 	return pss.([]*TCC), err
 }
 
 // AllTCD returns a slice containing all TCD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCD() ([]*TCD, error) {
-	pss, err := m.ParseAll("TCD")
+	pss, err := m.ParseAllWithTypes(Types, "TCD")
 	return pss.([]*TCD), err
 }
 
 // AllTQ1 returns a slice containing all TQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ1() ([]*TQ1, error) {
-	pss, err := m.ParseAll("TQ1")
+	pss, err := m.ParseAllWithTypes(Types, "TQ1")
 	return pss.([]*TQ1), err
 }
 
 // AllTQ2 returns a slice containing all TQ2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ2() ([]*TQ2, error) {
-	pss, err := m.ParseAll("TQ2")
+	pss, err := m.ParseAllWithTypes(Types, "TQ2")
 	return pss.([]*TQ2), err
 }
 
 // AllTXA returns a slice containing all TXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTXA() ([]*TXA, error) {
-	pss, err := m.ParseAll("TXA")
+	pss, err := m.ParseAllWithTypes(Types, "TXA")
 	return pss.([]*TXA), err
 }
 
 // AllUAC returns a slice containing all UAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUAC() ([]*UAC, error) {
-	pss, err := m.ParseAll("UAC")
+	pss, err := m.ParseAllWithTypes(Types, "UAC")
 	return pss.([]*UAC), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllUB2 returns a slice containing all UB2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB2() ([]*UB2, error) {
-	pss, err := m.ParseAll("UB2")
+	pss, err := m.ParseAllWithTypes(Types, "UB2")
 	return pss.([]*UB2), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
 // AllVAR returns a slice containing all VAR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVAR() ([]*VAR, error) {
-	pss, err := m.ParseAll("VAR")
+	pss, err := m.ParseAllWithTypes(Types, "VAR")
 	return pss.([]*VAR), err
 }
 
 // AllVND returns a slice containing all VND segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVND() ([]*VND, error) {
-	pss, err := m.ParseAll("VND")
+	pss, err := m.ParseAllWithTypes(Types, "VND")
 	return pss.([]*VND), err
 }
 
 // AllVTQ returns a slice containing all VTQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVTQ() ([]*VTQ, error) {
-	pss, err := m.ParseAll("VTQ")
+	pss, err := m.ParseAllWithTypes(Types, "VTQ")
 	return pss.([]*VTQ), err
 }
 
 // AllZL7 returns a slice containing all ZL7 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllZL7() ([]*ZL7, error) {
-	pss, err := m.ParseAll("ZL7")
+	pss, err := m.ParseAllWithTypes(Types, "ZL7")
 	return pss.([]*ZL7), err
 }
 
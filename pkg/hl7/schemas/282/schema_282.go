@@ -15,7 +15,7 @@
 // This file contains the schemas for HL7 messages, segments and values for HL7v2 version 2.8.2.
 // It has been auto-generated from the HL7v2 specification.
 
-package hl7
+package v282
 
 import "reflect"
 
@@ -3637,68 +3637,65 @@ func (s *ODT) SegmentName() string {
 // OM1 represents the corresponding HL7 segment.
 // Definition from HL7 2.8.2
 type OM1 struct {
-	SequenceNumberTestObservationMasterFile                *NM   `hl7:"true,Sequence Number - Test/Observation Master File"`          // OM1-1
-	ProducerSServiceTestObservationID                      *CWE  `hl7:"true,Producer'S Service/Test/Observation ID"`                  // OM1-2
-	PermittedDataTypes                                     []ID  `hl7:"false,Permitted Data Types"`                                   // OM1-3
-	SpecimenRequired                                       *ID   `hl7:"true,Specimen Required"`                                       // OM1-4
-	ProducerID                                             *CWE  `hl7:"true,Producer ID"`                                             // OM1-5
-	ObservationDescription                                 *TX   `hl7:"false,Observation Description"`                                // OM1-6
-	OtherServiceTestObservationIDsForTheObservation        []CWE `hl7:"false,Other Service/Test/Observation IDs For The Observation"` // OM1-7
-	OtherNames1                                            []ST  `hl7:"false,Other Names"`                                            // OM1-8
-	PreferredReportNameForTheObservation                   *ST   `hl7:"false,Preferred Report Name For The Observation"`              // OM1-9
-	PreferredShortNameOrMnemonicForTheObservation          *ST   `hl7:"false,Preferred Short Name Or Mnemonic For The Observation"`   // OM1-10
-	PreferredLongNameForTheObservation                     *ST   `hl7:"false,Preferred Long Name For The Observation"`                // OM1-11
-	Orderability                                           *ID   `hl7:"false,Orderability"`                                           // OM1-12
-	IdentityOfInstrumentUsedToPerformThisStudy             []CWE `hl7:"false,Identity Of Instrument Used To Perform This Study"`      // OM1-13
-	CodedRepresentationOfMethod                            []CWE `hl7:"false,Coded Representation Of Method"`                         // OM1-14
-	PortableDeviceIndicator                                *ID   `hl7:"false,Portable Device Indicator"`                              // OM1-15
-	ObservationProducingDepartmentSection                  []CWE `hl7:"false,Observation Producing Department/Section"`               // OM1-16
-	TelephoneNumberOfSection                               *XTN  `hl7:"false,Telephone Number Of Section"`                            // OM1-17
-	NatureOfServiceTestObservation                         *CWE  `hl7:"true,Nature Of Service/Test/Observation"`                      // OM1-18
-	ReportSubheader                                        *CWE  `hl7:"false,Report Subheader"`                                       // OM1-19
-	ReportDisplayOrder                                     *ST   `hl7:"false,Report Display Order"`                                   // OM1-20
-	DateTimeStampForAnyChangeInDefinitionForTheObservation *DTM  `hl7:"false,Date/Time Stamp For Any Change In Definition For The Observation
-        "` // OM1-21
-	EffectiveDateTimeOfChange                                            *DTM  `hl7:"false,Effective Date/Time Of Change"`                       // OM1-22
-	TypicalTurnAroundTime1                                               *NM   `hl7:"false,Typical Turn-Around Time"`                            // OM1-23
-	ProcessingTime                                                       *NM   `hl7:"false,Processing Time"`                                     // OM1-24
-	ProcessingPriority                                                   []ID  `hl7:"false,Processing Priority"`                                 // OM1-25
-	ReportingPriority                                                    *ID   `hl7:"false,Reporting Priority"`                                  // OM1-26
-	OutsideSiteSWhereObservationMayBePerformed                           []CWE `hl7:"false,Outside Site(S) Where Observation May Be Performed"`  // OM1-27
-	AddressOfOutsideSiteS                                                []XAD `hl7:"false,Address Of Outside Site(S)"`                          // OM1-28
-	PhoneNumberOfOutsideSite                                             *XTN  `hl7:"false,Phone Number Of Outside Site"`                        // OM1-29
-	ConfidentialityCode                                                  *CWE  `hl7:"false,Confidentiality Code"`                                // OM1-30
-	ObservationsRequiredToInterpretThisObservation                       []CWE `hl7:"false,Observations Required To Interpret This Observation"` // OM1-31
-	InterpretationOfObservations                                         *TX   `hl7:"false,Interpretation Of Observations"`                      // OM1-32
-	ContraindicationsToObservations                                      []CWE `hl7:"false,Contraindications To Observations"`                   // OM1-33
-	ReflexTestsObservations                                              []CWE `hl7:"false,Reflex Tests/Observations"`                           // OM1-34
-	RulesThatTriggerReflexTesting                                        []TX  `hl7:"false,Rules That Trigger Reflex Testing"`                   // OM1-35
-	FixedCannedMessage                                                   []CWE `hl7:"false,Fixed Canned Message"`                                // OM1-36
-	PatientPreparation                                                   []TX  `hl7:"false,Patient Preparation"`                                 // OM1-37
-	ProcedureMedication                                                  *CWE  `hl7:"false,Procedure Medication"`                                // OM1-38
-	FactorsThatMayAffectTheObservation                                   *TX   `hl7:"false,Factors That May Affect The Observation"`             // OM1-39
-	ServiceTestObservationPerformanceSchedule                            []ST  `hl7:"false,Service/Test/Observation Performance Schedule"`       // OM1-40
-	DescriptionOfTestMethods                                             *TX   `hl7:"false,Description Of Test Methods"`                         // OM1-41
-	KindOfQuantityObserved                                               *CWE  `hl7:"false,Kind Of Quantity Observed"`                           // OM1-42
-	PointVersusInterval                                                  *CWE  `hl7:"false,Point Versus Interval"`                               // OM1-43
-	ChallengeInformation                                                 *TX   `hl7:"false,Challenge Information"`                               // OM1-44
-	RelationshipModifier                                                 *CWE  `hl7:"false,Relationship Modifier"`                               // OM1-45
-	TargetAnatomicSiteOfTest                                             *CWE  `hl7:"false,Target Anatomic Site Of Test"`                        // OM1-46
-	ModalityOfImagingMeasurement                                         *CWE  `hl7:"false,Modality Of Imaging Measurement"`                     // OM1-47
-	ExclusiveTest                                                        *ID   `hl7:"false,Exclusive Test"`                                      // OM1-48
-	DiagnosticServSectID                                                 *ID   `hl7:"false,Diagnostic Serv Sect ID"`                             // OM1-49
-	TaxonomicClassificationCode                                          *CWE  `hl7:"false,Taxonomic Classification Code"`                       // OM1-50
-	OtherNames2                                                          []ST  `hl7:"false,Other Names"`                                         // OM1-51
-	ReplacementProducerSServiceTestObservationID                         []CWE `hl7:"false,Replacement Producer'S Service/Test/Observation ID"`  // OM1-52
-	PriorResutsInstructions                                              []TX  `hl7:"false,Prior Resuts Instructions"`                           // OM1-53
-	SpecialInstructions                                                  *TX   `hl7:"false,Special Instructions"`                                // OM1-54
-	TestCategory                                                         []CWE `hl7:"false,Test Category"`                                       // OM1-55
-	ObservationIdentifierAssociatedWithProducerSServiceTestObservationID *CWE  `hl7:"false,Observation/Identifier Associated With Producer'S Service/Test/Observation
-          ID
-        "` // OM1-56
-	TypicalTurnAroundTime2 *CQ   `hl7:"false,Typical Turn-Around Time"` // OM1-57
-	GenderRestriction      []CWE `hl7:"false,Gender Restriction"`       // OM1-58
-	AgeRestriction         []NR  `hl7:"false,Age Restriction"`          // OM1-59
+	SequenceNumberTestObservationMasterFile                              *NM   `hl7:"true,Sequence Number - Test/Observation Master File"`                                 // OM1-1
+	ProducerSServiceTestObservationID                                    *CWE  `hl7:"true,Producer'S Service/Test/Observation ID"`                                         // OM1-2
+	PermittedDataTypes                                                   []ID  `hl7:"false,Permitted Data Types"`                                                          // OM1-3
+	SpecimenRequired                                                     *ID   `hl7:"true,Specimen Required"`                                                              // OM1-4
+	ProducerID                                                           *CWE  `hl7:"true,Producer ID"`                                                                    // OM1-5
+	ObservationDescription                                               *TX   `hl7:"false,Observation Description"`                                                       // OM1-6
+	OtherServiceTestObservationIDsForTheObservation                      []CWE `hl7:"false,Other Service/Test/Observation IDs For The Observation"`                        // OM1-7
+	OtherNames1                                                          []ST  `hl7:"false,Other Names"`                                                                   // OM1-8
+	PreferredReportNameForTheObservation                                 *ST   `hl7:"false,Preferred Report Name For The Observation"`                                     // OM1-9
+	PreferredShortNameOrMnemonicForTheObservation                        *ST   `hl7:"false,Preferred Short Name Or Mnemonic For The Observation"`                          // OM1-10
+	PreferredLongNameForTheObservation                                   *ST   `hl7:"false,Preferred Long Name For The Observation"`                                       // OM1-11
+	Orderability                                                         *ID   `hl7:"false,Orderability"`                                                                  // OM1-12
+	IdentityOfInstrumentUsedToPerformThisStudy                           []CWE `hl7:"false,Identity Of Instrument Used To Perform This Study"`                             // OM1-13
+	CodedRepresentationOfMethod                                          []CWE `hl7:"false,Coded Representation Of Method"`                                                // OM1-14
+	PortableDeviceIndicator                                              *ID   `hl7:"false,Portable Device Indicator"`                                                     // OM1-15
+	ObservationProducingDepartmentSection                                []CWE `hl7:"false,Observation Producing Department/Section"`                                      // OM1-16
+	TelephoneNumberOfSection                                             *XTN  `hl7:"false,Telephone Number Of Section"`                                                   // OM1-17
+	NatureOfServiceTestObservation                                       *CWE  `hl7:"true,Nature Of Service/Test/Observation"`                                             // OM1-18
+	ReportSubheader                                                      *CWE  `hl7:"false,Report Subheader"`                                                              // OM1-19
+	ReportDisplayOrder                                                   *ST   `hl7:"false,Report Display Order"`                                                          // OM1-20
+	DateTimeStampForAnyChangeInDefinitionForTheObservation               *DTM  `hl7:"false,Date/Time Stamp For Any Change In Definition For The Observation"`              // OM1-21
+	EffectiveDateTimeOfChange                                            *DTM  `hl7:"false,Effective Date/Time Of Change"`                                                 // OM1-22
+	TypicalTurnAroundTime1                                               *NM   `hl7:"false,Typical Turn-Around Time"`                                                      // OM1-23
+	ProcessingTime                                                       *NM   `hl7:"false,Processing Time"`                                                               // OM1-24
+	ProcessingPriority                                                   []ID  `hl7:"false,Processing Priority"`                                                           // OM1-25
+	ReportingPriority                                                    *ID   `hl7:"false,Reporting Priority"`                                                            // OM1-26
+	OutsideSiteSWhereObservationMayBePerformed                           []CWE `hl7:"false,Outside Site(S) Where Observation May Be Performed"`                            // OM1-27
+	AddressOfOutsideSiteS                                                []XAD `hl7:"false,Address Of Outside Site(S)"`                                                    // OM1-28
+	PhoneNumberOfOutsideSite                                             *XTN  `hl7:"false,Phone Number Of Outside Site"`                                                  // OM1-29
+	ConfidentialityCode                                                  *CWE  `hl7:"false,Confidentiality Code"`                                                          // OM1-30
+	ObservationsRequiredToInterpretThisObservation                       []CWE `hl7:"false,Observations Required To Interpret This Observation"`                           // OM1-31
+	InterpretationOfObservations                                         *TX   `hl7:"false,Interpretation Of Observations"`                                                // OM1-32
+	ContraindicationsToObservations                                      []CWE `hl7:"false,Contraindications To Observations"`                                             // OM1-33
+	ReflexTestsObservations                                              []CWE `hl7:"false,Reflex Tests/Observations"`                                                     // OM1-34
+	RulesThatTriggerReflexTesting                                        []TX  `hl7:"false,Rules That Trigger Reflex Testing"`                                             // OM1-35
+	FixedCannedMessage                                                   []CWE `hl7:"false,Fixed Canned Message"`                                                          // OM1-36
+	PatientPreparation                                                   []TX  `hl7:"false,Patient Preparation"`                                                           // OM1-37
+	ProcedureMedication                                                  *CWE  `hl7:"false,Procedure Medication"`                                                          // OM1-38
+	FactorsThatMayAffectTheObservation                                   *TX   `hl7:"false,Factors That May Affect The Observation"`                                       // OM1-39
+	ServiceTestObservationPerformanceSchedule                            []ST  `hl7:"false,Service/Test/Observation Performance Schedule"`                                 // OM1-40
+	DescriptionOfTestMethods                                             *TX   `hl7:"false,Description Of Test Methods"`                                                   // OM1-41
+	KindOfQuantityObserved                                               *CWE  `hl7:"false,Kind Of Quantity Observed"`                                                     // OM1-42
+	PointVersusInterval                                                  *CWE  `hl7:"false,Point Versus Interval"`                                                         // OM1-43
+	ChallengeInformation                                                 *TX   `hl7:"false,Challenge Information"`                                                         // OM1-44
+	RelationshipModifier                                                 *CWE  `hl7:"false,Relationship Modifier"`                                                         // OM1-45
+	TargetAnatomicSiteOfTest                                             *CWE  `hl7:"false,Target Anatomic Site Of Test"`                                                  // OM1-46
+	ModalityOfImagingMeasurement                                         *CWE  `hl7:"false,Modality Of Imaging Measurement"`                                               // OM1-47
+	ExclusiveTest                                                        *ID   `hl7:"false,Exclusive Test"`                                                                // OM1-48
+	DiagnosticServSectID                                                 *ID   `hl7:"false,Diagnostic Serv Sect ID"`                                                       // OM1-49
+	TaxonomicClassificationCode                                          *CWE  `hl7:"false,Taxonomic Classification Code"`                                                 // OM1-50
+	OtherNames2                                                          []ST  `hl7:"false,Other Names"`                                                                   // OM1-51
+	ReplacementProducerSServiceTestObservationID                         []CWE `hl7:"false,Replacement Producer'S Service/Test/Observation ID"`                            // OM1-52
+	PriorResutsInstructions                                              []TX  `hl7:"false,Prior Resuts Instructions"`                                                     // OM1-53
+	SpecialInstructions                                                  *TX   `hl7:"false,Special Instructions"`                                                          // OM1-54
+	TestCategory                                                         []CWE `hl7:"false,Test Category"`                                                                 // OM1-55
+	ObservationIdentifierAssociatedWithProducerSServiceTestObservationID *CWE  `hl7:"false,Observation/Identifier Associated With Producer'S Service/Test/Observation ID"` // OM1-56
+	TypicalTurnAroundTime2                                               *CQ   `hl7:"false,Typical Turn-Around Time"`                                                      // OM1-57
+	GenderRestriction                                                    []CWE `hl7:"false,Gender Restriction"`                                                            // OM1-58
+	AgeRestriction                                                       []NR  `hl7:"false,Age Restriction"`                                                               // OM1-59
 }
 
 func (s *OM1) SegmentName() string {
@@ -3708,17 +3705,16 @@ func (s *OM1) SegmentName() string {
 // OM2 represents the corresponding HL7 segment.
 // Definition from HL7 2.8.2
 type OM2 struct {
-	SequenceNumberTestObservationMasterFile                 *NM   `hl7:"false,Sequence Number - Test/Observation Master File"` // OM2-1
-	UnitsOfMeasure                                          *CWE  `hl7:"false,Units Of Measure"`                               // OM2-2
-	RangeOfDecimalPrecision                                 []NM  `hl7:"false,Range Of Decimal Precision"`                     // OM2-3
-	CorrespondingSIUnitsOfMeasure                           *CWE  `hl7:"false,Corresponding SI Units Of Measure"`              // OM2-4
-	SIConversionFactor                                      *TX   `hl7:"false,SI Conversion Factor"`                           // OM2-5
-	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Reference (Normal) Range For Ordinal And Continuous Observations
-        "` // OM2-6
-	CriticalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Critical Range For Ordinal And Continuous Observations"` // OM2-7
-	AbsoluteRangeForOrdinalAndContinuousObservations *RFR  `hl7:"false,Absolute Range For Ordinal And Continuous Observations"` // OM2-8
-	DeltaCheckCriteria                               []DLT `hl7:"false,Delta Check Criteria"`                                   // OM2-9
-	MinimumMeaningfulIncrements                      *NM   `hl7:"false,Minimum Meaningful Increments"`                          // OM2-10
+	SequenceNumberTestObservationMasterFile                 *NM   `hl7:"false,Sequence Number - Test/Observation Master File"`                   // OM2-1
+	UnitsOfMeasure                                          *CWE  `hl7:"false,Units Of Measure"`                                                 // OM2-2
+	RangeOfDecimalPrecision                                 []NM  `hl7:"false,Range Of Decimal Precision"`                                       // OM2-3
+	CorrespondingSIUnitsOfMeasure                           *CWE  `hl7:"false,Corresponding SI Units Of Measure"`                                // OM2-4
+	SIConversionFactor                                      *TX   `hl7:"false,SI Conversion Factor"`                                             // OM2-5
+	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR `hl7:"false,Reference (Normal) Range For Ordinal And Continuous Observations"` // OM2-6
+	CriticalRangeForOrdinalAndContinuousObservations        []RFR `hl7:"false,Critical Range For Ordinal And Continuous Observations"`           // OM2-7
+	AbsoluteRangeForOrdinalAndContinuousObservations        *RFR  `hl7:"false,Absolute Range For Ordinal And Continuous Observations"`           // OM2-8
+	DeltaCheckCriteria                                      []DLT `hl7:"false,Delta Check Criteria"`                                             // OM2-9
+	MinimumMeaningfulIncrements                             *NM   `hl7:"false,Minimum Meaningful Increments"`                                    // OM2-10
 }
 
 func (s *OM2) SegmentName() string {
@@ -28901,7 +28897,7 @@ func (s *GenericHL7Segment) SegmentName() string {
 
 // ABS returns the first ABS segment within the message, or nil if there isn't one.
 func (m *Message) ABS() (*ABS, error) {
-	ps, err := m.Parse("ABS")
+	ps, err := m.ParseWithTypes(Types, "ABS")
 	pst, ok := ps.(*ABS)
 	if ok {
 		return pst, err
@@ -28911,7 +28907,7 @@ func (m *Message) ABS() (*ABS, error) {
 
 // ACC returns the first ACC segment within the message, or nil if there isn't one.
 func (m *Message) ACC() (*ACC, error) {
-	ps, err := m.Parse("ACC")
+	ps, err := m.ParseWithTypes(Types, "ACC")
 	pst, ok := ps.(*ACC)
 	if ok {
 		return pst, err
@@ -28921,7 +28917,7 @@ func (m *Message) ACC() (*ACC, error) {
 
 // ADD returns the first ADD segment within the message, or nil if there isn't one.
 func (m *Message) ADD() (*ADD, error) {
-	ps, err := m.Parse("ADD")
+	ps, err := m.ParseWithTypes(Types, "ADD")
 	pst, ok := ps.(*ADD)
 	if ok {
 		return pst, err
@@ -28931,7 +28927,7 @@ func (m *Message) ADD() (*ADD, error) {
 
 // ADJ returns the first ADJ segment within the message, or nil if there isn't one.
 func (m *Message) ADJ() (*ADJ, error) {
-	ps, err := m.Parse("ADJ")
+	ps, err := m.ParseWithTypes(Types, "ADJ")
 	pst, ok := ps.(*ADJ)
 	if ok {
 		return pst, err
@@ -28941,7 +28937,7 @@ func (m *Message) ADJ() (*ADJ, error) {
 
 // AFF returns the first AFF segment within the message, or nil if there isn't one.
 func (m *Message) AFF() (*AFF, error) {
-	ps, err := m.Parse("AFF")
+	ps, err := m.ParseWithTypes(Types, "AFF")
 	pst, ok := ps.(*AFF)
 	if ok {
 		return pst, err
@@ -28951,7 +28947,7 @@ func (m *Message) AFF() (*AFF, error) {
 
 // AIG returns the first AIG segment within the message, or nil if there isn't one.
 func (m *Message) AIG() (*AIG, error) {
-	ps, err := m.Parse("AIG")
+	ps, err := m.ParseWithTypes(Types, "AIG")
 	pst, ok := ps.(*AIG)
 	if ok {
 		return pst, err
@@ -28961,7 +28957,7 @@ func (m *Message) AIG() (*AIG, error) {
 
 // AIL returns the first AIL segment within the message, or nil if there isn't one.
 func (m *Message) AIL() (*AIL, error) {
-	ps, err := m.Parse("AIL")
+	ps, err := m.ParseWithTypes(Types, "AIL")
 	pst, ok := ps.(*AIL)
 	if ok {
 		return pst, err
@@ -28971,7 +28967,7 @@ func (m *Message) AIL() (*AIL, error) {
 
 // AIP returns the first AIP segment within the message, or nil if there isn't one.
 func (m *Message) AIP() (*AIP, error) {
-	ps, err := m.Parse("AIP")
+	ps, err := m.ParseWithTypes(Types, "AIP")
 	pst, ok := ps.(*AIP)
 	if ok {
 		return pst, err
@@ -28981,7 +28977,7 @@ func (m *Message) AIP() (*AIP, error) {
 
 // AIS returns the first AIS segment within the message, or nil if there isn't one.
 func (m *Message) AIS() (*AIS, error) {
-	ps, err := m.Parse("AIS")
+	ps, err := m.ParseWithTypes(Types, "AIS")
 	pst, ok := ps.(*AIS)
 	if ok {
 		return pst, err
@@ -28991,7 +28987,7 @@ func (m *Message) AIS() (*AIS, error) {
 
 // AL1 returns the first AL1 segment within the message, or nil if there isn't one.
 func (m *Message) AL1() (*AL1, error) {
-	ps, err := m.Parse("AL1")
+	ps, err := m.ParseWithTypes(Types, "AL1")
 	pst, ok := ps.(*AL1)
 	if ok {
 		return pst, err
@@ -29001,7 +28997,7 @@ func (m *Message) AL1() (*AL1, error) {
 
 // APR returns the first APR segment within the message, or nil if there isn't one.
 func (m *Message) APR() (*APR, error) {
-	ps, err := m.Parse("APR")
+	ps, err := m.ParseWithTypes(Types, "APR")
 	pst, ok := ps.(*APR)
 	if ok {
 		return pst, err
@@ -29011,7 +29007,7 @@ func (m *Message) APR() (*APR, error) {
 
 // ARQ returns the first ARQ segment within the message, or nil if there isn't one.
 func (m *Message) ARQ() (*ARQ, error) {
-	ps, err := m.Parse("ARQ")
+	ps, err := m.ParseWithTypes(Types, "ARQ")
 	pst, ok := ps.(*ARQ)
 	if ok {
 		return pst, err
@@ -29021,7 +29017,7 @@ func (m *Message) ARQ() (*ARQ, error) {
 
 // ARV returns the first ARV segment within the message, or nil if there isn't one.
 func (m *Message) ARV() (*ARV, error) {
-	ps, err := m.Parse("ARV")
+	ps, err := m.ParseWithTypes(Types, "ARV")
 	pst, ok := ps.(*ARV)
 	if ok {
 		return pst, err
@@ -29031,7 +29027,7 @@ func (m *Message) ARV() (*ARV, error) {
 
 // AUT returns the first AUT segment within the message, or nil if there isn't one.
 func (m *Message) AUT() (*AUT, error) {
-	ps, err := m.Parse("AUT")
+	ps, err := m.ParseWithTypes(Types, "AUT")
 	pst, ok := ps.(*AUT)
 	if ok {
 		return pst, err
@@ -29041,7 +29037,7 @@ func (m *Message) AUT() (*AUT, error) {
 
 // BHS returns the first BHS segment within the message, or nil if there isn't one.
 func (m *Message) BHS() (*BHS, error) {
-	ps, err := m.Parse("BHS")
+	ps, err := m.ParseWithTypes(Types, "BHS")
 	pst, ok := ps.(*BHS)
 	if ok {
 		return pst, err
@@ -29051,7 +29047,7 @@ func (m *Message) BHS() (*BHS, error) {
 
 // BLC returns the first BLC segment within the message, or nil if there isn't one.
 func (m *Message) BLC() (*BLC, error) {
-	ps, err := m.Parse("BLC")
+	ps, err := m.ParseWithTypes(Types, "BLC")
 	pst, ok := ps.(*BLC)
 	if ok {
 		return pst, err
@@ -29061,7 +29057,7 @@ func (m *Message) BLC() (*BLC, error) {
 
 // BLG returns the first BLG segment within the message, or nil if there isn't one.
 func (m *Message) BLG() (*BLG, error) {
-	ps, err := m.Parse("BLG")
+	ps, err := m.ParseWithTypes(Types, "BLG")
 	pst, ok := ps.(*BLG)
 	if ok {
 		return pst, err
@@ -29071,7 +29067,7 @@ func (m *Message) BLG() (*BLG, error) {
 
 // BPO returns the first BPO segment within the message, or nil if there isn't one.
 func (m *Message) BPO() (*BPO, error) {
-	ps, err := m.Parse("BPO")
+	ps, err := m.ParseWithTypes(Types, "BPO")
 	pst, ok := ps.(*BPO)
 	if ok {
 		return pst, err
@@ -29081,7 +29077,7 @@ func (m *Message) BPO() (*BPO, error) {
 
 // BPX returns the first BPX segment within the message, or nil if there isn't one.
 func (m *Message) BPX() (*BPX, error) {
-	ps, err := m.Parse("BPX")
+	ps, err := m.ParseWithTypes(Types, "BPX")
 	pst, ok := ps.(*BPX)
 	if ok {
 		return pst, err
@@ -29091,7 +29087,7 @@ func (m *Message) BPX() (*BPX, error) {
 
 // BTS returns the first BTS segment within the message, or nil if there isn't one.
 func (m *Message) BTS() (*BTS, error) {
-	ps, err := m.Parse("BTS")
+	ps, err := m.ParseWithTypes(Types, "BTS")
 	pst, ok := ps.(*BTS)
 	if ok {
 		return pst, err
@@ -29101,7 +29097,7 @@ func (m *Message) BTS() (*BTS, error) {
 
 // BTX returns the first BTX segment within the message, or nil if there isn't one.
 func (m *Message) BTX() (*BTX, error) {
-	ps, err := m.Parse("BTX")
+	ps, err := m.ParseWithTypes(Types, "BTX")
 	pst, ok := ps.(*BTX)
 	if ok {
 		return pst, err
@@ -29111,7 +29107,7 @@ func (m *Message) BTX() (*BTX, error) {
 
 // BUI returns the first BUI segment within the message, or nil if there isn't one.
 func (m *Message) BUI() (*BUI, error) {
-	ps, err := m.Parse("BUI")
+	ps, err := m.ParseWithTypes(Types, "BUI")
 	pst, ok := ps.(*BUI)
 	if ok {
 		return pst, err
@@ -29121,7 +29117,7 @@ func (m *Message) BUI() (*BUI, error) {
 
 // CDM returns the first CDM segment within the message, or nil if there isn't one.
 func (m *Message) CDM() (*CDM, error) {
-	ps, err := m.Parse("CDM")
+	ps, err := m.ParseWithTypes(Types, "CDM")
 	pst, ok := ps.(*CDM)
 	if ok {
 		return pst, err
@@ -29131,7 +29127,7 @@ func (m *Message) CDM() (*CDM, error) {
 
 // CDO returns the first CDO segment within the message, or nil if there isn't one.
 func (m *Message) CDO() (*CDO, error) {
-	ps, err := m.Parse("CDO")
+	ps, err := m.ParseWithTypes(Types, "CDO")
 	pst, ok := ps.(*CDO)
 	if ok {
 		return pst, err
@@ -29141,7 +29137,7 @@ func (m *Message) CDO() (*CDO, error) {
 
 // CER returns the first CER segment within the message, or nil if there isn't one.
 func (m *Message) CER() (*CER, error) {
-	ps, err := m.Parse("CER")
+	ps, err := m.ParseWithTypes(Types, "CER")
 	pst, ok := ps.(*CER)
 	if ok {
 		return pst, err
@@ -29151,7 +29147,7 @@ func (m *Message) CER() (*CER, error) {
 
 // CM0 returns the first CM0 segment within the message, or nil if there isn't one.
 func (m *Message) CM0() (*CM0, error) {
-	ps, err := m.Parse("CM0")
+	ps, err := m.ParseWithTypes(Types, "CM0")
 	pst, ok := ps.(*CM0)
 	if ok {
 		return pst, err
@@ -29161,7 +29157,7 @@ func (m *Message) CM0() (*CM0, error) {
 
 // CM1 returns the first CM1 segment within the message, or nil if there isn't one.
 func (m *Message) CM1() (*CM1, error) {
-	ps, err := m.Parse("CM1")
+	ps, err := m.ParseWithTypes(Types, "CM1")
 	pst, ok := ps.(*CM1)
 	if ok {
 		return pst, err
@@ -29171,7 +29167,7 @@ func (m *Message) CM1() (*CM1, error) {
 
 // CM2 returns the first CM2 segment within the message, or nil if there isn't one.
 func (m *Message) CM2() (*CM2, error) {
-	ps, err := m.Parse("CM2")
+	ps, err := m.ParseWithTypes(Types, "CM2")
 	pst, ok := ps.(*CM2)
 	if ok {
 		return pst, err
@@ -29181,7 +29177,7 @@ func (m *Message) CM2() (*CM2, error) {
 
 // CNS returns the first CNS segment within the message, or nil if there isn't one.
 func (m *Message) CNS() (*CNS, error) {
-	ps, err := m.Parse("CNS")
+	ps, err := m.ParseWithTypes(Types, "CNS")
 	pst, ok := ps.(*CNS)
 	if ok {
 		return pst, err
@@ -29191,7 +29187,7 @@ func (m *Message) CNS() (*CNS, error) {
 
 // CON returns the first CON segment within the message, or nil if there isn't one.
 func (m *Message) CON() (*CON, error) {
-	ps, err := m.Parse("CON")
+	ps, err := m.ParseWithTypes(Types, "CON")
 	pst, ok := ps.(*CON)
 	if ok {
 		return pst, err
@@ -29201,7 +29197,7 @@ func (m *Message) CON() (*CON, error) {
 
 // CSP returns the first CSP segment within the message, or nil if there isn't one.
 func (m *Message) CSP() (*CSP, error) {
-	ps, err := m.Parse("CSP")
+	ps, err := m.ParseWithTypes(Types, "CSP")
 	pst, ok := ps.(*CSP)
 	if ok {
 		return pst, err
@@ -29211,7 +29207,7 @@ func (m *Message) CSP() (*CSP, error) {
 
 // CSR returns the first CSR segment within the message, or nil if there isn't one.
 func (m *Message) CSR() (*CSR, error) {
-	ps, err := m.Parse("CSR")
+	ps, err := m.ParseWithTypes(Types, "CSR")
 	pst, ok := ps.(*CSR)
 	if ok {
 		return pst, err
@@ -29221,7 +29217,7 @@ func (m *Message) CSR() (*CSR, error) {
 
 // CSS returns the first CSS segment within the message, or nil if there isn't one.
 func (m *Message) CSS() (*CSS, error) {
-	ps, err := m.Parse("CSS")
+	ps, err := m.ParseWithTypes(Types, "CSS")
 	pst, ok := ps.(*CSS)
 	if ok {
 		return pst, err
@@ -29231,7 +29227,7 @@ func (m *Message) CSS() (*CSS, error) {
 
 // CTD returns the first CTD segment within the message, or nil if there isn't one.
 func (m *Message) CTD() (*CTD, error) {
-	ps, err := m.Parse("CTD")
+	ps, err := m.ParseWithTypes(Types, "CTD")
 	pst, ok := ps.(*CTD)
 	if ok {
 		return pst, err
@@ -29241,7 +29237,7 @@ func (m *Message) CTD() (*CTD, error) {
 
 // CTI returns the first CTI segment within the message, or nil if there isn't one.
 func (m *Message) CTI() (*CTI, error) {
-	ps, err := m.Parse("CTI")
+	ps, err := m.ParseWithTypes(Types, "CTI")
 	pst, ok := ps.(*CTI)
 	if ok {
 		return pst, err
@@ -29251,7 +29247,7 @@ func (m *Message) CTI() (*CTI, error) {
 
 // DB1 returns the first DB1 segment within the message, or nil if there isn't one.
 func (m *Message) DB1() (*DB1, error) {
-	ps, err := m.Parse("DB1")
+	ps, err := m.ParseWithTypes(Types, "DB1")
 	pst, ok := ps.(*DB1)
 	if ok {
 		return pst, err
@@ -29261,7 +29257,7 @@ func (m *Message) DB1() (*DB1, error) {
 
 // DG1 returns the first DG1 segment within the message, or nil if there isn't one.
 func (m *Message) DG1() (*DG1, error) {
-	ps, err := m.Parse("DG1")
+	ps, err := m.ParseWithTypes(Types, "DG1")
 	pst, ok := ps.(*DG1)
 	if ok {
 		return pst, err
@@ -29271,7 +29267,7 @@ func (m *Message) DG1() (*DG1, error) {
 
 // DMI returns the first DMI segment within the message, or nil if there isn't one.
 func (m *Message) DMI() (*DMI, error) {
-	ps, err := m.Parse("DMI")
+	ps, err := m.ParseWithTypes(Types, "DMI")
 	pst, ok := ps.(*DMI)
 	if ok {
 		return pst, err
@@ -29281,7 +29277,7 @@ func (m *Message) DMI() (*DMI, error) {
 
 // DON returns the first DON segment within the message, or nil if there isn't one.
 func (m *Message) DON() (*DON, error) {
-	ps, err := m.Parse("DON")
+	ps, err := m.ParseWithTypes(Types, "DON")
 	pst, ok := ps.(*DON)
 	if ok {
 		return pst, err
@@ -29291,7 +29287,7 @@ func (m *Message) DON() (*DON, error) {
 
 // DPS returns the first DPS segment within the message, or nil if there isn't one.
 func (m *Message) DPS() (*DPS, error) {
-	ps, err := m.Parse("DPS")
+	ps, err := m.ParseWithTypes(Types, "DPS")
 	pst, ok := ps.(*DPS)
 	if ok {
 		return pst, err
@@ -29301,7 +29297,7 @@ func (m *Message) DPS() (*DPS, error) {
 
 // DRG returns the first DRG segment within the message, or nil if there isn't one.
 func (m *Message) DRG() (*DRG, error) {
-	ps, err := m.Parse("DRG")
+	ps, err := m.ParseWithTypes(Types, "DRG")
 	pst, ok := ps.(*DRG)
 	if ok {
 		return pst, err
@@ -29311,7 +29307,7 @@ func (m *Message) DRG() (*DRG, error) {
 
 // DSC returns the first DSC segment within the message, or nil if there isn't one.
 func (m *Message) DSC() (*DSC, error) {
-	ps, err := m.Parse("DSC")
+	ps, err := m.ParseWithTypes(Types, "DSC")
 	pst, ok := ps.(*DSC)
 	if ok {
 		return pst, err
@@ -29321,7 +29317,7 @@ func (m *Message) DSC() (*DSC, error) {
 
 // DSP returns the first DSP segment within the message, or nil if there isn't one.
 func (m *Message) DSP() (*DSP, error) {
-	ps, err := m.Parse("DSP")
+	ps, err := m.ParseWithTypes(Types, "DSP")
 	pst, ok := ps.(*DSP)
 	if ok {
 		return pst, err
@@ -29331,7 +29327,7 @@ func (m *Message) DSP() (*DSP, error) {
 
 // ECD returns the first ECD segment within the message, or nil if there isn't one.
 func (m *Message) ECD() (*ECD, error) {
-	ps, err := m.Parse("ECD")
+	ps, err := m.ParseWithTypes(Types, "ECD")
 	pst, ok := ps.(*ECD)
 	if ok {
 		return pst, err
@@ -29341,7 +29337,7 @@ func (m *Message) ECD() (*ECD, error) {
 
 // ECR returns the first ECR segment within the message, or nil if there isn't one.
 func (m *Message) ECR() (*ECR, error) {
-	ps, err := m.Parse("ECR")
+	ps, err := m.ParseWithTypes(Types, "ECR")
 	pst, ok := ps.(*ECR)
 	if ok {
 		return pst, err
@@ -29351,7 +29347,7 @@ func (m *Message) ECR() (*ECR, error) {
 
 // EDU returns the first EDU segment within the message, or nil if there isn't one.
 func (m *Message) EDU() (*EDU, error) {
-	ps, err := m.Parse("EDU")
+	ps, err := m.ParseWithTypes(Types, "EDU")
 	pst, ok := ps.(*EDU)
 	if ok {
 		return pst, err
@@ -29361,7 +29357,7 @@ func (m *Message) EDU() (*EDU, error) {
 
 // EQL returns the first EQL segment within the message, or nil if there isn't one.
 func (m *Message) EQL() (*EQL, error) {
-	ps, err := m.Parse("EQL")
+	ps, err := m.ParseWithTypes(Types, "EQL")
 	pst, ok := ps.(*EQL)
 	if ok {
 		return pst, err
@@ -29371,7 +29367,7 @@ func (m *Message) EQL() (*EQL, error) {
 
 // EQP returns the first EQP segment within the message, or nil if there isn't one.
 func (m *Message) EQP() (*EQP, error) {
-	ps, err := m.Parse("EQP")
+	ps, err := m.ParseWithTypes(Types, "EQP")
 	pst, ok := ps.(*EQP)
 	if ok {
 		return pst, err
@@ -29381,7 +29377,7 @@ func (m *Message) EQP() (*EQP, error) {
 
 // EQU returns the first EQU segment within the message, or nil if there isn't one.
 func (m *Message) EQU() (*EQU, error) {
-	ps, err := m.Parse("EQU")
+	ps, err := m.ParseWithTypes(Types, "EQU")
 	pst, ok := ps.(*EQU)
 	if ok {
 		return pst, err
@@ -29391,7 +29387,7 @@ func (m *Message) EQU() (*EQU, error) {
 
 // ERQ returns the first ERQ segment within the message, or nil if there isn't one.
 func (m *Message) ERQ() (*ERQ, error) {
-	ps, err := m.Parse("ERQ")
+	ps, err := m.ParseWithTypes(Types, "ERQ")
 	pst, ok := ps.(*ERQ)
 	if ok {
 		return pst, err
@@ -29401,7 +29397,7 @@ func (m *Message) ERQ() (*ERQ, error) {
 
 // ERR returns the first ERR segment within the message, or nil if there isn't one.
 func (m *Message) ERR() (*ERR, error) {
-	ps, err := m.Parse("ERR")
+	ps, err := m.ParseWithTypes(Types, "ERR")
 	pst, ok := ps.(*ERR)
 	if ok {
 		return pst, err
@@ -29411,7 +29407,7 @@ func (m *Message) ERR() (*ERR, error) {
 
 // EVN returns the first EVN segment within the message, or nil if there isn't one.
 func (m *Message) EVN() (*EVN, error) {
-	ps, err := m.Parse("EVN")
+	ps, err := m.ParseWithTypes(Types, "EVN")
 	pst, ok := ps.(*EVN)
 	if ok {
 		return pst, err
@@ -29421,7 +29417,7 @@ func (m *Message) EVN() (*EVN, error) {
 
 // FAC returns the first FAC segment within the message, or nil if there isn't one.
 func (m *Message) FAC() (*FAC, error) {
-	ps, err := m.Parse("FAC")
+	ps, err := m.ParseWithTypes(Types, "FAC")
 	pst, ok := ps.(*FAC)
 	if ok {
 		return pst, err
@@ -29431,7 +29427,7 @@ func (m *Message) FAC() (*FAC, error) {
 
 // FHS returns the first FHS segment within the message, or nil if there isn't one.
 func (m *Message) FHS() (*FHS, error) {
-	ps, err := m.Parse("FHS")
+	ps, err := m.ParseWithTypes(Types, "FHS")
 	pst, ok := ps.(*FHS)
 	if ok {
 		return pst, err
@@ -29441,7 +29437,7 @@ func (m *Message) FHS() (*FHS, error) {
 
 // FT1 returns the first FT1 segment within the message, or nil if there isn't one.
 func (m *Message) FT1() (*FT1, error) {
-	ps, err := m.Parse("FT1")
+	ps, err := m.ParseWithTypes(Types, "FT1")
 	pst, ok := ps.(*FT1)
 	if ok {
 		return pst, err
@@ -29451,7 +29447,7 @@ func (m *Message) FT1() (*FT1, error) {
 
 // FTS returns the first FTS segment within the message, or nil if there isn't one.
 func (m *Message) FTS() (*FTS, error) {
-	ps, err := m.Parse("FTS")
+	ps, err := m.ParseWithTypes(Types, "FTS")
 	pst, ok := ps.(*FTS)
 	if ok {
 		return pst, err
@@ -29461,7 +29457,7 @@ func (m *Message) FTS() (*FTS, error) {
 
 // GOL returns the first GOL segment within the message, or nil if there isn't one.
 func (m *Message) GOL() (*GOL, error) {
-	ps, err := m.Parse("GOL")
+	ps, err := m.ParseWithTypes(Types, "GOL")
 	pst, ok := ps.(*GOL)
 	if ok {
 		return pst, err
@@ -29471,7 +29467,7 @@ func (m *Message) GOL() (*GOL, error) {
 
 // GP1 returns the first GP1 segment within the message, or nil if there isn't one.
 func (m *Message) GP1() (*GP1, error) {
-	ps, err := m.Parse("GP1")
+	ps, err := m.ParseWithTypes(Types, "GP1")
 	pst, ok := ps.(*GP1)
 	if ok {
 		return pst, err
@@ -29481,7 +29477,7 @@ func (m *Message) GP1() (*GP1, error) {
 
 // GP2 returns the first GP2 segment within the message, or nil if there isn't one.
 func (m *Message) GP2() (*GP2, error) {
-	ps, err := m.Parse("GP2")
+	ps, err := m.ParseWithTypes(Types, "GP2")
 	pst, ok := ps.(*GP2)
 	if ok {
 		return pst, err
@@ -29491,7 +29487,7 @@ func (m *Message) GP2() (*GP2, error) {
 
 // GT1 returns the first GT1 segment within the message, or nil if there isn't one.
 func (m *Message) GT1() (*GT1, error) {
-	ps, err := m.Parse("GT1")
+	ps, err := m.ParseWithTypes(Types, "GT1")
 	pst, ok := ps.(*GT1)
 	if ok {
 		return pst, err
@@ -29501,7 +29497,7 @@ func (m *Message) GT1() (*GT1, error) {
 
 // IAM returns the first IAM segment within the message, or nil if there isn't one.
 func (m *Message) IAM() (*IAM, error) {
-	ps, err := m.Parse("IAM")
+	ps, err := m.ParseWithTypes(Types, "IAM")
 	pst, ok := ps.(*IAM)
 	if ok {
 		return pst, err
@@ -29511,7 +29507,7 @@ func (m *Message) IAM() (*IAM, error) {
 
 // IAR returns the first IAR segment within the message, or nil if there isn't one.
 func (m *Message) IAR() (*IAR, error) {
-	ps, err := m.Parse("IAR")
+	ps, err := m.ParseWithTypes(Types, "IAR")
 	pst, ok := ps.(*IAR)
 	if ok {
 		return pst, err
@@ -29521,7 +29517,7 @@ func (m *Message) IAR() (*IAR, error) {
 
 // IIM returns the first IIM segment within the message, or nil if there isn't one.
 func (m *Message) IIM() (*IIM, error) {
-	ps, err := m.Parse("IIM")
+	ps, err := m.ParseWithTypes(Types, "IIM")
 	pst, ok := ps.(*IIM)
 	if ok {
 		return pst, err
@@ -29531,7 +29527,7 @@ func (m *Message) IIM() (*IIM, error) {
 
 // ILT returns the first ILT segment within the message, or nil if there isn't one.
 func (m *Message) ILT() (*ILT, error) {
-	ps, err := m.Parse("ILT")
+	ps, err := m.ParseWithTypes(Types, "ILT")
 	pst, ok := ps.(*ILT)
 	if ok {
 		return pst, err
@@ -29541,7 +29537,7 @@ func (m *Message) ILT() (*ILT, error) {
 
 // IN1 returns the first IN1 segment within the message, or nil if there isn't one.
 func (m *Message) IN1() (*IN1, error) {
-	ps, err := m.Parse("IN1")
+	ps, err := m.ParseWithTypes(Types, "IN1")
 	pst, ok := ps.(*IN1)
 	if ok {
 		return pst, err
@@ -29551,7 +29547,7 @@ func (m *Message) IN1() (*IN1, error) {
 
 // IN2 returns the first IN2 segment within the message, or nil if there isn't one.
 func (m *Message) IN2() (*IN2, error) {
-	ps, err := m.Parse("IN2")
+	ps, err := m.ParseWithTypes(Types, "IN2")
 	pst, ok := ps.(*IN2)
 	if ok {
 		return pst, err
@@ -29561,7 +29557,7 @@ func (m *Message) IN2() (*IN2, error) {
 
 // IN3 returns the first IN3 segment within the message, or nil if there isn't one.
 func (m *Message) IN3() (*IN3, error) {
-	ps, err := m.Parse("IN3")
+	ps, err := m.ParseWithTypes(Types, "IN3")
 	pst, ok := ps.(*IN3)
 	if ok {
 		return pst, err
@@ -29571,7 +29567,7 @@ func (m *Message) IN3() (*IN3, error) {
 
 // INV returns the first INV segment within the message, or nil if there isn't one.
 func (m *Message) INV() (*INV, error) {
-	ps, err := m.Parse("INV")
+	ps, err := m.ParseWithTypes(Types, "INV")
 	pst, ok := ps.(*INV)
 	if ok {
 		return pst, err
@@ -29581,7 +29577,7 @@ func (m *Message) INV() (*INV, error) {
 
 // IPC returns the first IPC segment within the message, or nil if there isn't one.
 func (m *Message) IPC() (*IPC, error) {
-	ps, err := m.Parse("IPC")
+	ps, err := m.ParseWithTypes(Types, "IPC")
 	pst, ok := ps.(*IPC)
 	if ok {
 		return pst, err
@@ -29591,7 +29587,7 @@ func (m *Message) IPC() (*IPC, error) {
 
 // IPR returns the first IPR segment within the message, or nil if there isn't one.
 func (m *Message) IPR() (*IPR, error) {
-	ps, err := m.Parse("IPR")
+	ps, err := m.ParseWithTypes(Types, "IPR")
 	pst, ok := ps.(*IPR)
 	if ok {
 		return pst, err
@@ -29601,7 +29597,7 @@ func (m *Message) IPR() (*IPR, error) {
 
 // ISD returns the first ISD segment within the message, or nil if there isn't one.
 func (m *Message) ISD() (*ISD, error) {
-	ps, err := m.Parse("ISD")
+	ps, err := m.ParseWithTypes(Types, "ISD")
 	pst, ok := ps.(*ISD)
 	if ok {
 		return pst, err
@@ -29611,7 +29607,7 @@ func (m *Message) ISD() (*ISD, error) {
 
 // ITM returns the first ITM segment within the message, or nil if there isn't one.
 func (m *Message) ITM() (*ITM, error) {
-	ps, err := m.Parse("ITM")
+	ps, err := m.ParseWithTypes(Types, "ITM")
 	pst, ok := ps.(*ITM)
 	if ok {
 		return pst, err
@@ -29621,7 +29617,7 @@ func (m *Message) ITM() (*ITM, error) {
 
 // IVC returns the first IVC segment within the message, or nil if there isn't one.
 func (m *Message) IVC() (*IVC, error) {
-	ps, err := m.Parse("IVC")
+	ps, err := m.ParseWithTypes(Types, "IVC")
 	pst, ok := ps.(*IVC)
 	if ok {
 		return pst, err
@@ -29631,7 +29627,7 @@ func (m *Message) IVC() (*IVC, error) {
 
 // IVT returns the first IVT segment within the message, or nil if there isn't one.
 func (m *Message) IVT() (*IVT, error) {
-	ps, err := m.Parse("IVT")
+	ps, err := m.ParseWithTypes(Types, "IVT")
 	pst, ok := ps.(*IVT)
 	if ok {
 		return pst, err
@@ -29641,7 +29637,7 @@ func (m *Message) IVT() (*IVT, error) {
 
 // LAN returns the first LAN segment within the message, or nil if there isn't one.
 func (m *Message) LAN() (*LAN, error) {
-	ps, err := m.Parse("LAN")
+	ps, err := m.ParseWithTypes(Types, "LAN")
 	pst, ok := ps.(*LAN)
 	if ok {
 		return pst, err
@@ -29651,7 +29647,7 @@ func (m *Message) LAN() (*LAN, error) {
 
 // LCC returns the first LCC segment within the message, or nil if there isn't one.
 func (m *Message) LCC() (*LCC, error) {
-	ps, err := m.Parse("LCC")
+	ps, err := m.ParseWithTypes(Types, "LCC")
 	pst, ok := ps.(*LCC)
 	if ok {
 		return pst, err
@@ -29661,7 +29657,7 @@ func (m *Message) LCC() (*LCC, error) {
 
 // LCH returns the first LCH segment within the message, or nil if there isn't one.
 func (m *Message) LCH() (*LCH, error) {
-	ps, err := m.Parse("LCH")
+	ps, err := m.ParseWithTypes(Types, "LCH")
 	pst, ok := ps.(*LCH)
 	if ok {
 		return pst, err
@@ -29671,7 +29667,7 @@ func (m *Message) LCH() (*LCH, error) {
 
 // LDP returns the first LDP segment within the message, or nil if there isn't one.
 func (m *Message) LDP() (*LDP, error) {
-	ps, err := m.Parse("LDP")
+	ps, err := m.ParseWithTypes(Types, "LDP")
 	pst, ok := ps.(*LDP)
 	if ok {
 		return pst, err
@@ -29681,7 +29677,7 @@ func (m *Message) LDP() (*LDP, error) {
 
 // LOC returns the first LOC segment within the message, or nil if there isn't one.
 func (m *Message) LOC() (*LOC, error) {
-	ps, err := m.Parse("LOC")
+	ps, err := m.ParseWithTypes(Types, "LOC")
 	pst, ok := ps.(*LOC)
 	if ok {
 		return pst, err
@@ -29691,7 +29687,7 @@ func (m *Message) LOC() (*LOC, error) {
 
 // LRL returns the first LRL segment within the message, or nil if there isn't one.
 func (m *Message) LRL() (*LRL, error) {
-	ps, err := m.Parse("LRL")
+	ps, err := m.ParseWithTypes(Types, "LRL")
 	pst, ok := ps.(*LRL)
 	if ok {
 		return pst, err
@@ -29701,7 +29697,7 @@ func (m *Message) LRL() (*LRL, error) {
 
 // MCP returns the first MCP segment within the message, or nil if there isn't one.
 func (m *Message) MCP() (*MCP, error) {
-	ps, err := m.Parse("MCP")
+	ps, err := m.ParseWithTypes(Types, "MCP")
 	pst, ok := ps.(*MCP)
 	if ok {
 		return pst, err
@@ -29711,7 +29707,7 @@ func (m *Message) MCP() (*MCP, error) {
 
 // MFA returns the first MFA segment within the message, or nil if there isn't one.
 func (m *Message) MFA() (*MFA, error) {
-	ps, err := m.Parse("MFA")
+	ps, err := m.ParseWithTypes(Types, "MFA")
 	pst, ok := ps.(*MFA)
 	if ok {
 		return pst, err
@@ -29721,7 +29717,7 @@ func (m *Message) MFA() (*MFA, error) {
 
 // MFE returns the first MFE segment within the message, or nil if there isn't one.
 func (m *Message) MFE() (*MFE, error) {
-	ps, err := m.Parse("MFE")
+	ps, err := m.ParseWithTypes(Types, "MFE")
 	pst, ok := ps.(*MFE)
 	if ok {
 		return pst, err
@@ -29731,7 +29727,7 @@ func (m *Message) MFE() (*MFE, error) {
 
 // MFI returns the first MFI segment within the message, or nil if there isn't one.
 func (m *Message) MFI() (*MFI, error) {
-	ps, err := m.Parse("MFI")
+	ps, err := m.ParseWithTypes(Types, "MFI")
 	pst, ok := ps.(*MFI)
 	if ok {
 		return pst, err
@@ -29741,7 +29737,7 @@ func (m *Message) MFI() (*MFI, error) {
 
 // MRG returns the first MRG segment within the message, or nil if there isn't one.
 func (m *Message) MRG() (*MRG, error) {
-	ps, err := m.Parse("MRG")
+	ps, err := m.ParseWithTypes(Types, "MRG")
 	pst, ok := ps.(*MRG)
 	if ok {
 		return pst, err
@@ -29751,7 +29747,7 @@ func (m *Message) MRG() (*MRG, error) {
 
 // MSA returns the first MSA segment within the message, or nil if there isn't one.
 func (m *Message) MSA() (*MSA, error) {
-	ps, err := m.Parse("MSA")
+	ps, err := m.ParseWithTypes(Types, "MSA")
 	pst, ok := ps.(*MSA)
 	if ok {
 		return pst, err
@@ -29761,7 +29757,7 @@ func (m *Message) MSA() (*MSA, error) {
 
 // MSH returns the first MSH segment within the message, or nil if there isn't one.
 func (m *Message) MSH() (*MSH, error) {
-	ps, err := m.Parse("MSH")
+	ps, err := m.ParseWithTypes(Types, "MSH")
 	pst, ok := ps.(*MSH)
 	if ok {
 		return pst, err
@@ -29771,7 +29767,7 @@ func (m *Message) MSH() (*MSH, error) {
 
 // NCK returns the first NCK segment within the message, or nil if there isn't one.
 func (m *Message) NCK() (*NCK, error) {
-	ps, err := m.Parse("NCK")
+	ps, err := m.ParseWithTypes(Types, "NCK")
 	pst, ok := ps.(*NCK)
 	if ok {
 		return pst, err
@@ -29781,7 +29777,7 @@ func (m *Message) NCK() (*NCK, error) {
 
 // NDS returns the first NDS segment within the message, or nil if there isn't one.
 func (m *Message) NDS() (*NDS, error) {
-	ps, err := m.Parse("NDS")
+	ps, err := m.ParseWithTypes(Types, "NDS")
 	pst, ok := ps.(*NDS)
 	if ok {
 		return pst, err
@@ -29791,7 +29787,7 @@ func (m *Message) NDS() (*NDS, error) {
 
 // NK1 returns the first NK1 segment within the message, or nil if there isn't one.
 func (m *Message) NK1() (*NK1, error) {
-	ps, err := m.Parse("NK1")
+	ps, err := m.ParseWithTypes(Types, "NK1")
 	pst, ok := ps.(*NK1)
 	if ok {
 		return pst, err
@@ -29801,7 +29797,7 @@ func (m *Message) NK1() (*NK1, error) {
 
 // NPU returns the first NPU segment within the message, or nil if there isn't one.
 func (m *Message) NPU() (*NPU, error) {
-	ps, err := m.Parse("NPU")
+	ps, err := m.ParseWithTypes(Types, "NPU")
 	pst, ok := ps.(*NPU)
 	if ok {
 		return pst, err
@@ -29811,7 +29807,7 @@ func (m *Message) NPU() (*NPU, error) {
 
 // NSC returns the first NSC segment within the message, or nil if there isn't one.
 func (m *Message) NSC() (*NSC, error) {
-	ps, err := m.Parse("NSC")
+	ps, err := m.ParseWithTypes(Types, "NSC")
 	pst, ok := ps.(*NSC)
 	if ok {
 		return pst, err
@@ -29821,7 +29817,7 @@ func (m *Message) NSC() (*NSC, error) {
 
 // NST returns the first NST segment within the message, or nil if there isn't one.
 func (m *Message) NST() (*NST, error) {
-	ps, err := m.Parse("NST")
+	ps, err := m.ParseWithTypes(Types, "NST")
 	pst, ok := ps.(*NST)
 	if ok {
 		return pst, err
@@ -29831,7 +29827,7 @@ func (m *Message) NST() (*NST, error) {
 
 // NTE returns the first NTE segment within the message, or nil if there isn't one.
 func (m *Message) NTE() (*NTE, error) {
-	ps, err := m.Parse("NTE")
+	ps, err := m.ParseWithTypes(Types, "NTE")
 	pst, ok := ps.(*NTE)
 	if ok {
 		return pst, err
@@ -29841,7 +29837,7 @@ func (m *Message) NTE() (*NTE, error) {
 
 // OBR returns the first OBR segment within the message, or nil if there isn't one.
 func (m *Message) OBR() (*OBR, error) {
-	ps, err := m.Parse("OBR")
+	ps, err := m.ParseWithTypes(Types, "OBR")
 	pst, ok := ps.(*OBR)
 	if ok {
 		return pst, err
@@ -29851,7 +29847,7 @@ func (m *Message) OBR() (*OBR, error) {
 
 // OBX returns the first OBX segment within the message, or nil if there isn't one.
 func (m *Message) OBX() (*OBX, error) {
-	ps, err := m.Parse("OBX")
+	ps, err := m.ParseWithTypes(Types, "OBX")
 	pst, ok := ps.(*OBX)
 	if ok {
 		return pst, err
@@ -29861,7 +29857,7 @@ func (m *Message) OBX() (*OBX, error) {
 
 // ODS returns the first ODS segment within the message, or nil if there isn't one.
 func (m *Message) ODS() (*ODS, error) {
-	ps, err := m.Parse("ODS")
+	ps, err := m.ParseWithTypes(Types, "ODS")
 	pst, ok := ps.(*ODS)
 	if ok {
 		return pst, err
@@ -29871,7 +29867,7 @@ func (m *Message) ODS() (*ODS, error) {
 
 // ODT returns the first ODT segment within the message, or nil if there isn't one.
 func (m *Message) ODT() (*ODT, error) {
-	ps, err := m.Parse("ODT")
+	ps, err := m.ParseWithTypes(Types, "ODT")
 	pst, ok := ps.(*ODT)
 	if ok {
 		return pst, err
@@ -29881,7 +29877,7 @@ func (m *Message) ODT() (*ODT, error) {
 
 // OM1 returns the first OM1 segment within the message, or nil if there isn't one.
 func (m *Message) OM1() (*OM1, error) {
-	ps, err := m.Parse("OM1")
+	ps, err := m.ParseWithTypes(Types, "OM1")
 	pst, ok := ps.(*OM1)
 	if ok {
 		return pst, err
@@ -29891,7 +29887,7 @@ func (m *Message) OM1() (*OM1, error) {
 
 // OM2 returns the first OM2 segment within the message, or nil if there isn't one.
 func (m *Message) OM2() (*OM2, error) {
-	ps, err := m.Parse("OM2")
+	ps, err := m.ParseWithTypes(Types, "OM2")
 	pst, ok := ps.(*OM2)
 	if ok {
 		return pst, err
@@ -29901,7 +29897,7 @@ func (m *Message) OM2() (*OM2, error) {
 
 // OM3 returns the first OM3 segment within the message, or nil if there isn't one.
 func (m *Message) OM3() (*OM3, error) {
-	ps, err := m.Parse("OM3")
+	ps, err := m.ParseWithTypes(Types, "OM3")
 	pst, ok := ps.(*OM3)
 	if ok {
 		return pst, err
@@ -29911,7 +29907,7 @@ func (m *Message) OM3() (*OM3, error) {
 
 // OM4 returns the first OM4 segment within the message, or nil if there isn't one.
 func (m *Message) OM4() (*OM4, error) {
-	ps, err := m.Parse("OM4")
+	ps, err := m.ParseWithTypes(Types, "OM4")
 	pst, ok := ps.(*OM4)
 	if ok {
 		return pst, err
@@ -29921,7 +29917,7 @@ func (m *Message) OM4() (*OM4, error) {
 
 // OM5 returns the first OM5 segment within the message, or nil if there isn't one.
 func (m *Message) OM5() (*OM5, error) {
-	ps, err := m.Parse("OM5")
+	ps, err := m.ParseWithTypes(Types, "OM5")
 	pst, ok := ps.(*OM5)
 	if ok {
 		return pst, err
@@ -29931,7 +29927,7 @@ func (m *Message) OM5() (*OM5, error) {
 
 // OM6 returns the first OM6 segment within the message, or nil if there isn't one.
 func (m *Message) OM6() (*OM6, error) {
-	ps, err := m.Parse("OM6")
+	ps, err := m.ParseWithTypes(Types, "OM6")
 	pst, ok := ps.(*OM6)
 	if ok {
 		return pst, err
@@ -29941,7 +29937,7 @@ func (m *Message) OM6() (*OM6, error) {
 
 // OM7 returns the first OM7 segment within the message, or nil if there isn't one.
 func (m *Message) OM7() (*OM7, error) {
-	ps, err := m.Parse("OM7")
+	ps, err := m.ParseWithTypes(Types, "OM7")
 	pst, ok := ps.(*OM7)
 	if ok {
 		return pst, err
@@ -29951,7 +29947,7 @@ func (m *Message) OM7() (*OM7, error) {
 
 // OMC returns the first OMC segment within the message, or nil if there isn't one.
 func (m *Message) OMC() (*OMC, error) {
-	ps, err := m.Parse("OMC")
+	ps, err := m.ParseWithTypes(Types, "OMC")
 	pst, ok := ps.(*OMC)
 	if ok {
 		return pst, err
@@ -29961,7 +29957,7 @@ func (m *Message) OMC() (*OMC, error) {
 
 // ORC returns the first ORC segment within the message, or nil if there isn't one.
 func (m *Message) ORC() (*ORC, error) {
-	ps, err := m.Parse("ORC")
+	ps, err := m.ParseWithTypes(Types, "ORC")
 	pst, ok := ps.(*ORC)
 	if ok {
 		return pst, err
@@ -29971,7 +29967,7 @@ func (m *Message) ORC() (*ORC, error) {
 
 // ORG returns the first ORG segment within the message, or nil if there isn't one.
 func (m *Message) ORG() (*ORG, error) {
-	ps, err := m.Parse("ORG")
+	ps, err := m.ParseWithTypes(Types, "ORG")
 	pst, ok := ps.(*ORG)
 	if ok {
 		return pst, err
@@ -29981,7 +29977,7 @@ func (m *Message) ORG() (*ORG, error) {
 
 // ORO returns the first ORO segment within the message, or nil if there isn't one.
 func (m *Message) ORO() (*ORO, error) {
-	ps, err := m.Parse("ORO")
+	ps, err := m.ParseWithTypes(Types, "ORO")
 	pst, ok := ps.(*ORO)
 	if ok {
 		return pst, err
@@ -29991,7 +29987,7 @@ func (m *Message) ORO() (*ORO, error) {
 
 // OVR returns the first OVR segment within the message, or nil if there isn't one.
 func (m *Message) OVR() (*OVR, error) {
-	ps, err := m.Parse("OVR")
+	ps, err := m.ParseWithTypes(Types, "OVR")
 	pst, ok := ps.(*OVR)
 	if ok {
 		return pst, err
@@ -30001,7 +29997,7 @@ func (m *Message) OVR() (*OVR, error) {
 
 // PAC returns the first PAC segment within the message, or nil if there isn't one.
 func (m *Message) PAC() (*PAC, error) {
-	ps, err := m.Parse("PAC")
+	ps, err := m.ParseWithTypes(Types, "PAC")
 	pst, ok := ps.(*PAC)
 	if ok {
 		return pst, err
@@ -30011,7 +30007,7 @@ func (m *Message) PAC() (*PAC, error) {
 
 // PCE returns the first PCE segment within the message, or nil if there isn't one.
 func (m *Message) PCE() (*PCE, error) {
-	ps, err := m.Parse("PCE")
+	ps, err := m.ParseWithTypes(Types, "PCE")
 	pst, ok := ps.(*PCE)
 	if ok {
 		return pst, err
@@ -30021,7 +30017,7 @@ func (m *Message) PCE() (*PCE, error) {
 
 // PCR returns the first PCR segment within the message, or nil if there isn't one.
 func (m *Message) PCR() (*PCR, error) {
-	ps, err := m.Parse("PCR")
+	ps, err := m.ParseWithTypes(Types, "PCR")
 	pst, ok := ps.(*PCR)
 	if ok {
 		return pst, err
@@ -30031,7 +30027,7 @@ func (m *Message) PCR() (*PCR, error) {
 
 // PD1 returns the first PD1 segment within the message, or nil if there isn't one.
 func (m *Message) PD1() (*PD1, error) {
-	ps, err := m.Parse("PD1")
+	ps, err := m.ParseWithTypes(Types, "PD1")
 	pst, ok := ps.(*PD1)
 	if ok {
 		return pst, err
@@ -30041,7 +30037,7 @@ func (m *Message) PD1() (*PD1, error) {
 
 // PDA returns the first PDA segment within the message, or nil if there isn't one.
 func (m *Message) PDA() (*PDA, error) {
-	ps, err := m.Parse("PDA")
+	ps, err := m.ParseWithTypes(Types, "PDA")
 	pst, ok := ps.(*PDA)
 	if ok {
 		return pst, err
@@ -30051,7 +30047,7 @@ func (m *Message) PDA() (*PDA, error) {
 
 // PDC returns the first PDC segment within the message, or nil if there isn't one.
 func (m *Message) PDC() (*PDC, error) {
-	ps, err := m.Parse("PDC")
+	ps, err := m.ParseWithTypes(Types, "PDC")
 	pst, ok := ps.(*PDC)
 	if ok {
 		return pst, err
@@ -30061,7 +30057,7 @@ func (m *Message) PDC() (*PDC, error) {
 
 // PEO returns the first PEO segment within the message, or nil if there isn't one.
 func (m *Message) PEO() (*PEO, error) {
-	ps, err := m.Parse("PEO")
+	ps, err := m.ParseWithTypes(Types, "PEO")
 	pst, ok := ps.(*PEO)
 	if ok {
 		return pst, err
@@ -30071,7 +30067,7 @@ func (m *Message) PEO() (*PEO, error) {
 
 // PES returns the first PES segment within the message, or nil if there isn't one.
 func (m *Message) PES() (*PES, error) {
-	ps, err := m.Parse("PES")
+	ps, err := m.ParseWithTypes(Types, "PES")
 	pst, ok := ps.(*PES)
 	if ok {
 		return pst, err
@@ -30081,7 +30077,7 @@ func (m *Message) PES() (*PES, error) {
 
 // PID returns the first PID segment within the message, or nil if there isn't one.
 func (m *Message) PID() (*PID, error) {
-	ps, err := m.Parse("PID")
+	ps, err := m.ParseWithTypes(Types, "PID")
 	pst, ok := ps.(*PID)
 	if ok {
 		return pst, err
@@ -30091,7 +30087,7 @@ func (m *Message) PID() (*PID, error) {
 
 // PKG returns the first PKG segment within the message, or nil if there isn't one.
 func (m *Message) PKG() (*PKG, error) {
-	ps, err := m.Parse("PKG")
+	ps, err := m.ParseWithTypes(Types, "PKG")
 	pst, ok := ps.(*PKG)
 	if ok {
 		return pst, err
@@ -30101,7 +30097,7 @@ func (m *Message) PKG() (*PKG, error) {
 
 // PM1 returns the first PM1 segment within the message, or nil if there isn't one.
 func (m *Message) PM1() (*PM1, error) {
-	ps, err := m.Parse("PM1")
+	ps, err := m.ParseWithTypes(Types, "PM1")
 	pst, ok := ps.(*PM1)
 	if ok {
 		return pst, err
@@ -30111,7 +30107,7 @@ func (m *Message) PM1() (*PM1, error) {
 
 // PMT returns the first PMT segment within the message, or nil if there isn't one.
 func (m *Message) PMT() (*PMT, error) {
-	ps, err := m.Parse("PMT")
+	ps, err := m.ParseWithTypes(Types, "PMT")
 	pst, ok := ps.(*PMT)
 	if ok {
 		return pst, err
@@ -30121,7 +30117,7 @@ func (m *Message) PMT() (*PMT, error) {
 
 // PR1 returns the first PR1 segment within the message, or nil if there isn't one.
 func (m *Message) PR1() (*PR1, error) {
-	ps, err := m.Parse("PR1")
+	ps, err := m.ParseWithTypes(Types, "PR1")
 	pst, ok := ps.(*PR1)
 	if ok {
 		return pst, err
@@ -30131,7 +30127,7 @@ func (m *Message) PR1() (*PR1, error) {
 
 // PRA returns the first PRA segment within the message, or nil if there isn't one.
 func (m *Message) PRA() (*PRA, error) {
-	ps, err := m.Parse("PRA")
+	ps, err := m.ParseWithTypes(Types, "PRA")
 	pst, ok := ps.(*PRA)
 	if ok {
 		return pst, err
@@ -30141,7 +30137,7 @@ func (m *Message) PRA() (*PRA, error) {
 
 // PRB returns the first PRB segment within the message, or nil if there isn't one.
 func (m *Message) PRB() (*PRB, error) {
-	ps, err := m.Parse("PRB")
+	ps, err := m.ParseWithTypes(Types, "PRB")
 	pst, ok := ps.(*PRB)
 	if ok {
 		return pst, err
@@ -30151,7 +30147,7 @@ func (m *Message) PRB() (*PRB, error) {
 
 // PRC returns the first PRC segment within the message, or nil if there isn't one.
 func (m *Message) PRC() (*PRC, error) {
-	ps, err := m.Parse("PRC")
+	ps, err := m.ParseWithTypes(Types, "PRC")
 	pst, ok := ps.(*PRC)
 	if ok {
 		return pst, err
@@ -30161,7 +30157,7 @@ func (m *Message) PRC() (*PRC, error) {
 
 // PRD returns the first PRD segment within the message, or nil if there isn't one.
 func (m *Message) PRD() (*PRD, error) {
-	ps, err := m.Parse("PRD")
+	ps, err := m.ParseWithTypes(Types, "PRD")
 	pst, ok := ps.(*PRD)
 	if ok {
 		return pst, err
@@ -30171,7 +30167,7 @@ func (m *Message) PRD() (*PRD, error) {
 
 // PRT returns the first PRT segment within the message, or nil if there isn't one.
 func (m *Message) PRT() (*PRT, error) {
-	ps, err := m.Parse("PRT")
+	ps, err := m.ParseWithTypes(Types, "PRT")
 	pst, ok := ps.(*PRT)
 	if ok {
 		return pst, err
@@ -30181,7 +30177,7 @@ func (m *Message) PRT() (*PRT, error) {
 
 // PSG returns the first PSG segment within the message, or nil if there isn't one.
 func (m *Message) PSG() (*PSG, error) {
-	ps, err := m.Parse("PSG")
+	ps, err := m.ParseWithTypes(Types, "PSG")
 	pst, ok := ps.(*PSG)
 	if ok {
 		return pst, err
@@ -30191,7 +30187,7 @@ func (m *Message) PSG() (*PSG, error) {
 
 // PSH returns the first PSH segment within the message, or nil if there isn't one.
 func (m *Message) PSH() (*PSH, error) {
-	ps, err := m.Parse("PSH")
+	ps, err := m.ParseWithTypes(Types, "PSH")
 	pst, ok := ps.(*PSH)
 	if ok {
 		return pst, err
@@ -30201,7 +30197,7 @@ func (m *Message) PSH() (*PSH, error) {
 
 // PSL returns the first PSL segment within the message, or nil if there isn't one.
 func (m *Message) PSL() (*PSL, error) {
-	ps, err := m.Parse("PSL")
+	ps, err := m.ParseWithTypes(Types, "PSL")
 	pst, ok := ps.(*PSL)
 	if ok {
 		return pst, err
@@ -30211,7 +30207,7 @@ func (m *Message) PSL() (*PSL, error) {
 
 // PSS returns the first PSS segment within the message, or nil if there isn't one.
 func (m *Message) PSS() (*PSS, error) {
-	ps, err := m.Parse("PSS")
+	ps, err := m.ParseWithTypes(Types, "PSS")
 	pst, ok := ps.(*PSS)
 	if ok {
 		return pst, err
@@ -30221,7 +30217,7 @@ func (m *Message) PSS() (*PSS, error) {
 
 // PTH returns the first PTH segment within the message, or nil if there isn't one.
 func (m *Message) PTH() (*PTH, error) {
-	ps, err := m.Parse("PTH")
+	ps, err := m.ParseWithTypes(Types, "PTH")
 	pst, ok := ps.(*PTH)
 	if ok {
 		return pst, err
@@ -30231,7 +30227,7 @@ func (m *Message) PTH() (*PTH, error) {
 
 // PV1 returns the first PV1 segment within the message, or nil if there isn't one.
 func (m *Message) PV1() (*PV1, error) {
-	ps, err := m.Parse("PV1")
+	ps, err := m.ParseWithTypes(Types, "PV1")
 	pst, ok := ps.(*PV1)
 	if ok {
 		return pst, err
@@ -30241,7 +30237,7 @@ func (m *Message) PV1() (*PV1, error) {
 
 // PV2 returns the first PV2 segment within the message, or nil if there isn't one.
 func (m *Message) PV2() (*PV2, error) {
-	ps, err := m.Parse("PV2")
+	ps, err := m.ParseWithTypes(Types, "PV2")
 	pst, ok := ps.(*PV2)
 	if ok {
 		return pst, err
@@ -30251,7 +30247,7 @@ func (m *Message) PV2() (*PV2, error) {
 
 // PYE returns the first PYE segment within the message, or nil if there isn't one.
 func (m *Message) PYE() (*PYE, error) {
-	ps, err := m.Parse("PYE")
+	ps, err := m.ParseWithTypes(Types, "PYE")
 	pst, ok := ps.(*PYE)
 	if ok {
 		return pst, err
@@ -30261,7 +30257,7 @@ func (m *Message) PYE() (*PYE, error) {
 
 // QAK returns the first QAK segment within the message, or nil if there isn't one.
 func (m *Message) QAK() (*QAK, error) {
-	ps, err := m.Parse("QAK")
+	ps, err := m.ParseWithTypes(Types, "QAK")
 	pst, ok := ps.(*QAK)
 	if ok {
 		return pst, err
@@ -30271,7 +30267,7 @@ func (m *Message) QAK() (*QAK, error) {
 
 // QID returns the first QID segment within the message, or nil if there isn't one.
 func (m *Message) QID() (*QID, error) {
-	ps, err := m.Parse("QID")
+	ps, err := m.ParseWithTypes(Types, "QID")
 	pst, ok := ps.(*QID)
 	if ok {
 		return pst, err
@@ -30281,7 +30277,7 @@ func (m *Message) QID() (*QID, error) {
 
 // QPD returns the first QPD segment within the message, or nil if there isn't one.
 func (m *Message) QPD() (*QPD, error) {
-	ps, err := m.Parse("QPD")
+	ps, err := m.ParseWithTypes(Types, "QPD")
 	pst, ok := ps.(*QPD)
 	if ok {
 		return pst, err
@@ -30291,7 +30287,7 @@ func (m *Message) QPD() (*QPD, error) {
 
 // QRD returns the first QRD segment within the message, or nil if there isn't one.
 func (m *Message) QRD() (*QRD, error) {
-	ps, err := m.Parse("QRD")
+	ps, err := m.ParseWithTypes(Types, "QRD")
 	pst, ok := ps.(*QRD)
 	if ok {
 		return pst, err
@@ -30301,7 +30297,7 @@ func (m *Message) QRD() (*QRD, error) {
 
 // QRF returns the first QRF segment within the message, or nil if there isn't one.
 func (m *Message) QRF() (*QRF, error) {
-	ps, err := m.Parse("QRF")
+	ps, err := m.ParseWithTypes(Types, "QRF")
 	pst, ok := ps.(*QRF)
 	if ok {
 		return pst, err
@@ -30311,7 +30307,7 @@ func (m *Message) QRF() (*QRF, error) {
 
 // QRI returns the first QRI segment within the message, or nil if there isn't one.
 func (m *Message) QRI() (*QRI, error) {
-	ps, err := m.Parse("QRI")
+	ps, err := m.ParseWithTypes(Types, "QRI")
 	pst, ok := ps.(*QRI)
 	if ok {
 		return pst, err
@@ -30321,7 +30317,7 @@ func (m *Message) QRI() (*QRI, error) {
 
 // RCP returns the first RCP segment within the message, or nil if there isn't one.
 func (m *Message) RCP() (*RCP, error) {
-	ps, err := m.Parse("RCP")
+	ps, err := m.ParseWithTypes(Types, "RCP")
 	pst, ok := ps.(*RCP)
 	if ok {
 		return pst, err
@@ -30331,7 +30327,7 @@ func (m *Message) RCP() (*RCP, error) {
 
 // RDF returns the first RDF segment within the message, or nil if there isn't one.
 func (m *Message) RDF() (*RDF, error) {
-	ps, err := m.Parse("RDF")
+	ps, err := m.ParseWithTypes(Types, "RDF")
 	pst, ok := ps.(*RDF)
 	if ok {
 		return pst, err
@@ -30341,7 +30337,7 @@ func (m *Message) RDF() (*RDF, error) {
 
 // RDT returns the first RDT segment within the message, or nil if there isn't one.
 func (m *Message) RDT() (*RDT, error) {
-	ps, err := m.Parse("RDT")
+	ps, err := m.ParseWithTypes(Types, "RDT")
 	pst, ok := ps.(*RDT)
 	if ok {
 		return pst, err
@@ -30351,7 +30347,7 @@ func (m *Message) RDT() (*RDT, error) {
 
 // REL returns the first REL segment within the message, or nil if there isn't one.
 func (m *Message) REL() (*REL, error) {
-	ps, err := m.Parse("REL")
+	ps, err := m.ParseWithTypes(Types, "REL")
 	pst, ok := ps.(*REL)
 	if ok {
 		return pst, err
@@ -30361,7 +30357,7 @@ func (m *Message) REL() (*REL, error) {
 
 // RF1 returns the first RF1 segment within the message, or nil if there isn't one.
 func (m *Message) RF1() (*RF1, error) {
-	ps, err := m.Parse("RF1")
+	ps, err := m.ParseWithTypes(Types, "RF1")
 	pst, ok := ps.(*RF1)
 	if ok {
 		return pst, err
@@ -30371,7 +30367,7 @@ func (m *Message) RF1() (*RF1, error) {
 
 // RFI returns the first RFI segment within the message, or nil if there isn't one.
 func (m *Message) RFI() (*RFI, error) {
-	ps, err := m.Parse("RFI")
+	ps, err := m.ParseWithTypes(Types, "RFI")
 	pst, ok := ps.(*RFI)
 	if ok {
 		return pst, err
@@ -30381,7 +30377,7 @@ func (m *Message) RFI() (*RFI, error) {
 
 // RGS returns the first RGS segment within the message, or nil if there isn't one.
 func (m *Message) RGS() (*RGS, error) {
-	ps, err := m.Parse("RGS")
+	ps, err := m.ParseWithTypes(Types, "RGS")
 	pst, ok := ps.(*RGS)
 	if ok {
 		return pst, err
@@ -30391,7 +30387,7 @@ func (m *Message) RGS() (*RGS, error) {
 
 // RMI returns the first RMI segment within the message, or nil if there isn't one.
 func (m *Message) RMI() (*RMI, error) {
-	ps, err := m.Parse("RMI")
+	ps, err := m.ParseWithTypes(Types, "RMI")
 	pst, ok := ps.(*RMI)
 	if ok {
 		return pst, err
@@ -30401,7 +30397,7 @@ func (m *Message) RMI() (*RMI, error) {
 
 // ROL returns the first ROL segment within the message, or nil if there isn't one.
 func (m *Message) ROL() (*ROL, error) {
-	ps, err := m.Parse("ROL")
+	ps, err := m.ParseWithTypes(Types, "ROL")
 	pst, ok := ps.(*ROL)
 	if ok {
 		return pst, err
@@ -30411,7 +30407,7 @@ func (m *Message) ROL() (*ROL, error) {
 
 // RQ1 returns the first RQ1 segment within the message, or nil if there isn't one.
 func (m *Message) RQ1() (*RQ1, error) {
-	ps, err := m.Parse("RQ1")
+	ps, err := m.ParseWithTypes(Types, "RQ1")
 	pst, ok := ps.(*RQ1)
 	if ok {
 		return pst, err
@@ -30421,7 +30417,7 @@ func (m *Message) RQ1() (*RQ1, error) {
 
 // RQD returns the first RQD segment within the message, or nil if there isn't one.
 func (m *Message) RQD() (*RQD, error) {
-	ps, err := m.Parse("RQD")
+	ps, err := m.ParseWithTypes(Types, "RQD")
 	pst, ok := ps.(*RQD)
 	if ok {
 		return pst, err
@@ -30431,7 +30427,7 @@ func (m *Message) RQD() (*RQD, error) {
 
 // RX1 returns the first RX1 segment within the message, or nil if there isn't one.
 func (m *Message) RX1() (*RX1, error) {
-	ps, err := m.Parse("RX1")
+	ps, err := m.ParseWithTypes(Types, "RX1")
 	pst, ok := ps.(*RX1)
 	if ok {
 		return pst, err
@@ -30441,7 +30437,7 @@ func (m *Message) RX1() (*RX1, error) {
 
 // RXA returns the first RXA segment within the message, or nil if there isn't one.
 func (m *Message) RXA() (*RXA, error) {
-	ps, err := m.Parse("RXA")
+	ps, err := m.ParseWithTypes(Types, "RXA")
 	pst, ok := ps.(*RXA)
 	if ok {
 		return pst, err
@@ -30451,7 +30447,7 @@ func (m *Message) RXA() (*RXA, error) {
 
 // RXC returns the first RXC segment within the message, or nil if there isn't one.
 func (m *Message) RXC() (*RXC, error) {
-	ps, err := m.Parse("RXC")
+	ps, err := m.ParseWithTypes(Types, "RXC")
 	pst, ok := ps.(*RXC)
 	if ok {
 		return pst, err
@@ -30461,7 +30457,7 @@ func (m *Message) RXC() (*RXC, error) {
 
 // RXD returns the first RXD segment within the message, or nil if there isn't one.
 func (m *Message) RXD() (*RXD, error) {
-	ps, err := m.Parse("RXD")
+	ps, err := m.ParseWithTypes(Types, "RXD")
 	pst, ok := ps.(*RXD)
 	if ok {
 		return pst, err
@@ -30471,7 +30467,7 @@ func (m *Message) RXD() (*RXD, error) {
 
 // RXE returns the first RXE segment within the message, or nil if there isn't one.
 func (m *Message) RXE() (*RXE, error) {
-	ps, err := m.Parse("RXE")
+	ps, err := m.ParseWithTypes(Types, "RXE")
 	pst, ok := ps.(*RXE)
 	if ok {
 		return pst, err
@@ -30481,7 +30477,7 @@ func (m *Message) RXE() (*RXE, error) {
 
 // RXG returns the first RXG segment within the message, or nil if there isn't one.
 func (m *Message) RXG() (*RXG, error) {
-	ps, err := m.Parse("RXG")
+	ps, err := m.ParseWithTypes(Types, "RXG")
 	pst, ok := ps.(*RXG)
 	if ok {
 		return pst, err
@@ -30491,7 +30487,7 @@ func (m *Message) RXG() (*RXG, error) {
 
 // RXO returns the first RXO segment within the message, or nil if there isn't one.
 func (m *Message) RXO() (*RXO, error) {
-	ps, err := m.Parse("RXO")
+	ps, err := m.ParseWithTypes(Types, "RXO")
 	pst, ok := ps.(*RXO)
 	if ok {
 		return pst, err
@@ -30501,7 +30497,7 @@ func (m *Message) RXO() (*RXO, error) {
 
 // RXR returns the first RXR segment within the message, or nil if there isn't one.
 func (m *Message) RXR() (*RXR, error) {
-	ps, err := m.Parse("RXR")
+	ps, err := m.ParseWithTypes(Types, "RXR")
 	pst, ok := ps.(*RXR)
 	if ok {
 		return pst, err
@@ -30511,7 +30507,7 @@ func (m *Message) RXR() (*RXR, error) {
 
 // RXV returns the first RXV segment within the message, or nil if there isn't one.
 func (m *Message) RXV() (*RXV, error) {
-	ps, err := m.Parse("RXV")
+	ps, err := m.ParseWithTypes(Types, "RXV")
 	pst, ok := ps.(*RXV)
 	if ok {
 		return pst, err
@@ -30521,7 +30517,7 @@ func (m *Message) RXV() (*RXV, error) {
 
 // SAC returns the first SAC segment within the message, or nil if there isn't one.
 func (m *Message) SAC() (*SAC, error) {
-	ps, err := m.Parse("SAC")
+	ps, err := m.ParseWithTypes(Types, "SAC")
 	pst, ok := ps.(*SAC)
 	if ok {
 		return pst, err
@@ -30531,7 +30527,7 @@ func (m *Message) SAC() (*SAC, error) {
 
 // SCD returns the first SCD segment within the message, or nil if there isn't one.
 func (m *Message) SCD() (*SCD, error) {
-	ps, err := m.Parse("SCD")
+	ps, err := m.ParseWithTypes(Types, "SCD")
 	pst, ok := ps.(*SCD)
 	if ok {
 		return pst, err
@@ -30541,7 +30537,7 @@ func (m *Message) SCD() (*SCD, error) {
 
 // SCH returns the first SCH segment within the message, or nil if there isn't one.
 func (m *Message) SCH() (*SCH, error) {
-	ps, err := m.Parse("SCH")
+	ps, err := m.ParseWithTypes(Types, "SCH")
 	pst, ok := ps.(*SCH)
 	if ok {
 		return pst, err
@@ -30551,7 +30547,7 @@ func (m *Message) SCH() (*SCH, error) {
 
 // SCP returns the first SCP segment within the message, or nil if there isn't one.
 func (m *Message) SCP() (*SCP, error) {
-	ps, err := m.Parse("SCP")
+	ps, err := m.ParseWithTypes(Types, "SCP")
 	pst, ok := ps.(*SCP)
 	if ok {
 		return pst, err
@@ -30561,7 +30557,7 @@ func (m *Message) SCP() (*SCP, error) {
 
 // SDD returns the first SDD segment within the message, or nil if there isn't one.
 func (m *Message) SDD() (*SDD, error) {
-	ps, err := m.Parse("SDD")
+	ps, err := m.ParseWithTypes(Types, "SDD")
 	pst, ok := ps.(*SDD)
 	if ok {
 		return pst, err
@@ -30571,7 +30567,7 @@ func (m *Message) SDD() (*SDD, error) {
 
 // SFT returns the first SFT segment within the message, or nil if there isn't one.
 func (m *Message) SFT() (*SFT, error) {
-	ps, err := m.Parse("SFT")
+	ps, err := m.ParseWithTypes(Types, "SFT")
 	pst, ok := ps.(*SFT)
 	if ok {
 		return pst, err
@@ -30581,7 +30577,7 @@ func (m *Message) SFT() (*SFT, error) {
 
 // SGH returns the first SGH segment within the message, or nil if there isn't one.
 func (m *Message) SGH() (*SGH, error) {
-	ps, err := m.Parse("SGH")
+	ps, err := m.ParseWithTypes(Types, "SGH")
 	pst, ok := ps.(*SGH)
 	if ok {
 		return pst, err
@@ -30591,7 +30587,7 @@ func (m *Message) SGH() (*SGH, error) {
 
 // SGT returns the first SGT segment within the message, or nil if there isn't one.
 func (m *Message) SGT() (*SGT, error) {
-	ps, err := m.Parse("SGT")
+	ps, err := m.ParseWithTypes(Types, "SGT")
 	pst, ok := ps.(*SGT)
 	if ok {
 		return pst, err
@@ -30601,7 +30597,7 @@ func (m *Message) SGT() (*SGT, error) {
 
 // SHP returns the first SHP segment within the message, or nil if there isn't one.
 func (m *Message) SHP() (*SHP, error) {
-	ps, err := m.Parse("SHP")
+	ps, err := m.ParseWithTypes(Types, "SHP")
 	pst, ok := ps.(*SHP)
 	if ok {
 		return pst, err
@@ -30611,7 +30607,7 @@ func (m *Message) SHP() (*SHP, error) {
 
 // SID returns the first SID segment within the message, or nil if there isn't one.
 func (m *Message) SID() (*SID, error) {
-	ps, err := m.Parse("SID")
+	ps, err := m.ParseWithTypes(Types, "SID")
 	pst, ok := ps.(*SID)
 	if ok {
 		return pst, err
@@ -30621,7 +30617,7 @@ func (m *Message) SID() (*SID, error) {
 
 // SLT returns the first SLT segment within the message, or nil if there isn't one.
 func (m *Message) SLT() (*SLT, error) {
-	ps, err := m.Parse("SLT")
+	ps, err := m.ParseWithTypes(Types, "SLT")
 	pst, ok := ps.(*SLT)
 	if ok {
 		return pst, err
@@ -30631,7 +30627,7 @@ func (m *Message) SLT() (*SLT, error) {
 
 // SPM returns the first SPM segment within the message, or nil if there isn't one.
 func (m *Message) SPM() (*SPM, error) {
-	ps, err := m.Parse("SPM")
+	ps, err := m.ParseWithTypes(Types, "SPM")
 	pst, ok := ps.(*SPM)
 	if ok {
 		return pst, err
@@ -30641,7 +30637,7 @@ func (m *Message) SPM() (*SPM, error) {
 
 // SPR returns the first SPR segment within the message, or nil if there isn't one.
 func (m *Message) SPR() (*SPR, error) {
-	ps, err := m.Parse("SPR")
+	ps, err := m.ParseWithTypes(Types, "SPR")
 	pst, ok := ps.(*SPR)
 	if ok {
 		return pst, err
@@ -30651,7 +30647,7 @@ func (m *Message) SPR() (*SPR, error) {
 
 // STF returns the first STF segment within the message, or nil if there isn't one.
 func (m *Message) STF() (*STF, error) {
-	ps, err := m.Parse("STF")
+	ps, err := m.ParseWithTypes(Types, "STF")
 	pst, ok := ps.(*STF)
 	if ok {
 		return pst, err
@@ -30661,7 +30657,7 @@ func (m *Message) STF() (*STF, error) {
 
 // STZ returns the first STZ segment within the message, or nil if there isn't one.
 func (m *Message) STZ() (*STZ, error) {
-	ps, err := m.Parse("STZ")
+	ps, err := m.ParseWithTypes(Types, "STZ")
 	pst, ok := ps.(*STZ)
 	if ok {
 		return pst, err
@@ -30671,7 +30667,7 @@ func (m *Message) STZ() (*STZ, error) {
 
 // TCC returns the first TCC segment within the message, or nil if there isn't one.
 func (m *Message) TCC() (*TCC, error) {
-	ps, err := m.Parse("TCC")
+	ps, err := m.ParseWithTypes(Types, "TCC")
 	pst, ok := ps.(*TCC)
 	if ok {
 		return pst, err
@@ -30681,7 +30677,7 @@ func (m *Message) TCC() (*TCC, error) {
 
 // TCD returns the first TCD segment within the message, or nil if there isn't one.
 func (m *Message) TCD() (*TCD, error) {
-	ps, err := m.Parse("TCD")
+	ps, err := m.ParseWithTypes(Types, "TCD")
 	pst, ok := ps.(*TCD)
 	if ok {
 		return pst, err
@@ -30691,7 +30687,7 @@ func (m *Message) TCD() (*TCD, error) {
 
 // TQ1 returns the first TQ1 segment within the message, or nil if there isn't one.
 func (m *Message) TQ1() (*TQ1, error) {
-	ps, err := m.Parse("TQ1")
+	ps, err := m.ParseWithTypes(Types, "TQ1")
 	pst, ok := ps.(*TQ1)
 	if ok {
 		return pst, err
@@ -30701,7 +30697,7 @@ func (m *Message) TQ1() (*TQ1, error) {
 
 // TQ2 returns the first TQ2 segment within the message, or nil if there isn't one.
 func (m *Message) TQ2() (*TQ2, error) {
-	ps, err := m.Parse("TQ2")
+	ps, err := m.ParseWithTypes(Types, "TQ2")
 	pst, ok := ps.(*TQ2)
 	if ok {
 		return pst, err
@@ -30711,7 +30707,7 @@ func (m *Message) TQ2() (*TQ2, error) {
 
 // TXA returns the first TXA segment within the message, or nil if there isn't one.
 func (m *Message) TXA() (*TXA, error) {
-	ps, err := m.Parse("TXA")
+	ps, err := m.ParseWithTypes(Types, "TXA")
 	pst, ok := ps.(*TXA)
 	if ok {
 		return pst, err
@@ -30721,7 +30717,7 @@ func (m *Message) TXA() (*TXA, error) {
 
 // UAC returns the first UAC segment within the message, or nil if there isn't one.
 func (m *Message) UAC() (*UAC, error) {
-	ps, err := m.Parse("UAC")
+	ps, err := m.ParseWithTypes(Types, "UAC")
 	pst, ok := ps.(*UAC)
 	if ok {
 		return pst, err
@@ -30731,7 +30727,7 @@ func (m *Message) UAC() (*UAC, error) {
 
 // UB1 returns the first UB1 segment within the message, or nil if there isn't one.
 func (m *Message) UB1() (*UB1, error) {
-	ps, err := m.Parse("UB1")
+	ps, err := m.ParseWithTypes(Types, "UB1")
 	pst, ok := ps.(*UB1)
 	if ok {
 		return pst, err
@@ -30741,7 +30737,7 @@ func (m *Message) UB1() (*UB1, error) {
 
 // UB2 returns the first UB2 segment within the message, or nil if there isn't one.
 func (m *Message) UB2() (*UB2, error) {
-	ps, err := m.Parse("UB2")
+	ps, err := m.ParseWithTypes(Types, "UB2")
 	pst, ok := ps.(*UB2)
 	if ok {
 		return pst, err
@@ -30751,7 +30747,7 @@ func (m *Message) UB2() (*UB2, error) {
 
 // URD returns the first URD segment within the message, or nil if there isn't one.
 func (m *Message) URD() (*URD, error) {
-	ps, err := m.Parse("URD")
+	ps, err := m.ParseWithTypes(Types, "URD")
 	pst, ok := ps.(*URD)
 	if ok {
 		return pst, err
@@ -30761,7 +30757,7 @@ func (m *Message) URD() (*URD, error) {
 
 // URS returns the first URS segment within the message, or nil if there isn't one.
 func (m *Message) URS() (*URS, error) {
-	ps, err := m.Parse("URS")
+	ps, err := m.ParseWithTypes(Types, "URS")
 	pst, ok := ps.(*URS)
 	if ok {
 		return pst, err
@@ -30771,7 +30767,7 @@ func (m *Message) URS() (*URS, error) {
 
 // VAR returns the first VAR segment within the message, or nil if there isn't one.
 func (m *Message) VAR() (*VAR, error) {
-	ps, err := m.Parse("VAR")
+	ps, err := m.ParseWithTypes(Types, "VAR")
 	pst, ok := ps.(*VAR)
 	if ok {
 		return pst, err
@@ -30781,7 +30777,7 @@ func (m *Message) VAR() (*VAR, error) {
 
 // VND returns the first VND segment within the message, or nil if there isn't one.
 func (m *Message) VND() (*VND, error) {
-	ps, err := m.Parse("VND")
+	ps, err := m.ParseWithTypes(Types, "VND")
 	pst, ok := ps.(*VND)
 	if ok {
 		return pst, err
@@ -30791,7 +30787,7 @@ func (m *Message) VND() (*VND, error) {
 
 // VTQ returns the first VTQ segment within the message, or nil if there isn't one.
 func (m *Message) VTQ() (*VTQ, error) {
-	ps, err := m.Parse("VTQ")
+	ps, err := m.ParseWithTypes(Types, "VTQ")
 	pst, ok := ps.(*VTQ)
 	if ok {
 		return pst, err
@@ -30801,7 +30797,7 @@ func (m *Message) VTQ() (*VTQ, error) {
 
 // ZL7 returns the first ZL7 segment within the message, or nil if there isn't one.
 func (m *Message) ZL7() (*ZL7, error) {
-	ps, err := m.Parse("ZL7")
+	ps, err := m.ParseWithTypes(Types, "ZL7")
 	pst, ok := ps.(*ZL7)
 	if ok {
 		return pst, err
@@ -30812,1337 +30808,1337 @@ func (m *Message) ZL7() (*ZL7, error) {
 // AllABS returns a slice containing all ABS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllABS() ([]*ABS, error) {
-	pss, err := m.ParseAll("ABS")
+	pss, err := m.ParseAllWithTypes(Types, "ABS")
 	return pss.([]*ABS), err
 }
 
 // AllACC returns a slice containing all ACC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllACC() ([]*ACC, error) {
-	pss, err := m.ParseAll("ACC")
+	pss, err := m.ParseAllWithTypes(Types, "ACC")
 	return pss.([]*ACC), err
 }
 
 // AllADD returns a slice containing all ADD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADD() ([]*ADD, error) {
-	pss, err := m.ParseAll("ADD")
+	pss, err := m.ParseAllWithTypes(Types, "ADD")
 	return pss.([]*ADD), err
 }
 
 // AllADJ returns a slice containing all ADJ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllADJ() ([]*ADJ, error) {
-	pss, err := m.ParseAll("ADJ")
+	pss, err := m.ParseAllWithTypes(Types, "ADJ")
 	return pss.([]*ADJ), err
 }
 
 // AllAFF returns a slice containing all AFF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAFF() ([]*AFF, error) {
-	pss, err := m.ParseAll("AFF")
+	pss, err := m.ParseAllWithTypes(Types, "AFF")
 	return pss.([]*AFF), err
 }
 
 // AllAIG returns a slice containing all AIG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIG() ([]*AIG, error) {
-	pss, err := m.ParseAll("AIG")
+	pss, err := m.ParseAllWithTypes(Types, "AIG")
 	return pss.([]*AIG), err
 }
 
 // AllAIL returns a slice containing all AIL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIL() ([]*AIL, error) {
-	pss, err := m.ParseAll("AIL")
+	pss, err := m.ParseAllWithTypes(Types, "AIL")
 	return pss.([]*AIL), err
 }
 
 // AllAIP returns a slice containing all AIP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIP() ([]*AIP, error) {
-	pss, err := m.ParseAll("AIP")
+	pss, err := m.ParseAllWithTypes(Types, "AIP")
 	return pss.([]*AIP), err
 }
 
 // AllAIS returns a slice containing all AIS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAIS() ([]*AIS, error) {
-	pss, err := m.ParseAll("AIS")
+	pss, err := m.ParseAllWithTypes(Types, "AIS")
 	return pss.([]*AIS), err
 }
 
 // AllAL1 returns a slice containing all AL1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAL1() ([]*AL1, error) {
-	pss, err := m.ParseAll("AL1")
+	pss, err := m.ParseAllWithTypes(Types, "AL1")
 	return pss.([]*AL1), err
 }
 
 // AllAPR returns a slice containing all APR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAPR() ([]*APR, error) {
-	pss, err := m.ParseAll("APR")
+	pss, err := m.ParseAllWithTypes(Types, "APR")
 	return pss.([]*APR), err
 }
 
 // AllARQ returns a slice containing all ARQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARQ() ([]*ARQ, error) {
-	pss, err := m.ParseAll("ARQ")
+	pss, err := m.ParseAllWithTypes(Types, "ARQ")
 	return pss.([]*ARQ), err
 }
 
 // AllARV returns a slice containing all ARV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllARV() ([]*ARV, error) {
-	pss, err := m.ParseAll("ARV")
+	pss, err := m.ParseAllWithTypes(Types, "ARV")
 	return pss.([]*ARV), err
 }
 
 // AllAUT returns a slice containing all AUT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllAUT() ([]*AUT, error) {
-	pss, err := m.ParseAll("AUT")
+	pss, err := m.ParseAllWithTypes(Types, "AUT")
 	return pss.([]*AUT), err
 }
 
 // AllBHS returns a slice containing all BHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBHS() ([]*BHS, error) {
-	pss, err := m.ParseAll("BHS")
+	pss, err := m.ParseAllWithTypes(Types, "BHS")
 	return pss.([]*BHS), err
 }
 
 // AllBLC returns a slice containing all BLC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLC() ([]*BLC, error) {
-	pss, err := m.ParseAll("BLC")
+	pss, err := m.ParseAllWithTypes(Types, "BLC")
 	return pss.([]*BLC), err
 }
 
 // AllBLG returns a slice containing all BLG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBLG() ([]*BLG, error) {
-	pss, err := m.ParseAll("BLG")
+	pss, err := m.ParseAllWithTypes(Types, "BLG")
 	return pss.([]*BLG), err
 }
 
 // AllBPO returns a slice containing all BPO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPO() ([]*BPO, error) {
-	pss, err := m.ParseAll("BPO")
+	pss, err := m.ParseAllWithTypes(Types, "BPO")
 	return pss.([]*BPO), err
 }
 
 // AllBPX returns a slice containing all BPX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBPX() ([]*BPX, error) {
-	pss, err := m.ParseAll("BPX")
+	pss, err := m.ParseAllWithTypes(Types, "BPX")
 	return pss.([]*BPX), err
 }
 
 // AllBTS returns a slice containing all BTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTS() ([]*BTS, error) {
-	pss, err := m.ParseAll("BTS")
+	pss, err := m.ParseAllWithTypes(Types, "BTS")
 	return pss.([]*BTS), err
 }
 
 // AllBTX returns a slice containing all BTX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBTX() ([]*BTX, error) {
-	pss, err := m.ParseAll("BTX")
+	pss, err := m.ParseAllWithTypes(Types, "BTX")
 	return pss.([]*BTX), err
 }
 
 // AllBUI returns a slice containing all BUI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllBUI() ([]*BUI, error) {
-	pss, err := m.ParseAll("BUI")
+	pss, err := m.ParseAllWithTypes(Types, "BUI")
 	return pss.([]*BUI), err
 }
 
 // AllCDM returns a slice containing all CDM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCDM() ([]*CDM, error) {
-	pss, err := m.ParseAll("CDM")
+	pss, err := m.ParseAllWithTypes(Types, "CDM")
 	return pss.([]*CDM), err
 }
 
 // AllCDO returns a slice containing all CDO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCDO() ([]*CDO, error) {
-	pss, err := m.ParseAll("CDO")
+	pss, err := m.ParseAllWithTypes(Types, "CDO")
 	return pss.([]*CDO), err
 }
 
 // AllCER returns a slice containing all CER segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCER() ([]*CER, error) {
-	pss, err := m.ParseAll("CER")
+	pss, err := m.ParseAllWithTypes(Types, "CER")
 	return pss.([]*CER), err
 }
 
 // AllCM0 returns a slice containing all CM0 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM0() ([]*CM0, error) {
-	pss, err := m.ParseAll("CM0")
+	pss, err := m.ParseAllWithTypes(Types, "CM0")
 	return pss.([]*CM0), err
 }
 
 // AllCM1 returns a slice containing all CM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM1() ([]*CM1, error) {
-	pss, err := m.ParseAll("CM1")
+	pss, err := m.ParseAllWithTypes(Types, "CM1")
 	return pss.([]*CM1), err
 }
 
 // AllCM2 returns a slice containing all CM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCM2() ([]*CM2, error) {
-	pss, err := m.ParseAll("CM2")
+	pss, err := m.ParseAllWithTypes(Types, "CM2")
 	return pss.([]*CM2), err
 }
 
 // AllCNS returns a slice containing all CNS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCNS() ([]*CNS, error) {
-	pss, err := m.ParseAll("CNS")
+	pss, err := m.ParseAllWithTypes(Types, "CNS")
 	return pss.([]*CNS), err
 }
 
 // AllCON returns a slice containing all CON segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCON() ([]*CON, error) {
-	pss, err := m.ParseAll("CON")
+	pss, err := m.ParseAllWithTypes(Types, "CON")
 	return pss.([]*CON), err
 }
 
 // AllCSP returns a slice containing all CSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSP() ([]*CSP, error) {
-	pss, err := m.ParseAll("CSP")
+	pss, err := m.ParseAllWithTypes(Types, "CSP")
 	return pss.([]*CSP), err
 }
 
 // AllCSR returns a slice containing all CSR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSR() ([]*CSR, error) {
-	pss, err := m.ParseAll("CSR")
+	pss, err := m.ParseAllWithTypes(Types, "CSR")
 	return pss.([]*CSR), err
 }
 
 // AllCSS returns a slice containing all CSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCSS() ([]*CSS, error) {
-	pss, err := m.ParseAll("CSS")
+	pss, err := m.ParseAllWithTypes(Types, "CSS")
 	return pss.([]*CSS), err
 }
 
 // AllCTD returns a slice containing all CTD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTD() ([]*CTD, error) {
-	pss, err := m.ParseAll("CTD")
+	pss, err := m.ParseAllWithTypes(Types, "CTD")
 	return pss.([]*CTD), err
 }
 
 // AllCTI returns a slice containing all CTI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllCTI() ([]*CTI, error) {
-	pss, err := m.ParseAll("CTI")
+	pss, err := m.ParseAllWithTypes(Types, "CTI")
 	return pss.([]*CTI), err
 }
 
 // AllDB1 returns a slice containing all DB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDB1() ([]*DB1, error) {
-	pss, err := m.ParseAll("DB1")
+	pss, err := m.ParseAllWithTypes(Types, "DB1")
 	return pss.([]*DB1), err
 }
 
 // AllDG1 returns a slice containing all DG1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDG1() ([]*DG1, error) {
-	pss, err := m.ParseAll("DG1")
+	pss, err := m.ParseAllWithTypes(Types, "DG1")
 	return pss.([]*DG1), err
 }
 
 // AllDMI returns a slice containing all DMI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDMI() ([]*DMI, error) {
-	pss, err := m.ParseAll("DMI")
+	pss, err := m.ParseAllWithTypes(Types, "DMI")
 	return pss.([]*DMI), err
 }
 
 // AllDON returns a slice containing all DON segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDON() ([]*DON, error) {
-	pss, err := m.ParseAll("DON")
+	pss, err := m.ParseAllWithTypes(Types, "DON")
 	return pss.([]*DON), err
 }
 
 // AllDPS returns a slice containing all DPS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDPS() ([]*DPS, error) {
-	pss, err := m.ParseAll("DPS")
+	pss, err := m.ParseAllWithTypes(Types, "DPS")
 	return pss.([]*DPS), err
 }
 
 // AllDRG returns a slice containing all DRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDRG() ([]*DRG, error) {
-	pss, err := m.ParseAll("DRG")
+	pss, err := m.ParseAllWithTypes(Types, "DRG")
 	return pss.([]*DRG), err
 }
 
 // AllDSC returns a slice containing all DSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSC() ([]*DSC, error) {
-	pss, err := m.ParseAll("DSC")
+	pss, err := m.ParseAllWithTypes(Types, "DSC")
 	return pss.([]*DSC), err
 }
 
 // AllDSP returns a slice containing all DSP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllDSP() ([]*DSP, error) {
-	pss, err := m.ParseAll("DSP")
+	pss, err := m.ParseAllWithTypes(Types, "DSP")
 	return pss.([]*DSP), err
 }
 
 // AllECD returns a slice containing all ECD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECD() ([]*ECD, error) {
-	pss, err := m.ParseAll("ECD")
+	pss, err := m.ParseAllWithTypes(Types, "ECD")
 	return pss.([]*ECD), err
 }
 
 // AllECR returns a slice containing all ECR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllECR() ([]*ECR, error) {
-	pss, err := m.ParseAll("ECR")
+	pss, err := m.ParseAllWithTypes(Types, "ECR")
 	return pss.([]*ECR), err
 }
 
 // AllEDU returns a slice containing all EDU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEDU() ([]*EDU, error) {
-	pss, err := m.ParseAll("EDU")
+	pss, err := m.ParseAllWithTypes(Types, "EDU")
 	return pss.([]*EDU), err
 }
 
 // AllEQL returns a slice containing all EQL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQL() ([]*EQL, error) {
-	pss, err := m.ParseAll("EQL")
+	pss, err := m.ParseAllWithTypes(Types, "EQL")
 	return pss.([]*EQL), err
 }
 
 // AllEQP returns a slice containing all EQP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQP() ([]*EQP, error) {
-	pss, err := m.ParseAll("EQP")
+	pss, err := m.ParseAllWithTypes(Types, "EQP")
 	return pss.([]*EQP), err
 }
 
 // AllEQU returns a slice containing all EQU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEQU() ([]*EQU, error) {
-	pss, err := m.ParseAll("EQU")
+	pss, err := m.ParseAllWithTypes(Types, "EQU")
 	return pss.([]*EQU), err
 }
 
 // AllERQ returns a slice containing all ERQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERQ() ([]*ERQ, error) {
-	pss, err := m.ParseAll("ERQ")
+	pss, err := m.ParseAllWithTypes(Types, "ERQ")
 	return pss.([]*ERQ), err
 }
 
 // AllERR returns a slice containing all ERR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllERR() ([]*ERR, error) {
-	pss, err := m.ParseAll("ERR")
+	pss, err := m.ParseAllWithTypes(Types, "ERR")
 	return pss.([]*ERR), err
 }
 
 // AllEVN returns a slice containing all EVN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllEVN() ([]*EVN, error) {
-	pss, err := m.ParseAll("EVN")
+	pss, err := m.ParseAllWithTypes(Types, "EVN")
 	return pss.([]*EVN), err
 }
 
 // AllFAC returns a slice containing all FAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFAC() ([]*FAC, error) {
-	pss, err := m.ParseAll("FAC")
+	pss, err := m.ParseAllWithTypes(Types, "FAC")
 	return pss.([]*FAC), err
 }
 
 // AllFHS returns a slice containing all FHS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFHS() ([]*FHS, error) {
-	pss, err := m.ParseAll("FHS")
+	pss, err := m.ParseAllWithTypes(Types, "FHS")
 	return pss.([]*FHS), err
 }
 
 // AllFT1 returns a slice containing all FT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFT1() ([]*FT1, error) {
-	pss, err := m.ParseAll("FT1")
+	pss, err := m.ParseAllWithTypes(Types, "FT1")
 	return pss.([]*FT1), err
 }
 
 // AllFTS returns a slice containing all FTS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllFTS() ([]*FTS, error) {
-	pss, err := m.ParseAll("FTS")
+	pss, err := m.ParseAllWithTypes(Types, "FTS")
 	return pss.([]*FTS), err
 }
 
 // AllGOL returns a slice containing all GOL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGOL() ([]*GOL, error) {
-	pss, err := m.ParseAll("GOL")
+	pss, err := m.ParseAllWithTypes(Types, "GOL")
 	return pss.([]*GOL), err
 }
 
 // AllGP1 returns a slice containing all GP1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP1() ([]*GP1, error) {
-	pss, err := m.ParseAll("GP1")
+	pss, err := m.ParseAllWithTypes(Types, "GP1")
 	return pss.([]*GP1), err
 }
 
 // AllGP2 returns a slice containing all GP2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGP2() ([]*GP2, error) {
-	pss, err := m.ParseAll("GP2")
+	pss, err := m.ParseAllWithTypes(Types, "GP2")
 	return pss.([]*GP2), err
 }
 
 // AllGT1 returns a slice containing all GT1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllGT1() ([]*GT1, error) {
-	pss, err := m.ParseAll("GT1")
+	pss, err := m.ParseAllWithTypes(Types, "GT1")
 	return pss.([]*GT1), err
 }
 
 // AllIAM returns a slice containing all IAM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIAM() ([]*IAM, error) {
-	pss, err := m.ParseAll("IAM")
+	pss, err := m.ParseAllWithTypes(Types, "IAM")
 	return pss.([]*IAM), err
 }
 
 // AllIAR returns a slice containing all IAR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIAR() ([]*IAR, error) {
-	pss, err := m.ParseAll("IAR")
+	pss, err := m.ParseAllWithTypes(Types, "IAR")
 	return pss.([]*IAR), err
 }
 
 // AllIIM returns a slice containing all IIM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIIM() ([]*IIM, error) {
-	pss, err := m.ParseAll("IIM")
+	pss, err := m.ParseAllWithTypes(Types, "IIM")
 	return pss.([]*IIM), err
 }
 
 // AllILT returns a slice containing all ILT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllILT() ([]*ILT, error) {
-	pss, err := m.ParseAll("ILT")
+	pss, err := m.ParseAllWithTypes(Types, "ILT")
 	return pss.([]*ILT), err
 }
 
 // AllIN1 returns a slice containing all IN1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN1() ([]*IN1, error) {
-	pss, err := m.ParseAll("IN1")
+	pss, err := m.ParseAllWithTypes(Types, "IN1")
 	return pss.([]*IN1), err
 }
 
 // AllIN2 returns a slice containing all IN2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN2() ([]*IN2, error) {
-	pss, err := m.ParseAll("IN2")
+	pss, err := m.ParseAllWithTypes(Types, "IN2")
 	return pss.([]*IN2), err
 }
 
 // AllIN3 returns a slice containing all IN3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIN3() ([]*IN3, error) {
-	pss, err := m.ParseAll("IN3")
+	pss, err := m.ParseAllWithTypes(Types, "IN3")
 	return pss.([]*IN3), err
 }
 
 // AllINV returns a slice containing all INV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllINV() ([]*INV, error) {
-	pss, err := m.ParseAll("INV")
+	pss, err := m.ParseAllWithTypes(Types, "INV")
 	return pss.([]*INV), err
 }
 
 // AllIPC returns a slice containing all IPC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIPC() ([]*IPC, error) {
-	pss, err := m.ParseAll("IPC")
+	pss, err := m.ParseAllWithTypes(Types, "IPC")
 	return pss.([]*IPC), err
 }
 
 // AllIPR returns a slice containing all IPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIPR() ([]*IPR, error) {
-	pss, err := m.ParseAll("IPR")
+	pss, err := m.ParseAllWithTypes(Types, "IPR")
 	return pss.([]*IPR), err
 }
 
 // AllISD returns a slice containing all ISD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllISD() ([]*ISD, error) {
-	pss, err := m.ParseAll("ISD")
+	pss, err := m.ParseAllWithTypes(Types, "ISD")
 	return pss.([]*ISD), err
 }
 
 // AllITM returns a slice containing all ITM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllITM() ([]*ITM, error) {
-	pss, err := m.ParseAll("ITM")
+	pss, err := m.ParseAllWithTypes(Types, "ITM")
 	return pss.([]*ITM), err
 }
 
 // AllIVC returns a slice containing all IVC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIVC() ([]*IVC, error) {
-	pss, err := m.ParseAll("IVC")
+	pss, err := m.ParseAllWithTypes(Types, "IVC")
 	return pss.([]*IVC), err
 }
 
 // AllIVT returns a slice containing all IVT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllIVT() ([]*IVT, error) {
-	pss, err := m.ParseAll("IVT")
+	pss, err := m.ParseAllWithTypes(Types, "IVT")
 	return pss.([]*IVT), err
 }
 
 // AllLAN returns a slice containing all LAN segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLAN() ([]*LAN, error) {
-	pss, err := m.ParseAll("LAN")
+	pss, err := m.ParseAllWithTypes(Types, "LAN")
 	return pss.([]*LAN), err
 }
 
 // AllLCC returns a slice containing all LCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCC() ([]*LCC, error) {
-	pss, err := m.ParseAll("LCC")
+	pss, err := m.ParseAllWithTypes(Types, "LCC")
 	return pss.([]*LCC), err
 }
 
 // AllLCH returns a slice containing all LCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLCH() ([]*LCH, error) {
-	pss, err := m.ParseAll("LCH")
+	pss, err := m.ParseAllWithTypes(Types, "LCH")
 	return pss.([]*LCH), err
 }
 
 // AllLDP returns a slice containing all LDP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLDP() ([]*LDP, error) {
-	pss, err := m.ParseAll("LDP")
+	pss, err := m.ParseAllWithTypes(Types, "LDP")
 	return pss.([]*LDP), err
 }
 
 // AllLOC returns a slice containing all LOC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLOC() ([]*LOC, error) {
-	pss, err := m.ParseAll("LOC")
+	pss, err := m.ParseAllWithTypes(Types, "LOC")
 	return pss.([]*LOC), err
 }
 
 // AllLRL returns a slice containing all LRL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllLRL() ([]*LRL, error) {
-	pss, err := m.ParseAll("LRL")
+	pss, err := m.ParseAllWithTypes(Types, "LRL")
 	return pss.([]*LRL), err
 }
 
 // AllMCP returns a slice containing all MCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMCP() ([]*MCP, error) {
-	pss, err := m.ParseAll("MCP")
+	pss, err := m.ParseAllWithTypes(Types, "MCP")
 	return pss.([]*MCP), err
 }
 
 // AllMFA returns a slice containing all MFA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFA() ([]*MFA, error) {
-	pss, err := m.ParseAll("MFA")
+	pss, err := m.ParseAllWithTypes(Types, "MFA")
 	return pss.([]*MFA), err
 }
 
 // AllMFE returns a slice containing all MFE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFE() ([]*MFE, error) {
-	pss, err := m.ParseAll("MFE")
+	pss, err := m.ParseAllWithTypes(Types, "MFE")
 	return pss.([]*MFE), err
 }
 
 // AllMFI returns a slice containing all MFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMFI() ([]*MFI, error) {
-	pss, err := m.ParseAll("MFI")
+	pss, err := m.ParseAllWithTypes(Types, "MFI")
 	return pss.([]*MFI), err
 }
 
 // AllMRG returns a slice containing all MRG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMRG() ([]*MRG, error) {
-	pss, err := m.ParseAll("MRG")
+	pss, err := m.ParseAllWithTypes(Types, "MRG")
 	return pss.([]*MRG), err
 }
 
 // AllMSA returns a slice containing all MSA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSA() ([]*MSA, error) {
-	pss, err := m.ParseAll("MSA")
+	pss, err := m.ParseAllWithTypes(Types, "MSA")
 	return pss.([]*MSA), err
 }
 
 // AllMSH returns a slice containing all MSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllMSH() ([]*MSH, error) {
-	pss, err := m.ParseAll("MSH")
+	pss, err := m.ParseAllWithTypes(Types, "MSH")
 	return pss.([]*MSH), err
 }
 
 // AllNCK returns a slice containing all NCK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNCK() ([]*NCK, error) {
-	pss, err := m.ParseAll("NCK")
+	pss, err := m.ParseAllWithTypes(Types, "NCK")
 	return pss.([]*NCK), err
 }
 
 // AllNDS returns a slice containing all NDS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNDS() ([]*NDS, error) {
-	pss, err := m.ParseAll("NDS")
+	pss, err := m.ParseAllWithTypes(Types, "NDS")
 	return pss.([]*NDS), err
 }
 
 // AllNK1 returns a slice containing all NK1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNK1() ([]*NK1, error) {
-	pss, err := m.ParseAll("NK1")
+	pss, err := m.ParseAllWithTypes(Types, "NK1")
 	return pss.([]*NK1), err
 }
 
 // AllNPU returns a slice containing all NPU segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNPU() ([]*NPU, error) {
-	pss, err := m.ParseAll("NPU")
+	pss, err := m.ParseAllWithTypes(Types, "NPU")
 	return pss.([]*NPU), err
 }
 
 // AllNSC returns a slice containing all NSC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNSC() ([]*NSC, error) {
-	pss, err := m.ParseAll("NSC")
+	pss, err := m.ParseAllWithTypes(Types, "NSC")
 	return pss.([]*NSC), err
 }
 
 // AllNST returns a slice containing all NST segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNST() ([]*NST, error) {
-	pss, err := m.ParseAll("NST")
+	pss, err := m.ParseAllWithTypes(Types, "NST")
 	return pss.([]*NST), err
 }
 
 // AllNTE returns a slice containing all NTE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllNTE() ([]*NTE, error) {
-	pss, err := m.ParseAll("NTE")
+	pss, err := m.ParseAllWithTypes(Types, "NTE")
 	return pss.([]*NTE), err
 }
 
 // AllOBR returns a slice containing all OBR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBR() ([]*OBR, error) {
-	pss, err := m.ParseAll("OBR")
+	pss, err := m.ParseAllWithTypes(Types, "OBR")
 	return pss.([]*OBR), err
 }
 
 // AllOBX returns a slice containing all OBX segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOBX() ([]*OBX, error) {
-	pss, err := m.ParseAll("OBX")
+	pss, err := m.ParseAllWithTypes(Types, "OBX")
 	return pss.([]*OBX), err
 }
 
 // AllODS returns a slice containing all ODS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODS() ([]*ODS, error) {
-	pss, err := m.ParseAll("ODS")
+	pss, err := m.ParseAllWithTypes(Types, "ODS")
 	return pss.([]*ODS), err
 }
 
 // AllODT returns a slice containing all ODT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllODT() ([]*ODT, error) {
-	pss, err := m.ParseAll("ODT")
+	pss, err := m.ParseAllWithTypes(Types, "ODT")
 	return pss.([]*ODT), err
 }
 
 // AllOM1 returns a slice containing all OM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM1() ([]*OM1, error) {
-	pss, err := m.ParseAll("OM1")
+	pss, err := m.ParseAllWithTypes(Types, "OM1")
 	return pss.([]*OM1), err
 }
 
 // AllOM2 returns a slice containing all OM2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM2() ([]*OM2, error) {
-	pss, err := m.ParseAll("OM2")
+	pss, err := m.ParseAllWithTypes(Types, "OM2")
 	return pss.([]*OM2), err
 }
 
 // AllOM3 returns a slice containing all OM3 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM3() ([]*OM3, error) {
-	pss, err := m.ParseAll("OM3")
+	pss, err := m.ParseAllWithTypes(Types, "OM3")
 	return pss.([]*OM3), err
 }
 
 // AllOM4 returns a slice containing all OM4 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM4() ([]*OM4, error) {
-	pss, err := m.ParseAll("OM4")
+	pss, err := m.ParseAllWithTypes(Types, "OM4")
 	return pss.([]*OM4), err
 }
 
 // AllOM5 returns a slice containing all OM5 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM5() ([]*OM5, error) {
-	pss, err := m.ParseAll("OM5")
+	pss, err := m.ParseAllWithTypes(Types, "OM5")
 	return pss.([]*OM5), err
 }
 
 // AllOM6 returns a slice containing all OM6 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM6() ([]*OM6, error) {
-	pss, err := m.ParseAll("OM6")
+	pss, err := m.ParseAllWithTypes(Types, "OM6")
 	return pss.([]*OM6), err
 }
 
 // AllOM7 returns a slice containing all OM7 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOM7() ([]*OM7, error) {
-	pss, err := m.ParseAll("OM7")
+	pss, err := m.ParseAllWithTypes(Types, "OM7")
 	return pss.([]*OM7), err
 }
 
 // AllOMC returns a slice containing all OMC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOMC() ([]*OMC, error) {
-	pss, err := m.ParseAll("OMC")
+	pss, err := m.ParseAllWithTypes(Types, "OMC")
 	return pss.([]*OMC), err
 }
 
 // AllORC returns a slice containing all ORC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORC() ([]*ORC, error) {
-	pss, err := m.ParseAll("ORC")
+	pss, err := m.ParseAllWithTypes(Types, "ORC")
 	return pss.([]*ORC), err
 }
 
 // AllORG returns a slice containing all ORG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORG() ([]*ORG, error) {
-	pss, err := m.ParseAll("ORG")
+	pss, err := m.ParseAllWithTypes(Types, "ORG")
 	return pss.([]*ORG), err
 }
 
 // AllORO returns a slice containing all ORO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllORO() ([]*ORO, error) {
-	pss, err := m.ParseAll("ORO")
+	pss, err := m.ParseAllWithTypes(Types, "ORO")
 	return pss.([]*ORO), err
 }
 
 // AllOVR returns a slice containing all OVR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllOVR() ([]*OVR, error) {
-	pss, err := m.ParseAll("OVR")
+	pss, err := m.ParseAllWithTypes(Types, "OVR")
 	return pss.([]*OVR), err
 }
 
 // AllPAC returns a slice containing all PAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPAC() ([]*PAC, error) {
-	pss, err := m.ParseAll("PAC")
+	pss, err := m.ParseAllWithTypes(Types, "PAC")
 	return pss.([]*PAC), err
 }
 
 // AllPCE returns a slice containing all PCE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCE() ([]*PCE, error) {
-	pss, err := m.ParseAll("PCE")
+	pss, err := m.ParseAllWithTypes(Types, "PCE")
 	return pss.([]*PCE), err
 }
 
 // AllPCR returns a slice containing all PCR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPCR() ([]*PCR, error) {
-	pss, err := m.ParseAll("PCR")
+	pss, err := m.ParseAllWithTypes(Types, "PCR")
 	return pss.([]*PCR), err
 }
 
 // AllPD1 returns a slice containing all PD1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPD1() ([]*PD1, error) {
-	pss, err := m.ParseAll("PD1")
+	pss, err := m.ParseAllWithTypes(Types, "PD1")
 	return pss.([]*PD1), err
 }
 
 // AllPDA returns a slice containing all PDA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDA() ([]*PDA, error) {
-	pss, err := m.ParseAll("PDA")
+	pss, err := m.ParseAllWithTypes(Types, "PDA")
 	return pss.([]*PDA), err
 }
 
 // AllPDC returns a slice containing all PDC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPDC() ([]*PDC, error) {
-	pss, err := m.ParseAll("PDC")
+	pss, err := m.ParseAllWithTypes(Types, "PDC")
 	return pss.([]*PDC), err
 }
 
 // AllPEO returns a slice containing all PEO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPEO() ([]*PEO, error) {
-	pss, err := m.ParseAll("PEO")
+	pss, err := m.ParseAllWithTypes(Types, "PEO")
 	return pss.([]*PEO), err
 }
 
 // AllPES returns a slice containing all PES segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPES() ([]*PES, error) {
-	pss, err := m.ParseAll("PES")
+	pss, err := m.ParseAllWithTypes(Types, "PES")
 	return pss.([]*PES), err
 }
 
 // AllPID returns a slice containing all PID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPID() ([]*PID, error) {
-	pss, err := m.ParseAll("PID")
+	pss, err := m.ParseAllWithTypes(Types, "PID")
 	return pss.([]*PID), err
 }
 
 // AllPKG returns a slice containing all PKG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPKG() ([]*PKG, error) {
-	pss, err := m.ParseAll("PKG")
+	pss, err := m.ParseAllWithTypes(Types, "PKG")
 	return pss.([]*PKG), err
 }
 
 // AllPM1 returns a slice containing all PM1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPM1() ([]*PM1, error) {
-	pss, err := m.ParseAll("PM1")
+	pss, err := m.ParseAllWithTypes(Types, "PM1")
 	return pss.([]*PM1), err
 }
 
 // AllPMT returns a slice containing all PMT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPMT() ([]*PMT, error) {
-	pss, err := m.ParseAll("PMT")
+	pss, err := m.ParseAllWithTypes(Types, "PMT")
 	return pss.([]*PMT), err
 }
 
 // AllPR1 returns a slice containing all PR1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPR1() ([]*PR1, error) {
-	pss, err := m.ParseAll("PR1")
+	pss, err := m.ParseAllWithTypes(Types, "PR1")
 	return pss.([]*PR1), err
 }
 
 // AllPRA returns a slice containing all PRA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRA() ([]*PRA, error) {
-	pss, err := m.ParseAll("PRA")
+	pss, err := m.ParseAllWithTypes(Types, "PRA")
 	return pss.([]*PRA), err
 }
 
 // AllPRB returns a slice containing all PRB segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRB() ([]*PRB, error) {
-	pss, err := m.ParseAll("PRB")
+	pss, err := m.ParseAllWithTypes(Types, "PRB")
 	return pss.([]*PRB), err
 }
 
 // AllPRC returns a slice containing all PRC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRC() ([]*PRC, error) {
-	pss, err := m.ParseAll("PRC")
+	pss, err := m.ParseAllWithTypes(Types, "PRC")
 	return pss.([]*PRC), err
 }
 
 // AllPRD returns a slice containing all PRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRD() ([]*PRD, error) {
-	pss, err := m.ParseAll("PRD")
+	pss, err := m.ParseAllWithTypes(Types, "PRD")
 	return pss.([]*PRD), err
 }
 
 // AllPRT returns a slice containing all PRT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPRT() ([]*PRT, error) {
-	pss, err := m.ParseAll("PRT")
+	pss, err := m.ParseAllWithTypes(Types, "PRT")
 	return pss.([]*PRT), err
 }
 
 // AllPSG returns a slice containing all PSG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSG() ([]*PSG, error) {
-	pss, err := m.ParseAll("PSG")
+	pss, err := m.ParseAllWithTypes(Types, "PSG")
 	return pss.([]*PSG), err
 }
 
 // AllPSH returns a slice containing all PSH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSH() ([]*PSH, error) {
-	pss, err := m.ParseAll("PSH")
+	pss, err := m.ParseAllWithTypes(Types, "PSH")
 	return pss.([]*PSH), err
 }
 
 // AllPSL returns a slice containing all PSL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSL() ([]*PSL, error) {
-	pss, err := m.ParseAll("PSL")
+	pss, err := m.ParseAllWithTypes(Types, "PSL")
 	return pss.([]*PSL), err
 }
 
 // AllPSS returns a slice containing all PSS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPSS() ([]*PSS, error) {
-	pss, err := m.ParseAll("PSS")
+	pss, err := m.ParseAllWithTypes(Types, "PSS")
 	return pss.([]*PSS), err
 }
 
 // AllPTH returns a slice containing all PTH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPTH() ([]*PTH, error) {
-	pss, err := m.ParseAll("PTH")
+	pss, err := m.ParseAllWithTypes(Types, "PTH")
 	return pss.([]*PTH), err
 }
 
 // AllPV1 returns a slice containing all PV1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV1() ([]*PV1, error) {
-	pss, err := m.ParseAll("PV1")
+	pss, err := m.ParseAllWithTypes(Types, "PV1")
 	return pss.([]*PV1), err
 }
 
 // AllPV2 returns a slice containing all PV2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPV2() ([]*PV2, error) {
-	pss, err := m.ParseAll("PV2")
+	pss, err := m.ParseAllWithTypes(Types, "PV2")
 	return pss.([]*PV2), err
 }
 
 // AllPYE returns a slice containing all PYE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllPYE() ([]*PYE, error) {
-	pss, err := m.ParseAll("PYE")
+	pss, err := m.ParseAllWithTypes(Types, "PYE")
 	return pss.([]*PYE), err
 }
 
 // AllQAK returns a slice containing all QAK segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQAK() ([]*QAK, error) {
-	pss, err := m.ParseAll("QAK")
+	pss, err := m.ParseAllWithTypes(Types, "QAK")
 	return pss.([]*QAK), err
 }
 
 // AllQID returns a slice containing all QID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQID() ([]*QID, error) {
-	pss, err := m.ParseAll("QID")
+	pss, err := m.ParseAllWithTypes(Types, "QID")
 	return pss.([]*QID), err
 }
 
 // AllQPD returns a slice containing all QPD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQPD() ([]*QPD, error) {
-	pss, err := m.ParseAll("QPD")
+	pss, err := m.ParseAllWithTypes(Types, "QPD")
 	return pss.([]*QPD), err
 }
 
 // AllQRD returns a slice containing all QRD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRD() ([]*QRD, error) {
-	pss, err := m.ParseAll("QRD")
+	pss, err := m.ParseAllWithTypes(Types, "QRD")
 	return pss.([]*QRD), err
 }
 
 // AllQRF returns a slice containing all QRF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRF() ([]*QRF, error) {
-	pss, err := m.ParseAll("QRF")
+	pss, err := m.ParseAllWithTypes(Types, "QRF")
 	return pss.([]*QRF), err
 }
 
 // AllQRI returns a slice containing all QRI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllQRI() ([]*QRI, error) {
-	pss, err := m.ParseAll("QRI")
+	pss, err := m.ParseAllWithTypes(Types, "QRI")
 	return pss.([]*QRI), err
 }
 
 // AllRCP returns a slice containing all RCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRCP() ([]*RCP, error) {
-	pss, err := m.ParseAll("RCP")
+	pss, err := m.ParseAllWithTypes(Types, "RCP")
 	return pss.([]*RCP), err
 }
 
 // AllRDF returns a slice containing all RDF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDF() ([]*RDF, error) {
-	pss, err := m.ParseAll("RDF")
+	pss, err := m.ParseAllWithTypes(Types, "RDF")
 	return pss.([]*RDF), err
 }
 
 // AllRDT returns a slice containing all RDT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRDT() ([]*RDT, error) {
-	pss, err := m.ParseAll("RDT")
+	pss, err := m.ParseAllWithTypes(Types, "RDT")
 	return pss.([]*RDT), err
 }
 
 // AllREL returns a slice containing all REL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllREL() ([]*REL, error) {
-	pss, err := m.ParseAll("REL")
+	pss, err := m.ParseAllWithTypes(Types, "REL")
 	return pss.([]*REL), err
 }
 
 // AllRF1 returns a slice containing all RF1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRF1() ([]*RF1, error) {
-	pss, err := m.ParseAll("RF1")
+	pss, err := m.ParseAllWithTypes(Types, "RF1")
 	return pss.([]*RF1), err
 }
 
 // AllRFI returns a slice containing all RFI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRFI() ([]*RFI, error) {
-	pss, err := m.ParseAll("RFI")
+	pss, err := m.ParseAllWithTypes(Types, "RFI")
 	return pss.([]*RFI), err
 }
 
 // AllRGS returns a slice containing all RGS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRGS() ([]*RGS, error) {
-	pss, err := m.ParseAll("RGS")
+	pss, err := m.ParseAllWithTypes(Types, "RGS")
 	return pss.([]*RGS), err
 }
 
 // AllRMI returns a slice containing all RMI segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRMI() ([]*RMI, error) {
-	pss, err := m.ParseAll("RMI")
+	pss, err := m.ParseAllWithTypes(Types, "RMI")
 	return pss.([]*RMI), err
 }
 
 // AllROL returns a slice containing all ROL segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllROL() ([]*ROL, error) {
-	pss, err := m.ParseAll("ROL")
+	pss, err := m.ParseAllWithTypes(Types, "ROL")
 	return pss.([]*ROL), err
 }
 
 // AllRQ1 returns a slice containing all RQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQ1() ([]*RQ1, error) {
-	pss, err := m.ParseAll("RQ1")
+	pss, err := m.ParseAllWithTypes(Types, "RQ1")
 	return pss.([]*RQ1), err
 }
 
 // AllRQD returns a slice containing all RQD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRQD() ([]*RQD, error) {
-	pss, err := m.ParseAll("RQD")
+	pss, err := m.ParseAllWithTypes(Types, "RQD")
 	return pss.([]*RQD), err
 }
 
 // AllRX1 returns a slice containing all RX1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRX1() ([]*RX1, error) {
-	pss, err := m.ParseAll("RX1")
+	pss, err := m.ParseAllWithTypes(Types, "RX1")
 	return pss.([]*RX1), err
 }
 
 // AllRXA returns a slice containing all RXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXA() ([]*RXA, error) {
-	pss, err := m.ParseAll("RXA")
+	pss, err := m.ParseAllWithTypes(Types, "RXA")
 	return pss.([]*RXA), err
 }
 
 // AllRXC returns a slice containing all RXC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXC() ([]*RXC, error) {
-	pss, err := m.ParseAll("RXC")
+	pss, err := m.ParseAllWithTypes(Types, "RXC")
 	return pss.([]*RXC), err
 }
 
 // AllRXD returns a slice containing all RXD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXD() ([]*RXD, error) {
-	pss, err := m.ParseAll("RXD")
+	pss, err := m.ParseAllWithTypes(Types, "RXD")
 	return pss.([]*RXD), err
 }
 
 // AllRXE returns a slice containing all RXE segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXE() ([]*RXE, error) {
-	pss, err := m.ParseAll("RXE")
+	pss, err := m.ParseAllWithTypes(Types, "RXE")
 	return pss.([]*RXE), err
 }
 
 // AllRXG returns a slice containing all RXG segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXG() ([]*RXG, error) {
-	pss, err := m.ParseAll("RXG")
+	pss, err := m.ParseAllWithTypes(Types, "RXG")
 	return pss.([]*RXG), err
 }
 
 // AllRXO returns a slice containing all RXO segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXO() ([]*RXO, error) {
-	pss, err := m.ParseAll("RXO")
+	pss, err := m.ParseAllWithTypes(Types, "RXO")
 	return pss.([]*RXO), err
 }
 
 // AllRXR returns a slice containing all RXR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXR() ([]*RXR, error) {
-	pss, err := m.ParseAll("RXR")
+	pss, err := m.ParseAllWithTypes(Types, "RXR")
 	return pss.([]*RXR), err
 }
 
 // AllRXV returns a slice containing all RXV segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllRXV() ([]*RXV, error) {
-	pss, err := m.ParseAll("RXV")
+	pss, err := m.ParseAllWithTypes(Types, "RXV")
 	return pss.([]*RXV), err
 }
 
 // AllSAC returns a slice containing all SAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSAC() ([]*SAC, error) {
-	pss, err := m.ParseAll("SAC")
+	pss, err := m.ParseAllWithTypes(Types, "SAC")
 	return pss.([]*SAC), err
 }
 
 // AllSCD returns a slice containing all SCD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCD() ([]*SCD, error) {
-	pss, err := m.ParseAll("SCD")
+	pss, err := m.ParseAllWithTypes(Types, "SCD")
 	return pss.([]*SCD), err
 }
 
 // AllSCH returns a slice containing all SCH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCH() ([]*SCH, error) {
-	pss, err := m.ParseAll("SCH")
+	pss, err := m.ParseAllWithTypes(Types, "SCH")
 	return pss.([]*SCH), err
 }
 
 // AllSCP returns a slice containing all SCP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSCP() ([]*SCP, error) {
-	pss, err := m.ParseAll("SCP")
+	pss, err := m.ParseAllWithTypes(Types, "SCP")
 	return pss.([]*SCP), err
 }
 
 // AllSDD returns a slice containing all SDD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSDD() ([]*SDD, error) {
-	pss, err := m.ParseAll("SDD")
+	pss, err := m.ParseAllWithTypes(Types, "SDD")
 	return pss.([]*SDD), err
 }
 
 // AllSFT returns a slice containing all SFT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSFT() ([]*SFT, error) {
-	pss, err := m.ParseAll("SFT")
+	pss, err := m.ParseAllWithTypes(Types, "SFT")
 	return pss.([]*SFT), err
 }
 
 // AllSGH returns a slice containing all SGH segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSGH() ([]*SGH, error) {
-	pss, err := m.ParseAll("SGH")
+	pss, err := m.ParseAllWithTypes(Types, "SGH")
 	return pss.([]*SGH), err
 }
 
 // AllSGT returns a slice containing all SGT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSGT() ([]*SGT, error) {
-	pss, err := m.ParseAll("SGT")
+	pss, err := m.ParseAllWithTypes(Types, "SGT")
 	return pss.([]*SGT), err
 }
 
 // AllSHP returns a slice containing all SHP segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSHP() ([]*SHP, error) {
-	pss, err := m.ParseAll("SHP")
+	pss, err := m.ParseAllWithTypes(Types, "SHP")
 	return pss.([]*SHP), err
 }
 
 // AllSID returns a slice containing all SID segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSID() ([]*SID, error) {
-	pss, err := m.ParseAll("SID")
+	pss, err := m.ParseAllWithTypes(Types, "SID")
 	return pss.([]*SID), err
 }
 
 // AllSLT returns a slice containing all SLT segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSLT() ([]*SLT, error) {
-	pss, err := m.ParseAll("SLT")
+	pss, err := m.ParseAllWithTypes(Types, "SLT")
 	return pss.([]*SLT), err
 }
 
 // AllSPM returns a slice containing all SPM segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPM() ([]*SPM, error) {
-	pss, err := m.ParseAll("SPM")
+	pss, err := m.ParseAllWithTypes(Types, "SPM")
 	return pss.([]*SPM), err
 }
 
 // AllSPR returns a slice containing all SPR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSPR() ([]*SPR, error) {
-	pss, err := m.ParseAll("SPR")
+	pss, err := m.ParseAllWithTypes(Types, "SPR")
 	return pss.([]*SPR), err
 }
 
 // AllSTF returns a slice containing all STF segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTF() ([]*STF, error) {
-	pss, err := m.ParseAll("STF")
+	pss, err := m.ParseAllWithTypes(Types, "STF")
 	return pss.([]*STF), err
 }
 
 // AllSTZ returns a slice containing all STZ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllSTZ() ([]*STZ, error) {
-	pss, err := m.ParseAll("STZ")
+	pss, err := m.ParseAllWithTypes(Types, "STZ")
 	return pss.([]*STZ), err
 }
 
 // AllTCC returns a slice containing all TCC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCC() ([]*TCC, error) {
-	pss, err := m.ParseAll("TCC")
+	pss, err := m.ParseAllWithTypes(Types, "TCC")
 	return pss.([]*TCC), err
 }
 
 // AllTCD returns a slice containing all TCD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTCD() ([]*TCD, error) {
-	pss, err := m.ParseAll("TCD")
+	pss, err := m.ParseAllWithTypes(Types, "TCD")
 	return pss.([]*TCD), err
 }
 
 // AllTQ1 returns a slice containing all TQ1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ1() ([]*TQ1, error) {
-	pss, err := m.ParseAll("TQ1")
+	pss, err := m.ParseAllWithTypes(Types, "TQ1")
 	return pss.([]*TQ1), err
 }
 
 // AllTQ2 returns a slice containing all TQ2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTQ2() ([]*TQ2, error) {
-	pss, err := m.ParseAll("TQ2")
+	pss, err := m.ParseAllWithTypes(Types, "TQ2")
 	return pss.([]*TQ2), err
 }
 
 // AllTXA returns a slice containing all TXA segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllTXA() ([]*TXA, error) {
-	pss, err := m.ParseAll("TXA")
+	pss, err := m.ParseAllWithTypes(Types, "TXA")
 	return pss.([]*TXA), err
 }
 
 // AllUAC returns a slice containing all UAC segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUAC() ([]*UAC, error) {
-	pss, err := m.ParseAll("UAC")
+	pss, err := m.ParseAllWithTypes(Types, "UAC")
 	return pss.([]*UAC), err
 }
 
 // AllUB1 returns a slice containing all UB1 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB1() ([]*UB1, error) {
-	pss, err := m.ParseAll("UB1")
+	pss, err := m.ParseAllWithTypes(Types, "UB1")
 	return pss.([]*UB1), err
 }
 
 // AllUB2 returns a slice containing all UB2 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllUB2() ([]*UB2, error) {
-	pss, err := m.ParseAll("UB2")
+	pss, err := m.ParseAllWithTypes(Types, "UB2")
 	return pss.([]*UB2), err
 }
 
 // AllURD returns a slice containing all URD segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURD() ([]*URD, error) {
-	pss, err := m.ParseAll("URD")
+	pss, err := m.ParseAllWithTypes(Types, "URD")
 	return pss.([]*URD), err
 }
 
 // AllURS returns a slice containing all URS segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllURS() ([]*URS, error) {
-	pss, err := m.ParseAll("URS")
+	pss, err := m.ParseAllWithTypes(Types, "URS")
 	return pss.([]*URS), err
 }
 
 // AllVAR returns a slice containing all VAR segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVAR() ([]*VAR, error) {
-	pss, err := m.ParseAll("VAR")
+	pss, err := m.ParseAllWithTypes(Types, "VAR")
 	return pss.([]*VAR), err
 }
 
 // AllVND returns a slice containing all VND segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVND() ([]*VND, error) {
-	pss, err := m.ParseAll("VND")
+	pss, err := m.ParseAllWithTypes(Types, "VND")
 	return pss.([]*VND), err
 }
 
 // AllVTQ returns a slice containing all VTQ segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllVTQ() ([]*VTQ, error) {
-	pss, err := m.ParseAll("VTQ")
+	pss, err := m.ParseAllWithTypes(Types, "VTQ")
 	return pss.([]*VTQ), err
 }
 
 // AllZL7 returns a slice containing all ZL7 segments within the message,
 // or an empty slice if there aren't any.
 func (m *Message) AllZL7() ([]*ZL7, error) {
-	pss, err := m.ParseAll("ZL7")
+	pss, err := m.ParseAllWithTypes(Types, "ZL7")
 	return pss.([]*ZL7), err
 }
 
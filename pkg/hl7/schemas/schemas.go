@@ -0,0 +1,59 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schemas registers the schemas of all the versions of the HL7v2 specification from 2.1
+// to 2.8.2, so that messages are parsed with the types of the version in their MSH-12.
+// The schema of version 2.5.1 is the one in package hl7, which is always registered.
+//
+// Importing this package doesn't register the schemas, as registering them changes how every
+// message in the binary is parsed. The main packages that need them call Register:
+//
+//	schemas.Register()
+//
+// To register the schemas of some versions only, call the Register functions of the packages of
+// those versions instead, eg v230.Register().
+//
+// The typed accessors of hl7.Message, eg PID(), always return the types of hl7.DefaultVersion.
+// Each version package has a Message type whose accessors return the types of that version, which
+// can wrap a message that is already parsed, eg v230.NewMessage(m), or parse one, eg
+// v230.ParseMessage(input).
+package schemas
+
+import (
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/210"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/220"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/230"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/231"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/240"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/250"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/260"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/271"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/281"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/282"
+)
+
+// Register registers the schemas of all the versions with hl7.RegisterSchema.
+// It can be called more than once.
+func Register() {
+	v210.Register()
+	v220.Register()
+	v230.Register()
+	v231.Register()
+	v240.Register()
+	v250.Register()
+	v260.Register()
+	v271.Register()
+	v281.Register()
+	v282.Register()
+}
@@ -0,0 +1,318 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/210"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/220"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/230"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/231"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/240"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/250"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/251"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/260"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/271"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/281"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas/282"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMain(m *testing.M) {
+	hl7.TimezoneAndLocation("Europe/London")
+	Register()
+	retCode := m.Run()
+	os.Exit(retCode)
+}
+
+func message(version string) []byte {
+	return []byte(strings.Join([]string{
+		"MSH|^~\\&|CERNER|RAL|CARELINK|AKI2|20141128001635||ADT^A01|2014112800163507740000|T|" + version,
+		"EVN|A01|20141128001635",
+		"PID|1||843124^^^RAL MRN^MRN||ZZZTEST^PAUL",
+		"PV1|1|INPATIENT",
+	}, hl7.SegmentTerminatorStr))
+}
+
+func TestVersions(t *testing.T) {
+	want := []string{"2.1", "2.2", "2.3", "2.3.1", "2.4", "2.5", "2.5.1", "2.6", "2.7.1", "2.8.1", "2.8.2"}
+	if diff := cmp.Diff(want, hl7.Versions()); diff != "" {
+		t.Errorf("hl7.Versions() got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestParseMessage_DispatchesOnVersion(t *testing.T) {
+	tests := []struct {
+		version         string
+		wantVersion     string
+		wantPID         string
+		wantMessageType string
+	}{
+		{version: "2.3", wantVersion: "2.3", wantPID: "*v230.PID", wantMessageType: "*v230.ADT_A01"},
+		{version: "2.3.0", wantVersion: "2.3", wantPID: "*v230.PID", wantMessageType: "*v230.ADT_A01"},
+		{version: "2.4", wantVersion: "2.4", wantPID: "*v240.PID", wantMessageType: "*v240.ADT_A01"},
+		{version: "2.5.1", wantVersion: "2.5.1", wantPID: "*hl7.PID", wantMessageType: "*hl7.ADT_A01"},
+		{version: "2.6", wantVersion: "2.6", wantPID: "*v260.PID", wantMessageType: "*v260.ADT_A01"},
+		{version: "2.9", wantVersion: "2.5.1", wantPID: "*hl7.PID", wantMessageType: "*hl7.ADT_A01"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			m, err := hl7.ParseMessage(message(tc.version))
+			if err != nil {
+				t.Fatalf("hl7.ParseMessage() failed with %v", err)
+			}
+			if got := m.Version(); got != tc.wantVersion {
+				t.Errorf("m.Version() got %q, want %q", got, tc.wantVersion)
+			}
+			pid, err := m.Parse("PID")
+			if err != nil {
+				t.Fatalf("m.Parse(PID) failed with %v", err)
+			}
+			if got := fmt.Sprintf("%T", pid); got != tc.wantPID {
+				t.Errorf("m.Parse(PID) got %s, want %s", got, tc.wantPID)
+			}
+			mt, err := m.ParseMessageType()
+			if err != nil {
+				t.Fatalf("m.ParseMessageType() failed with %v", err)
+			}
+			if got := fmt.Sprintf("%T", mt); got != tc.wantMessageType {
+				t.Errorf("m.ParseMessageType() got %s, want %s", got, tc.wantMessageType)
+			}
+		})
+	}
+}
+
+func TestTypedAccessors(t *testing.T) {
+	m, err := hl7.ParseMessage(message("2.3"))
+	if err != nil {
+		t.Fatalf("hl7.ParseMessage() failed with %v", err)
+	}
+
+	// In HL7 2.3, PID-5 isn't repeated and the family name is a string.
+	m23, err := v230.NewMessage(m)
+	if err != nil {
+		t.Fatalf("v230.NewMessage() failed with %v", err)
+	}
+	pid23, err := m23.PID()
+	if err != nil {
+		t.Fatalf("PID() failed with %v", err)
+	}
+	if got, want := pid23.PatientName.FamilyName.String(), "ZZZTEST"; got != want {
+		t.Errorf("v230 PID.PatientName.FamilyName got %q, want %q", got, want)
+	}
+
+	// The accessors in package hl7 still return the types of hl7.DefaultVersion.
+	pid251, err := m.PID()
+	if err != nil {
+		t.Fatalf("PID() failed with %v", err)
+	}
+	if got, want := pid251.PatientName[0].FamilyName.Surname.String(), "ZZZTEST"; got != want {
+		t.Errorf("hl7 PID.PatientName[0].FamilyName.Surname got %q, want %q", got, want)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	tests := []struct {
+		version string
+		// pid wraps the message with the Message type of the version package and returns its PID.
+		pid     func(*hl7.Message) (interface{}, error)
+		wantPID string
+	}{{
+		version: "2.1",
+		wantPID: "*v210.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v210.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.2",
+		wantPID: "*v220.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v220.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.3",
+		wantPID: "*v230.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v230.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.3.1",
+		wantPID: "*v231.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v231.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.4",
+		wantPID: "*v240.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v240.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.5",
+		wantPID: "*v250.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v250.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.5.1",
+		wantPID: "*v251.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v251.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.6",
+		wantPID: "*v260.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v260.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.7.1",
+		wantPID: "*v271.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v271.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.8.1",
+		wantPID: "*v281.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v281.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}, {
+		version: "2.8.2",
+		wantPID: "*v282.PID",
+		pid: func(m *hl7.Message) (interface{}, error) {
+			vm, err := v282.NewMessage(m)
+			if err != nil {
+				return nil, err
+			}
+			return vm.PID()
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			m, err := hl7.ParseMessage(message(tc.version))
+			if err != nil {
+				t.Fatalf("hl7.ParseMessage() failed with %v", err)
+			}
+			pid, err := tc.pid(m)
+			if err != nil {
+				t.Fatalf("PID() failed with %v", err)
+			}
+			if got := fmt.Sprintf("%T", pid); got != tc.wantPID {
+				t.Errorf("PID() got %s, want %s", got, tc.wantPID)
+			}
+		})
+	}
+}
+
+func TestNewMessage_OtherVersion(t *testing.T) {
+	m, err := hl7.ParseMessage(message("2.4"))
+	if err != nil {
+		t.Fatalf("hl7.ParseMessage() failed with %v", err)
+	}
+	if _, err := v230.NewMessage(m); err == nil {
+		t.Error("v230.NewMessage() of a 2.4 message got nil error, want error")
+	}
+}
+
+func TestParseMessage_ForcesVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func([]byte) (string, error)
+	}{{
+		name: "2.4",
+		parse: func(input []byte) (string, error) {
+			m, err := v240.ParseMessage(input)
+			if err != nil {
+				return "", err
+			}
+			pid, err := m.PID()
+			if err != nil {
+				return "", err
+			}
+			return pid.PatientName[0].FamilyName.Surname.String(), nil
+		},
+	}, {
+		name: "2.5.1",
+		parse: func(input []byte) (string, error) {
+			m, err := v251.ParseMessage(input)
+			if err != nil {
+				return "", err
+			}
+			pid, err := m.PID()
+			if err != nil {
+				return "", err
+			}
+			return pid.PatientName[0].FamilyName.Surname.String(), nil
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// The message has version 2.6 in MSH-12.
+			got, err := tc.parse(message("2.6"))
+			if err != nil {
+				t.Fatalf("ParseMessage() failed with %v", err)
+			}
+			if want := "ZZZTEST"; got != want {
+				t.Errorf("PID.PatientName[0].FamilyName.Surname got %q, want %q", got, want)
+			}
+		})
+	}
+}
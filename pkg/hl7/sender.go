@@ -16,13 +16,16 @@ package hl7
 
 import (
 	"bytes"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
 	"syscall"
 	"time"
 
+	"github.com/bitcrshr/simhospital/pkg/monitoring"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // Sender is an interface for sending HL7 messages.
@@ -55,7 +58,35 @@ func (s *stdoutSender) Close() error {
 	return nil
 }
 
-var recoverableErrs = map[syscall.Errno]bool{syscall.EPIPE: true, syscall.ECONNRESET: true}
+var (
+	recoverableErrs = map[syscall.Errno]bool{syscall.EPIPE: true, syscall.ECONNRESET: true}
+
+	counters struct {
+		SimulatedHospital struct {
+			MllpAcksTotal         *prometheus.CounterVec `help:"Number of acknowledgments received by the MLLP sender, by acknowledgment code" labels:"ack_code"`
+			MllpDeadLettersTotal  *prometheus.CounterVec `help:"Number of messages sent to the dead-letter sink by the MLLP sender, by acknowledgment code" labels:"ack_code"`
+			MllpSendRetriesTotal  *prometheus.CounterVec `help:"Number of times the MLLP sender re-sent a message after a negative acknowledgment, by acknowledgment code" labels:"ack_code"`
+			MllpControlIDMismatch prometheus.Counter     `help:"Number of acknowledgments whose MSA-2 did not match the MSH-10 of the message sent"`
+			HttpResponsesTotal    *prometheus.CounterVec `help:"Number of responses received by the HTTP sender, by status code" labels:"status_code"`
+			HttpSendRetriesTotal  prometheus.Counter     `help:"Number of times the HTTP sender re-sent a message after a failed request"`
+		}
+	}
+)
+
+func init() {
+	if err := monitoring.CreateAndRegisterMetricsFromStruct(&counters); err != nil {
+		log.WithError(err).Fatal("Cannot register metrics from the 'hl7' package")
+	}
+}
+
+// NackError is returned when a message was not accepted by the receiver.
+type NackError struct {
+	Ack *Ack
+}
+
+func (e *NackError) Error() string {
+	return fmt.Sprintf("message was not accepted: %v", e.Ack)
+}
 
 // mllpSender sends HL7 messages via the MLLP protocol.
 type mllpSender struct {
@@ -64,15 +95,59 @@ type mllpSender struct {
 	address             string
 	mllpKeepAlive       bool
 	mllpKeepAlivePeriod time.Duration
-	count               int
+	tlsConfig           *tls.Config
+	ackPolicy           *AckPolicy
+	// halted is set if the sender was halted due to a negative acknowledgment.
+	halted error
+	count  int
+}
+
+// MLLPSenderOptions contains optional parameters to NewMLLPSenderWithOptions.
+type MLLPSenderOptions struct {
+	// KeepAlive is whether to send keep-alive messages on the MLLP connection.
+	KeepAlive bool
+	// KeepAlivePeriod is the interval between keep-alive messages.
+	// Only relevant if KeepAlive=true.
+	KeepAlivePeriod time.Duration
+	// TLS is the configuration used to establish the connection over TLS.
+	// If nil, the connection is established over plain TCP.
+	TLS *tls.Config
+	// AckPolicy defines what to do with the acknowledgments received for each message.
+	AckPolicy *AckPolicy
+}
+
+// NewMLLPSenderOptions returns a MLLPSenderOptions with the default AckPolicy,
+// which can be used to configure the sender's behaviour.
+func NewMLLPSenderOptions() *MLLPSenderOptions {
+	return &MLLPSenderOptions{
+		AckPolicy: NewAckPolicy(),
+	}
 }
 
 // NewMLLPSender returns a sender that sends HL7 messages via the MLLP protocol.
 func NewMLLPSender(address string, mllpKeepAlive bool, mllpKeepAlivePeriod time.Duration) (Sender, error) {
+	options := NewMLLPSenderOptions()
+	options.KeepAlive = mllpKeepAlive
+	options.KeepAlivePeriod = mllpKeepAlivePeriod
+	return NewMLLPSenderWithOptions(address, options)
+}
+
+// NewMLLPSenderWithOptions returns a sender that sends HL7 messages via the MLLP protocol,
+// configured with the given options.
+func NewMLLPSenderWithOptions(address string, options *MLLPSenderOptions) (Sender, error) {
+	ackPolicy := options.AckPolicy
+	if ackPolicy == nil {
+		ackPolicy = NewAckPolicy()
+	}
+	if err := ackPolicy.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid ack policy")
+	}
 	sender := &mllpSender{
 		address:             address,
-		mllpKeepAlive:       mllpKeepAlive,
-		mllpKeepAlivePeriod: mllpKeepAlivePeriod,
+		mllpKeepAlive:       options.KeepAlive,
+		mllpKeepAlivePeriod: options.KeepAlivePeriod,
+		tlsConfig:           options.TLS,
+		ackPolicy:           ackPolicy,
 	}
 	if err := sender.establishConnection(); err != nil {
 		return nil, errors.Wrapf(err, "cannot establish mllp connection on sender %+v", sender)
@@ -81,7 +156,9 @@ func NewMLLPSender(address string, mllpKeepAlive bool, mllpKeepAlivePeriod time.
 }
 
 func isRecoverable(err error) bool {
-	if opError, ok := err.(*net.OpError); ok {
+	// Errors on TLS connections wrap the underlying *net.OpError.
+	var opError *net.OpError
+	if errors.As(err, &opError) {
 		if syscallErr, ok := opError.Err.(*os.SyscallError); ok {
 			if errno, ok := syscallErr.Err.(syscall.Errno); ok && recoverableErrs[errno] {
 				return true
@@ -107,37 +184,127 @@ func (s *mllpSender) establishConnection() error {
 		}
 	}
 
+	if s.tlsConfig != nil {
+		tlsConn, err := s.tlsClient(conn)
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		conn = tlsConn
+	}
+
 	s.conn = conn
 	s.client = NewMLLPClient(conn)
 	return nil
 }
 
+// tlsClient establishes a TLS connection on top of the given TCP connection.
+func (s *mllpSender) tlsClient(conn net.Conn) (net.Conn, error) {
+	config := s.tlsConfig
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(s.address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get the host of address %s", s.address)
+		}
+		config = config.Clone()
+		config.ServerName = host
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, errors.Wrapf(err, "cannot complete TLS handshake with %s", s.address)
+	}
+	return tlsConn, nil
+}
+
 // Send sends a messages via the MLLP protocol.
-// It returns an error if the message cannot be sent or was not acknowledged.
+// The acknowledgment received for the message is handled according to the sender's AckPolicy.
+// It returns an error if the message cannot be sent, the acknowledgment cannot be interpreted
+// or does not correspond to the message, or the message was not accepted and the policy does not
+// say otherwise.
 func (s *mllpSender) Send(message []byte) error {
+	if s.halted != nil {
+		return errors.Wrap(s.halted, "mllp sender was halted")
+	}
+	controlID := messageControlID(message)
+	for attempt := 0; ; attempt++ {
+		ack, err := s.sendOnce(message)
+		if err != nil {
+			return err
+		}
+		code := string(ack.Code)
+		counters.SimulatedHospital.MllpAcksTotal.With(prometheus.Labels{"ack_code": code}).Inc()
+		if controlID != "" && ack.ControlID != controlID {
+			counters.SimulatedHospital.MllpControlIDMismatch.Inc()
+			return errors.Errorf("ack control ID %q does not match the control ID of the message sent %q", ack.ControlID, controlID)
+		}
+
+		logLocal := log.WithField("ack_code", code).WithField("message_control_id", controlID)
+		switch s.ackPolicy.Action(ack.Code) {
+		case AckActionAccept:
+			s.count++
+			return nil
+		case AckActionSkip:
+			logLocal.Warningf("Message was not accepted, skipping: %v", ack)
+			return nil
+		case AckActionDeadLetter:
+			return s.deadLetter(message, ack)
+		case AckActionRetry:
+			if attempt >= s.ackPolicy.MaxRetries {
+				logLocal.Warningf("Message was not accepted after %d retries: %v", attempt, ack)
+				if s.ackPolicy.DeadLetter != nil {
+					return s.deadLetter(message, ack)
+				}
+				return &NackError{Ack: ack}
+			}
+			backoff := s.ackPolicy.RetryBackoff * (1 << uint(attempt))
+			logLocal.Warningf("Message was not accepted, retrying in %v: %v", backoff, ack)
+			counters.SimulatedHospital.MllpSendRetriesTotal.With(prometheus.Labels{"ack_code": code}).Inc()
+			time.Sleep(backoff)
+		default:
+			logLocal.Errorf("Message was not accepted, halting the sender: %v", ack)
+			s.halted = &NackError{Ack: ack}
+			return s.halted
+		}
+	}
+}
+
+// sendOnce writes the message and returns the acknowledgment received.
+func (s *mllpSender) sendOnce(message []byte) (*Ack, error) {
 	if err := s.client.Write(message); err != nil {
 		if !isRecoverable(err) {
-			return errors.Wrap(err, "cannot send message")
+			return nil, errors.Wrap(err, "cannot send message")
 		}
 		// If the socket was closed by the peer, handle it by trying to
 		// write once again on a new connection.
 		if err = s.establishConnection(); err != nil {
-			return errors.Wrap(err, "cannot send message: error when re-establishing connection")
+			return nil, errors.Wrap(err, "cannot send message: error when re-establishing connection")
 		}
 		if err = s.client.Write(message); err != nil {
-			return errors.Wrap(err, "cannot send message after re-establishing connection")
+			return nil, errors.Wrap(err, "cannot send message after re-establishing connection")
 		}
 	}
 
-	ack, err := s.client.Read()
+	b, err := s.client.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read an ack after sending message")
+	}
+	ack, err := ParseAck(b)
 	if err != nil {
-		return errors.Wrap(err, "cannot read an ack after sending message")
+		return nil, errors.Wrap(err, "cannot interpret ack")
 	}
+	return ack, nil
+}
 
-	if _, err = ParseMessage(ack); err != nil {
-		return errors.Wrap(err, "ack message cannot be parsed")
+// deadLetter sends the message to the dead-letter sink.
+func (s *mllpSender) deadLetter(message []byte, ack *Ack) error {
+	if s.ackPolicy.DeadLetter == nil {
+		return errors.Wrap(&NackError{Ack: ack}, "no dead-letter sink configured")
 	}
-	s.count++
+	log.WithField("ack_code", string(ack.Code)).Warningf("Message was not accepted, sending to the dead-letter sink: %v", ack)
+	if err := s.ackPolicy.DeadLetter.Send(message); err != nil {
+		return errors.Wrapf(err, "cannot dead-letter message not accepted with %v", ack)
+	}
+	counters.SimulatedHospital.MllpDeadLettersTotal.With(prometheus.Labels{"ack_code": string(ack.Code)}).Inc()
 	return nil
 }
 
@@ -149,6 +316,11 @@ func (s *mllpSender) Close() error {
 	if err := s.conn.Close(); err != nil {
 		return errors.Wrap(err, "closing mllp sender connection")
 	}
+	if s.ackPolicy.DeadLetter != nil {
+		if err := s.ackPolicy.DeadLetter.Close(); err != nil {
+			return errors.Wrap(err, "closing mllp sender dead-letter sink")
+		}
+	}
 	return nil
 }
 
@@ -189,3 +361,32 @@ func (s *fileSender) Close() error {
 	}
 	return nil
 }
+
+// xmlSender sends HL7 messages in the XML encoding with another sender.
+type xmlSender struct {
+	sender Sender
+}
+
+// NewXMLSender returns a sender that converts HL7 messages to the XML encoding with
+// MarshalMessageXML, and sends them with the given sender.
+func NewXMLSender(sender Sender) Sender {
+	return &xmlSender{sender: sender}
+}
+
+// Send converts the message to the XML encoding and sends it.
+func (s *xmlSender) Send(message []byte) error {
+	m, err := ParseMessage(message)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse message")
+	}
+	x, err := MarshalMessageXML(m)
+	if err != nil {
+		return errors.Wrap(err, "cannot convert message to XML")
+	}
+	return s.sender.Send(x)
+}
+
+// Close closes the underlying sender.
+func (s *xmlSender) Close() error {
+	return s.sender.Close()
+}
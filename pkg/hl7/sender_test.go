@@ -15,6 +15,7 @@
 package hl7
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
@@ -105,7 +106,7 @@ func TestMllpSender(t *testing.T) {
 	mllpClient := NewMLLPClient(conn)
 
 	// Read the message sent using mllpSender and ACK it.
-	// ACK needs to be a valid HL7 message.
+	// ACK needs to be a valid HL7 message with a MSA segment.
 	gotB, err := mllpClient.Read()
 	if err != nil {
 		t.Fatalf("mllpClient.Read() failed with %v", err)
@@ -114,7 +115,7 @@ func TestMllpSender(t *testing.T) {
 		t.Errorf("mllpClient.Read() got %q, want %q", got, want)
 	}
 
-	mllpClient.Write([]byte("MSH|^~\\&|"))
+	mllpClient.Write([]byte(ackMessage(AckApplicationAccept, "")))
 	<-done
 }
 
@@ -192,7 +193,7 @@ func TestMllpSender_ReEstablishConn(t *testing.T) {
 	mllpClient := NewMLLPClient(conn)
 
 	// Read the message sent using mllpSender and ACK it.
-	// ACK needs to be a valid HL7 message.
+	// ACK needs to be a valid HL7 message with a MSA segment.
 	gotB, err := mllpClient.Read()
 	if err != nil {
 		t.Fatalf("mllpClient.Read() failed with %v", err)
@@ -201,7 +202,7 @@ func TestMllpSender_ReEstablishConn(t *testing.T) {
 		t.Errorf("mllpClient.Read() got %q, want %q", got, want)
 	}
 
-	mllpClient.Write([]byte("MSH|^~\\&|"))
+	mllpClient.Write([]byte(ackMessage(AckApplicationAccept, "")))
 	<-done
 }
 
@@ -232,3 +233,238 @@ func TestStdoutSender(t *testing.T) {
 		t.Errorf("stdoutSender.Send(%s) got %q, want containing %q", msg, got, want)
 	}
 }
+
+const sentMessage = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101000000||ADT^A01|1234|T|2.3|||AL||44\rPID|1"
+
+func ackMessage(code AckCode, controlID string) string {
+	return fmt.Sprintf("MSH|^~\\&|RAPP|RFAC|SIMHOSP|SFAC|20200101000001||ACK|5678|T|2.3\rMSA|%s|%s|Some text", code, controlID)
+}
+
+// ackAll accepts a connection on ln, and acknowledges every message it receives with the given codes, in order.
+// It returns the number of messages received.
+func ackAll(t *testing.T, ln net.Listener, controlID string, codes ...AckCode) <-chan int {
+	t.Helper()
+	received := make(chan int, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			t.Errorf("ln.Accept() failed with %v", err)
+			received <- 0
+			return
+		}
+		defer conn.Close()
+		mllpClient := NewMLLPClient(conn)
+		n := 0
+		for _, code := range codes {
+			if _, err := mllpClient.Read(); err != nil {
+				break
+			}
+			n++
+			mllpClient.Write([]byte(ackMessage(code, controlID)))
+		}
+		received <- n
+	}()
+	return received
+}
+
+func TestMllpSender_AckPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		actions        map[AckCode]AckAction
+		acks           []AckCode
+		deadLetter     bool
+		wantErr        bool
+		wantReceived   int
+		wantDeadLetter bool
+	}{{
+		name:         "accepted",
+		acks:         []AckCode{AckApplicationAccept},
+		wantReceived: 1,
+	}, {
+		name:         "commit accepted",
+		acks:         []AckCode{AckCommitAccept},
+		wantReceived: 1,
+	}, {
+		name:         "error skipped by default",
+		acks:         []AckCode{AckApplicationError},
+		wantReceived: 1,
+	}, {
+		name:         "rejected skipped by default",
+		acks:         []AckCode{AckCommitReject},
+		wantReceived: 1,
+	}, {
+		name:         "retried and accepted",
+		actions:      map[AckCode]AckAction{AckApplicationError: AckActionRetry},
+		acks:         []AckCode{AckApplicationError, AckApplicationError, AckApplicationAccept},
+		wantReceived: 3,
+	}, {
+		name:         "retries exhausted",
+		actions:      map[AckCode]AckAction{AckApplicationError: AckActionRetry},
+		acks:         []AckCode{AckApplicationError, AckApplicationError, AckApplicationError},
+		wantErr:      true,
+		wantReceived: 3,
+	}, {
+		name:           "retries exhausted with dead letter",
+		actions:        map[AckCode]AckAction{AckApplicationError: AckActionRetry},
+		acks:           []AckCode{AckApplicationError, AckApplicationError, AckApplicationError},
+		deadLetter:     true,
+		wantReceived:   3,
+		wantDeadLetter: true,
+	}, {
+		name:         "rejected halts",
+		actions:      map[AckCode]AckAction{AckApplicationReject: AckActionHalt},
+		acks:         []AckCode{AckApplicationReject},
+		wantErr:      true,
+		wantReceived: 1,
+	}, {
+		name:           "rejected dead lettered",
+		actions:        map[AckCode]AckAction{AckCommitReject: AckActionDeadLetter},
+		acks:           []AckCode{AckCommitReject},
+		deadLetter:     true,
+		wantReceived:   1,
+		wantDeadLetter: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", ":0")
+			if err != nil {
+				t.Fatalf(`net.Listen("tcp", ":0") failed with %v`, err)
+			}
+			defer ln.Close()
+
+			options := NewMLLPSenderOptions()
+			options.AckPolicy.MaxRetries = 2
+			options.AckPolicy.RetryBackoff = time.Millisecond
+			for k, v := range tc.actions {
+				options.AckPolicy.Actions[k] = v
+			}
+			deadLetter := &recordingSender{}
+			if tc.deadLetter {
+				options.AckPolicy.DeadLetter = deadLetter
+			}
+			received := ackAll(t, ln, "1234", tc.acks...)
+			mllpSender, err := NewMLLPSenderWithOptions(ln.Addr().String(), options)
+			if err != nil {
+				t.Fatalf("NewMLLPSenderWithOptions(%s, %+v) failed with %v", ln.Addr().String(), options, err)
+			}
+			defer mllpSender.Close()
+
+			err = mllpSender.Send([]byte(sentMessage))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("mllpSender.Send() got err=%v, want error? %t", err, tc.wantErr)
+			}
+			closeConn(mllpSender)
+			if got := <-received; got != tc.wantReceived {
+				t.Errorf("mllpSender.Send() sent the message %d times, want %d", got, tc.wantReceived)
+			}
+			if got := len(deadLetter.messages) > 0; got != tc.wantDeadLetter {
+				t.Errorf("mllpSender.Send() dead-lettered message: %t, want %t", got, tc.wantDeadLetter)
+			}
+		})
+	}
+}
+
+func TestMllpSender_HaltedSenderDoesNotSend(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf(`net.Listen("tcp", ":0") failed with %v`, err)
+	}
+	defer ln.Close()
+
+	received := ackAll(t, ln, "1234", AckApplicationReject, AckApplicationAccept)
+	options := NewMLLPSenderOptions()
+	options.AckPolicy.Actions[AckApplicationReject] = AckActionHalt
+	mllpSender, err := NewMLLPSenderWithOptions(ln.Addr().String(), options)
+	if err != nil {
+		t.Fatalf("NewMLLPSenderWithOptions(%s) failed with %v", ln.Addr().String(), err)
+	}
+	defer mllpSender.Close()
+
+	if err := mllpSender.Send([]byte(sentMessage)); err == nil {
+		t.Error("mllpSender.Send() got nil err, want non-nil err")
+	}
+	if err := mllpSender.Send([]byte(sentMessage)); err == nil {
+		t.Error("mllpSender.Send() after halting got nil err, want non-nil err")
+	}
+	closeConn(mllpSender)
+	if got, want := <-received, 1; got != want {
+		t.Errorf("mllpSender.Send() sent %d messages, want %d", got, want)
+	}
+}
+
+func TestMllpSender_ControlIDMismatch(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf(`net.Listen("tcp", ":0") failed with %v`, err)
+	}
+	defer ln.Close()
+
+	received := ackAll(t, ln, "another-id", AckApplicationAccept)
+	mllpSender, err := NewMLLPSender(ln.Addr().String(), false, 0)
+	if err != nil {
+		t.Fatalf("NewMLLPSender(%s) failed with %v", ln.Addr().String(), err)
+	}
+	defer mllpSender.Close()
+
+	if err := mllpSender.Send([]byte(sentMessage)); err == nil {
+		t.Error("mllpSender.Send() got nil err, want non-nil err")
+	}
+	<-received
+}
+
+func TestNewMLLPSenderWithOptions_InvalidPolicy(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf(`net.Listen("tcp", ":0") failed with %v`, err)
+	}
+	defer ln.Close()
+
+	options := NewMLLPSenderOptions()
+	options.AckPolicy.Actions[AckApplicationReject] = AckActionDeadLetter
+	if _, err := NewMLLPSenderWithOptions(ln.Addr().String(), options); err == nil {
+		t.Error("NewMLLPSenderWithOptions() with a dead-letter action and no dead-letter sink got nil err, want non-nil err")
+	}
+}
+
+func TestXMLSender(t *testing.T) {
+	r := &recordingSender{}
+	s := NewXMLSender(r)
+	defer s.Close()
+
+	message := er7(xmlORUR01)
+	if err := s.Send(message); err != nil {
+		t.Fatalf("Send(%q) failed with %v", message, err)
+	}
+	if err := s.Send([]byte("PID|1")); err == nil {
+		t.Error("Send(PID|1) got nil err, want non-nil err")
+	}
+	if len(r.messages) != 1 {
+		t.Fatalf("Send() sent %d messages, want 1", len(r.messages))
+	}
+	got, err := XMLToER7([]byte(r.messages[0]))
+	if err != nil {
+		t.Fatalf("XMLToER7(%s) failed with %v", r.messages[0], err)
+	}
+	if diff := cmp.Diff(string(message), string(got)); diff != "" {
+		t.Errorf("Send() sent a message that got diff (-want, +got):\n%s", diff)
+	}
+}
+
+type recordingSender struct {
+	messages []string
+}
+
+func (s *recordingSender) Send(message []byte) error {
+	s.messages = append(s.messages, string(message))
+	return nil
+}
+
+func (s *recordingSender) Close() error {
+	return nil
+}
+
+// closeConn closes the connection of the given mllpSender, so that the receiving end stops waiting for messages.
+func closeConn(s Sender) {
+	s.(*mllpSender).conn.Close()
+}
@@ -0,0 +1,660 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// This file contains the terser: functions to get, set and delete the values in a message by path,
+// without having to deal with the message type and segment structs.
+//
+// A path identifies a segment, and optionally a field, repetition, component and subcomponent
+// within it. All the indexes start at 1:
+//
+//   PID                 the first PID segment in the message
+//   OBX[3]              the third OBX segment in the message; OBX(3) is equivalent
+//   PID-5               all the repetitions of PID-5
+//   PID-5(2)            the second repetition of PID-5; PID-5[2] is equivalent
+//   PID-5.1             the first component of the first repetition of PID-5
+//   PID-5(2).1.2        the second subcomponent of the first component of the second repetition
+//
+// Paths that start with "/" identify the segment by its position in the structure of the message
+// type, with the groups from the root of the message type struct, eg in an ORU^R01 message:
+//
+//   /PATIENT_RESULT/ORDER_OBSERVATION(2)/OBR-4.1
+//   /PATIENT_RESULT/ORDER_OBSERVATION(2)/OBSERVATION(3)/OBX-5
+//
+// is the first component of OBR-4 in the second order of the first patient result, and the value
+// of the third observation in that order. Groups and segments without repetition refer to the
+// first one. The segments that are not part of the structure can only be accessed with paths that
+// don't start with "/".
+//
+// The values are read and written as they are encoded in the message, ie, with escape sequences,
+// and can contain the delimiters of the levels below the path, eg "Doe^John" for PID-5.
+// Set and Delete parse the segment into the struct of its type, with the types of the version of
+// the message, change the value in the struct, and marshal the segment again with MarshalSegment
+// and the delimiters of the message, as SetSegment does. So the segments that don't have a type,
+// eg Z segments, cannot be changed, and the components and fields that the struct doesn't have
+// are removed. The rewrites in the parse options are not applied.
+
+var (
+	pathGroupRegex   = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)(?:\(([1-9][0-9]*)\)|\[([1-9][0-9]*)\])?$`)
+	pathSegmentRegex = regexp.MustCompile(`^([A-Z][A-Z0-9]{2})(?:\(([1-9][0-9]*)\)|\[([1-9][0-9]*)\])?` +
+		`(?:-([1-9][0-9]*)(?:\(([1-9][0-9]*)\)|\[([1-9][0-9]*)\])?(?:\.([1-9][0-9]*)(?:\.([1-9][0-9]*))?)?)?$`)
+)
+
+// pathElement is a segment or group in a path, and its repetition.
+type pathElement struct {
+	name       string
+	repetition int
+	// t is the type of the group or segment, eg ORU_R01_PATIENT_RESULT. It is only set in the
+	// paths returned by structurePaths.
+	t reflect.Type
+}
+
+// terserPath is a parsed path. The field, repetition, component and subcomponent are 0 if they
+// are not in the path.
+type terserPath struct {
+	path string
+	// inStructure is whether the path starts with "/", ie, whether it identifies the segment by
+	// its position in the structure of the message type.
+	inStructure  bool
+	groups       []pathElement
+	segment      pathElement
+	field        int
+	repetition   int
+	component    int
+	subcomponent int
+}
+
+func parseTerserPath(path string) (*terserPath, error) {
+	p := &terserPath{path: path}
+	last := path
+	if strings.HasPrefix(path, "/") {
+		p.inStructure = true
+		elements := strings.Split(path[1:], "/")
+		for _, e := range elements[:len(elements)-1] {
+			parts := pathGroupRegex.FindStringSubmatch(e)
+			if parts == nil {
+				return nil, errors.Errorf("invalid path %q: invalid group %q", path, e)
+			}
+			p.groups = append(p.groups, pathElement{name: parts[1], repetition: pathIndex(parts[2]+parts[3], 1)})
+		}
+		last = elements[len(elements)-1]
+	}
+	parts := pathSegmentRegex.FindStringSubmatch(last)
+	if parts == nil {
+		return nil, errors.Errorf("invalid path %q: want eg PID-5(1).2, OBX[3]-5 or /PATIENT_RESULT/ORDER_OBSERVATION(2)/OBR-4.1", path)
+	}
+	p.segment = pathElement{name: parts[1], repetition: pathIndex(parts[2]+parts[3], 1)}
+	p.field = pathIndex(parts[4], 0)
+	p.repetition = pathIndex(parts[5]+parts[6], 0)
+	p.component = pathIndex(parts[7], 0)
+	p.subcomponent = pathIndex(parts[8], 0)
+	if p.segment.name == "MSH" && p.field > 0 && p.field <= 2 && (p.repetition > 0 || p.component > 0) {
+		return nil, errors.Errorf("invalid path %q: MSH-%d doesn't have repetitions or components", path, p.field)
+	}
+	return p, nil
+}
+
+// pathIndex returns the index in s, which has already been validated by a regular expression, or
+// def if s is empty.
+func pathIndex(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	i, _ := strconv.Atoi(s)
+	return i
+}
+
+// fieldIndex returns the index of the field of the path in the segment split by the field
+// delimiter. The field delimiter itself is MSH-1, so MSH-n is at index n-1.
+func (p *terserPath) fieldIndex() int {
+	if p.segment.name == "MSH" {
+		return p.field - 1
+	}
+	return p.field
+}
+
+// Get returns the value at the given path, as encoded in the message. If the path refers to a
+// field without repetition, all of its repetitions are returned; if it refers to a component or
+// subcomponent without repetition, the one in the first repetition is returned.
+// Get returns an empty string if the message doesn't have such value, and an error if the path is
+// invalid or refers to a field, component or subcomponent that the segment doesn't have.
+func (m *Message) Get(path string) (string, error) {
+	p, i, err := m.resolve(path)
+	if err != nil || i < 0 {
+		return "", err
+	}
+	segment := m.Segments[i].Value
+	if p.field == 0 {
+		return string(segment), nil
+	}
+	if p.segment.name == "MSH" && p.field == 1 {
+		return string([]byte{m.Delimiters.Field}), nil
+	}
+	value := nthPart(segment, m.Delimiters.Field, p.fieldIndex())
+	if p.repetition == 0 && p.component == 0 || p.segment.name == "MSH" && p.field == 2 {
+		return string(value), nil
+	}
+	value = nthPart(value, m.Delimiters.Repetition, repetitionOrFirst(p.repetition)-1)
+	if p.component == 0 {
+		return string(value), nil
+	}
+	value = nthPart(value, m.Delimiters.Component, p.component-1)
+	if p.subcomponent == 0 {
+		return string(value), nil
+	}
+	return string(nthPart(value, m.Delimiters.Subcomponent, p.subcomponent-1)), nil
+}
+
+// Set sets the value at the given path, which must be encoded as in the message. Repetitions,
+// components and subcomponents are added if the segment doesn't have them. If the path refers to a
+// field without repetition, all of its repetitions are replaced; if the path refers to a segment,
+// the whole segment is replaced.
+// Set returns an error if the path is invalid, the message doesn't have such segment, the segment
+// doesn't have a type or has values that cannot be parsed, or the value cannot be parsed or
+// contains the delimiters of the level of the path or above, eg the component delimiter for
+// PID-5.1. MSH-1, MSH-2 and the MSH segment itself cannot be set, so that the delimiters of the
+// message don't change.
+func (m *Message) Set(path string, value string) error {
+	p, i, err := m.resolve(path)
+	if err != nil {
+		return err
+	}
+	if i < 0 {
+		return errors.Errorf("cannot set %s: the message doesn't have such segment", path)
+	}
+	if err := m.checkSettable(p, value); err != nil {
+		return errors.Wrapf(err, "cannot set %s", path)
+	}
+	if p.field == 0 {
+		s, err := m.parseTerserSegment(p.segment.name, []byte(value))
+		if err != nil {
+			return errors.Wrapf(err, "cannot set %s", path)
+		}
+		return m.marshalTerserSegment(i, s)
+	}
+	s, err := m.parseTerserSegment(p.segment.name, m.Segments[i].Value)
+	if err != nil {
+		return errors.Wrapf(err, "cannot set %s", path)
+	}
+	v, c := p.value(s.Elem(), m.terserContext())
+	v.Set(reflect.Zero(v.Type()))
+	if err := parseValue(Token{Value: []byte(value)}, c, v); err != nil {
+		return errors.Wrapf(err, "cannot set %s", path)
+	}
+	return m.marshalTerserSegment(i, s)
+}
+
+// Delete deletes the value at the given path. If the path refers to a repetition, the repetition
+// is removed, so the following repetitions move up; if it refers to a segment, the segment is
+// removed from the message. Otherwise, the value is emptied.
+// Delete returns an error if the path is invalid, or if the path refers to a field and the segment
+// doesn't have a type or has values that cannot be parsed. Deleting a value that doesn't exist is not an
+// error. MSH-1, MSH-2 and the MSH segment itself cannot be deleted.
+func (m *Message) Delete(path string) error {
+	p, i, err := m.resolve(path)
+	if err != nil {
+		return err
+	}
+	if p.segment.name == "MSH" && p.field <= 2 {
+		return errors.Errorf("cannot delete %s: the delimiters of the message cannot change", path)
+	}
+	if i < 0 {
+		return nil
+	}
+	if p.field == 0 {
+		m.Segments = append(m.Segments[:i:i], m.Segments[i+1:]...)
+		return nil
+	}
+	if p.repetition == 0 || p.component > 0 {
+		return m.Set(path, "")
+	}
+	s, err := m.parseTerserSegment(p.segment.name, m.Segments[i].Value)
+	if err != nil {
+		return errors.Wrapf(err, "cannot delete %s", path)
+	}
+	v := p.fieldValue(s.Elem())
+	switch {
+	case !isRepeatedField(v.Type()):
+		v.Set(reflect.Zero(v.Type()))
+	case p.repetition <= v.Len():
+		v.Set(reflect.AppendSlice(v.Slice(0, p.repetition-1), v.Slice(p.repetition, v.Len())))
+	}
+	return m.marshalTerserSegment(i, s)
+}
+
+// GetSegment returns the parsed representation of the segment at the given path, or nil if the
+// message doesn't have such segment. The path must not contain a field.
+func (m *Message) GetSegment(path string) (Segment, error) {
+	p, i, err := m.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if p.field > 0 {
+		return nil, errors.Errorf("path %q doesn't refer to a segment", path)
+	}
+	if i < 0 {
+		return nil, nil
+	}
+	t, ok := lookupType(m.types(), p.segment.name)
+	if !ok {
+		return nil, &BadSegmentError{p.segment.name}
+	}
+	ps := reflect.New(t)
+	rwRes, err := parseSegmentValue(m.Segments[i], m.Context, ps.Elem())
+	if rwRes != nil && rwRes.action == deleteToken {
+		return nil, nil
+	}
+	return ps.Interface().(Segment), err
+}
+
+// SetSegment replaces the segment at the given path with s, marshalled with MarshalSegment and the
+// delimiters of the message. The path must not contain a field, and must refer to a segment of the
+// same type as s that the message has. The MSH segment cannot be set.
+func (m *Message) SetSegment(path string, s Segment) error {
+	p, i, err := m.resolve(path)
+	if err != nil {
+		return err
+	}
+	switch {
+	case p.field > 0:
+		return errors.Errorf("path %q doesn't refer to a segment", path)
+	case s.SegmentName() != p.segment.name:
+		return errors.Errorf("cannot set %s to a %s segment", path, s.SegmentName())
+	case p.segment.name == "MSH":
+		return errors.Errorf("cannot set %s: the delimiters of the message cannot change", path)
+	case i < 0:
+		return errors.Errorf("cannot set %s: the message doesn't have such segment", path)
+	}
+	b, err := MarshalSegment(s, m.terserContext())
+	if err != nil {
+		return errors.Wrapf(err, "cannot marshal %s", path)
+	}
+	return m.setSegment(i, b)
+}
+
+// terserContext returns the context used to parse and marshal the segments that are changed: the
+// context of the message at the segment level, without the rewrites.
+func (m *Message) terserContext() *Context {
+	c := *m.Context
+	c.Nesting = 0
+	c.Rewrite = nil
+	return &c
+}
+
+// parseTerserSegment parses value as a segment with the given name, and returns a pointer to the
+// struct of the segment. It returns an error if the segment doesn't have a type, or if any of its
+// values cannot be parsed.
+func (m *Message) parseTerserSegment(name string, value []byte) (reflect.Value, error) {
+	t, ok := lookupType(m.types(), name)
+	if !ok {
+		return reflect.Value{}, &BadSegmentError{name}
+	}
+	ps := reflect.New(t)
+	if _, err := parseSegmentValue(Token{Value: value}, m.terserContext(), ps.Elem()); err != nil {
+		return reflect.Value{}, err
+	}
+	return ps, nil
+}
+
+// marshalTerserSegment marshals the segment that ps points to with MarshalSegment, and sets it as
+// the segment at index i.
+func (m *Message) marshalTerserSegment(i int, ps reflect.Value) error {
+	b, err := MarshalSegment(ps.Interface().(Segment), m.terserContext())
+	if err != nil {
+		return err
+	}
+	return m.setSegment(i, b)
+}
+
+// setSegment sets the value of the segment at index i.
+// If the segment is the MSH, the header of the message is parsed again.
+func (m *Message) setSegment(i int, value []byte) error {
+	m.Segments[i].Value = value
+	if i != 0 || !bytes.HasPrefix(value, []byte("MSH")) {
+		return nil
+	}
+	m.msh = MSH{}
+	_, err := parseSegment(value, m.Context, &m.msh)
+	return err
+}
+
+// checkSettable returns an error if the value cannot be set at the path.
+func (m *Message) checkSettable(p *terserPath, value string) error {
+	d := m.Delimiters
+	if p.segment.name == "MSH" && p.field <= 2 {
+		return errors.New("the delimiters of the message cannot change")
+	}
+	forbidden := []byte{SegmentTerminator, asciiNewLine}
+	if p.field == 0 {
+		if !strings.HasPrefix(value, p.segment.name+string([]byte{d.Field})) && value != p.segment.name {
+			return errors.Errorf("the value is not a %s segment", p.segment.name)
+		}
+	} else {
+		forbidden = append(forbidden, d.Field)
+	}
+	if p.repetition > 0 || p.component > 0 {
+		forbidden = append(forbidden, d.Repetition)
+	}
+	if p.component > 0 {
+		forbidden = append(forbidden, d.Component)
+	}
+	if p.subcomponent > 0 {
+		forbidden = append(forbidden, d.Subcomponent)
+	}
+	if i := strings.IndexAny(value, string(forbidden)); i >= 0 {
+		return errors.Errorf("the value contains the delimiter %q", value[i])
+	}
+	return nil
+}
+
+// resolve parses the path, checks that it is valid for the message, and returns the index in
+// m.Segments of the segment that the path refers to, or -1 if the message doesn't have it.
+func (m *Message) resolve(path string) (*terserPath, int, error) {
+	p, err := parseTerserPath(path)
+	if err != nil {
+		return nil, -1, err
+	}
+	if t, ok := lookupType(m.types(), p.segment.name); ok {
+		if err := p.checkFields(t); err != nil {
+			return nil, -1, errors.Wrapf(err, "invalid path %q", path)
+		}
+	}
+	if !p.inStructure {
+		n := 0
+		for i, s := range m.Segments {
+			if isExpectedSegment(s, p.segment.name, m.Delimiters) {
+				n++
+				if n == p.segment.repetition {
+					return p, i, nil
+				}
+			}
+		}
+		return p, -1, nil
+	}
+	t, err := m.messageType()
+	if err != nil {
+		return nil, -1, errors.Wrapf(err, "cannot resolve path %q", path)
+	}
+	if err := p.checkStructure(t); err != nil {
+		return nil, -1, errors.Wrapf(err, "invalid path %q", path)
+	}
+	want := append(append([]pathElement{}, p.groups...), p.segment)
+	for i, got := range m.structurePaths(m.types(), t) {
+		if samePath(want, got) {
+			return p, i, nil
+		}
+	}
+	return p, -1, nil
+}
+
+// samePath returns whether the paths a and b have the same groups and segment with the same
+// repetitions. The types of the elements are ignored.
+func samePath(a, b []pathElement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].name != b[i].name || a[i].repetition != b[i].repetition {
+			return false
+		}
+	}
+	return true
+}
+
+// checkFields returns an error if the segment type t doesn't have the field, component or
+// subcomponent of the path, or the field cannot repeat and the path has a repetition other than
+// the first one.
+func (p *terserPath) checkFields(t reflect.Type) error {
+	if p.field == 0 || p.segment.name == "MSH" && p.field <= 2 {
+		return nil
+	}
+	i := p.field - 1
+	n := t.NumField()
+	if p.segment.name == "MSH" {
+		// MSH-1 is not in the struct.
+		i--
+		n++
+	}
+	if i >= t.NumField() {
+		return errors.Errorf("%s has %d fields", p.segment.name, n)
+	}
+	ft := t.Field(i).Type
+	if p.repetition > 1 && !isRepeatedField(ft) {
+		return errors.Errorf("%s-%d cannot repeat", p.segment.name, p.field)
+	}
+	if p.component == 0 {
+		return nil
+	}
+	ct, err := componentType(ft, p.component)
+	if err != nil {
+		return errors.Wrapf(err, "%s-%d", p.segment.name, p.field)
+	}
+	if p.subcomponent == 0 {
+		return nil
+	}
+	if _, err := componentType(ct, p.subcomponent); err != nil {
+		return errors.Wrapf(err, "%s-%d.%d", p.segment.name, p.field, p.component)
+	}
+	return nil
+}
+
+// fieldValue returns the field of the path in the segment struct s.
+func (p *terserPath) fieldValue(s reflect.Value) reflect.Value {
+	i := p.field - 1
+	if p.segment.name == "MSH" {
+		// MSH-1 is not in the struct.
+		i--
+	}
+	return s.Field(i)
+}
+
+// value returns the field, repetition, component or subcomponent of the path in the segment
+// struct s, and the context to parse it with, which is c nested once per component level.
+// The repetitions and the components that s doesn't have are added.
+func (p *terserPath) value(s reflect.Value, c *Context) (reflect.Value, *Context) {
+	v := p.fieldValue(s)
+	if p.repetition == 0 && p.component == 0 {
+		return v, c
+	}
+	if isRepeatedField(v.Type()) {
+		n := repetitionOrFirst(p.repetition)
+		if v.Len() < n {
+			v.Set(reflect.AppendSlice(v, reflect.MakeSlice(v.Type(), n-v.Len(), n-v.Len())))
+		}
+		v = v.Index(n - 1)
+	}
+	for _, n := range []int{p.component, p.subcomponent} {
+		if n == 0 {
+			break
+		}
+		v, c = componentValue(v, c, n)
+	}
+	return v, c
+}
+
+// componentValue returns the nth component of v, starting at 1, and the context to parse it with.
+// v is allocated if it's nil. Primitive values only have one component: themselves.
+func componentValue(v reflect.Value, c *Context, n int) (reflect.Value, *Context) {
+	for !isPrimitive(v.Type()) {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if isPrimitive(v.Type().Elem()) {
+				return v, c
+			}
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		case reflect.Slice:
+			if v.Len() == 0 {
+				v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+			}
+			v = v.Index(0)
+		default:
+			return v.Field(n - 1), c.Nested()
+		}
+	}
+	return v, c
+}
+
+// isRepeatedField returns whether t is the type of a field that can repeat, eg []CX.
+func isRepeatedField(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+}
+
+// componentType returns the type of the nth component of a value of type t, starting at 1.
+// Primitive values only have one component: themselves.
+func componentType(t reflect.Type, n int) (reflect.Type, error) {
+	primitiveType := reflect.TypeOf((*Primitive)(nil)).Elem()
+	for !reflect.PtrTo(t).Implements(primitiveType) && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(primitiveType) {
+		if n > 1 {
+			return nil, errors.Errorf("%s doesn't have components", t.Name())
+		}
+		return t, nil
+	}
+	if n > t.NumField() {
+		return nil, errors.Errorf("%s has %d components", t.Name(), t.NumField())
+	}
+	return t.Field(n - 1).Type, nil
+}
+
+// checkStructure returns an error if the groups and the segment of the path are not in the
+// message type struct t, or if the path has a repetition of a group or segment that cannot repeat.
+func (p *terserPath) checkStructure(t reflect.Type) error {
+	for _, g := range p.groups {
+		f, ok := structureField(t, g.name)
+		if !ok || isSegmentType(f.Type) {
+			return errors.Errorf("%s doesn't have a group %s", t.Name(), g.name)
+		}
+		if g.repetition > 1 && f.Type.Kind() != reflect.Slice {
+			return errors.Errorf("group %s cannot repeat", g.name)
+		}
+		t = f.Type.Elem()
+	}
+	f, ok := structureField(t, p.segment.name)
+	if !ok || !isSegmentType(f.Type) {
+		return errors.Errorf("%s doesn't have a segment %s", t.Name(), p.segment.name)
+	}
+	if p.segment.repetition > 1 && f.Type.Kind() != reflect.Slice {
+		return errors.Errorf("segment %s cannot repeat in %s", p.segment.name, t.Name())
+	}
+	return nil
+}
+
+// structureField returns the field of the message type struct t with the given name in its tag.
+func structureField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if n, _ := parseTag(t.Field(i)); n == name {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// structurePaths returns the positions in the message type struct t of the segments in the
+// message, as the groups and the segment with their repetitions, keyed by index in m.Segments.
+// The segments that are not part of the structure are not included. Segments are matched to the
+// structure as in ParseMessageType, with the segment types in types, but they are not parsed, so
+// that the paths can be resolved in messages with invalid values.
+func (m *Message) structurePaths(types map[string]reflect.Type, t reflect.Type) map[int][]pathElement {
+	var segments []interface{}
+	var indexes []int
+	for i, s := range m.Segments {
+		if len(s.Value) == 0 {
+			continue
+		}
+		name, err := segmentName(s, m.Delimiters)
+		if err != nil {
+			continue
+		}
+		var segment interface{} = &GenericHL7Segment{}
+		if st, ok := lookupType(types, name); ok && !strings.HasPrefix(name, "Z") {
+			segment = reflect.New(st).Interface()
+		}
+		segments = append(segments, segment)
+		indexes = append(indexes, i)
+	}
+	paths := map[int][]pathElement{}
+	result := reflect.New(t)
+	matchSegments(result, segments, func(s stack, i int) {
+		paths[indexes[i]] = structurePath(result, s)
+	})
+	return paths
+}
+
+// structurePath returns the groups and the segment, with their repetitions, of the field at the
+// top of s, which has just been set in result with setField.
+func structurePath(result reflect.Value, s stack) []pathElement {
+	var path []pathElement
+	v := result
+	for i := 0; i < len(s)-1; i++ {
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		} else {
+			v = v.Index(v.Len() - 1)
+		}
+		f := s[i].t.Elem().Field(s[i].f)
+		name, _ := parseTag(f)
+		v = v.Field(s[i].f)
+		repetition := 1
+		if v.Kind() == reflect.Slice {
+			repetition = v.Len()
+		}
+		path = append(path, pathElement{name: name, repetition: repetition, t: f.Type.Elem()})
+	}
+	return path
+}
+
+func repetitionOrFirst(r int) int {
+	if r == 0 {
+		return 1
+	}
+	return r
+}
+
+// nthPart returns the nth part of value split by the delimiter, starting at 0, or nil if there is
+// no such part.
+func nthPart(value []byte, delimiter byte, n int) []byte {
+	parts := bytes.Split(value, []byte{delimiter})
+	if n < len(parts) {
+		return parts[n]
+	}
+	return nil
+}
+
+// joinParts joins the parts with the delimiter, without the trailing empty parts.
+func joinParts(parts [][]byte, delimiter byte) []byte {
+	end := len(parts)
+	for end > 0 && len(parts[end-1]) == 0 {
+		end--
+	}
+	return bytes.Join(parts[:end], []byte{delimiter})
+}
@@ -0,0 +1,407 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var terserMessage = strings.Join([]string{
+	`MSH|^~\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ORU^R01^ORU_R01|1|T|2.5.1`,
+	`PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe^Johnny||19800101|M`,
+	`PV1|1|I`,
+	`ORC|RE|1`,
+	`OBR|1|1||lpdc-3969^UREA AND ELECTROLYTES^WinPath`,
+	`OBX|1|NM|tt-3-1^Creatinine^WinPath||52|UMOLL|49 - 92||||F`,
+	`OBX|2|NM|tt-3-2^Sodium^WinPath||140|MMOLL|135 - 145||||F`,
+	`ZCM|1|RADIOLOGY`,
+	`ORC|RE|2`,
+	`OBR|2|2||lpdc-2012^FULL BLOOD COUNT^WinPath`,
+	`NTE|1||O\T\Brien \F\ note`,
+	`OBX|1|NM|lpdc-2108^Haemoglobin^WinPath||123|g/L|||||F`,
+	`ZXX|1|UNKNOWN`,
+}, SegmentTerminatorStr)
+
+func parseTerserMessage(t *testing.T, input string) *Message {
+	t.Helper()
+	m, err := ParseMessage([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseMessage() failed with %v", err)
+	}
+	return m
+}
+
+// segments returns the segments of the message as strings.
+func segments(m *Message) []string {
+	var s []string
+	for _, t := range m.Segments {
+		s = append(s, string(t.Value))
+	}
+	return s
+}
+
+func TestGet(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "MSH-1", want: "|"},
+		{path: "MSH-2", want: `^~\&`},
+		{path: "MSH-3", want: "SIMHOSP"},
+		{path: "MSH-9.2", want: "R01"},
+		{path: "PID", want: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe^Johnny||19800101|M"},
+		{path: "PID-3", want: "1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR"},
+		{path: "PID-5(1)", want: "Doe^John^^^Mr"},
+		{path: "PID-5[2]", want: "Roe^Johnny"},
+		{path: "PID-5(1).2", want: "John"},
+		{path: "PID-5.2", want: "John"},
+		{path: "PID-5(2).2", want: "Johnny"},
+		{path: "PID-5(3).2", want: ""},
+		{path: "PID-3(2).4.1", want: "NHS"},
+		{path: "PID-3(1).4.2", want: ""},
+		{path: "PID-8.1", want: "M"},
+		{path: "PID-20", want: ""},
+		{path: "OBX[3]-5", want: "123"},
+		{path: "OBX(2)-3.2", want: "Sodium"},
+		{path: "OBX[4]-5", want: ""},
+		{path: "ZCM-2", want: "RADIOLOGY"},
+		{path: "NTE-3", want: `O\T\Brien \F\ note`},
+		{path: "EVN-1", want: ""},
+		{path: "/PATIENT_RESULT/PATIENT/PID-5.1", want: "Doe"},
+		{path: "/PATIENT_RESULT/PATIENT/VISIT/PV1-2", want: "I"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION/OBR-4.1", want: "lpdc-3969"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(2)/OBR-4.1", want: "lpdc-2012"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(2)/ORC-2", want: "2"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(1)/OBSERVATION(2)/OBX-5", want: "140"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(2)/OBSERVATION/OBX-5", want: "123"},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(2)/NTE(1)-3", want: `O\T\Brien \F\ note`},
+		{path: "/PATIENT_RESULT/ORDER_OBSERVATION(3)/OBR-4.1", want: ""},
+		{path: "/MSH-10", want: "1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			got, err := m.Get(tc.path)
+			if err != nil {
+				t.Fatalf("Get(%q) failed with %v", tc.path, err)
+			}
+			if got != tc.want {
+				t.Errorf("Get(%q) got %q, want %q", tc.path, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGet_InvalidPath(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	for _, path := range []string{
+		"",
+		"PID-",
+		"PID-0",
+		"PID-5(0)",
+		"pid-5",
+		"PID-5.1.2.3",
+		"PID-5-1",
+		"MSH-2.1",
+		"MSH-1(2)",
+		"PID-99",
+		"PID-8(2)",
+		"PID-5.99",
+		"PID-8.2",
+		"PID-5.2.2",
+		"OBX-5.2",
+		"/PATIENT_RESULT/UNKNOWN/OBR-4",
+		"/PATIENT_RESULT/ORDER_OBSERVATION/PID-3",
+		"/PATIENT_RESULT/ORDER_OBSERVATION/OBR(2)-4",
+		"/PATIENT_RESULT/PATIENT(2)/PID-3",
+		"/PATIENT_RESULT/ORDER_OBSERVATION/OBR/OBX-5",
+		"/patient_result/PID",
+	} {
+		t.Run(path, func(t *testing.T) {
+			if _, err := m.Get(path); err == nil {
+				t.Errorf("Get(%q) got nil error, want non nil", path)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		path  string
+		value string
+		// want are the segments that change, keyed by index.
+		want map[int]string
+	}{{
+		path:  "MSH-5",
+		value: "OTHER^1.2.3^ISO",
+		want:  map[int]string{0: `MSH|^~\&|SIMHOSP|SFAC|OTHER^1.2.3^ISO|RFAC|20200101120000||ORU^R01^ORU_R01|1|T|2.5.1`},
+	}, {
+		path:  "PID-5(1).2",
+		value: "Jim",
+		want:  map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^Jim^^^Mr~Roe^Johnny||19800101|M"},
+	}, {
+		path:  "PID-5",
+		value: "Doe^Jane",
+		want:  map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^Jane||19800101|M"},
+	}, {
+		path:  "PID-5(3)",
+		value: "Smith^J",
+		want:  map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe^Johnny~Smith^J||19800101|M"},
+	}, {
+		path:  "PID-3(2).4.2",
+		value: "2.16.840.1.113883.2.1.4.1",
+		want:  map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS&2.16.840.1.113883.2.1.4.1^NHSNMBR||Doe^John^^^Mr~Roe^Johnny||19800101|M"},
+	}, {
+		path:  "PID-30",
+		value: "Y",
+		want:  map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe^Johnny||19800101|M" + strings.Repeat("|", 22) + "Y"},
+	}, {
+		path:  "PV1-3.1",
+		value: "ED",
+		want:  map[int]string{2: "PV1|1|I|ED"},
+	}, {
+		path:  "OBX[3]-5",
+		value: "124",
+		want:  map[int]string{11: "OBX|1|NM|lpdc-2108^Haemoglobin^WinPath||124|g/L|||||F"},
+	}, {
+		path:  "/PATIENT_RESULT/ORDER_OBSERVATION(2)/OBR-4.2",
+		value: `FBC \T\ DIFF`,
+		want:  map[int]string{9: `OBR|2|2||lpdc-2012^FBC \T\ DIFF^WinPath`},
+	}, {
+		path:  "NTE-1",
+		value: "2",
+		want:  map[int]string{10: `NTE|2||O\T\Brien \F\ note`},
+	}, {
+		path:  "PV1",
+		value: "PV1|1|O",
+		want:  map[int]string{2: "PV1|1|O"},
+	}, {
+		path:  "PV1-2",
+		value: "",
+		want:  map[int]string{2: "PV1|1"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			m := parseTerserMessage(t, terserMessage)
+			want := segments(m)
+			for i, s := range tc.want {
+				want[i] = s
+			}
+			if err := m.Set(tc.path, tc.value); err != nil {
+				t.Fatalf("Set(%q, %q) failed with %v", tc.path, tc.value, err)
+			}
+			if diff := cmp.Diff(want, segments(m)); diff != "" {
+				t.Errorf("Set(%q, %q) got diff in segments (-want, +got):\n%s", tc.path, tc.value, diff)
+			}
+			got, err := m.Get(tc.path)
+			if err != nil {
+				t.Fatalf("Get(%q) failed with %v", tc.path, err)
+			}
+			if got != tc.value {
+				t.Errorf("Get(%q) after Set() got %q, want %q", tc.path, got, tc.value)
+			}
+		})
+	}
+}
+
+func TestSet_UpdatesHeader(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	if err := m.Set("MSH-10", "ABC"); err != nil {
+		t.Fatalf("Set() failed with %v", err)
+	}
+	msh, err := m.MSH()
+	if err != nil {
+		t.Fatalf("MSH() failed with %v", err)
+	}
+	if got, want := msh.MessageControlID.String(), "ABC"; got != want {
+		t.Errorf("MSH().MessageControlID got %q, want %q", got, want)
+	}
+	if got, want := string(*m.msh.MessageControlID), "ABC"; got != want {
+		t.Errorf("m.msh.MessageControlID got %q, want %q", got, want)
+	}
+}
+
+func TestSet_KeepsDelimiters(t *testing.T) {
+	input := strings.Join([]string{
+		`MSH#*!?$#SIMHOSP#SFAC#RAPP#RFAC#20200101120000##ADT*A01#1#T#2.5.1`,
+		`PID#1##1234***SIMULATOR MRN*MRN!5678***NHS*NHSNMBR##Doe*John`,
+	}, SegmentTerminatorStr)
+	m := parseTerserMessage(t, input)
+	if err := m.Set("PID-5.2", "Jim"); err != nil {
+		t.Fatalf("Set() failed with %v", err)
+	}
+	if err := m.Set("PID-3(2).4.2", "OID"); err != nil {
+		t.Fatalf("Set() failed with %v", err)
+	}
+	want := `PID#1##1234***SIMULATOR MRN*MRN!5678***NHS$OID*NHSNMBR##Doe*Jim`
+	if got := string(m.Segments[1].Value); got != want {
+		t.Errorf("Set() got segment %q, want %q", got, want)
+	}
+	if err := m.Set("PID-5.2", "Jim*Bob"); err == nil {
+		t.Error("Set() with the component delimiter in the value got nil error, want non nil")
+	}
+}
+
+func TestSet_Invalid(t *testing.T) {
+	tests := []struct {
+		path  string
+		value string
+	}{
+		{path: "MSH-1", value: "#"},
+		{path: "MSH-2", value: "*!?$"},
+		{path: "MSH", value: "MSH|^~\\&|A"},
+		{path: "EVN-1", value: "A01"},
+		{path: "OBX[4]-5", value: "1"},
+		{path: "PID-5", value: "Doe|John"},
+		{path: "PID-5(1)", value: "Doe~Roe"},
+		{path: "PID-5.1", value: "Doe^John"},
+		{path: "PID-3.4.1", value: "NHS&OID"},
+		{path: "PID-5", value: "Doe\rPV1|1"},
+		{path: "PV1", value: "PID|1"},
+		{path: "PID-99", value: "1"},
+		{path: "PID-7", value: "yesterday"},
+		{path: "ZXX-2", value: "OTHER"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			m := parseTerserMessage(t, terserMessage)
+			want := segments(m)
+			if err := m.Set(tc.path, tc.value); err == nil {
+				t.Errorf("Set(%q, %q) got nil error, want non nil", tc.path, tc.value)
+			}
+			if diff := cmp.Diff(want, segments(m)); diff != "" {
+				t.Errorf("Set(%q, %q) changed the segments (-want, +got):\n%s", tc.path, tc.value, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		path string
+		// want are the segments that change, keyed by index. Empty segments are removed.
+		want map[int]string
+	}{{
+		path: "PID-5(1)",
+		want: map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Roe^Johnny||19800101|M"},
+	}, {
+		path: "PID-5",
+		want: map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||||19800101|M"},
+	}, {
+		path: "PID-5(2).2",
+		want: map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe||19800101|M"},
+	}, {
+		path: "PID-8",
+		want: map[int]string{1: "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS^NHSNMBR||Doe^John^^^Mr~Roe^Johnny||19800101"},
+	}, {
+		path: "OBX[2]",
+		want: map[int]string{6: ""},
+	}, {
+		path: "/PATIENT_RESULT/ORDER_OBSERVATION(2)/NTE",
+		want: map[int]string{10: ""},
+	}, {
+		path: "PID-5(3)",
+	}, {
+		path: "EVN",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			m := parseTerserMessage(t, terserMessage)
+			var want []string
+			for i, s := range segments(m) {
+				if w, ok := tc.want[i]; ok {
+					s = w
+				}
+				if s != "" {
+					want = append(want, s)
+				}
+			}
+			if err := m.Delete(tc.path); err != nil {
+				t.Fatalf("Delete(%q) failed with %v", tc.path, err)
+			}
+			if diff := cmp.Diff(want, segments(m)); diff != "" {
+				t.Errorf("Delete(%q) got diff in segments (-want, +got):\n%s", tc.path, diff)
+			}
+		})
+	}
+}
+
+func TestDelete_Invalid(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	for _, path := range []string{"MSH", "MSH-1", "MSH-2", "PID-99", "/PATIENT_RESULT/UNKNOWN/OBR", "ZXX-2"} {
+		t.Run(path, func(t *testing.T) {
+			if err := m.Delete(path); err == nil {
+				t.Errorf("Delete(%q) got nil error, want non nil", path)
+			}
+		})
+	}
+}
+
+func TestGetAndSetSegment(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	s, err := m.GetSegment("/PATIENT_RESULT/ORDER_OBSERVATION(2)/OBSERVATION/OBX")
+	if err != nil {
+		t.Fatalf("GetSegment() failed with %v", err)
+	}
+	obx, ok := s.(*OBX)
+	if !ok {
+		t.Fatalf("GetSegment() got %T, want *OBX", s)
+	}
+	if got, want := obx.ObservationIdentifier.Text.String(), "Haemoglobin"; got != want {
+		t.Errorf("GetSegment().ObservationIdentifier.Text got %q, want %q", got, want)
+	}
+	obx.ObservationValue = []Any{Any("130")}
+	if err := m.SetSegment("OBX[3]", obx); err != nil {
+		t.Fatalf("SetSegment() failed with %v", err)
+	}
+	if got, want := string(m.Segments[11].Value), "OBX|1|NM|lpdc-2108^Haemoglobin^WinPath||130|g/L|||||F"; got != want {
+		t.Errorf("SetSegment() got segment %q, want %q", got, want)
+	}
+
+	if s, err := m.GetSegment("EVN"); err != nil || s != nil {
+		t.Errorf("GetSegment(%q) got (%v, %v), want (nil, nil)", "EVN", s, err)
+	}
+	for _, path := range []string{"OBX[3]-5", "PID", "OBX[4]", "MSH"} {
+		if err := m.SetSegment(path, obx); err == nil {
+			t.Errorf("SetSegment(%q) got nil error, want non nil", path)
+		}
+	}
+}
+
+func TestMarshalMessageER7(t *testing.T) {
+	m := parseTerserMessage(t, terserMessage)
+	if got, want := string(MarshalMessageER7(m)), terserMessage; got != want {
+		t.Errorf("MarshalMessageER7() got %q, want %q", got, want)
+	}
+	if err := m.Set("PID-5(1).2", "Jim"); err != nil {
+		t.Fatalf("Set() failed with %v", err)
+	}
+	if err := m.Delete("OBX[2]"); err != nil {
+		t.Fatalf("Delete() failed with %v", err)
+	}
+	got, err := ParseMessage(MarshalMessageER7(m))
+	if err != nil {
+		t.Fatalf("ParseMessage(MarshalMessageER7()) failed with %v", err)
+	}
+	if diff := cmp.Diff(segments(m), segments(got)); diff != "" {
+		t.Errorf("ParseMessage(MarshalMessageER7()) got diff in segments (-want, +got):\n%s", diff)
+	}
+	if got, want := len(got.Segments), len(strings.Split(terserMessage, SegmentTerminatorStr))-1; got != want {
+		t.Errorf("len(ParseMessage(MarshalMessageER7()).Segments) got %d, want %d", got, want)
+	}
+}
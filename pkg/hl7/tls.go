@@ -0,0 +1,95 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSOptions contains the parameters to establish MLLP connections over TLS.
+type TLSOptions struct {
+	// CAFile is the path to a PEM file with the certificate authorities used to verify the
+	// server's certificate. If empty, the system's certificate authorities are used.
+	CAFile string
+	// CertFile and KeyFile are the paths to the PEM files with the client certificate and its
+	// private key, used for mutual TLS. Either both or none must be set.
+	CertFile string
+	KeyFile  string
+	// ServerName is the name used to verify the server's certificate.
+	// If empty, the host of the address the sender connects to is used.
+	ServerName string
+	// MinVersion is the minimum TLS version accepted, one of 1.0, 1.1, 1.2 or 1.3.
+	// If empty, it defaults to 1.2.
+	MinVersion string
+}
+
+// NewTLSConfig returns a tls.Config built from the given options.
+func NewTLSConfig(options *TLSOptions) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: options.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if options.MinVersion != "" {
+		v, ok := tlsVersions[options.MinVersion]
+		if !ok {
+			return nil, errors.Errorf("invalid TLS version %q; supported versions: [%s]", options.MinVersion, strings.Join(supportedTLSVersions(), ", "))
+		}
+		config.MinVersion = v
+	}
+	if options.CAFile != "" {
+		pem, err := ioutil.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read CA file %s", options.CAFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no valid certificates found in CA file %s", options.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if (options.CertFile == "") != (options.KeyFile == "") {
+		return nil, errors.New("both the client certificate and key files must be set for mutual TLS")
+	}
+	if options.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot load client certificate %s and key %s", options.CertFile, options.KeyFile)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+func supportedTLSVersions() []string {
+	var versions []string
+	for v := range tlsVersions {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
@@ -0,0 +1,258 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+)
+
+// testPKI contains a certificate authority and certificates signed by it, written to files in dir.
+type testPKI struct {
+	dir      string
+	caFile   string
+	pool     *x509.CertPool
+	server   tls.Certificate
+	certFile string
+	keyFile  string
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	dir := testwrite.TempDir(t)
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey() failed with %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate() failed with %v", err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate() failed with %v", err)
+	}
+	p := &testPKI{dir: dir, pool: x509.NewCertPool()}
+	p.pool.AddCert(ca)
+	p.caFile = writePEM(t, dir, "ca.pem", "CERTIFICATE", caDER)
+
+	sign := func(serial int64, usage x509.ExtKeyUsage) ([]byte, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("ecdsa.GenerateKey() failed with %v", err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("x509.CreateCertificate() failed with %v", err)
+		}
+		return der, key
+	}
+
+	serverDER, serverKey := sign(2, x509.ExtKeyUsageServerAuth)
+	p.server = tls.Certificate{Certificate: [][]byte{serverDER}, PrivateKey: serverKey}
+
+	clientDER, clientKey := sign(3, x509.ExtKeyUsageClientAuth)
+	keyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatalf("x509.MarshalECPrivateKey() failed with %v", err)
+	}
+	p.certFile = writePEM(t, dir, "client.pem", "CERTIFICATE", clientDER)
+	p.keyFile = writePEM(t, dir, "client.key", "EC PRIVATE KEY", keyDER)
+	return p
+}
+
+func writePEM(t *testing.T, dir string, name string, blockType string, der []byte) string {
+	t.Helper()
+	return testwrite.BytesToFileInExistingDir(t, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), dir, name)
+}
+
+// listenMutualTLS returns a TLS listener that requires clients to present a certificate signed by the test CA.
+func listenMutualTLS(t *testing.T, p *testPKI) net.Listener {
+	t.Helper()
+	config := &tls.Config{
+		Certificates: []tls.Certificate{p.server},
+		ClientCAs:    p.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatalf(`tls.Listen("tcp", "127.0.0.1:0") failed with %v`, err)
+	}
+	return ln
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	p := newTestPKI(t)
+	config, err := NewTLSConfig(&TLSOptions{CAFile: p.caFile, CertFile: p.certFile, KeyFile: p.keyFile, ServerName: "localhost", MinVersion: "1.3"})
+	if err != nil {
+		t.Fatalf("NewTLSConfig() failed with %v", err)
+	}
+	if got, want := config.MinVersion, uint16(tls.VersionTLS13); got != want {
+		t.Errorf("config.MinVersion got %v, want %v", got, want)
+	}
+	if got, want := config.ServerName, "localhost"; got != want {
+		t.Errorf("config.ServerName got %q, want %q", got, want)
+	}
+	if got, want := len(config.Certificates), 1; got != want {
+		t.Errorf("len(config.Certificates) got %d, want %d", got, want)
+	}
+	if config.RootCAs == nil {
+		t.Error("config.RootCAs got nil, want non-nil")
+	}
+
+	config, err = NewTLSConfig(&TLSOptions{})
+	if err != nil {
+		t.Fatalf("NewTLSConfig() with no options failed with %v", err)
+	}
+	if got, want := config.MinVersion, uint16(tls.VersionTLS12); got != want {
+		t.Errorf("config.MinVersion got %v, want %v", got, want)
+	}
+}
+
+func TestNewTLSConfig_Error(t *testing.T) {
+	p := newTestPKI(t)
+	notPEM := testwrite.BytesToFileInExistingDir(t, []byte("not a certificate"), p.dir, "bad.pem")
+	tests := []struct {
+		name    string
+		options *TLSOptions
+	}{
+		{name: "invalid version", options: &TLSOptions{MinVersion: "2.0"}},
+		{name: "missing CA file", options: &TLSOptions{CAFile: path.Join(p.dir, "missing.pem")}},
+		{name: "invalid CA file", options: &TLSOptions{CAFile: notPEM}},
+		{name: "cert without key", options: &TLSOptions{CertFile: p.certFile}},
+		{name: "key without cert", options: &TLSOptions{KeyFile: p.keyFile}},
+		{name: "invalid key pair", options: &TLSOptions{CertFile: p.certFile, KeyFile: notPEM}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewTLSConfig(tc.options); err == nil {
+				t.Errorf("NewTLSConfig(%+v) got nil err, want non-nil err", tc.options)
+			}
+		})
+	}
+}
+
+func TestMllpSender_MutualTLS(t *testing.T) {
+	p := newTestPKI(t)
+	ln := listenMutualTLS(t, p)
+	defer ln.Close()
+
+	config, err := NewTLSConfig(&TLSOptions{CAFile: p.caFile, CertFile: p.certFile, KeyFile: p.keyFile})
+	if err != nil {
+		t.Fatalf("NewTLSConfig() failed with %v", err)
+	}
+	options := NewMLLPSenderOptions()
+	options.TLS = config
+
+	// The TLS handshake happens when the connection is established, so the server needs to be accepting already.
+	received := make(chan string, 2)
+	go func() {
+		for i := 0; i < 2; i++ {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			client := NewMLLPClient(conn)
+			b, err := client.Read()
+			if err != nil {
+				conn.Close()
+				continue
+			}
+			received <- string(b)
+			client.Write([]byte(ackMessage(AckApplicationAccept, "1234")))
+			// Reset the connection so that the sender needs to re-establish it.
+			conn.(*tls.Conn).NetConn().(*net.TCPConn).SetLinger(0)
+			conn.Close()
+		}
+	}()
+
+	s, err := NewMLLPSenderWithOptions(ln.Addr().String(), options)
+	if err != nil {
+		t.Fatalf("NewMLLPSenderWithOptions(%q, %+v) failed with %v", ln.Addr().String(), options, err)
+	}
+	defer s.Close()
+
+	if err := s.Send([]byte(sentMessage)); err != nil {
+		t.Fatalf("Send(%q) failed with %v", sentMessage, err)
+	}
+	if got := <-received; got != sentMessage {
+		t.Errorf("received message got %q, want %q", got, sentMessage)
+	}
+	// Give the reset time to arrive, so that the next write fails and the connection is re-established.
+	time.Sleep(100 * time.Millisecond)
+	if err := s.Send([]byte(sentMessage)); err != nil {
+		t.Fatalf("Send(%q) after the connection was reset failed with %v", sentMessage, err)
+	}
+	if got := <-received; got != sentMessage {
+		t.Errorf("received message got %q, want %q", got, sentMessage)
+	}
+}
+
+func TestMllpSender_MutualTLS_NoClientCertificate(t *testing.T) {
+	p := newTestPKI(t)
+	ln := listenMutualTLS(t, p)
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.(*tls.Conn).Handshake()
+	}()
+
+	config, err := NewTLSConfig(&TLSOptions{CAFile: p.caFile, MinVersion: "1.2"})
+	if err != nil {
+		t.Fatalf("NewTLSConfig() failed with %v", err)
+	}
+	// Force TLS 1.2 so that the server rejects the missing client certificate during the handshake.
+	config.MaxVersion = tls.VersionTLS12
+	options := NewMLLPSenderOptions()
+	options.TLS = config
+	if _, err := NewMLLPSenderWithOptions(ln.Addr().String(), options); err == nil {
+		t.Errorf("NewMLLPSenderWithOptions(%q) without a client certificate got nil err, want non-nil err", ln.Addr().String())
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/bitcrshr/simhospital/pkg/files"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Config contains the rules for specific fields, components and subcomponents that are not part of
+// the message structures, eg the maximum lengths and the tables of values.
+type Config struct {
+	// Fields contains the rules keyed by location, without repetitions, eg PID-3.1 for the first
+	// component of every repetition of PID-3.
+	Fields map[string]FieldRule `yaml:"fields"`
+	// Tables contains the values of the tables, keyed by table name.
+	Tables map[string][]string `yaml:"tables"`
+}
+
+// FieldRule contains the rules for a field, component or subcomponent.
+type FieldRule struct {
+	// MaxLength is the maximum length of the value as encoded in the message, ie, including escape
+	// sequences and delimiters. If 0, the length is not checked.
+	MaxLength int `yaml:"max_length"`
+	// Table is the name of the table that contains the valid values. If empty, the value is not
+	// checked against any table.
+	Table string `yaml:"table"`
+}
+
+// locationRegex matches the locations of fields, components and subcomponents without
+// repetitions, eg PID-3, PID-3.1 or PID-3.4.1.
+var locationRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}-[1-9][0-9]*(\.[1-9][0-9]*){0,2}$`)
+
+// LoadConfig loads a Config from the given YAML file.
+func LoadConfig(ctx context.Context, filename string) (*Config, error) {
+	data, err := files.Read(ctx, filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read validation config file %s", filename)
+	}
+	var c Config
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return nil, errors.Wrapf(err, "cannot unmarshal validation config from %s", filename)
+	}
+	if _, err := c.rules(); err != nil {
+		return nil, errors.Wrapf(err, "invalid validation config in %s", filename)
+	}
+	return &c, nil
+}
+
+// rule is a FieldRule with the values of its table.
+type rule struct {
+	maxLength int
+	table     string
+	values    map[string]bool
+}
+
+// rules returns the rules in the config keyed by location, and an error if the config is invalid.
+func (c *Config) rules() (map[string]*rule, error) {
+	rules := map[string]*rule{}
+	if c == nil {
+		return rules, nil
+	}
+	for location, f := range c.Fields {
+		if !locationRegex.MatchString(location) {
+			return nil, errors.Errorf("field %q: invalid location, want eg PID-3 or PID-3.1", location)
+		}
+		if f.MaxLength < 0 {
+			return nil, errors.Errorf("field %q: max_length must not be negative", location)
+		}
+		r := &rule{maxLength: f.MaxLength, table: f.Table}
+		if f.Table != "" {
+			values, ok := c.Tables[f.Table]
+			if !ok {
+				return nil, errors.Errorf("field %q: unknown table %q", location, f.Table)
+			}
+			r.values = map[string]bool{}
+			for _, v := range values {
+				r.values[v] = true
+			}
+		}
+		rules[location] = r
+	}
+	return rules, nil
+}
+
+// check checks the value at the given location against the rule.
+func (r *rule) check(v *validation, n int, location string, value []byte) {
+	if r.maxLength > 0 && len(value) > r.maxLength {
+		v.add(Error, RuleLength, n, location, "has %d characters, but the maximum length is %d", len(value), r.maxLength)
+	}
+	if r.values != nil && !r.values[string(value)] {
+		v.add(Error, RuleTable, n, location, "value %q is not in table %s", value, r.table)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadConfig(t *testing.T) {
+	ctx := context.Background()
+	config := []byte(`
+fields:
+  PID-8:
+    max_length: 1
+    table: sex
+  PID-3.5:
+    table: identifier_type
+tables:
+  sex: ["F", "M"]
+  identifier_type: ["MRN"]
+`)
+	want := &Config{
+		Fields: map[string]FieldRule{
+			"PID-8":   {MaxLength: 1, Table: "sex"},
+			"PID-3.5": {Table: "identifier_type"},
+		},
+		Tables: map[string][]string{
+			"sex":             {"F", "M"},
+			"identifier_type": {"MRN"},
+		},
+	}
+	fName := testwrite.BytesToFile(t, config)
+	got, err := LoadConfig(ctx, fName)
+	if err != nil {
+		t.Fatalf("LoadConfig(%s) failed with %v", fName, err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LoadConfig(%s) got diff (-want, +got):\n%s", fName, diff)
+	}
+}
+
+func TestLoadConfig_Prod(t *testing.T) {
+	ctx := context.Background()
+	c, err := LoadConfig(ctx, test.ValidationConfigProd)
+	if err != nil {
+		t.Fatalf("LoadConfig(%s) failed with %v", test.ValidationConfigProd, err)
+	}
+	if len(c.Fields) == 0 {
+		t.Errorf("LoadConfig(%s) got no fields, want some", test.ValidationConfigProd)
+	}
+	if _, err := NewValidator(c); err != nil {
+		t.Errorf("NewValidator() failed with %v", err)
+	}
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		config string
+	}{{
+		name:   "unknown table",
+		config: "fields:\n  PID-8:\n    table: sex\n",
+	}, {
+		name:   "location with repetition",
+		config: "fields:\n  PID-3[1].1:\n    max_length: 10\n",
+	}, {
+		name:   "location without field",
+		config: "fields:\n  PID:\n    max_length: 10\n",
+	}, {
+		name:   "negative max length",
+		config: "fields:\n  PID-8:\n    max_length: -1\n",
+	}, {
+		name:   "unknown key",
+		config: "fields:\n  PID-8:\n    length: 1\n",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fName := testwrite.BytesToFile(t, []byte(tc.config))
+			if _, err := LoadConfig(ctx, fName); err == nil {
+				t.Errorf("LoadConfig(%s) got nil error, want non nil", fName)
+			}
+		})
+	}
+}
@@ -0,0 +1,542 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validate checks parsed HL7 messages against the structure of their message type, as
+// defined by the types of the version of the HL7 specification the messages are parsed with.
+//
+// The following is checked:
+//   - The order of the segments, and that the required segments and groups of segments are present.
+//   - That only repeatable segments, groups and fields are repeated.
+//   - That the required fields are present, and the required components of the fields that are
+//     present.
+//   - The format of the TS, DTM, NM and SI values.
+//   - The maximum lengths and the tables of values of the fields and components set in a Config.
+//
+// The issues are reported with their locations in the message, eg PID-3[2].1 is the first
+// component of the second repetition of PID-3.
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+)
+
+// Severity is how serious an issue is.
+type Severity int
+
+// The severities of the issues.
+const (
+	// Warning is for issues that receivers usually tolerate, eg extra fields or components that
+	// might have been added in later versions of the HL7 specification.
+	Warning Severity = iota
+	// Error is for issues that make the message non-conformant.
+	Error
+)
+
+func (s Severity) String() string {
+	if s == Error {
+		return "ERROR"
+	}
+	return "WARNING"
+}
+
+// The rules that issues can break.
+const (
+	RuleStructure   = "structure"
+	RuleRequired    = "required"
+	RuleCardinality = "cardinality"
+	RuleFormat      = "format"
+	RuleLength      = "length"
+	RuleTable       = "table"
+)
+
+// Issue is a problem found in a message.
+type Issue struct {
+	Severity Severity
+	// Rule is the rule that the message breaks, eg RuleRequired.
+	Rule string
+	// Segment is the position of the segment in the message, starting at 1. For segments that are
+	// missing, this is the position of the segment where they were expected, or 0 if they were
+	// expected at the end of the message.
+	Segment int
+	// Location is where the issue is. Fields are identified by the segment name, the field number,
+	// the repetition if the field is repeatable, and the component and subcomponent numbers, eg:
+	// PID-3[2].1. Segments and groups are identified by their path within the message structure,
+	// eg: PATIENT_RESULT/ORDER_OBSERVATION/OBR.
+	Location string
+	Message  string
+}
+
+func (i Issue) String() string {
+	if i.Segment == 0 {
+		return fmt.Sprintf("%s %s: %s [%s]", i.Severity, i.Location, i.Message, i.Rule)
+	}
+	return fmt.Sprintf("%s segment %d, %s: %s [%s]", i.Severity, i.Segment, i.Location, i.Message, i.Rule)
+}
+
+// Report is the result of validating a message.
+type Report struct {
+	// Version is the version of the HL7 specification the message was validated against.
+	Version string
+	// MessageStructure is the name of the message structure the message was validated against,
+	// eg ADT_A01, or empty if the message structure is unknown.
+	MessageStructure string
+	Issues           []Issue
+}
+
+// Valid returns whether the message doesn't have any issue with severity Error.
+func (r *Report) Valid() bool {
+	return r.Count(Error) == 0
+}
+
+// Count returns the number of issues with the given severity.
+func (r *Report) Count(s Severity) int {
+	n := 0
+	for _, i := range r.Issues {
+		if i.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+// Validator validates HL7 messages.
+type Validator struct {
+	rules map[string]*rule
+}
+
+// NewValidator returns a Validator that checks the maximum lengths and the tables of values in the
+// given config, in addition to the structure of the messages. The config can be nil.
+func NewValidator(c *Config) (*Validator, error) {
+	rules, err := c.rules()
+	if err != nil {
+		return nil, err
+	}
+	return &Validator{rules: rules}, nil
+}
+
+// Validate checks the message and returns a report with the issues found.
+// The message is validated against the types of the version of the HL7 specification it was parsed
+// with. See hl7.ParseMessageWithOptions.
+func (v *Validator) Validate(m *hl7.Message) *Report {
+	loc := m.TimezoneLoc
+	if loc == nil {
+		loc = time.UTC
+	}
+	vn := &validation{
+		Validator: v,
+		types:     m.Schema().Types,
+		delims:    m.Delimiters,
+		loc:       loc,
+		report:    &Report{Version: m.Version()},
+	}
+	vn.validate(m.Segments)
+	return vn.report
+}
+
+// validation contains the state of the validation of one message.
+type validation struct {
+	*Validator
+	types  map[string]reflect.Type
+	delims *hl7.Delimiters
+	loc    *time.Location
+	report *Report
+}
+
+// segment is a segment of the message being validated.
+type segment struct {
+	// n is the position of the segment in the message, starting at 1.
+	n     int
+	name  string
+	value []byte
+}
+
+func (v *validation) add(severity Severity, rule string, n int, location string, format string, args ...interface{}) {
+	v.report.Issues = append(v.report.Issues, Issue{
+		Severity: severity,
+		Rule:     rule,
+		Segment:  n,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validation) validate(tokens []hl7.Token) {
+	var segments []segment
+	n := 0
+	for _, t := range tokens {
+		if len(bytes.TrimSpace(t.Value)) == 0 {
+			continue
+		}
+		n++
+		if !segmentNameRegex.Match(t.Value) || (len(t.Value) > 3 && t.Value[3] != v.delims.Field) {
+			v.add(Error, RuleStructure, n, "", "bad segment name")
+			continue
+		}
+		segments = append(segments, segment{n: n, name: string(t.Value[:3]), value: t.Value})
+	}
+	if len(segments) == 0 || segments[0].name != "MSH" {
+		v.add(Error, RuleStructure, 1, "MSH", "the message doesn't start with an MSH segment")
+		return
+	}
+
+	var known []segment
+	for _, s := range segments {
+		t, ok := v.segmentType(s.name)
+		if !ok {
+			if !strings.HasPrefix(s.name, "Z") {
+				v.add(Error, RuleStructure, s.n, s.name, "unknown segment %s", s.name)
+			}
+			continue
+		}
+		v.checkSegment(s, t)
+		// Z segments are site-defined, so they can be anywhere in the message.
+		if !strings.HasPrefix(s.name, "Z") {
+			known = append(known, s)
+		}
+	}
+	v.checkStructure(segments[0], known)
+
+	// Report the issues in the order of the segments, with the segments missing at the end of the
+	// message last.
+	position := func(i int) int {
+		if n := v.report.Issues[i].Segment; n > 0 {
+			return n
+		}
+		return len(tokens) + 1
+	}
+	sort.SliceStable(v.report.Issues, func(i, j int) bool { return position(i) < position(j) })
+}
+
+// segmentType returns the type of the segment with the given name. Segments that are not in the
+// version of the message are looked up in hl7.Types, which can contain custom segments.
+func (v *validation) segmentType(name string) (reflect.Type, bool) {
+	t, ok := v.types[name]
+	if !ok {
+		t, ok = hl7.Types[name]
+	}
+	if !ok || !reflect.PtrTo(t).Implements(segmentInterface) {
+		return nil, false
+	}
+	return t, true
+}
+
+var (
+	segmentNameRegex   = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}`)
+	segmentInterface   = reflect.TypeOf((*hl7.Segment)(nil)).Elem()
+	primitiveInterface = reflect.TypeOf((*hl7.Primitive)(nil)).Elem()
+	delimitersType     = reflect.TypeOf(hl7.Delimiters{})
+)
+
+// parseTag returns the name in the hl7 struct tag of the given field, and whether the field is
+// required.
+func parseTag(f reflect.StructField) (string, bool) {
+	parts := strings.SplitN(f.Tag.Get("hl7"), ",", 2)
+	if len(parts) == 2 {
+		return parts[1], parts[0] == "true"
+	}
+	return parts[0], false
+}
+
+// field returns the value of field number n of the given segment, or nil if the segment doesn't
+// have such field.
+func (v *validation) field(s segment, n int) []byte {
+	fields := bytes.Split(s.value, []byte{v.delims.Field})
+	// MSH-1 is the field separator itself, so MSH-n is at index n-1.
+	if s.name == "MSH" {
+		n--
+	}
+	if n < len(fields) {
+		return fields[n]
+	}
+	return nil
+}
+
+// messageStructure returns the name of the message structure of the message with the given MSH
+// segment: MSH-9.3 if set and known, or otherwise MSH-9.1 and MSH-9.2, eg ADT_A01.
+func (v *validation) messageStructure(msh segment) (string, bool) {
+	components := bytes.Split(v.field(msh, 9), []byte{v.delims.Component})
+	var candidates []string
+	if len(components) > 2 && len(components[2]) > 0 {
+		candidates = append(candidates, string(components[2]))
+	}
+	if len(components) > 1 && len(components[1]) > 0 {
+		candidates = append(candidates, string(components[0])+"_"+string(components[1]))
+	}
+	// The ACK message type doesn't need a trigger event.
+	candidates = append(candidates, string(components[0]))
+	for _, c := range candidates {
+		if t, ok := v.types[c]; ok && !reflect.PtrTo(t).Implements(segmentInterface) {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// checkSegment checks the fields of the segment against the segment type t.
+func (v *validation) checkSegment(s segment, t reflect.Type) {
+	fields := bytes.Split(s.value, []byte{v.delims.Field})[1:]
+	first := 1
+	if s.name == "MSH" {
+		// The MSH type doesn't have a field for MSH-1, as it's the field separator itself.
+		first = 2
+	}
+	if len(fields) > t.NumField() {
+		v.add(Warning, RuleCardinality, s.n, fmt.Sprintf("%s-%d", s.name, t.NumField()+first),
+			"%s has %d fields, but only %d are defined", s.name, len(fields)+first-1, t.NumField()+first-1)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		location := fmt.Sprintf("%s-%d", s.name, i+first)
+		var value []byte
+		if i < len(fields) {
+			value = fields[i]
+		}
+		name, required := parseTag(f)
+		if len(value) == 0 {
+			if required {
+				v.add(Error, RuleRequired, s.n, location, "required field %s is missing", name)
+			}
+			continue
+		}
+		if f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() != reflect.Uint8 {
+			for j, r := range bytes.Split(value, []byte{v.delims.Repetition}) {
+				if len(r) > 0 {
+					v.checkValue(s.n, fmt.Sprintf("%s[%d]", location, j+1), location, elem(f.Type), r, 0)
+				}
+			}
+			continue
+		}
+		t := elem(f.Type)
+		if t == delimitersType {
+			// MSH-2 contains the delimiters, so it cannot be split.
+			continue
+		}
+		if r := bytes.Split(value, []byte{v.delims.Repetition}); len(r) > 1 {
+			v.add(Error, RuleCardinality, s.n, location, "%s cannot repeat, but has %d repetitions", name, len(r))
+			value = r[0]
+		}
+		v.checkValue(s.n, location, location, t, value, 0)
+	}
+}
+
+// elem returns the type of the values of a field of type t, which is either a pointer or a slice.
+func elem(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) {
+		t = t.Elem()
+	}
+	return t
+}
+
+// checkValue checks a value of type t at the given location. The key is the location without the
+// repetitions, which identifies the rule in the config that applies to the value.
+// nesting is 0 for fields, 1 for components and 2 for subcomponents.
+func (v *validation) checkValue(n int, location, key string, t reflect.Type, value []byte, nesting int) {
+	if hl7.IsHL7Null(value) {
+		return
+	}
+	if r, ok := v.rules[key]; ok {
+		r.check(v, n, location, value)
+	}
+	if reflect.PtrTo(t).Implements(primitiveInterface) {
+		v.checkPrimitive(n, location, t, value, nesting)
+		return
+	}
+	if t.Kind() != reflect.Struct || nesting > 1 {
+		return
+	}
+	delimiter := v.delims.Component
+	if nesting == 1 {
+		delimiter = v.delims.Subcomponent
+	}
+	components := bytes.Split(value, []byte{delimiter})
+	if len(components) > t.NumField() {
+		v.add(Warning, RuleCardinality, n, location, "has %d components, but %s only has %d", len(components), t.Name(), t.NumField())
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		cLocation := fmt.Sprintf("%s.%d", location, i+1)
+		cKey := fmt.Sprintf("%s.%d", key, i+1)
+		if i >= len(components) || len(components[i]) == 0 {
+			if name, required := parseTag(f); required {
+				v.add(Error, RuleRequired, n, cLocation, "required component %s is missing", name)
+			}
+			continue
+		}
+		v.checkValue(n, cLocation, cKey, elem(f.Type), components[i], nesting+1)
+	}
+}
+
+// checkPrimitive checks the format of a value of the primitive type t.
+// The values themselves are not included in the issues, as they might contain patient
+// identifiable data.
+func (v *validation) checkPrimitive(n int, location string, t reflect.Type, value []byte, nesting int) {
+	var p hl7.Primitive
+	var description string
+	switch t {
+	case reflect.TypeOf(hl7.TS{}):
+		p, description = &hl7.TS{}, "date/time"
+	case reflect.TypeOf(hl7.DTM{}):
+		p, description = &hl7.DTM{}, "date/time"
+	case reflect.TypeOf(hl7.NM{}):
+		p, description = &hl7.NM{}, "number"
+	case reflect.TypeOf(hl7.SI{}):
+		p, description = &hl7.SI{}, "sequence ID"
+	default:
+		return
+	}
+	c := &hl7.Context{Delimiters: v.delims, Nesting: nesting, TimezoneLoc: v.loc}
+	if err := p.Unmarshal(value, c); err != nil {
+		v.add(Error, RuleFormat, n, location, "invalid %s (%s)", description, t.Name())
+	}
+}
+
+// checkStructure checks the order of the segments against the message structure in MSH-9.
+func (v *validation) checkStructure(msh segment, segments []segment) {
+	name, ok := v.messageStructure(msh)
+	if !ok {
+		v.add(Warning, RuleStructure, msh.n, "MSH-9", "unknown message structure in HL7 version %s, the order of the segments is not checked", v.report.Version)
+		return
+	}
+	v.report.MessageStructure = name
+	m := &matcher{segments: segments}
+	v.report.Issues = append(v.report.Issues, m.group(v.types[name], "", true)...)
+}
+
+// groupField is a field of a message structure or of a group of segments, which contains either
+// segments or groups of segments.
+type groupField struct {
+	// name is the name of the segment, or of the group.
+	name     string
+	required bool
+	repeated bool
+	// group is the type of the group, or nil if the field contains segments.
+	group reflect.Type
+}
+
+func groupFields(t reflect.Type) []groupField {
+	var fields []groupField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Name == "Other" {
+			continue
+		}
+		name, required := parseTag(f)
+		gf := groupField{name: name, required: required, repeated: f.Type.Kind() == reflect.Slice}
+		e := elem(f.Type)
+		if reflect.PtrTo(e).Implements(segmentInterface) {
+			gf.name = reflect.New(e).Interface().(hl7.Segment).SegmentName()
+		} else {
+			gf.group = e
+		}
+		fields = append(fields, gf)
+	}
+	return fields
+}
+
+// contains returns whether any of the fields can contain a segment with the given name.
+func contains(fields []groupField, name string) bool {
+	for _, f := range fields {
+		if f.group == nil && f.name == name || f.group != nil && contains(groupFields(f.group), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matcher matches segments against a message structure.
+type matcher struct {
+	segments []segment
+	// pos is the index of the next segment to match.
+	pos int
+}
+
+// position returns the position in the message of the next segment to match, or 0 if all the
+// segments have been matched.
+func (m *matcher) position() int {
+	if m.pos < len(m.segments) {
+		return m.segments[m.pos].n
+	}
+	return 0
+}
+
+// group matches as many segments as possible against the fields of the group of type t, in order,
+// and returns the issues found. The segments that don't fit in the structure are reported and
+// skipped only at the root of the structure, as they might fit in the enclosing groups otherwise.
+func (m *matcher) group(t reflect.Type, path string, root bool) []Issue {
+	var issues []Issue
+	fields := groupFields(t)
+	for i, f := range fields {
+		location := f.name
+		if path != "" {
+			location = path + "/" + f.name
+		}
+		count := 0
+		for m.pos < len(m.segments) && (count == 0 || f.repeated) {
+			if f.group == nil {
+				if m.segments[m.pos].name != f.name {
+					break
+				}
+				m.pos++
+			} else {
+				start := m.pos
+				gIssues := m.group(f.group, location, false)
+				if m.pos == start {
+					break
+				}
+				issues = append(issues, gIssues...)
+			}
+			count++
+		}
+		if count == 0 && f.required {
+			kind := "segment"
+			if f.group != nil {
+				kind = "group"
+			}
+			issues = append(issues, Issue{
+				Severity: Error,
+				Rule:     RuleRequired,
+				Segment:  m.position(),
+				Location: location,
+				Message:  fmt.Sprintf("required %s %s is missing", kind, f.name),
+			})
+		}
+		if !root {
+			continue
+		}
+		for m.pos < len(m.segments) && !contains(fields[i+1:], m.segments[m.pos].name) {
+			s := m.segments[m.pos]
+			rule, message := RuleStructure, fmt.Sprintf("segment %s is not expected here", s.name)
+			if m.pos > 0 && m.segments[m.pos-1].name == s.name && f.group == nil && f.name == s.name {
+				rule, message = RuleCardinality, fmt.Sprintf("segment %s cannot repeat", s.name)
+			}
+			issues = append(issues, Issue{
+				Severity: Error,
+				Rule:     rule,
+				Segment:  s.n,
+				Location: s.name,
+				Message:  message,
+			})
+			m.pos++
+		}
+	}
+	return issues
+}
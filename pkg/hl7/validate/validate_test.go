@@ -0,0 +1,308 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/schemas"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestMain(m *testing.M) {
+	hl7.TimezoneAndLocation("Europe/London")
+	schemas.Register()
+	retCode := m.Run()
+	os.Exit(retCode)
+}
+
+const (
+	msh = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A01|1|T|2.5.1"
+	evn = "EVN|A01|20200101120000"
+	pid = "PID|1||1234^^^SIMULATOR MRN^MRN||Doe^John||19800101|M"
+	pv1 = "PV1|1|I"
+)
+
+func message(segments ...string) []byte {
+	return []byte(strings.Join(segments, hl7.SegmentTerminatorStr))
+}
+
+func validate(t *testing.T, c *Config, input []byte) *Report {
+	t.Helper()
+	m, err := hl7.ParseMessage(input)
+	if err != nil {
+		t.Fatalf("hl7.ParseMessage() failed with %v", err)
+	}
+	v, err := NewValidator(c)
+	if err != nil {
+		t.Fatalf("NewValidator(%+v) failed with %v", c, err)
+	}
+	return v.Validate(m)
+}
+
+// ignoreMessage ignores the text of the issues, so that the tests don't depend on the wording.
+var ignoreMessage = cmpopts.IgnoreFields(Issue{}, "Message")
+
+func TestValidate_ValidMessage(t *testing.T) {
+	r := validate(t, nil, message(msh, evn, pid, "NK1|1|Doe^Jane", pv1, "ZCM|1|RADIOLOGY", "ZZZ|unknown"))
+	if len(r.Issues) > 0 {
+		t.Errorf("Validate() got issues %v, want none", r.Issues)
+	}
+	if !r.Valid() {
+		t.Error("Valid() got false, want true")
+	}
+	if got, want := r.MessageStructure, "ADT_A01"; got != want {
+		t.Errorf("MessageStructure got %q, want %q", got, want)
+	}
+	if got, want := r.Version, "2.5.1"; got != want {
+		t.Errorf("Version got %q, want %q", got, want)
+	}
+}
+
+func TestValidate_Structure(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  []Issue
+	}{{
+		name:  "missing required segment",
+		input: message(msh, pid, pv1),
+		want:  []Issue{{Severity: Error, Rule: RuleRequired, Segment: 2, Location: "EVN"}},
+	}, {
+		name:  "missing required segment at the end",
+		input: message(msh, evn, pid),
+		want:  []Issue{{Severity: Error, Rule: RuleRequired, Segment: 0, Location: "PV1"}},
+	}, {
+		name:  "segment out of order",
+		input: message(msh, evn, pid, pv1, "NK1|1|Doe^Jane"),
+		want:  []Issue{{Severity: Error, Rule: RuleStructure, Segment: 5, Location: "NK1"}},
+	}, {
+		name:  "segments swapped",
+		input: message(msh, pid, evn, pv1),
+		want: []Issue{
+			{Severity: Error, Rule: RuleRequired, Segment: 2, Location: "EVN"},
+			{Severity: Error, Rule: RuleStructure, Segment: 3, Location: "EVN"},
+		},
+	}, {
+		name:  "non repeatable segment repeated",
+		input: message(msh, evn, pid, pid, pv1),
+		want:  []Issue{{Severity: Error, Rule: RuleCardinality, Segment: 4, Location: "PID"}},
+	}, {
+		name:  "repeatable segment repeated",
+		input: message(msh, evn, pid, "NK1|1|Doe^Jane", "NK1|2|Doe^Jim", pv1),
+	}, {
+		name:  "unknown segment",
+		input: message(msh, evn, pid, "XYZ|1", pv1),
+		want:  []Issue{{Severity: Error, Rule: RuleStructure, Segment: 4, Location: "XYZ"}},
+	}, {
+		name:  "bad segment name",
+		input: message(msh, evn, pid, "P|1", pv1),
+		want:  []Issue{{Severity: Error, Rule: RuleStructure, Segment: 4, Location: ""}},
+	}, {
+		name: "groups",
+		input: message(
+			"MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ORU^R01|1|T|2.5.1",
+			pid,
+			"OBR|1|1||lpdc-3969^UREA AND ELECTROLYTES",
+			"OBX|1|NM|tt-3969^Creatinine||52||||||F",
+			"OBR|2|2||lpdc-2012^HAEMATOLOGY",
+			"OBX|1|NM|tt-2012^Haemoglobin||130||||||F",
+			"OBX|2|NM|tt-2013^White blood cells||7.5||||||F"),
+	}, {
+		name: "missing required segment in group",
+		input: message(
+			"MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ORU^R01|1|T|2.5.1",
+			pid,
+			"OBX|1|NM|tt-3969^Creatinine||52||||||F"),
+		want: []Issue{{Severity: Error, Rule: RuleRequired, Segment: 3, Location: "PATIENT_RESULT/ORDER_OBSERVATION/OBR"}},
+	}, {
+		name:  "message structure in MSH-9.3",
+		input: message(strings.Replace(msh, "ADT^A01", "ADT^A04^ADT_A01", 1), evn, pid, pv1),
+	}, {
+		name:  "unknown message structure",
+		input: message(strings.Replace(msh, "ADT^A01", "XYZ^X01", 1), evn, pid, pv1),
+		want:  []Issue{{Severity: Warning, Rule: RuleStructure, Segment: 1, Location: "MSH-9"}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := validate(t, nil, tc.input)
+			if diff := cmp.Diff(tc.want, r.Issues, ignoreMessage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Validate() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_Fields(t *testing.T) {
+	tests := []struct {
+		name string
+		pid  string
+		want []Issue
+	}{{
+		name: "missing required field",
+		pid:  "PID|1||||Doe^John",
+		want: []Issue{{Severity: Error, Rule: RuleRequired, Segment: 3, Location: "PID-3"}},
+	}, {
+		name: "null required field",
+		pid:  `PID|1||""||Doe^John`,
+	}, {
+		name: "non repeatable field repeated",
+		pid:  "PID|1||1234||Doe^John||19800101~19810101",
+		want: []Issue{{Severity: Error, Rule: RuleCardinality, Segment: 3, Location: "PID-7"}},
+	}, {
+		name: "invalid date/time",
+		pid:  "PID|1||1234||Doe^John||1980-01-01",
+		want: []Issue{{Severity: Error, Rule: RuleFormat, Segment: 3, Location: "PID-7"}},
+	}, {
+		name: "invalid sequence ID",
+		pid:  "PID|A||1234||Doe^John",
+		want: []Issue{{Severity: Error, Rule: RuleFormat, Segment: 3, Location: "PID-1"}},
+	}, {
+		name: "invalid date/time in repeated component",
+		pid:  "PID|1||1234||Doe^John~Roe^John^^^^^^^^20130",
+		want: []Issue{{Severity: Error, Rule: RuleFormat, Segment: 3, Location: "PID-5[2].10.1"}},
+	}, {
+		name: "too many components",
+		pid:  "PID|1|1^2^3^4^5^6^7^8^9^10^11|1234||Doe^John",
+		want: []Issue{{Severity: Warning, Rule: RuleCardinality, Segment: 3, Location: "PID-2"}},
+	}, {
+		name: "too many fields",
+		pid:  "PID|1||1234||Doe^John" + strings.Repeat("|", 39) + "X",
+		want: []Issue{{Severity: Warning, Rule: RuleCardinality, Segment: 3, Location: "PID-40"}},
+	}, {
+		name: "invalid number",
+		pid:  "PID|1||1234||Doe^John" + strings.Repeat("|", 20) + "A",
+		want: []Issue{{Severity: Error, Rule: RuleFormat, Segment: 3, Location: "PID-25"}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := validate(t, nil, message(msh, evn, tc.pid, pv1))
+			if diff := cmp.Diff(tc.want, r.Issues, ignoreMessage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Validate() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_Config(t *testing.T) {
+	c := &Config{
+		Fields: map[string]FieldRule{
+			"PID-3.5": {Table: "identifier_type"},
+			"PID-5.1": {MaxLength: 5},
+			"PID-8":   {MaxLength: 1, Table: "sex"},
+		},
+		Tables: map[string][]string{
+			"identifier_type": {"MRN", "NHSNMBR"},
+			"sex":             {"F", "M", "U"},
+		},
+	}
+	tests := []struct {
+		name string
+		pid  string
+		want []Issue
+	}{{
+		name: "valid",
+		pid:  "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHSNBR^NHSNMBR||Doe^John||19800101|M",
+	}, {
+		name: "value not in table",
+		pid:  "PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHSNBR^NHS||Doe^John||19800101|M",
+		want: []Issue{{Severity: Error, Rule: RuleTable, Segment: 3, Location: "PID-3[2].5"}},
+	}, {
+		name: "too long",
+		pid:  "PID|1||1234^^^SIMULATOR MRN^MRN||Doe^John~Smith^John||19800101|MALE",
+		want: []Issue{
+			{Severity: Error, Rule: RuleLength, Segment: 3, Location: "PID-8"},
+			{Severity: Error, Rule: RuleTable, Segment: 3, Location: "PID-8"},
+		},
+	}, {
+		name: "too long in repeated field",
+		pid:  "PID|1||1234^^^SIMULATOR MRN^MRN||Doe^John~Smithson^John||19800101|M",
+		want: []Issue{{Severity: Error, Rule: RuleLength, Segment: 3, Location: "PID-5[2].1"}},
+	}, {
+		name: "null values are not checked",
+		pid:  `PID|1||1234^^^SIMULATOR MRN^MRN||Doe^John||19800101|""`,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := validate(t, c, message(msh, evn, tc.pid, pv1))
+			if diff := cmp.Diff(tc.want, r.Issues, ignoreMessage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Validate() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_Version(t *testing.T) {
+	tests := []struct {
+		version     string
+		messageType string
+		pid         string
+		want        []Issue
+	}{{
+		// PID-5 is repeatable from HL7 2.4.
+		version:     "2.3",
+		messageType: "ADT^A01",
+		pid:         "PID|1||1234||Doe^John~Roe^John",
+		want:        []Issue{{Severity: Error, Rule: RuleCardinality, Segment: 3, Location: "PID-5"}},
+	}, {
+		version:     "2.4",
+		messageType: "ADT^A01",
+		pid:         "PID|1||1234||Doe^John~Roe^John",
+	}, {
+		// The ID number of CX and the message structure are required from HL7 2.6.
+		version:     "2.6",
+		messageType: "ADT^A01^ADT_A01",
+		pid:         "PID|1||1234^^^SIMULATOR MRN^MRN~^^^NHSNBR^NHSNMBR||Doe^John",
+		want:        []Issue{{Severity: Error, Rule: RuleRequired, Segment: 3, Location: "PID-3[2].1"}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			header := strings.NewReplacer("|2.5.1", "|"+tc.version, "ADT^A01", tc.messageType).Replace(msh)
+			r := validate(t, nil, message(header, evn, tc.pid, pv1))
+			if got := r.Version; got != tc.version {
+				t.Errorf("Version got %q, want %q", got, tc.version)
+			}
+			if diff := cmp.Diff(tc.want, r.Issues, ignoreMessage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Validate() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIssueString(t *testing.T) {
+	tests := []struct {
+		issue Issue
+		want  string
+	}{{
+		issue: Issue{Severity: Error, Rule: RuleRequired, Segment: 3, Location: "PID-3", Message: "required field Patient Identifier List is missing"},
+		want:  "ERROR segment 3, PID-3: required field Patient Identifier List is missing [required]",
+	}, {
+		issue: Issue{Severity: Error, Rule: RuleRequired, Location: "PV1", Message: "required segment PV1 is missing"},
+		want:  "ERROR PV1: required segment PV1 is missing [required]",
+	}}
+	for _, tc := range tests {
+		if got := tc.issue.String(); got != tc.want {
+			t.Errorf("%+v.String() got %q, want %q", tc.issue, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultVersion is the version of the HL7 specification of the types in this package.
+// Messages are parsed with these types if their version is missing or doesn't have a registered
+// schema.
+const DefaultVersion = "2.5.1"
+
+// Schema contains the types of the segments and messages of a version of the HL7 specification,
+// as generated by cmd/generator.
+type Schema struct {
+	// Types maps the name of an HL7 segment or message type to the type of the struct that
+	// represents that segment or message type.
+	Types map[string]reflect.Type
+	// FollowSets are the follow sets of the fields within the message types, used by
+	// ParseMessageV2.
+	FollowSets map[string]StringSet
+}
+
+var (
+	schemasMu sync.RWMutex
+	schemas   = map[string]*Schema{
+		DefaultVersion: {Types: Types, FollowSets: FollowSets},
+	}
+)
+
+// RegisterSchema makes the schema of the given version of the HL7 specification available to the
+// parser, so that messages with that version in MSH-12 are parsed with the types in the schema.
+// Messages with a version that has no registered schema are parsed with the types of
+// DefaultVersion. Only the schema of DefaultVersion is registered by default: the schemas
+// generated under pkg/hl7/schemas are registered by calling their Register functions, or
+// schemas.Register for all of them.
+// RegisterSchema panics if a schema for the version is already registered.
+func RegisterSchema(version string, s *Schema) {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	version = normalizeVersion(version)
+	if _, ok := schemas[version]; ok {
+		panic(fmt.Sprintf("hl7: RegisterSchema called twice for version %s", version))
+	}
+	schemas[version] = s
+}
+
+// Versions returns the versions of the HL7 specification with a registered schema, sorted.
+func Versions() []string {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+	versions := make([]string, 0, len(schemas))
+	for v := range schemas {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// schemaForVersion returns the schema registered for the given version, and its normalized
+// version. Returns false if there is no such schema.
+func schemaForVersion(version string) (*Schema, string, bool) {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+	version = normalizeVersion(version)
+	s, ok := schemas[version]
+	return s, version, ok
+}
+
+// normalizeVersion removes the whitespace and the trailing ".0" from the given version, so that
+// "2.3" and "2.3.0" refer to the same version.
+func normalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	for strings.Count(version, ".") > 1 && strings.HasSuffix(version, ".0") {
+		version = strings.TrimSuffix(version, ".0")
+	}
+	return version
+}
+
+// lookupType returns the type with the given name in types, or in Types if there is no such type.
+// This allows parsing segments that are not part of a version of the specification, eg custom
+// segments registered in Types, in messages of that version.
+func lookupType(types map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if t, ok := types[name]; ok {
+		return t, true
+	}
+	t, ok := Types[name]
+	return t, ok
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testVersion is the version of the schema registered by registerTestSchema.
+const testVersion = "9.9"
+
+// testPID is the PID segment in the schema of testVersion.
+type testPID struct {
+	SetIDPID  *SI `hl7:"false,Set ID - PID"`
+	PatientID *ST `hl7:"false,Patient ID"`
+}
+
+func (s *testPID) SegmentName() string {
+	return "PID"
+}
+
+var registerOnce sync.Once
+
+func registerTestSchema() {
+	registerOnce.Do(func() {
+		RegisterSchema(testVersion, &Schema{Types: map[string]reflect.Type{"PID": reflect.TypeOf(testPID{})}})
+	})
+}
+
+func messageWithVersion(version string) []byte {
+	header := "MSH|^~\\&|CERNER|RAL|CARELINK|AKI2|20141128001635||ADT^A01|2014112800163507740000|T|" + version
+	return []byte(strings.Join([]string{header, "PID|1|843124"}, SegmentTerminatorStr))
+}
+
+func TestParseMessageWithOptions_Version(t *testing.T) {
+	registerTestSchema()
+
+	tests := []struct {
+		name         string
+		version      string
+		options      func(*ParseMessageOptions)
+		wantVersion  string
+		wantTestType bool
+	}{{
+		name:         "registered version",
+		version:      testVersion,
+		wantVersion:  testVersion,
+		wantTestType: true,
+	}, {
+		name:         "registered version with trailing zero",
+		version:      testVersion + ".0",
+		wantVersion:  testVersion,
+		wantTestType: true,
+	}, {
+		name:        "default version",
+		version:     "2.5.1",
+		wantVersion: DefaultVersion,
+	}, {
+		name:        "unknown version",
+		version:     "2.3",
+		wantVersion: DefaultVersion,
+	}, {
+		name:        "missing version",
+		version:     "",
+		wantVersion: DefaultVersion,
+	}, {
+		name:         "unknown version with fallback",
+		version:      "2.3",
+		options:      func(o *ParseMessageOptions) { o.Version = testVersion },
+		wantVersion:  testVersion,
+		wantTestType: true,
+	}, {
+		name:         "forced version",
+		version:      "2.5.1",
+		options:      func(o *ParseMessageOptions) { o.Version, o.ForceVersion = testVersion, true },
+		wantVersion:  testVersion,
+		wantTestType: true,
+	}, {
+		name:        "forced default version",
+		version:     testVersion,
+		options:     func(o *ParseMessageOptions) { o.ForceVersion = true },
+		wantVersion: DefaultVersion,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			options := NewParseMessageOptions()
+			if tc.options != nil {
+				tc.options(options)
+			}
+			m, err := ParseMessageWithOptions(messageWithVersion(tc.version), options)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions() failed with %v", err)
+			}
+			if got := m.Version(); got != tc.wantVersion {
+				t.Errorf("m.Version() got %q, want %q", got, tc.wantVersion)
+			}
+
+			ps, err := m.Parse("PID")
+			if err != nil {
+				t.Fatalf("m.Parse(PID) failed with %v", err)
+			}
+			if tc.wantTestType {
+				want := &testPID{SetIDPID: NewSI(1), PatientID: NewST("843124")}
+				if diff := cmp.Diff(want, ps); diff != "" {
+					t.Errorf("m.Parse(PID) got diff (-want, +got):\n%s", diff)
+				}
+			} else if _, ok := ps.(*PID); !ok {
+				t.Errorf("m.Parse(PID) got %T, want *PID", ps)
+			}
+
+			// The typed accessors always parse the segments with the types in this package.
+			pid, err := m.PID()
+			if err != nil {
+				t.Fatalf("m.PID() failed with %v", err)
+			}
+			if pid == nil || pid.SetIDPID == nil || pid.SetIDPID.Value != 1 {
+				t.Errorf("m.PID() got %+v, want PID with Set ID 1", pid)
+			}
+		})
+	}
+}
+
+func TestParseMessageWithOptions_UnknownFallbackVersion(t *testing.T) {
+	options := NewParseMessageOptions()
+	options.Version = "1.0"
+	if _, err := ParseMessageWithOptions(messageWithVersion("2.3"), options); err == nil {
+		t.Error("ParseMessageWithOptions() with unknown options.Version got nil error, want non nil")
+	}
+}
+
+func TestParseMessageWithOptions_VersionWithSegmentsFromTypes(t *testing.T) {
+	registerTestSchema()
+
+	// The schema of testVersion doesn't define PV1, so it's parsed with the types in this package.
+	input := append(messageWithVersion(testVersion), []byte(SegmentTerminatorStr+"PV1|1|INPATIENT")...)
+	m, err := ParseMessage(input)
+	if err != nil {
+		t.Fatalf("ParseMessage() failed with %v", err)
+	}
+	all, err := m.All()
+	if err != nil {
+		t.Fatalf("m.All() failed with %v", err)
+	}
+	var got []string
+	for _, s := range all {
+		got = append(got, reflect.TypeOf(s).String())
+	}
+	want := []string{"*hl7.MSH", "*hl7.testPID", "*hl7.PV1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("m.All() got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestRegisterSchema_Twice(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("RegisterSchema(%q) got no panic, want panic", DefaultVersion)
+		}
+	}()
+	RegisterSchema(DefaultVersion, &Schema{})
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "2.3", want: "2.3"},
+		{version: "2.3.0", want: "2.3"},
+		{version: " 2.3.1 ", want: "2.3.1"},
+		{version: "2.0", want: "2.0"},
+		{version: "", want: ""},
+	}
+	for _, tc := range tests {
+		if got := normalizeVersion(tc.version); got != tc.want {
+			t.Errorf("normalizeVersion(%q) got %q, want %q", tc.version, got, tc.want)
+		}
+	}
+}
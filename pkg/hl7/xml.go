@@ -0,0 +1,623 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// This file contains the conversion between the ER7 encoding of HL7 messages, ie, the encoding
+// with delimiters, and the XML encoding (v2.xml).
+//
+// The elements are named as in the HL7 XML schemas that cmd/generator consumes:
+//   - The root element is the message structure, eg ORU_R01.
+//   - Groups are named after the message structure and the group, eg ORU_R01.PATIENT_RESULT.
+//   - Segments are named after the segment, eg PID.
+//   - Fields are named after the segment and their position, eg PID.5. Each repetition of a field
+//     is a separate element.
+//   - Components and subcomponents are named after the data type of the value they are part of,
+//     and their position, eg XPN.1 and FN.1.
+//
+// The values are unescaped, except for the escape sequences that are not delimiters, eg \.br\,
+// which are represented with escape elements, eg <escape V=".br"/>.
+//
+// The segments are matched to the structure of the message type as in ParseMessageType. The
+// segments that are not part of the structure, eg Z segments, stay in the group of the segment
+// before them. The components of the values whose data type is not known, eg the fields of
+// Z segments, are named after the "varies" data type, except for OBX-5, whose data type is in
+// OBX-2. Empty fields, components and subcomponents are omitted, so trailing empty values are
+// not kept in a round trip.
+
+// XMLNamespace is the namespace of the elements in the XML encoding of HL7 messages.
+const XMLNamespace = "urn:hl7-org:v2xml"
+
+const (
+	// variesType is the name of the data type of the values whose data type is not known.
+	variesType = "varies"
+	// escapeElement is the name of the element that represents an escape sequence.
+	escapeElement = "escape"
+)
+
+var (
+	xmlSegmentName = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}$`)
+	xmlTypeName    = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+)
+
+// MarshalMessageXML returns the XML encoding of the message.
+// The message type is the structure in MSH-9.3 or, if it's empty, the message code and trigger
+// event in MSH-9, looked up in the schema of the version of the message and then in the schema of
+// DefaultVersion. If there is no such message type, the segments are not grouped, and the root
+// element is named after the message code and trigger event.
+func MarshalMessageXML(m *Message) ([]byte, error) {
+	root, t, types, err := m.xmlStructure()
+	if err != nil {
+		return nil, err
+	}
+	var paths map[int][]pathElement
+	if t != nil {
+		paths = m.structurePaths(types, t)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	e := xml.NewEncoder(&b)
+	start := xml.StartElement{Name: xml.Name{Local: root}, Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: XMLNamespace}}}
+	if err := e.EncodeToken(start); err != nil {
+		return nil, err
+	}
+	var groups []pathElement
+	for i, s := range m.Segments {
+		if len(s.Value) == 0 {
+			continue
+		}
+		if path, ok := paths[i]; ok {
+			// Close the groups that the segment is not in, and open the ones it is in.
+			want := path[:len(path)-1]
+			n := 0
+			for n < len(groups) && n < len(want) && groups[n] == want[n] {
+				n++
+			}
+			for j := len(groups) - 1; j >= n; j-- {
+				if err := e.EncodeToken(groupElement(root, groups[j]).End()); err != nil {
+					return nil, err
+				}
+			}
+			for _, g := range want[n:] {
+				if err := e.EncodeToken(groupElement(root, g)); err != nil {
+					return nil, err
+				}
+			}
+			groups = want
+		}
+		if err := m.encodeXMLSegment(e, types, s.Value); err != nil {
+			return nil, errors.Wrapf(err, "segment %d", i+1)
+		}
+	}
+	for j := len(groups) - 1; j >= 0; j-- {
+		if err := e.EncodeToken(groupElement(root, groups[j]).End()); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.EncodeToken(start.End()); err != nil {
+		return nil, err
+	}
+	if err := e.Flush(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// xmlStructure returns the name of the message structure, the message type struct and the
+// segment types to match the segments of the message with. The message type struct is nil if
+// there is no message type struct for the message.
+func (m *Message) xmlStructure() (string, reflect.Type, map[string]reflect.Type, error) {
+	name, err := m.messageTypeName()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	names := []string{name}
+	if s := m.msh.MessageType.MessageStructure; s != nil && *s != "" {
+		names = []string{string(*s), name}
+	}
+	for _, types := range []map[string]reflect.Type{m.types(), Types} {
+		for _, n := range names {
+			if t, ok := types[n]; ok {
+				return t.Name(), t, types, nil
+			}
+		}
+	}
+	return name, nil, m.types(), nil
+}
+
+// groupElement returns the start element of the group g in the message structure root.
+func groupElement(root string, g pathElement) xml.StartElement {
+	return xml.StartElement{Name: xml.Name{Local: root + "." + strings.TrimPrefix(g.t.Name(), root+"_")}}
+}
+
+// encodeXMLSegment encodes the segment, with the types of its fields looked up in types.
+func (m *Message) encodeXMLSegment(e *xml.Encoder, types map[string]reflect.Type, segment []byte) error {
+	d := m.Delimiters
+	fields := bytes.Split(segment, []byte{d.Field})
+	name := string(fields[0])
+	if !xmlSegmentName.MatchString(name) {
+		return errors.Errorf("invalid segment name %q", name)
+	}
+	st, _ := lookupType(types, name)
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	first := 1
+	// The position of the field in the segment is the index in fields, except in the MSH, where
+	// the field delimiter itself is MSH-1.
+	position := func(i int) int { return i }
+	if name == "MSH" {
+		for i, v := range [][]byte{{d.Field}, fields[1]} {
+			// The delimiters are not escaped.
+			if err := encodeXMLText(e, fmt.Sprintf("MSH.%d", i+1), v); err != nil {
+				return err
+			}
+		}
+		first = 2
+		position = func(i int) int { return i + 1 }
+	}
+	for i := first; i < len(fields); i++ {
+		if len(fields[i]) == 0 {
+			continue
+		}
+		// The segment structs don't have a field for MSH-1, so the index of the field in the struct
+		// is always i-1.
+		var t reflect.Type
+		if st != nil && i-1 < st.NumField() {
+			t = dataType(st.Field(i - 1).Type)
+		}
+		typeName := dataTypeName(t)
+		if name == "OBX" && position(i) == 5 && len(fields) > 2 && xmlTypeName.Match(fields[2]) {
+			typeName = string(fields[2])
+		}
+		for _, r := range bytes.Split(fields[i], []byte{d.Repetition}) {
+			if err := m.encodeXMLValue(e, fmt.Sprintf("%s.%d", name, position(i)), r, t, typeName, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXMLValue encodes the value of type t in an element with the given name. The components of
+// the value are named after typeName. nesting is 0 for fields, 1 for components and 2 for
+// subcomponents.
+func (m *Message) encodeXMLValue(e *xml.Encoder, name string, value []byte, t reflect.Type, typeName string, nesting int) error {
+	d := m.Delimiters
+	var parts [][]byte
+	switch nesting {
+	case 0:
+		parts = bytes.Split(value, []byte{d.Component})
+	case 1:
+		parts = bytes.Split(value, []byte{d.Subcomponent})
+	}
+	composite := t != nil && t.Kind() == reflect.Struct && !isPrimitive(t)
+	if len(parts) < 2 && !composite {
+		return m.encodeXMLEscapedText(e, name, value)
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for k, p := range parts {
+		if len(p) == 0 {
+			continue
+		}
+		var ct reflect.Type
+		if composite && k < t.NumField() {
+			ct = dataType(t.Field(k).Type)
+		}
+		if err := m.encodeXMLValue(e, fmt.Sprintf("%s.%d", typeName, k+1), p, ct, dataTypeName(ct), nesting+1); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXMLEscapedText encodes the text in value in an element with the given name. The escape
+// sequences for the delimiters are unescaped, and the other escape sequences are encoded as escape
+// elements. Unterminated escape sequences are kept as they are.
+func (m *Message) encodeXMLEscapedText(e *xml.Encoder, name string, value []byte) error {
+	d := m.Delimiters
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	var text []byte
+	for len(value) > 0 {
+		i := bytes.IndexByte(value, d.Escape)
+		j := -1
+		if i >= 0 {
+			j = bytes.IndexByte(value[i+1:], d.Escape)
+		}
+		if j < 0 {
+			text = append(text, value...)
+			break
+		}
+		text = append(text, value[:i]...)
+		sequence := value[i+1 : i+1+j]
+		value = value[i+j+2:]
+		switch string(sequence) {
+		case "F":
+			text = append(text, d.Field)
+		case "S":
+			text = append(text, d.Component)
+		case "T":
+			text = append(text, d.Subcomponent)
+		case "R":
+			text = append(text, d.Repetition)
+		case "E":
+			text = append(text, d.Escape)
+		default:
+			if len(text) > 0 {
+				if err := e.EncodeToken(xml.CharData(text)); err != nil {
+					return err
+				}
+				text = nil
+			}
+			escape := xml.StartElement{Name: xml.Name{Local: escapeElement}, Attr: []xml.Attr{{Name: xml.Name{Local: "V"}, Value: string(sequence)}}}
+			if err := e.EncodeToken(escape); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(escape.End()); err != nil {
+				return err
+			}
+		}
+	}
+	if len(text) > 0 {
+		if err := e.EncodeToken(xml.CharData(text)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXMLText encodes value as it is in an element with the given name.
+func encodeXMLText(e *xml.Encoder, name string, value []byte) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.EncodeToken(xml.CharData(value)); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// isPrimitive returns whether t is a primitive data type, eg ST.
+func isPrimitive(t reflect.Type) bool {
+	return reflect.PtrTo(t).Implements(reflect.TypeOf((*Primitive)(nil)).Elem())
+}
+
+// dataType returns the data type of a field or component of type t, eg CX for []CX.
+func dataType(t reflect.Type) reflect.Type {
+	for !isPrimitive(t) && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	return t
+}
+
+// dataTypeName returns the name of the data type t in the XML encoding.
+func dataTypeName(t reflect.Type) string {
+	if t == nil || t == reflect.TypeOf(Any{}) {
+		return variesType
+	}
+	return t.Name()
+}
+
+// xmlNode is an element of an XML document, or a piece of text within an element.
+type xmlNode struct {
+	// name is the name of the element, and is empty for text.
+	name string
+	// text is the text, or the value of the V attribute of escape elements.
+	text     []byte
+	children []*xmlNode
+}
+
+// decodeXMLNode decodes the element that starts with start, up to its end element.
+func decodeXMLNode(d *xml.Decoder, start xml.StartElement) (*xmlNode, error) {
+	n := &xmlNode{name: start.Name.Local}
+	if n.name == escapeElement {
+		for _, a := range start.Attr {
+			if a.Name.Local == "V" {
+				n.text = []byte(a.Value)
+			}
+		}
+	}
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			c, err := decodeXMLNode(d, t)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, c)
+		case xml.CharData:
+			n.children = append(n.children, &xmlNode{text: append([]byte(nil), t...)})
+		case xml.EndElement:
+			return n, nil
+		}
+	}
+}
+
+// elements returns the child elements of n, and an error if n has text other than whitespace
+// outside of them.
+func (n *xmlNode) elements() ([]*xmlNode, error) {
+	var elements []*xmlNode
+	for _, c := range n.children {
+		if c.name != "" {
+			elements = append(elements, c)
+		} else if len(bytes.TrimSpace(c.text)) > 0 {
+			return nil, errors.Errorf("unexpected text %q in %s", c.text, n.name)
+		}
+	}
+	return elements, nil
+}
+
+// hasValueElements returns whether n has child elements other than escape elements, ie, whether
+// its value has components or subcomponents.
+func (n *xmlNode) hasValueElements() bool {
+	for _, c := range n.children {
+		if c.name != "" && c.name != escapeElement {
+			return true
+		}
+	}
+	return false
+}
+
+// position returns the position at the end of the name of the element, eg 5 for PID.5.
+func (n *xmlNode) position() (int, error) {
+	i := strings.LastIndexByte(n.name, '.')
+	p, err := strconv.Atoi(n.name[i+1:])
+	if i < 0 || err != nil || p < 1 {
+		return 0, errors.Errorf("invalid element %s: want a name that ends with a position, eg PID.5", n.name)
+	}
+	return p, nil
+}
+
+// segments returns the segment elements in n and its groups, in order.
+func (n *xmlNode) segments() ([]*xmlNode, error) {
+	elements, err := n.elements()
+	if err != nil {
+		return nil, err
+	}
+	var segments []*xmlNode
+	for _, e := range elements {
+		if xmlSegmentName.MatchString(e.name) {
+			segments = append(segments, e)
+			continue
+		}
+		group, err := e.segments()
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, group...)
+	}
+	return segments, nil
+}
+
+// XMLToER7 returns the ER7 encoding of the message in the XML encoding in input, with the
+// delimiters in MSH-1 and MSH-2. The names of the segments must be as in the XML encoding, but only
+// the positions at the end of the names of fields, components and subcomponents are used. Groups
+// can have any name.
+func XMLToER7(input []byte) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(input))
+	var root *xmlNode
+	for root == nil {
+		t, err := d.Token()
+		if err == io.EOF {
+			return nil, errors.New("no root element")
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot decode XML")
+		}
+		if start, ok := t.(xml.StartElement); ok {
+			if root, err = decodeXMLNode(d, start); err != nil {
+				return nil, errors.Wrap(err, "cannot decode XML")
+			}
+		}
+	}
+	segments, err := root.segments()
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 || segments[0].name != "MSH" {
+		return nil, errors.New("the first segment must be MSH")
+	}
+	delimiters, err := xmlDelimiters(segments[0])
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]byte, len(segments))
+	for i, s := range segments {
+		if result[i], err = er7Segment(s, delimiters); err != nil {
+			return nil, errors.Wrapf(err, "segment %d", i+1)
+		}
+	}
+	return bytes.Join(result, []byte(SegmentTerminatorStr)), nil
+}
+
+// ParseMessageXML returns an object representing the HL7 message in the XML encoding in input.
+// See XMLToER7 and ParseMessage.
+func ParseMessageXML(input []byte) (*Message, error) {
+	er7, err := XMLToER7(input)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMessage(er7)
+}
+
+// xmlDelimiters returns the delimiters in MSH-1 and MSH-2 of the msh segment element.
+func xmlDelimiters(msh *xmlNode) (*Delimiters, error) {
+	values := map[int][]byte{}
+	for _, c := range msh.children {
+		if c.name == "MSH.1" || c.name == "MSH.2" {
+			p, _ := c.position()
+			values[p] = c.rawText()
+		}
+	}
+	if len(values[1]) != 1 || len(values[2]) < 4 {
+		return nil, errors.Errorf("invalid delimiters in MSH-1 and MSH-2: %q and %q", values[1], values[2])
+	}
+	return &Delimiters{
+		Field:        values[1][0],
+		Component:    values[2][0],
+		Repetition:   values[2][1],
+		Escape:       values[2][2],
+		Subcomponent: values[2][3],
+	}, nil
+}
+
+// rawText returns the text in n, without escaping it.
+func (n *xmlNode) rawText() []byte {
+	var text []byte
+	for _, c := range n.children {
+		if c.name == "" {
+			text = append(text, c.text...)
+		}
+	}
+	return text
+}
+
+// er7Segment returns the ER7 encoding of the segment element s.
+func er7Segment(s *xmlNode, d *Delimiters) ([]byte, error) {
+	elements, err := s.elements()
+	if err != nil {
+		return nil, err
+	}
+	fields := [][]byte{[]byte(s.name)}
+	seen := map[int]bool{}
+	for _, e := range elements {
+		i, err := e.position()
+		if err != nil {
+			return nil, err
+		}
+		var value []byte
+		switch {
+		case s.name == "MSH" && i == 1:
+			// MSH-1 is the field delimiter itself.
+			continue
+		case s.name == "MSH" && i == 2:
+			// The delimiters are not escaped.
+			value = e.rawText()
+		default:
+			if value, err = er7Value(e, d, 0); err != nil {
+				return nil, err
+			}
+		}
+		if s.name == "MSH" {
+			i--
+		}
+		for len(fields) <= i {
+			fields = append(fields, nil)
+		}
+		if seen[i] {
+			fields[i] = append(append(fields[i], d.Repetition), value...)
+		} else {
+			fields[i] = value
+		}
+		seen[i] = true
+	}
+	return joinParts(fields, d.Field), nil
+}
+
+// escapeText escapes the delimiters and the new lines in text, as marshalText does, but with the
+// escape character in d.
+func escapeText(text []byte, d *Delimiters) []byte {
+	sequences := map[byte]string{
+		d.Field:        "F",
+		d.Component:    "S",
+		d.Subcomponent: "T",
+		d.Repetition:   "R",
+		d.Escape:       "E",
+		asciiNewLine:   ".br",
+	}
+	var escaped []byte
+	for _, b := range text {
+		if s, ok := sequences[b]; ok {
+			escaped = append(append(append(escaped, d.Escape), s...), d.Escape)
+		} else {
+			escaped = append(escaped, b)
+		}
+	}
+	return escaped
+}
+
+// er7Value returns the ER7 encoding of the value of the element n. nesting is 0 for fields, 1 for
+// components and 2 for subcomponents.
+func er7Value(n *xmlNode, d *Delimiters, nesting int) ([]byte, error) {
+	if !n.hasValueElements() {
+		var value []byte
+		for _, c := range n.children {
+			if c.name == escapeElement {
+				value = append(append(append(value, d.Escape), c.text...), d.Escape)
+			} else if c.name == "" {
+				value = append(value, escapeText(c.text, d)...)
+			}
+		}
+		return value, nil
+	}
+	if nesting >= 2 {
+		return nil, errors.Errorf("%s cannot have components", n.name)
+	}
+	elements, err := n.elements()
+	if err != nil {
+		return nil, err
+	}
+	var parts [][]byte
+	seen := map[int]bool{}
+	for _, e := range elements {
+		if e.name == escapeElement {
+			return nil, errors.Errorf("unexpected escape sequence in %s, which has components", n.name)
+		}
+		k, err := e.position()
+		if err != nil {
+			return nil, err
+		}
+		for len(parts) < k {
+			parts = append(parts, nil)
+		}
+		if seen[k] {
+			return nil, errors.Errorf("repeated element %s in %s", e.name, n.name)
+		}
+		seen[k] = true
+		if parts[k-1], err = er7Value(e, d, nesting+1); err != nil {
+			return nil, err
+		}
+	}
+	delimiter := d.Component
+	if nesting == 1 {
+		delimiter = d.Subcomponent
+	}
+	return joinParts(parts, delimiter), nil
+}
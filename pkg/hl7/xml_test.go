@@ -0,0 +1,322 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hl7
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	xmlORUR01 = []string{
+		`MSH|^~\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ORU^R01|1|T|2.5.1`,
+		`PID|1||1234^^^SIMULATOR MRN^MRN~5678^^^NHS&2.16.840&ISO^NHSNMBR||Doe^John`,
+		`PV1|1|I`,
+		`OBR|1|1111||us-0003^UREA AND ELECTROLYTES`,
+		`OBX|1|NM|tt-0003-01^Creatinine||56|UMOLL|49 - 92`,
+		`OBX|2|CE|tt-0003-02^Status||A^Abnormal^HL70078`,
+		`NTE|1||Line 1\.br\Line 2 \T\ more\E\`,
+		`ZXY|1|custom^value`,
+		`OBR|2|2222||us-0005^FULL BLOOD COUNT`,
+		`OBX|1|TX|tt-0005-01^Comment||See\F\note`,
+	}
+
+	// Whitespace between elements, which is removed with compact.
+	xmlWhitespace = regexp.MustCompile(`>\s+<`)
+)
+
+func er7(segments []string) []byte {
+	return []byte(strings.Join(segments, SegmentTerminatorStr))
+}
+
+func compact(x string) string {
+	return xml.Header + xmlWhitespace.ReplaceAllString(strings.TrimSpace(x), "><")
+}
+
+func TestMarshalMessageXML(t *testing.T) {
+	m, err := ParseMessage(er7(xmlORUR01))
+	if err != nil {
+		t.Fatalf("ParseMessage() failed with %v", err)
+	}
+	got, err := MarshalMessageXML(m)
+	if err != nil {
+		t.Fatalf("MarshalMessageXML() failed with %v", err)
+	}
+	want := compact(`
+<ORU_R01 xmlns="urn:hl7-org:v2xml">
+  <MSH>
+    <MSH.1>|</MSH.1>
+    <MSH.2>^~\&amp;</MSH.2>
+    <MSH.3><HD.1>SIMHOSP</HD.1></MSH.3>
+    <MSH.4><HD.1>SFAC</HD.1></MSH.4>
+    <MSH.5><HD.1>RAPP</HD.1></MSH.5>
+    <MSH.6><HD.1>RFAC</HD.1></MSH.6>
+    <MSH.7>20200101120000</MSH.7>
+    <MSH.9><MSG.1>ORU</MSG.1><MSG.2>R01</MSG.2></MSH.9>
+    <MSH.10>1</MSH.10>
+    <MSH.11><PT.1>T</PT.1></MSH.11>
+    <MSH.12><VID.1>2.5.1</VID.1></MSH.12>
+  </MSH>
+  <ORU_R01.PATIENT_RESULT>
+    <ORU_R01.PATIENT>
+      <PID>
+        <PID.1>1</PID.1>
+        <PID.3><CX.1>1234</CX.1><CX.4><HD.1>SIMULATOR MRN</HD.1></CX.4><CX.5>MRN</CX.5></PID.3>
+        <PID.3><CX.1>5678</CX.1><CX.4><HD.1>NHS</HD.1><HD.2>2.16.840</HD.2><HD.3>ISO</HD.3></CX.4><CX.5>NHSNMBR</CX.5></PID.3>
+        <PID.5><XPN.1><FN.1>Doe</FN.1></XPN.1><XPN.2>John</XPN.2></PID.5>
+      </PID>
+      <ORU_R01.VISIT>
+        <PV1><PV1.1>1</PV1.1><PV1.2>I</PV1.2></PV1>
+      </ORU_R01.VISIT>
+    </ORU_R01.PATIENT>
+    <ORU_R01.ORDER_OBSERVATION>
+      <OBR>
+        <OBR.1>1</OBR.1>
+        <OBR.2><EI.1>1111</EI.1></OBR.2>
+        <OBR.4><CWE.1>us-0003</CWE.1><CWE.2>UREA AND ELECTROLYTES</CWE.2></OBR.4>
+      </OBR>
+      <ORU_R01.OBSERVATION>
+        <OBX>
+          <OBX.1>1</OBX.1>
+          <OBX.2>NM</OBX.2>
+          <OBX.3><CWE.1>tt-0003-01</CWE.1><CWE.2>Creatinine</CWE.2></OBX.3>
+          <OBX.5>56</OBX.5>
+          <OBX.6><CE.1>UMOLL</CE.1></OBX.6>
+          <OBX.7>49 - 92</OBX.7>
+        </OBX>
+      </ORU_R01.OBSERVATION>
+      <ORU_R01.OBSERVATION>
+        <OBX>
+          <OBX.1>2</OBX.1>
+          <OBX.2>CE</OBX.2>
+          <OBX.3><CWE.1>tt-0003-02</CWE.1><CWE.2>Status</CWE.2></OBX.3>
+          <OBX.5><CE.1>A</CE.1><CE.2>Abnormal</CE.2><CE.3>HL70078</CE.3></OBX.5>
+        </OBX>
+        <NTE><NTE.1>1</NTE.1><NTE.3>Line 1<escape V=".br"></escape>Line 2 &amp; more\</NTE.3></NTE>
+        <ZXY><ZXY.1>1</ZXY.1><ZXY.2><varies.1>custom</varies.1><varies.2>value</varies.2></ZXY.2></ZXY>
+      </ORU_R01.OBSERVATION>
+    </ORU_R01.ORDER_OBSERVATION>
+    <ORU_R01.ORDER_OBSERVATION>
+      <OBR>
+        <OBR.1>2</OBR.1>
+        <OBR.2><EI.1>2222</EI.1></OBR.2>
+        <OBR.4><CWE.1>us-0005</CWE.1><CWE.2>FULL BLOOD COUNT</CWE.2></OBR.4>
+      </OBR>
+      <ORU_R01.OBSERVATION>
+        <OBX>
+          <OBX.1>1</OBX.1>
+          <OBX.2>TX</OBX.2>
+          <OBX.3><CWE.1>tt-0005-01</CWE.1><CWE.2>Comment</CWE.2></OBX.3>
+          <OBX.5>See|note</OBX.5>
+        </OBX>
+      </ORU_R01.OBSERVATION>
+    </ORU_R01.ORDER_OBSERVATION>
+  </ORU_R01.PATIENT_RESULT>
+</ORU_R01>`)
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("MarshalMessageXML() got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestMarshalMessageXML_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []string
+		wantRoot string
+	}{{
+		name:     "groups, escape sequences and segments not in the structure",
+		segments: xmlORUR01,
+		wantRoot: "ORU_R01",
+	}, {
+		name: "other delimiters",
+		segments: []string{
+			`MSH#*@!%#SIMHOSP#SFAC#RAPP#RFAC#20200101120000##ADT*A01#1#T#2.5.1`,
+			`EVN#A01#20200101120000`,
+			`PID#1##1234***SIMULATOR MRN*MRN@5678***NHS%ISO*NHSNMBR##Doe*John!T!Jane`,
+			`PV1#1#I`,
+		},
+		wantRoot: "ADT_A01",
+	}, {
+		name: "empty repetitions and null values",
+		segments: []string{
+			`MSH|^~\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A01|1|T|2.5.1`,
+			`EVN|A01|20200101120000`,
+			`PID|1||~1234^^^SIMULATOR MRN^MRN||""`,
+			`PV1|1|I`,
+		},
+		wantRoot: "ADT_A01",
+	}, {
+		name: "message structure",
+		segments: []string{
+			`MSH|^~\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A04^ADT_A01|1|T|2.5.1`,
+			`EVN|A04|20200101120000`,
+			`PID|1||1234^^^SIMULATOR MRN^MRN`,
+			`PV1|1|O`,
+		},
+		wantRoot: "ADT_A01",
+	}, {
+		name: "unknown message type",
+		segments: []string{
+			`MSH|^~\&|SIMHOSP|SFAC|RAPP|RFAC|20200101120000||ADT^A47|1|T|2.5.1`,
+			`EVN|A47|20200101120000`,
+			`PID|1||1234^^^SIMULATOR MRN^MRN`,
+			`MRG|5678^^^SIMULATOR MRN^MRN`,
+		},
+		wantRoot: "ADT_A47",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := er7(tc.segments)
+			m, err := ParseMessage(input)
+			if err != nil {
+				t.Fatalf("ParseMessage() failed with %v", err)
+			}
+			x, err := MarshalMessageXML(m)
+			if err != nil {
+				t.Fatalf("MarshalMessageXML() failed with %v", err)
+			}
+			if want := "<" + tc.wantRoot + " "; !strings.Contains(string(x), want) {
+				t.Errorf("MarshalMessageXML() got %s, want root element %s", x, tc.wantRoot)
+			}
+			got, err := XMLToER7(x)
+			if err != nil {
+				t.Fatalf("XMLToER7(%s) failed with %v", x, err)
+			}
+			if diff := cmp.Diff(string(input), string(got)); diff != "" {
+				t.Errorf("XMLToER7(%s) got diff (-want, +got):\n%s", x, diff)
+			}
+		})
+	}
+}
+
+func TestXMLToER7(t *testing.T) {
+	// Indented, with other group names and the names of the data types of HL7 2.3.
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<ADT_A01 xmlns="urn:hl7-org:v2xml">
+  <MSH>
+    <MSH.1>|</MSH.1>
+    <MSH.2>^~\&amp;</MSH.2>
+    <MSH.3>
+      <HD.1>SIMHOSP</HD.1>
+    </MSH.3>
+    <MSH.9>
+      <CM_MSG.1>ADT</CM_MSG.1>
+      <CM_MSG.2>A01</CM_MSG.2>
+    </MSH.9>
+    <MSH.12>2.3</MSH.12>
+  </MSH>
+  <PID>
+    <PID.3>
+      <CX.1>1234</CX.1>
+      <CX.4>
+        <HD.1>NHS</HD.1>
+        <HD.3>ISO</HD.3>
+      </CX.4>
+    </PID.3>
+    <PID.3>
+      <CX.1>5678</CX.1>
+    </PID.3>
+    <PID.5>
+      <XPN.1>O^Brien &amp; Sons</XPN.1>
+      <XPN.2> John </XPN.2>
+    </PID.5>
+  </PID>
+  <ADT_A01.GROUP>
+    <NTE>
+      <NTE.3>Line 1<escape V=".br"/>Line 2</NTE.3>
+    </NTE>
+  </ADT_A01.GROUP>
+</ADT_A01>`
+	want := er7([]string{
+		`MSH|^~\&|SIMHOSP||||||ADT^A01|||2.3`,
+		`PID|||1234^^^NHS&&ISO~5678||O\S\Brien \T\ Sons^ John `,
+		`NTE|||Line 1\.br\Line 2`,
+	})
+	got, err := XMLToER7([]byte(input))
+	if err != nil {
+		t.Fatalf("XMLToER7() failed with %v", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("XMLToER7() got diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestXMLToER7_Invalid(t *testing.T) {
+	msh := `<MSH><MSH.1>|</MSH.1><MSH.2>^~\&amp;</MSH.2></MSH>`
+	tests := []struct {
+		name  string
+		input string
+	}{{
+		name:  "not XML",
+		input: `MSH|^~\&|SIMHOSP`,
+	}, {
+		name:  "malformed XML",
+		input: `<ADT_A01>` + msh,
+	}, {
+		name:  "no MSH",
+		input: `<ADT_A01><PID><PID.1>1</PID.1></PID></ADT_A01>`,
+	}, {
+		name:  "no delimiters",
+		input: `<ADT_A01><MSH><MSH.3><HD.1>SIMHOSP</HD.1></MSH.3></MSH></ADT_A01>`,
+	}, {
+		name:  "field without position",
+		input: `<ADT_A01>` + msh + `<PID><PID>1</PID></PID></ADT_A01>`,
+	}, {
+		name:  "text in segment",
+		input: `<ADT_A01>` + msh + `<PID>1</PID></ADT_A01>`,
+	}, {
+		name:  "repeated component",
+		input: `<ADT_A01>` + msh + `<PID><PID.5><XPN.1>A</XPN.1><XPN.1>B</XPN.1></PID.5></PID></ADT_A01>`,
+	}, {
+		name:  "too many levels",
+		input: `<ADT_A01>` + msh + `<PID><PID.3><CX.4><HD.1><X.1>A</X.1></HD.1></CX.4></PID.3></PID></ADT_A01>`,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, err := XMLToER7([]byte(tc.input)); err == nil {
+				t.Errorf("XMLToER7(%s) got %q, want error", tc.input, got)
+			}
+		})
+	}
+}
+
+func TestParseMessageXML(t *testing.T) {
+	m, err := ParseMessage(er7(xmlORUR01))
+	if err != nil {
+		t.Fatalf("ParseMessage() failed with %v", err)
+	}
+	x, err := MarshalMessageXML(m)
+	if err != nil {
+		t.Fatalf("MarshalMessageXML() failed with %v", err)
+	}
+	got, err := ParseMessageXML(x)
+	if err != nil {
+		t.Fatalf("ParseMessageXML(%s) failed with %v", x, err)
+	}
+	obx, err := got.AllOBX()
+	if err != nil {
+		t.Fatalf("AllOBX() failed with %v", err)
+	}
+	if len(obx) != 3 {
+		t.Fatalf("AllOBX() got %d segments, want 3", len(obx))
+	}
+	if got, want := string(obx[2].ObservationValue[0]), `See\F\note`; got != want {
+		t.Errorf("OBX-5 got %q, want %q", got, want)
+	}
+}
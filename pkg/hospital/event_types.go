@@ -101,9 +101,9 @@ func (h *Hospital) processOrder(e *state.Event, logLocal *logging.SimulatedHospi
 		o.OrderStatus = orderStatus
 	}
 
-	msg, err := message.BuildOrderORMO01(msgHeader, patientInfo, o, e.MessageTime)
+	msg, err := buildOrderMessage(msgHeader, patientInfo, o, e.MessageTime)
 	if err != nil {
-		return errors.Wrap(err, "cannot build ORM^O01 message")
+		return err
 	}
 	if err := h.queueMessage(logLocal, msg, e); err != nil {
 		return err
@@ -115,20 +115,117 @@ func (h *Hospital) processOrder(e *state.Event, logLocal *logging.SimulatedHospi
 	o.OrderControl = h.messageConfig.OrderControl.OK
 	delay := h.orderAckDelay.Random()
 	orderAckMessageTime := e.MessageTime.Add(delay)
-	msg, err = message.BuildPathologyORRO02(msgHeader, patientInfo, o, orderAckMessageTime)
+	msg, err = buildOrderAckMessage(msgHeader, patientInfo, o, orderAckMessageTime)
 	if err != nil {
-		return errors.Wrap(err, "cannot build ORR^O02 message")
+		return err
 	}
 	e.MessageTime = orderAckMessageTime
 	return h.queueMessage(logLocal, msg, e)
 }
 
+// buildOrderMessage builds the message that places or updates the given order:
+// OML^O21 for orders with the OML message style, ORM^O01 otherwise.
+func buildOrderMessage(msgHeader *message.HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*message.HL7Message, error) {
+	if o.MessageStyle == constants.OMLOrderStyle {
+		msg, err := message.BuildLabOrderOMLO21(msgHeader, p, o, msgTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build OML^O21 message")
+		}
+		return msg, nil
+	}
+	msg, err := message.BuildOrderORMO01(msgHeader, p, o, msgTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORM^O01 message")
+	}
+	return msg, nil
+}
+
+// buildOrderAckMessage builds the message that acknowledges the given order:
+// ORL^O22 for orders with the OML message style, ORR^O02 otherwise.
+func buildOrderAckMessage(msgHeader *message.HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*message.HL7Message, error) {
+	if o.MessageStyle == constants.OMLOrderStyle {
+		msg, err := message.BuildLabOrderAckORLO22(msgHeader, p, o, msgTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build ORL^O22 message")
+		}
+		return msg, nil
+	}
+	msg, err := message.BuildPathologyORRO02(msgHeader, p, o, msgTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORR^O02 message")
+	}
+	return msg, nil
+}
+
+// processOrderStatusChange cancels, discontinues, puts on hold or releases an existing order, and
+// sends an ORM^O01 or OML^O21 message with the new order control and order status.
+func (h *Hospital) processOrderStatusChange(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	orderControl := h.messageConfig.OrderControl
+	orderStatus := h.messageConfig.OrderStatus
+
+	var orderID, control, status string
+	switch {
+	case e.Step.CancelOrder != nil:
+		orderID, control, status = e.Step.CancelOrder.OrderID, orderControl.Cancel, orderStatus.Cancelled
+	case e.Step.DiscontinueOrder != nil:
+		orderID, control, status = e.Step.DiscontinueOrder.OrderID, orderControl.Discontinue, orderStatus.Discontinued
+	case e.Step.HoldOrder != nil:
+		orderID, control, status = e.Step.HoldOrder.OrderID, orderControl.Hold, orderStatus.Held
+	case e.Step.ReleaseOrder != nil:
+		orderID, control, status = e.Step.ReleaseOrder.OrderID, orderControl.Release, orderStatus.InProcess
+	}
+	o, err := h.activeOrder(patient, orderID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case e.Step.CancelOrder != nil && len(o.Results) > 0:
+		return fmt.Errorf("order with ID %q cannot be cancelled because it has results", orderID)
+	case e.Step.HoldOrder != nil && o.OrderStatus == orderStatus.Held:
+		return fmt.Errorf("order with ID %q is already on hold", orderID)
+	case e.Step.ReleaseOrder != nil && o.OrderStatus != orderStatus.Held:
+		return fmt.Errorf("order with ID %q is not on hold", orderID)
+	}
+	o.OrderControl = control
+	o.OrderStatus = status
+	h.updateDeathInfo(logLocal, now, e.PathwayName, patient.PatientInfo, e.Step.Parameters)
+
+	msg, err := buildOrderMessage(msgHeader, patient.PatientInfo, o, e.MessageTime)
+	if err != nil {
+		return err
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// activeOrder returns the patient's order with the given pathway ID.
+// Returns an error if the order doesn't exist or has been cancelled, discontinued or completed.
+func (h *Hospital) activeOrder(patient *state.Patient, id string) (*ir.Order, error) {
+	o := patient.GetOrder(id)
+	if o == nil {
+		return nil, fmt.Errorf("order with ID %q does not exist", id)
+	}
+	switch o.OrderStatus {
+	case h.messageConfig.OrderStatus.Cancelled, h.messageConfig.OrderStatus.Discontinued, h.messageConfig.OrderStatus.Completed:
+		return nil, fmt.Errorf("order with ID %q is no longer active", id)
+	}
+	return o, nil
+}
+
 func (h *Hospital) processResults(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
 	msgHeader := h.generator.NewHeader(&e.Step)
 	patient := h.patients.Get(e.PatientMRN)
 	patientInfo := patient.PatientInfo
 
 	h.setAdmissionDetailsIfMissing(patientInfo, e.EventTime)
+	if o := patient.GetOrder(e.Step.Result.OrderID); o != nil && o.OrderStatus == h.messageConfig.OrderStatus.Cancelled {
+		return fmt.Errorf("order with ID %q has been cancelled", e.Step.Result.OrderID)
+	}
+	var numberOfPreviousResults int
+	if o := patient.GetOrder(e.Step.Result.OrderID); o != nil {
+		numberOfPreviousResults = o.NumberOfPreviousResults
+	}
 	o, err := h.generator.SetResults(patient.GetOrder(e.Step.Result.OrderID), e.Step.Result, e.EventTime)
 	if err != nil {
 		return errors.Wrap(err, "cannot set results in Results event")
@@ -153,6 +250,11 @@ func (h *Hospital) processResults(e *state.Event, logLocal *logging.SimulatedHos
 	if !e.Step.Result.ExpectCorrection {
 		o.NumberOfPreviousResults += len(o.Results)
 	}
+	// Revisions start with the SetIDs of the results they revise; the SetIDs of any later
+	// results are not reused.
+	if o.NumberOfPreviousResults < numberOfPreviousResults {
+		o.NumberOfPreviousResults = numberOfPreviousResults
+	}
 	return h.queueMessage(logLocal, msg, e)
 }
 
@@ -208,6 +310,244 @@ func (h *Hospital) processDocument(e *state.Event, logLocal *logging.SimulatedHo
 	return h.queueMessage(logLocal, msg, e)
 }
 
+func (h *Hospital) processAppointment(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	id := e.Step.Appointment.ID
+	if id != "" && patient.GetAppointment(id) != nil {
+		return fmt.Errorf("appointment with ID %q already exists", id)
+	}
+	a, err := h.generator.NewAppointment(e.EventTime, e.Step.Appointment)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate appointment")
+	}
+	patient.AddAppointment(id, a)
+
+	msg, err := message.BuildNewAppointmentSIUS12(msgHeader, patient.PatientInfo, a, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build SIU^S12 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processRescheduleAppointment(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	a, err := h.bookedAppointment(patient, e.Step.RescheduleAppointment.ID)
+	if err != nil {
+		return err
+	}
+	if err := h.generator.RescheduleAppointment(e.EventTime, a, e.Step.RescheduleAppointment); err != nil {
+		return errors.Wrap(err, "cannot reschedule appointment")
+	}
+
+	msg, err := message.BuildRescheduleAppointmentSIUS13(msgHeader, patient.PatientInfo, a, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build SIU^S13 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processCancelAppointment(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	a, err := h.bookedAppointment(patient, e.Step.CancelAppointment.ID)
+	if err != nil {
+		return err
+	}
+	a.FillerStatus = h.messageConfig.Appointment.FillerStatus.Cancelled
+	a.EventReason = e.Step.CancelAppointment.Reason
+
+	msg, err := message.BuildCancelAppointmentSIUS15(msgHeader, patient.PatientInfo, a, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build SIU^S15 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processNoShow(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	a, err := h.bookedAppointment(patient, e.Step.NoShow.ID)
+	if err != nil {
+		return err
+	}
+	a.FillerStatus = h.messageConfig.Appointment.FillerStatus.NoShow
+	a.EventReason = ""
+
+	msg, err := message.BuildNoShowSIUS26(msgHeader, patient.PatientInfo, a, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build SIU^S26 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// bookedAppointment returns the patient's appointment with the given pathway ID.
+// Returns an error if the appointment doesn't exist, or if it has been cancelled or the patient
+// didn't show up, as these appointments cannot be updated any more.
+func (h *Hospital) bookedAppointment(patient *state.Patient, id string) (*ir.Appointment, error) {
+	a := patient.GetAppointment(id)
+	if a == nil {
+		return nil, fmt.Errorf("appointment with ID %q does not exist", id)
+	}
+	fs := h.messageConfig.Appointment.FillerStatus
+	if a.FillerStatus == fs.Cancelled || a.FillerStatus == fs.NoShow {
+		return nil, fmt.Errorf("appointment with ID %q has status %q and cannot be updated", id, a.FillerStatus)
+	}
+	return a, nil
+}
+
+func (h *Hospital) processPrescribe(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	id := e.Step.Prescribe.ID
+	if id != "" && patient.GetMedicationOrder(id) != nil {
+		return fmt.Errorf("medication order with ID %q already exists", id)
+	}
+	mo, err := h.generator.NewMedicationOrder(e.EventTime, e.Step.Prescribe, patient.PatientInfo.AttendingDoctor)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate medication order")
+	}
+	patient.AddMedicationOrder(id, mo)
+
+	msg, err := message.BuildPharmacyOrderRDEO11(msgHeader, patient.PatientInfo, mo, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build RDE^O11 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processVaccination(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	v, err := h.generator.NewVaccination(e.EventTime, e.Step.Vaccination)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate vaccination")
+	}
+	patient.PatientInfo.Vaccinations = append(patient.PatientInfo.Vaccinations, v)
+
+	msg, err := message.BuildVaccinationVXUV04(msgHeader, patient.PatientInfo, v, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build VXU^V04 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processCharge(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	account := h.account(patientInfo, e.EventTime)
+	charges, err := h.generator.NewCharges(e.EventTime, patientInfo, account, e.Step.Charge)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate charges")
+	}
+	account.Charges = append(account.Charges, charges...)
+
+	msg, err := message.BuildChargeDFTP03(msgHeader, patientInfo, charges, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build DFT^P03 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processAccountCreate(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	patientInfo.Account = nil
+	h.account(patientInfo, e.EventTime)
+
+	msg, err := message.BuildAddAccountBARP01(msgHeader, patientInfo, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build BAR^P01 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processAccountUpdate(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	h.account(patientInfo, e.EventTime)
+
+	msg, err := message.BuildUpdateAccountBARP05(msgHeader, patientInfo, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build BAR^P05 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// account returns the account of the patient, opening a new one if the patient doesn't have one.
+// Patients without a guarantor become their own guarantor.
+func (h *Hospital) account(patientInfo *ir.PatientInfo, eventTime time.Time) *ir.Account {
+	if patientInfo.Account == nil {
+		patientInfo.Account = h.generator.NewAccount(eventTime)
+	}
+	if patientInfo.Guarantor == nil {
+		patientInfo.Guarantor = h.generator.NewSelfGuarantor(patientInfo.Person)
+	}
+	return patientInfo.Account
+}
+
+func (h *Hospital) processDispense(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	mo, err := activeMedicationOrder(patient, e.Step.Dispense.ID)
+	if err != nil {
+		return err
+	}
+	d := h.generator.DispenseMedication(e.EventTime, mo, e.Step.Dispense)
+
+	msg, err := message.BuildPharmacyDispenseRDSO13(msgHeader, patient.PatientInfo, mo, d, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build RDS^O13 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processAdminister(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	mo, err := activeMedicationOrder(patient, e.Step.Administer.ID)
+	if err != nil {
+		return err
+	}
+	a := h.generator.AdministerMedication(e.EventTime, mo, e.Step.Administer)
+
+	msg, err := message.BuildPharmacyAdministrationRASO17(msgHeader, patient.PatientInfo, mo, a, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build RAS^O17 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) processDiscontinueMedication(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient := h.patients.Get(e.PatientMRN)
+	mo, err := activeMedicationOrder(patient, e.Step.DiscontinueMedication.ID)
+	if err != nil {
+		return err
+	}
+	h.generator.DiscontinueMedication(e.EventTime, mo, e.Step.DiscontinueMedication)
+
+	msg, err := message.BuildPharmacyOrderRDEO11(msgHeader, patient.PatientInfo, mo, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build RDE^O11 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// activeMedicationOrder returns the patient's medication order with the given pathway ID.
+// Returns an error if the medication order doesn't exist or has been discontinued.
+func activeMedicationOrder(patient *state.Patient, id string) (*ir.MedicationOrder, error) {
+	mo := patient.GetMedicationOrder(id)
+	if mo == nil {
+		return nil, fmt.Errorf("medication order with ID %q does not exist", id)
+	}
+	if mo.Discontinued() {
+		return nil, fmt.Errorf("medication order with ID %q has been discontinued", id)
+	}
+	return mo, nil
+}
+
 func (h *Hospital) processDischarge(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
 	msgHeader := h.generator.NewHeader(&e.Step)
 	mrn := e.PatientMRN
@@ -565,6 +905,90 @@ func (h *Hospital) bedSwap(e *state.Event, logLocal *logging.SimulatedHospitalLo
 	return h.queueMessage(logLocal, msg, e)
 }
 
+func (h *Hospital) linkPatients(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient, otherPatient, err := h.patientsToLink(e, logLocal, e.Step.LinkPatients.Patient1, e.Step.LinkPatients.Patient2)
+	if err != nil {
+		return err
+	}
+	if err := h.patients.Link(patient.PatientInfo.Person.MRN, otherPatient.PatientInfo.Person.MRN); err != nil {
+		return errors.Wrap(err, "cannot link patients")
+	}
+	h.updateDeathInfo(logLocal, now, e.PathwayName, patient.PatientInfo, e.Step.Parameters)
+
+	msg, err := message.BuildLinkPatientADTA24(msgHeader, patient.PatientInfo, e.EventTime, e.MessageTime, otherPatient.PatientInfo)
+	if err != nil {
+		return errors.Wrap(err, "cannot build ADT^A24 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) unlinkPatients(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patient, otherPatient, err := h.patientsToLink(e, logLocal, e.Step.UnlinkPatients.Patient1, e.Step.UnlinkPatients.Patient2)
+	if err != nil {
+		return err
+	}
+	if !patient.IsLinked(otherPatient.PatientInfo.Person.MRN) {
+		return errors.New("the patients are not linked")
+	}
+	if err := h.patients.Unlink(patient.PatientInfo.Person.MRN, otherPatient.PatientInfo.Person.MRN); err != nil {
+		return errors.Wrap(err, "cannot unlink patients")
+	}
+	h.updateDeathInfo(logLocal, now, e.PathwayName, patient.PatientInfo, e.Step.Parameters)
+
+	msg, err := message.BuildUnlinkPatientADTA37(msgHeader, patient.PatientInfo, e.EventTime, e.MessageTime, otherPatient.PatientInfo)
+	if err != nil {
+		return errors.Wrap(err, "cannot build ADT^A37 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// patientsToLink returns the patients of a step that links or unlinks patient1 and patient2.
+// patient1 must be the current patient.
+func (h *Hospital) patientsToLink(e *state.Event, logLocal *logging.SimulatedHospitalLogger, patient1 pathway.PatientID, patient2 pathway.PatientID) (*state.Patient, *state.Patient, error) {
+	patient := h.patients.Get(e.PatientMRN)
+	mainMRN := e.ResolveMRN(patient1)
+	if mainMRN != pathway.Current && h.patients.Get(mainMRN) != patient {
+		logLocal.Warningf("patient_1 %s is different from the current MRN %s; the data from the message might not be populated correctly", mainMRN, e.PatientMRN)
+		return nil, nil, errors.New("invalid link state")
+	}
+	otherMRN := e.ResolveMRN(patient2)
+	*logLocal = *logLocal.WithField("secondary_mrn", otherMRN)
+	otherPatient := h.patients.Get(otherMRN)
+	if otherPatient == nil {
+		return nil, nil, errors.New("unknown MRN in link")
+	}
+	if otherPatient == patient {
+		return nil, nil, errors.New("cannot link a patient to themselves")
+	}
+	return patient, otherPatient, nil
+}
+
+func (h *Hospital) changeIdentifier(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	priorMRN := patientInfo.Person.MRN
+	newMRN := e.Step.ChangeIdentifier.NewMRN
+	if newMRN == "" {
+		newMRN = h.generator.NewMRN()
+	}
+	if err := h.patients.ChangeID(priorMRN, newMRN); err != nil {
+		return errors.Wrap(err, "cannot change the identifier of the patient")
+	}
+	// The following steps in the pathway use the new MRN. The prior MRN can still be used to refer
+	// to the patient.
+	e.PatientMRN = newMRN
+	*logLocal = *logLocal.WithField("mrn", newMRN).WithField("previous_mrn", priorMRN)
+	h.updateDeathInfo(logLocal, now, e.PathwayName, patientInfo, e.Step.Parameters)
+
+	msg, err := message.BuildChangeIdentifierADTA47(msgHeader, patientInfo, e.EventTime, e.MessageTime, priorMRN)
+	if err != nil {
+		return errors.Wrap(err, "cannot build ADT^A47 message")
+	}
+	return h.queueMessage(logLocal, msg, e)
+}
+
 func (h *Hospital) addPerson(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
 	msgHeader := h.generator.NewHeader(&e.Step)
 	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
@@ -774,6 +1198,141 @@ func (h *Hospital) trackArrival(e *state.Event, logLocal *logging.SimulatedHospi
 	return h.queueMessage(logLocal, msg, e)
 }
 
+func (h *Hospital) leaveOfAbsence(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	pathwayName := e.PathwayName
+	step := e.Step.LeaveOfAbsence
+	eventTime := ir.NewValidTime(e.EventTime)
+
+	ec := patientInfo.LatestEncounter()
+	if ec == nil || ec.End.Valid {
+		ec = patientInfo.AddEncounter(eventTime, constants.EncounterStatusInProgress, patientInfo.Location)
+	}
+	ec.UpdateStatus(eventTime, constants.EncounterStatusOnLeave)
+
+	switch {
+	case step.ReleaseBed:
+		patientInfo.PriorLocation = h.freeLocation(logLocal, patientInfo, pathwayName)
+		patientInfo.Location = nil
+	case patientInfo.Location != nil && location.IsBed(patientInfo.Location):
+		// The bed stays occupied while the patient is on leave, so that it's not given to anybody else.
+		if err := h.locationManager.HoldBed(patientInfo.Location); err != nil {
+			return errors.Wrap(err, locationError)
+		}
+	}
+	patientInfo.ExpectedLOAReturnDateTime = ir.NewInvalidTime()
+	if step.ExpectedReturnTimeFromNow != nil {
+		patientInfo.ExpectedLOAReturnDateTime = ir.NewValidTime(e.EventTime.Add(*step.ExpectedReturnTimeFromNow))
+	}
+	h.updateDeathInfo(logLocal, now, pathwayName, patientInfo, e.Step.Parameters)
+
+	msg, err := message.BuildLeaveOfAbsenceADTA21(msgHeader, patientInfo, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build ADT^A21 message")
+	}
+	patientInfo.PriorLocation = nil
+	return h.queueMessage(logLocal, msg, e)
+}
+
+func (h *Hospital) returnFromLOA(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	pathwayName := e.PathwayName
+	step := e.Step.ReturnFromLOA
+	eventTime := ir.NewValidTime(e.EventTime)
+
+	ec := patientInfo.LatestEncounter()
+	if ec == nil || ec.Status != constants.EncounterStatusOnLeave {
+		return errors.New("the patient is not on leave of absence")
+	}
+	held := patientInfo.Location != nil && h.locationManager.IsHeld(patientInfo.Location)
+	if held {
+		if err := h.locationManager.ReleaseHold(patientInfo.Location); err != nil {
+			return errors.Wrap(err, locationError)
+		}
+	}
+	switch {
+	case step.Loc != "":
+		*logLocal = *logLocal.WithField(keyLocation, step.Loc)
+		// It's safe to call freeLocation even if the patient's bed was released when they left.
+		patientInfo.PriorLocation = h.freeLocation(logLocal, patientInfo, pathwayName)
+		loc, err := h.occupyBed(step.Loc, step.Bed)
+		if err != nil {
+			return errors.Wrap(err, locationError)
+		}
+		patientInfo.Location = loc
+	case !held:
+		return errors.New("the patient's bed was not held during the leave of absence, a location to return to is required")
+	}
+	ec.UpdateStatus(eventTime, constants.EncounterStatusInProgress)
+	ec.UpdateLocation(eventTime, patientInfo.Location)
+	patientInfo.ExpectedLOAReturnDateTime = ir.NewInvalidTime()
+	h.updateDeathInfo(logLocal, now, pathwayName, patientInfo, e.Step.Parameters)
+
+	msg, err := message.BuildReturnFromLeaveOfAbsenceADTA22(msgHeader, patientInfo, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrap(err, "cannot build ADT^A22 message")
+	}
+	patientInfo.PriorLocation = nil
+	return h.queueMessage(logLocal, msg, e)
+}
+
+// changePatientClass changes the class of the patient. Patients who become inpatients are sent in
+// an ADT^A06 message, and the rest in an ADT^A07 message.
+func (h *Hospital) changePatientClass(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
+	msgHeader := h.generator.NewHeader(&e.Step)
+	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
+	pathwayName := e.PathwayName
+	step := e.Step.ChangePatientClass
+	eventTime := ir.NewValidTime(e.EventTime)
+	inpatient := h.messageConfig.PatientClass.Inpatient
+
+	class := step.PatientClass
+	if class == "" {
+		class = inpatient
+		if patientInfo.Class == inpatient {
+			class = h.messageConfig.PatientClass.Outpatient
+		}
+	}
+	toInpatient := class == inpatient
+	if toInpatient {
+		h.setAdmissionDetailsIfMissing(patientInfo, e.EventTime)
+		if patientInfo.VisitID == 0 {
+			patientInfo.VisitID = h.generator.NewVisitID()
+		}
+		patientInfo.AccountStatus = h.messageConfig.PatientAccountStatus.Arrived
+	}
+	if step.Loc != "" {
+		*logLocal = *logLocal.WithField(keyLocation, step.Loc)
+		patientInfo.PriorLocation = h.freeLocation(logLocal, patientInfo, pathwayName)
+		loc, err := h.occupyBed(step.Loc, step.Bed)
+		if err != nil {
+			return errors.Wrap(err, locationError)
+		}
+		patientInfo.Location = loc
+	}
+	patientInfo.Class = class
+
+	if ec := patientInfo.LatestEncounter(); ec != nil && !ec.End.Valid {
+		ec.UpdateLocation(eventTime, patientInfo.Location)
+	} else {
+		patientInfo.AddEncounter(eventTime, constants.EncounterStatusInProgress, patientInfo.Location)
+	}
+	h.updateDeathInfo(logLocal, now, pathwayName, patientInfo, e.Step.Parameters)
+
+	build, msgType := message.BuildChangeInpatientToOutpatientADTA07, "ADT^A07"
+	if toInpatient {
+		build, msgType = message.BuildChangeOutpatientToInpatientADTA06, "ADT^A06"
+	}
+	msg, err := build(msgHeader, patientInfo, e.EventTime, e.MessageTime)
+	if err != nil {
+		return errors.Wrapf(err, "cannot build %s message", msgType)
+	}
+	patientInfo.PriorLocation = nil
+	return h.queueMessage(logLocal, msg, e)
+}
+
 func (h *Hospital) hardcodedMessage(e *state.Event, logLocal *logging.SimulatedHospitalLogger, now time.Time) error {
 	patientInfo := h.patients.Get(e.PatientMRN).PatientInfo
 	toIncludeRegex := e.Step.HardcodedMessage.Regex
@@ -814,10 +1373,42 @@ func (h *Hospital) processEventType(ctx context.Context, e *state.Event, logLoca
 		return h.processOrder(e, logLocal, now)
 	case pathway.StepResults:
 		return h.processResults(e, logLocal, now)
+	case pathway.StepCancelOrder, pathway.StepDiscontinueOrder, pathway.StepHoldOrder, pathway.StepReleaseOrder:
+		return h.processOrderStatusChange(e, logLocal, now)
 	case pathway.StepClinicalNote:
 		return h.processClinicalNote(ctx, e, logLocal, now)
 	case pathway.StepDocument:
 		return h.processDocument(e, logLocal, now)
+	case pathway.StepAppointment:
+		return h.processAppointment(e, logLocal, now)
+	case pathway.StepRescheduleAppointment:
+		return h.processRescheduleAppointment(e, logLocal, now)
+	case pathway.StepCancelAppointment:
+		return h.processCancelAppointment(e, logLocal, now)
+	case pathway.StepNoShow:
+		return h.processNoShow(e, logLocal, now)
+	case pathway.StepPrescribe:
+		return h.processPrescribe(e, logLocal, now)
+	case pathway.StepDispense:
+		return h.processDispense(e, logLocal, now)
+	case pathway.StepAdminister:
+		return h.processAdminister(e, logLocal, now)
+	case pathway.StepDiscontinueMedication:
+		return h.processDiscontinueMedication(e, logLocal, now)
+	case pathway.StepVaccination:
+		return h.processVaccination(e, logLocal, now)
+	case pathway.StepCharge:
+		return h.processCharge(e, logLocal, now)
+	case pathway.StepAccountCreate:
+		return h.processAccountCreate(e, logLocal, now)
+	case pathway.StepAccountUpdate:
+		return h.processAccountUpdate(e, logLocal, now)
+	case pathway.StepLeaveOfAbsence:
+		return h.leaveOfAbsence(e, logLocal, now)
+	case pathway.StepReturnFromLOA:
+		return h.returnFromLOA(e, logLocal, now)
+	case pathway.StepChangePatientClass:
+		return h.changePatientClass(e, logLocal, now)
 	case pathway.StepDischarge:
 		return h.processDischarge(e, logLocal, now)
 	case pathway.StepDischargeInError:
@@ -846,6 +1437,12 @@ func (h *Hospital) processEventType(ctx context.Context, e *state.Event, logLoca
 		return h.merge(e, logLocal, now)
 	case pathway.StepBedSwap:
 		return h.bedSwap(e, logLocal, now)
+	case pathway.StepLinkPatients:
+		return h.linkPatients(e, logLocal, now)
+	case pathway.StepUnlinkPatients:
+		return h.unlinkPatients(e, logLocal, now)
+	case pathway.StepChangeIdentifier:
+		return h.changeIdentifier(e, logLocal, now)
 	case pathway.StepAddPerson:
 		return h.addPerson(e, logLocal, now)
 	case pathway.StepUpdatePerson:
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bitcrshr/simhospital/pkg/hl7"
 	"github.com/bitcrshr/simhospital/pkg/logging"
 	"github.com/bitcrshr/simhospital/pkg/message"
 	"github.com/bitcrshr/simhospital/pkg/state"
@@ -98,7 +99,7 @@ func (h *Hospital) processMessage(m state.HL7Message) error {
 	if !processed {
 		logLocal.Info("Sending message")
 		logLocal.WithField(keyMessage, m).Debug("Sending message")
-		if err := h.sender.Send([]byte(m.Message.Message)); err != nil {
+		if err := h.send(m); err != nil {
 			counters.SimulatedHospital.ErrorsTotal.With(prometheus.Labels{
 				"pathway_name": m.PathwayName,
 				"reason":       "send_message",
@@ -123,6 +124,15 @@ func (h *Hospital) processMessage(m state.HL7Message) error {
 	return nil
 }
 
+// send sends the message using the configured sender.
+// If the sender can use metadata about the message, eg: to route it, the metadata is provided as well.
+func (h *Hospital) send(m state.HL7Message) error {
+	if ms, ok := h.sender.(hl7.MetadataSender); ok {
+		return ms.SendWithMetadata([]byte(m.Message.Message), hl7.Metadata{PathwayName: m.PathwayName})
+	}
+	return h.sender.Send([]byte(m.Message.Message))
+}
+
 func runMessageProcessors(logLocal *logging.SimulatedHospitalLogger, m *state.HL7Message, ps []MessageProcessor) (bool, error) {
 	processed := false
 	for _, p := range ps {
@@ -478,11 +478,11 @@ func TestEncounters(t *testing.T) {
 
 			opts := []cmp.Option{
 				cmpopts.EquateEmpty(),
-				// We ignore any fields that are randomly generated.
+				// We ignore any fields that are randomly generated or that come from the HL7 configuration.
 				cmpopts.IgnoreFields(ir.Result{}, "Status", "ObservationDateTime", "Notes", "ValueType"),
 				cmpopts.IgnoreFields(ir.Order{}, "NumberOfPreviousResults", "MessageControlIDOriginalOrder",
 					"OrderProfile", "Placer", "Filler", "OrderControl", "OrderStatus", "ResultsStatus",
-					"ReceivedInLabDateTime", "CollectedDateTime"),
+					"ReceivedInLabDateTime", "CollectedDateTime", "MessageStyle", "Priority", "Specimen"),
 			}
 
 			var got []*ir.Encounter
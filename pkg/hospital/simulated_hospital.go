@@ -17,8 +17,10 @@ package hospital
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
+	"github.com/bitcrshr/simhospital/pkg/chargemaster"
 	"github.com/bitcrshr/simhospital/pkg/clock"
 	"github.com/bitcrshr/simhospital/pkg/config"
 	"github.com/bitcrshr/simhospital/pkg/doctor"
@@ -32,16 +34,22 @@ import (
 	"github.com/bitcrshr/simhospital/pkg/generator/person"
 	"github.com/bitcrshr/simhospital/pkg/hardcoded"
 	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/hl7/conformance"
+	"github.com/bitcrshr/simhospital/pkg/insurance"
 	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/journal"
 	"github.com/bitcrshr/simhospital/pkg/location"
 	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/medication"
 	"github.com/bitcrshr/simhospital/pkg/message"
+	"github.com/bitcrshr/simhospital/pkg/microbiology"
 	"github.com/bitcrshr/simhospital/pkg/monitoring"
 	"github.com/bitcrshr/simhospital/pkg/orderprofile"
 	"github.com/bitcrshr/simhospital/pkg/pathway"
 	"github.com/bitcrshr/simhospital/pkg/processor"
 	"github.com/bitcrshr/simhospital/pkg/state"
 	"github.com/bitcrshr/simhospital/pkg/state/persist"
+	"github.com/bitcrshr/simhospital/pkg/vaccine"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/protobuf/encoding/prototext"
@@ -124,7 +132,7 @@ type Arguments struct {
 	HardcodedMessagesDir *string
 
 	// Hl7ConfigFile to create Config.HL7Config.
-	// Also required to create Config.OrderProfiles.
+	// Also required to create Config.OrderProfiles and Config.Medications.
 	Hl7ConfigFile *string
 
 	// HeaderConfigFile to create Config.Header.
@@ -138,6 +146,21 @@ type Arguments struct {
 	// Also required to create Config.PathwayParser and Config.PathwayManager.
 	OrderProfilesFile *string
 
+	// MedicationsFile to create Config.Medications.
+	MedicationsFile *string
+
+	// VaccinesFile to create Config.Vaccines.
+	VaccinesFile *string
+
+	// ChargemasterFile to create Config.Chargemaster.
+	ChargemasterFile *string
+
+	// InsuranceFile to create Config.Insurance.
+	InsuranceFile *string
+
+	// MicrobiologyFile to create Config.Microbiology.
+	MicrobiologyFile *string
+
 	// ResourceArguments to create ResourceWriter.
 	ResourceArguments *ResourceArguments
 
@@ -150,6 +173,15 @@ type Arguments struct {
 	// SenderArguments to create Config.Sender.
 	SenderArguments *SenderArguments
 
+	// JournalArguments to create Config.Journal and the ItemSyncers for the journal.
+	// If nil, messages are not journaled.
+	JournalArguments *JournalArguments
+
+	// ConformanceArguments to add a message processor that checks the messages against a
+	// conformance profile to Config.AdditionalConfig.Processors.MessagePre.
+	// If nil, messages are not checked.
+	ConformanceArguments *ConformanceArguments
+
 	// DataFiles to set as Config.DataFiles.
 	DataFiles *config.DataFiles
 
@@ -175,14 +207,60 @@ type PathwayArguments struct {
 	Type string
 }
 
+// JournalArguments contains arguments to journal the messages that are sent.
+type JournalArguments struct {
+	// Dir is the directory where the outbound journal and the dead-letter store are kept.
+	// If empty, they are kept in memory.
+	Dir string
+
+	// RetainDelivered is whether to keep the messages in the outbound journal after they are delivered.
+	RetainDelivered bool
+}
+
+// ConformanceArguments contains arguments to check the messages against a conformance profile
+// before they are sent.
+type ConformanceArguments struct {
+	// ProfileFile is the path to the YAML file with the conformance profile.
+	ProfileFile string
+
+	// Fail is whether the messages that don't conform to the profile are not sent.
+	// If false, the issues are only logged.
+	Fail bool
+}
+
 // SenderArguments contains arguments to create a Sender.
 type SenderArguments struct {
 	// Output specified where the generated HL7 messages will be sent.
 	Output string
 
+	// Format is the encoding of the messages that are sent: er7, ie, the encoding with delimiters,
+	// or xml. If empty, er7 is used. If Output=routing, it is the format of the destinations that
+	// don't specify one, as messages are routed before they are converted.
+	Format string
+
 	// OutputFile is a file path to write messages if Output=file.
 	OutputFile string
 
+	// BatchFilePattern is the pattern for the paths of the batch files if Output=batch.
+	// See hl7.BatchFileSenderOptions for the placeholders it supports.
+	BatchFilePattern string
+
+	// BatchMaxMessages is the maximum number of messages in a batch file.
+	// If nil, the default is used. Only relevant if Output=batch.
+	BatchMaxMessages *int
+
+	// BatchMaxBytes is the size of a batch file after which a new file is started.
+	// If nil or 0, there is no maximum. Only relevant if Output=batch.
+	BatchMaxBytes *int64
+
+	// BatchMaxDuration is how long messages are added to a batch file before a new file is started.
+	// If nil or 0, there is no maximum. Only relevant if Output=batch.
+	BatchMaxDuration *time.Duration
+
+	// RoutingConfigFile is the path to a YAML file with the destinations and routes used to
+	// send each message to a different destination if Output=routing.
+	RoutingConfigFile string
+
 	// MllpDestination is Host:Port to which MLLP messages will be sent if Output=mllp.
 	MllpDestination string
 
@@ -193,6 +271,58 @@ type SenderArguments struct {
 	// MllpKeepAliveInterval is an interval between keep-alive messages.
 	// Only relevant if Output=mllp and MllpKeepAlive=true.
 	MllpKeepAliveInterval *time.Duration
+
+	// MllpTLS contains the options to send MLLP messages over TLS. If nil, messages are
+	// sent over plain TCP.
+	// Only relevant if Output=mllp.
+	MllpTLS *hl7.TLSOptions
+
+	// MllpAckActions is a comma-separated list of CODE=action pairs, eg: "AE=retry,AR=dead_letter",
+	// that override the default action for each acknowledgment code.
+	// Only relevant if Output=mllp.
+	MllpAckActions string
+
+	// MllpMaxRetries is the maximum number of times a message is re-sent if the action for its
+	// acknowledgment code is "retry". If nil, the default is used.
+	// Only relevant if Output=mllp.
+	MllpMaxRetries *int
+
+	// MllpRetryBackoff is the time to wait before re-sending a message the first time. The time
+	// doubles with every retry. If nil, the default is used.
+	// Only relevant if Output=mllp.
+	MllpRetryBackoff *time.Duration
+
+	// MllpDeadLetterFile is a file path to write messages to if they are dead-lettered.
+	// Only relevant if Output=mllp.
+	MllpDeadLetterFile string
+
+	// HTTPURL is the URL to which messages are posted if Output=http.
+	HTTPURL string
+
+	// HTTPHeaders is a comma-separated list of Name=Value pairs with additional headers to send
+	// with every request. Only relevant if Output=http.
+	HTTPHeaders string
+
+	// HTTPBearerToken is the token to send in the Authorization header of every request.
+	// Only relevant if Output=http.
+	HTTPBearerToken string
+
+	// HTTPUsername and HTTPPassword are used for basic authentication if HTTPUsername is set and
+	// HTTPBearerToken is not. Only relevant if Output=http.
+	HTTPUsername string
+	HTTPPassword string
+
+	// HTTPTimeout is the maximum duration of each request. If nil, the default is used.
+	// Only relevant if Output=http.
+	HTTPTimeout *time.Duration
+
+	// HTTPMaxRetries is the maximum number of times a message is re-sent if a request fails with an
+	// error that might be temporary. If nil, the default is used. Only relevant if Output=http.
+	HTTPMaxRetries *int
+
+	// HTTPRetryBackoff is the time to wait before re-sending a message the first time. The time
+	// doubles with every retry. If nil, the default is used. Only relevant if Output=http.
+	HTTPRetryBackoff *time.Duration
 }
 
 // ResourceArguments contains arguments to create a ResourceWriter.
@@ -240,6 +370,26 @@ type Config struct {
 	// OrderProfiles are the order profiles to be used in pathways.
 	OrderProfiles *orderprofile.OrderProfiles
 
+	// Medications are the medications that can be prescribed in pathways.
+	// If nil, only medications that are not picked at random can be prescribed.
+	Medications *medication.Medications
+
+	// Vaccines are the vaccines that can be administered in pathways.
+	// If nil, only vaccines that are not picked at random can be administered.
+	Vaccines *vaccine.Vaccines
+
+	// Chargemaster contains the items that can be charged to patient accounts.
+	// If nil, no charges are captured automatically, and charging items explicitly fails.
+	Chargemaster *chargemaster.Chargemaster
+
+	// Insurance contains the insurance plans that cover patients and the guarantors of patients.
+	// If nil, patients are not given insurances nor guarantors when they are created.
+	Insurance *insurance.Catalogue
+
+	// Microbiology contains the organisms identified by cultures and the antibiotics that they are
+	// tested against. If nil, culture results cannot be generated.
+	Microbiology *microbiology.Catalogue
+
 	// PathwayParser is used to parse pathways.
 	PathwayParser *pathway.Parser
 
@@ -252,6 +402,13 @@ type Config struct {
 	// Sender contains the sender of HL7 messages.
 	Sender hl7.Sender
 
+	// Journal configures the journal of outbound messages. If set, every message is recorded in
+	// the journal before being sent with Sender, and the messages that cannot be sent are kept in a
+	// dead-letter store. The journal uses the "outbound_message" and "dead_letter" ItemSyncers in
+	// AdditionalConfig, or memory if they are not set.
+	// Optional.
+	Journal *JournalConfig
+
 	// Whether patients are deleted from the in-memory map after their pathways finish.
 	// Deleting patients saves memory, but patients cannot be reused for other pathways.
 	DeletePatientsFromMemory bool
@@ -264,6 +421,12 @@ type Config struct {
 	AdditionalConfig AdditionalConfig
 }
 
+// JournalConfig contains the configuration of the journal of outbound messages.
+type JournalConfig struct {
+	// RetainDelivered is whether to keep the messages in the outbound journal after they are delivered.
+	RetainDelivered bool
+}
+
 // AdditionalConfig contains optional configuration options for Simulated Hospital
 // used to extend the main functionality.
 // All fields are optional.
@@ -279,9 +442,11 @@ type AdditionalConfig struct {
 	//   - event
 	//   - message
 	//   - patient
+	//   - outbound_message: the journal of outbound messages, if Config.Journal is set
+	//   - dead_letter: the messages that could not be sent, if Config.Journal is set
 	ItemSyncers map[string]persist.ItemSyncer
 
-	// OrderAckDelay is the delay in sending Order Acknowledgement (ORR^O02) messages
+	// OrderAckDelay is the delay in sending Order Acknowledgement (ORR^O02 or ORL^O22) messages
 	// after the corresponding Order message.
 	OrderAckDelay *pathway.Delay
 
@@ -353,12 +518,70 @@ func DefaultConfig(ctx context.Context, arguments Arguments) (Config, error) {
 		}
 	}
 
+	if arguments.MedicationsFile != nil && c.HL7Config != nil {
+		if c.Medications, err = medication.Load(ctx, *arguments.MedicationsFile, c.HL7Config); err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the medications")
+		}
+	}
+
+	if arguments.VaccinesFile != nil {
+		if c.Vaccines, err = vaccine.Load(ctx, *arguments.VaccinesFile); err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the vaccines")
+		}
+	}
+
+	if arguments.ChargemasterFile != nil && c.HL7Config != nil {
+		if c.Chargemaster, err = chargemaster.Load(ctx, *arguments.ChargemasterFile, c.HL7Config); err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the chargemaster")
+		}
+	}
+
+	if arguments.InsuranceFile != nil && c.HL7Config != nil {
+		if c.Insurance, err = insurance.Load(ctx, *arguments.InsuranceFile, c.HL7Config); err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the insurance plans")
+		}
+	}
+
+	if arguments.MicrobiologyFile != nil && c.HL7Config != nil {
+		if c.Microbiology, err = microbiology.Load(ctx, *arguments.MicrobiologyFile, c.HL7Config); err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the microbiology catalogue")
+		}
+	}
+
 	if arguments.SenderArguments != nil {
-		if c.Sender, err = hl7Sender(*arguments.SenderArguments); err != nil {
+		if c.Sender, err = NewSender(ctx, *arguments.SenderArguments); err != nil {
 			return Config{}, errors.Wrap(err, "cannot create the sender")
 		}
 	}
 
+	if arguments.JournalArguments != nil {
+		c.Journal = &JournalConfig{RetainDelivered: arguments.JournalArguments.RetainDelivered}
+		if dir := arguments.JournalArguments.Dir; dir != "" {
+			syncers, err := journalItemSyncers(dir)
+			if err != nil {
+				return Config{}, errors.Wrap(err, "cannot create the journal storage")
+			}
+			if c.AdditionalConfig.ItemSyncers == nil {
+				c.AdditionalConfig.ItemSyncers = map[string]persist.ItemSyncer{}
+			}
+			for itemType, s := range syncers {
+				c.AdditionalConfig.ItemSyncers[itemType] = s
+			}
+		}
+	}
+
+	if arguments.ConformanceArguments != nil {
+		profile, err := conformance.LoadProfile(ctx, arguments.ConformanceArguments.ProfileFile)
+		if err != nil {
+			return Config{}, errors.Wrap(err, "cannot load the conformance profile")
+		}
+		p, err := conformance.NewProcessor(profile, arguments.ConformanceArguments.Fail)
+		if err != nil {
+			return Config{}, errors.Wrap(err, "cannot create the conformance processor")
+		}
+		c.AdditionalConfig.Processors.MessagePre = append(c.AdditionalConfig.Processors.MessagePre, p)
+	}
+
 	if arguments.ResourceArguments != nil && c.HL7Config != nil {
 		if c.ResourceWriter, err = resourceWriter(ctx, *arguments.ResourceArguments, c.HL7Config); err != nil {
 			return Config{}, errors.Wrap(err, "cannot create the resource writer")
@@ -432,19 +655,186 @@ func resourceMarshaller(arguments ResourceArguments) (fhir.Marshaller, error) {
 	}
 }
 
-func hl7Sender(arguments SenderArguments) (hl7.Sender, error) {
+// NewSender returns the sender of HL7 messages described by the arguments.
+// The xml format cannot be used with the batch output, as batch files contain ER7 messages.
+func NewSender(ctx context.Context, arguments SenderArguments) (hl7.Sender, error) {
+	switch arguments.Format {
+	case "", "er7":
+	case "xml":
+		if arguments.Output == "batch" {
+			return nil, errors.New("the xml format cannot be used with the batch output: batch files contain ER7 messages")
+		}
+	default:
+		return nil, errors.Errorf("unsupported output format %q", arguments.Format)
+	}
+	s, err := newSender(ctx, arguments)
+	if err != nil || arguments.Format != "xml" || arguments.Output == "routing" {
+		return s, err
+	}
+	return hl7.NewXMLSender(s), nil
+}
+
+// newSender returns the sender of HL7 messages to the output in arguments, without converting them
+// to the format in arguments.
+func newSender(ctx context.Context, arguments SenderArguments) (hl7.Sender, error) {
 	switch arguments.Output {
 	case "stdout":
 		return hl7.NewStdoutSender(), nil
 	case "mllp":
-		return hl7.NewMLLPSender(arguments.MllpDestination, arguments.MllpKeepAlive, *arguments.MllpKeepAliveInterval)
+		options, err := mllpSenderOptions(arguments)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid mllp sender options")
+		}
+		return hl7.NewMLLPSenderWithOptions(arguments.MllpDestination, options)
 	case "file":
 		return hl7.NewFileSender(arguments.OutputFile)
+	case "batch":
+		return hl7.NewBatchFileSender(batchFileSenderOptions(arguments))
+	case "http":
+		options, err := httpSenderOptions(arguments)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid http sender options")
+		}
+		return hl7.NewHTTPSender(arguments.HTTPURL, options)
+	case "routing":
+		return routingSender(ctx, arguments)
 	default:
 		return nil, errors.Errorf("unsupported output type %q", arguments.Output)
 	}
 }
 
+// routingSender creates a sender that sends each message to one of the destinations in the
+// routing configuration. MLLP and HTTP destinations use the MLLP and HTTP settings in arguments.
+func routingSender(ctx context.Context, arguments SenderArguments) (hl7.Sender, error) {
+	c, err := config.LoadRoutingConfig(ctx, arguments.RoutingConfigFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the routing configuration")
+	}
+	senders := map[string]hl7.Sender{}
+	closeAll := func() {
+		for _, s := range senders {
+			s.Close()
+		}
+	}
+	for name, d := range c.Destinations {
+		if d.Output == "routing" {
+			closeAll()
+			return nil, errors.Errorf("destination %q cannot be a routing sender", name)
+		}
+		destArgs := arguments
+		destArgs.Output = d.Output
+		destArgs.OutputFile = d.File
+		destArgs.MllpDestination = d.MllpDestination
+		destArgs.MllpDeadLetterFile = d.MllpDeadLetterFile
+		destArgs.BatchFilePattern = d.BatchFilePattern
+		destArgs.HTTPURL = d.HTTPURL
+		if d.Format != "" {
+			destArgs.Format = d.Format
+		}
+		s, err := NewSender(ctx, destArgs)
+		if err != nil {
+			closeAll()
+			return nil, errors.Wrapf(err, "cannot create the sender for destination %q", name)
+		}
+		senders[name] = s
+	}
+	s, err := hl7.NewRoutingSender(senders, c.Routes, c.Default)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	return s, nil
+}
+
+func batchFileSenderOptions(arguments SenderArguments) *hl7.BatchFileSenderOptions {
+	options := hl7.NewBatchFileSenderOptions()
+	if arguments.BatchFilePattern != "" {
+		options.FilenamePattern = arguments.BatchFilePattern
+	}
+	if arguments.BatchMaxMessages != nil {
+		options.MaxMessages = *arguments.BatchMaxMessages
+	}
+	if arguments.BatchMaxBytes != nil {
+		options.MaxBytes = *arguments.BatchMaxBytes
+	}
+	if arguments.BatchMaxDuration != nil {
+		options.MaxDuration = *arguments.BatchMaxDuration
+	}
+	return options
+}
+
+func httpSenderOptions(arguments SenderArguments) (*hl7.HTTPSenderOptions, error) {
+	options := hl7.NewHTTPSenderOptions()
+	headers, err := hl7.ParseHTTPHeaders(arguments.HTTPHeaders)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse headers")
+	}
+	options.Headers = headers
+	if arguments.Format == "xml" {
+		options.ContentType = hl7.XMLHTTPContentType
+	}
+	options.BearerToken = arguments.HTTPBearerToken
+	options.Username = arguments.HTTPUsername
+	options.Password = arguments.HTTPPassword
+	if arguments.HTTPTimeout != nil {
+		options.Timeout = *arguments.HTTPTimeout
+	}
+	if arguments.HTTPMaxRetries != nil {
+		options.MaxRetries = *arguments.HTTPMaxRetries
+	}
+	if arguments.HTTPRetryBackoff != nil {
+		options.RetryBackoff = *arguments.HTTPRetryBackoff
+	}
+	return options, nil
+}
+
+func mllpSenderOptions(arguments SenderArguments) (*hl7.MLLPSenderOptions, error) {
+	options := hl7.NewMLLPSenderOptions()
+	options.KeepAlive = arguments.MllpKeepAlive
+	if arguments.MllpKeepAliveInterval != nil {
+		options.KeepAlivePeriod = *arguments.MllpKeepAliveInterval
+	}
+	if arguments.MllpTLS != nil {
+		config, err := hl7.NewTLSConfig(arguments.MllpTLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create TLS config")
+		}
+		options.TLS = config
+	}
+	actions, err := hl7.ParseAckActions(arguments.MllpAckActions)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ack actions")
+	}
+	for code, action := range actions {
+		options.AckPolicy.Actions[code] = action
+	}
+	if arguments.MllpMaxRetries != nil {
+		options.AckPolicy.MaxRetries = *arguments.MllpMaxRetries
+	}
+	if arguments.MllpRetryBackoff != nil {
+		options.AckPolicy.RetryBackoff = *arguments.MllpRetryBackoff
+	}
+	if arguments.MllpDeadLetterFile != "" {
+		if options.AckPolicy.DeadLetter, err = hl7.NewFileSender(arguments.MllpDeadLetterFile); err != nil {
+			return nil, errors.Wrap(err, "cannot create dead-letter sink")
+		}
+	}
+	return options, nil
+}
+
+// journalItemSyncers returns the ItemSyncers for a journal kept in the given directory.
+func journalItemSyncers(dir string) (map[string]persist.ItemSyncer, error) {
+	syncers := map[string]persist.ItemSyncer{}
+	for _, itemType := range []string{state.OutboundMessageItemType, state.DeadLetterItemType} {
+		s, err := persist.NewDirSyncer(filepath.Join(dir, itemType), journal.EntryUnmarshaller{})
+		if err != nil {
+			return nil, err
+		}
+		syncers[itemType] = s
+	}
+	return syncers, nil
+}
+
 func pathwayManager(ctx context.Context, p *pathway.Parser, arguments PathwayArguments) (pathway.Manager, error) {
 	pathways, err := p.ParsePathways(ctx, arguments.Dir)
 	if err != nil {
@@ -503,6 +893,7 @@ type Hospital struct {
 	resourceWriter          ResourceWriter
 	messageConfig           *config.HL7Config
 	orderAckDelay           *pathway.Delay
+	journal                 *journal.Journal
 }
 
 func init() {
@@ -652,7 +1043,9 @@ func (h Hospital) newOrExistingPatient(person *pathway.Person, consultant *pathw
 func (h Hospital) newPatient(person *pathway.Person, consultant *pathway.Consultant) (*ir.Person, *state.Patient) {
 	newPerson := h.generator.NewPerson(person)
 	newConsultant := h.generator.NewDoctor(consultant)
-	return newPerson, h.generator.NewPatient(newPerson, newConsultant)
+	patient := h.generator.NewPatient(newPerson, newConsultant)
+	h.generator.SetCoverage(patient.PatientInfo, person)
+	return newPerson, patient
 }
 
 // calculateTimes calculates the time in which the event should take place, and the message should
@@ -719,12 +1112,33 @@ func NewHospital(ctx context.Context, c Config) (*Hospital, error) {
 		Doctors:          c.Doctors,
 		MsgCtrlGenerator: c.MessageControlGenerator,
 		OrderProfiles:    c.OrderProfiles,
+		Medications:      c.Medications,
+		Vaccines:         c.Vaccines,
+		Chargemaster:     c.Chargemaster,
+		Insurance:        c.Insurance,
+		Microbiology:     c.Microbiology,
+		LocationManager:  c.LocationManager,
 		AddressGenerator: ac.AddressGenerator,
 		MRNGenerator:     ac.MRNGenerator,
 		PlacerGenerator:  ac.PlacerGenerator,
 		FillerGenerator:  ac.FillerGenerator,
 	}
 
+	sender := c.Sender
+	var j *journal.Journal
+	if c.Journal != nil {
+		j, err = journal.New(c.Sender, journal.Options{
+			Outbound:        ac.ItemSyncers[state.OutboundMessageItemType],
+			DeadLetters:     ac.ItemSyncers[state.DeadLetterItemType],
+			RetainDelivered: c.Journal.RetainDelivered,
+			Clock:           c.Clock,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create the journal")
+		}
+		sender = j
+	}
+
 	messageQ := newMessageQueue(ac.ItemSyncers[state.MessageItemType])
 	eventQ := newEventQueue(ac.ItemSyncers[state.EventItemType])
 	patientsMap := state.NewPatientsMap(ac.ItemSyncers[state.PatientItemType], c.DeletePatientsFromMemory)
@@ -734,7 +1148,7 @@ func NewHospital(ctx context.Context, c Config) (*Hospital, error) {
 	}
 	return &Hospital{
 		clock:                   c.Clock,
-		sender:                  c.Sender,
+		sender:                  sender,
 		generator:               generator.NewGenerator(genConfig),
 		locationManager:         c.LocationManager,
 		messageQ:                messageQ,
@@ -746,9 +1160,15 @@ func NewHospital(ctx context.Context, c Config) (*Hospital, error) {
 		resourceWriter:          c.ResourceWriter,
 		messageConfig:           c.HL7Config,
 		orderAckDelay:           ac.OrderAckDelay,
+		journal:                 j,
 	}, nil
 }
 
+// Journal returns the journal of outbound messages, or nil if messages are not journaled.
+func (h *Hospital) Journal() *journal.Journal {
+	return h.journal
+}
+
 // Close closes resources held by the Hospital.
 // Should be called if the Hospital is no longer needed or at the program exit.
 func (h *Hospital) Close() error {
@@ -548,6 +548,51 @@ func TestRunPathwayWithMultipleHeaderFields(t *testing.T) {
 	}
 }
 
+func TestRunPathway_Journal(t *testing.T) {
+	ctx := context.Background()
+	pathways := map[string]pathway.Pathway{
+		testPathwayName: {Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{Result: &pathway.Results{}},
+		}},
+	}
+	cases := []struct {
+		name            string
+		sender          *testhl7.Sender
+		wantMessages    int
+		wantDeadLetters int
+	}{
+		{name: "delivered", sender: &testhl7.Sender{}, wantMessages: 2, wantDeadLetters: 0},
+		{name: "dead-lettered", sender: testhl7.SenderWithError(errors.New("cannot send")), wantMessages: 0, wantDeadLetters: 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			hospital := newHospital(ctx, t, Config{Sender: tc.sender, Journal: &JournalConfig{}}, pathways)
+			defer hospital.Close()
+			if hospital.Journal() == nil {
+				t.Fatal("Journal() got nil, want the journal")
+			}
+			startPathway(t, hospital, testPathwayName)
+			_, messages := hospital.ConsumeQueuesWithLimit(ctx, t, -1, false)
+			if got, want := len(messages), tc.wantMessages; got != want {
+				t.Errorf("len(messages) = %d, want %d", got, want)
+			}
+			deadLetters, err := hospital.Journal().DeadLetters()
+			if err != nil {
+				t.Fatalf("Journal().DeadLetters() failed with %v", err)
+			}
+			if got, want := len(deadLetters), tc.wantDeadLetters; got != want {
+				t.Fatalf("len(Journal().DeadLetters()) = %d, want %d", got, want)
+			}
+			for _, e := range deadLetters {
+				if got, want := e.PathwayName, testPathwayName; got != want {
+					t.Errorf("PathwayName = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestRunPathway_StepTypes(t *testing.T) {
 	ctx := context.Background()
 	type metric struct {
@@ -716,6 +761,408 @@ func TestRunPathway_StepTypes(t *testing.T) {
 				t.Errorf("Updated len(documentOBXs) got %v, want %v", got, want)
 			}
 		},
+	}, {
+		name: "Appointment rescheduled and cancelled",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Appointment: &pathway.Appointment{ID: "appt1", Loc: testLoc, TimeFromNow: &oneDay, Reason: "CHECKUP"}},
+			{RescheduleAppointment: &pathway.RescheduleAppointment{ID: "appt1", TimeFromNow: &twoHours, Reason: "Clinic closed"}},
+			{CancelAppointment: &pathway.CancelAppointment{ID: "appt1", Reason: "Patient request"}},
+		}},
+		wantMessageTypes: []string{"SIU^S12", "SIU^S13", "SIU^S15"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			bookedSCH := testhl7.SCH(t, messages[0])
+			cancelledSCH := testhl7.SCH(t, messages[2])
+			if got, want := cancelledSCH.FillerAppointmentID.EntityIdentifier.String(), bookedSCH.FillerAppointmentID.EntityIdentifier.String(); got != want {
+				t.Errorf("cancelledSCH.FillerAppointmentID.EntityIdentifier.String()=%v, want %v", got, want)
+			}
+			wantStatus := []string{
+				hospital.MessageConfig.Appointment.FillerStatus.Booked,
+				hospital.MessageConfig.Appointment.FillerStatus.Booked,
+				hospital.MessageConfig.Appointment.FillerStatus.Cancelled,
+			}
+			var gotStatus []string
+			for _, m := range messages {
+				gotStatus = append(gotStatus, testhl7.SCH(t, m).FillerStatusCode.Identifier.String())
+			}
+			if diff := cmp.Diff(wantStatus, gotStatus); diff != "" {
+				t.Errorf("StartPathway(%v) generated FillerStatusCode with diff (-want, +got):\n%s", testPathwayName, diff)
+			}
+			if got, want := cancelledSCH.EventReason.Text.String(), "Patient request"; got != want {
+				t.Errorf("cancelledSCH.EventReason.Text.String()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Appointment with no show",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Appointment: &pathway.Appointment{ID: "appt1"}},
+			{NoShow: &pathway.NoShow{ID: "appt1"}},
+		}},
+		wantMessageTypes: []string{"SIU^S12", "SIU^S26"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			sch := testhl7.SCH(t, messages[1])
+			if got, want := sch.FillerStatusCode.Identifier.String(), hospital.MessageConfig.Appointment.FillerStatus.NoShow; got != want {
+				t.Errorf("sch.FillerStatusCode.Identifier.String()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Cancelled appointment cannot be rescheduled",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Appointment: &pathway.Appointment{ID: "appt1"}},
+			{CancelAppointment: &pathway.CancelAppointment{ID: "appt1"}},
+			{RescheduleAppointment: &pathway.RescheduleAppointment{ID: "appt1"}},
+		}},
+		wantMessageTypes: []string{"SIU^S12", "SIU^S15"},
+	}, {
+		name: "Medication prescribed, dispensed, administered and discontinued",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Prescribe: &pathway.Prescribe{ID: "med1", Medication: "Paracetamol", Dose: "500"}},
+			{Dispense: &pathway.Dispense{ID: "med1"}},
+			{Administer: &pathway.Administer{ID: "med1"}},
+			{DiscontinueMedication: &pathway.DiscontinueMedication{ID: "med1", Reason: "Course completed"}},
+		}},
+		wantMessageTypes: []string{"RDE^O11", "RDS^O13", "RAS^O17", "RDE^O11"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			rxe := testhl7.RXE(t, messages[0])
+			if got, want := rxe.GiveCode.Text.String(), "Paracetamol"; got != want {
+				t.Errorf("rxe.GiveCode.Text.String()=%v, want %v", got, want)
+			}
+			rxa := testhl7.RXA(t, messages[2])
+			if got, want := rxa.AdministeredAmount.Value, 500.0; got != want {
+				t.Errorf("rxa.AdministeredAmount.Value=%v, want %v", got, want)
+			}
+			wantOrderControl := []string{
+				hospital.MessageConfig.OrderControl.New,
+				hospital.MessageConfig.OrderControl.WithObservations,
+				hospital.MessageConfig.OrderControl.WithObservations,
+				hospital.MessageConfig.OrderControl.Discontinue,
+			}
+			var gotOrderControl []string
+			for _, m := range messages {
+				gotOrderControl = append(gotOrderControl, testhl7.ORC(t, m).OrderControl.String())
+			}
+			if diff := cmp.Diff(wantOrderControl, gotOrderControl); diff != "" {
+				t.Errorf("StartPathway(%v) generated OrderControl with diff (-want, +got):\n%s", testPathwayName, diff)
+			}
+			if got, want := testhl7.ORC(t, messages[0]).FillerOrderNumber.EntityIdentifier.String(), testhl7.ORC(t, messages[3]).FillerOrderNumber.EntityIdentifier.String(); got != want {
+				t.Errorf("discontinued FillerOrderNumber=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Discontinued medication cannot be administered",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Prescribe: &pathway.Prescribe{ID: "med1"}},
+			{DiscontinueMedication: &pathway.DiscontinueMedication{ID: "med1"}},
+			{Administer: &pathway.Administer{ID: "med1"}},
+		}},
+		wantMessageTypes: []string{"RDE^O11", "RDE^O11"},
+	}, {
+		name: "Order held, released and discontinued",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", NoAcknowledgementMessage: true}},
+			{HoldOrder: &pathway.HoldOrder{OrderID: "order1"}},
+			{ReleaseOrder: &pathway.ReleaseOrder{OrderID: "order1"}},
+			{DiscontinueOrder: &pathway.DiscontinueOrder{OrderID: "order1"}},
+		}},
+		wantMessageTypes: []string{"ORM^O01", "ORM^O01", "ORM^O01", "ORM^O01"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			oc := hospital.MessageConfig.OrderControl
+			os := hospital.MessageConfig.OrderStatus
+			wantORC := [][]string{
+				{oc.New, os.InProcess},
+				{oc.Hold, os.Held},
+				{oc.Release, os.InProcess},
+				{oc.Discontinue, os.Discontinued},
+			}
+			var gotORC [][]string
+			for _, m := range messages {
+				orc := testhl7.ORC(t, m)
+				gotORC = append(gotORC, []string{orc.OrderControl.String(), orc.OrderStatus.String()})
+			}
+			if diff := cmp.Diff(wantORC, gotORC); diff != "" {
+				t.Errorf("StartPathway(%v) generated ORC-1 and ORC-5 with diff (-want, +got):\n%s", testPathwayName, diff)
+			}
+			if got, want := testhl7.ORC(t, messages[3]).PlacerOrderNumber.EntityIdentifier.String(), testhl7.ORC(t, messages[0]).PlacerOrderNumber.EntityIdentifier.String(); got != want {
+				t.Errorf("discontinued PlacerOrderNumber=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Order with the OML message style",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", MessageStyle: "oml"}},
+		}},
+		wantMessageTypes: []string{"OML^O21", "ORL^O22"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			if got, want := testhl7.MessageControlIDFromMSA(t, messages[1]), testhl7.MessageControlIDFromMSH(t, messages[0]); got != want {
+				t.Errorf("MessageControlIDFromMSA(%v)=%v, want %v", messages[1], got, want)
+			}
+			for _, m := range messages {
+				if got, want := testhl7.SPM(t, m).SpecimenType.Identifier.String(), hospital.MessageConfig.Order.SpecimenType; got != want {
+					t.Errorf("SPM.SpecimenType.Identifier.String()=%v, want %v", got, want)
+				}
+			}
+		},
+	}, {
+		name: "Order with the OML message style cancelled",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", MessageStyle: "OML", NoAcknowledgementMessage: true}},
+			{CancelOrder: &pathway.CancelOrder{OrderID: "order1"}},
+		}},
+		wantMessageTypes: []string{"OML^O21", "OML^O21"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			if got, want := testhl7.ORC(t, messages[1]).OrderControl.String(), hospital.MessageConfig.OrderControl.Cancel; got != want {
+				t.Errorf("orc.OrderControl.String()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Order cancelled",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", NoAcknowledgementMessage: true}},
+			{CancelOrder: &pathway.CancelOrder{OrderID: "order1"}},
+		}},
+		wantMessageTypes: []string{"ORM^O01", "ORM^O01"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			orc := testhl7.ORC(t, messages[1])
+			if got, want := orc.OrderControl.String(), hospital.MessageConfig.OrderControl.Cancel; got != want {
+				t.Errorf("orc.OrderControl.String()=%v, want %v", got, want)
+			}
+			if got, want := orc.OrderStatus.String(), hospital.MessageConfig.OrderStatus.Cancelled; got != want {
+				t.Errorf("orc.OrderStatus.String()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Order not on hold cannot be released",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", NoAcknowledgementMessage: true}},
+			{ReleaseOrder: &pathway.ReleaseOrder{OrderID: "order1"}},
+		}},
+		wantMessageTypes: []string{"ORM^O01"},
+		wantMetrics: []metric{{
+			name: "simulated_hospital_errors_total",
+			labels: map[string]string{
+				"pathway_name": testPathwayName,
+				"reason":       `order with ID "order1" is not on hold`,
+			},
+			wantDiff: 1,
+		}},
+	}, {
+		name: "Completed order cannot be cancelled",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Order: &pathway.Order{OrderID: "order1", OrderProfile: "UREA AND ELECTROLYTES", NoAcknowledgementMessage: true}},
+			{Result: &pathway.Results{OrderID: "order1"}},
+			{CancelOrder: &pathway.CancelOrder{OrderID: "order1"}},
+		}},
+		wantMessageTypes: []string{"ORM^O01", "ORU^R01"},
+		wantMetrics: []metric{{
+			name: "simulated_hospital_errors_total",
+			labels: map[string]string{
+				"pathway_name": testPathwayName,
+				"reason":       `order with ID "order1" is no longer active`,
+			},
+			wantDiff: 1,
+		}},
+	}, {
+		name: "Vaccination",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Vaccination: &pathway.Vaccination{Vaccine: "Influenza"}},
+		}},
+		wantMessageTypes: []string{"VXU^V04"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			rxa := testhl7.RXA(t, messages[0])
+			if got, want := rxa.AdministeredCode.Identifier.String(), "141"; got != want {
+				t.Errorf("rxa.AdministeredCode.Identifier.String()=%v, want %v", got, want)
+			}
+			if got, want := len(rxa.SubstanceLotNumber), 1; got != want {
+				t.Fatalf("len(rxa.SubstanceLotNumber)=%v, want %v", got, want)
+			}
+			if got, want := rxa.SubstanceLotNumber[0].String(), "LOT-1"; got != want {
+				t.Errorf("rxa.SubstanceLotNumber[0].String()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Charges and account update",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{Delay: &pathway.Delay{From: 48 * time.Hour, To: 48 * time.Hour}},
+			{Charge: &pathway.Charge{}},
+			{Charge: &pathway.Charge{Code: "SUP-DRESS", Quantity: 2}},
+			{AccountUpdate: &pathway.AccountUpdate{}},
+		}},
+		wantMessageTypes: []string{"ADT^A01", "DFT^P03", "DFT^P03", "BAR^P05"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			// The patient spends two nights in bed before the first charge.
+			if got, want := strings.Count(messages[1], "FT1|"), 2; got != want {
+				t.Errorf("strings.Count(%q, %q)=%v, want %v", messages[1], "FT1|", got, want)
+			}
+			if !strings.Contains(messages[1], "|BED-GEN^General bed^") {
+				t.Errorf("messages[1]=%q, want it to contain a charge for the bed", messages[1])
+			}
+			if !strings.Contains(messages[2], "|SUP-DRESS^Dressing pack^") || !strings.Contains(messages[2], "|2|24.80|12.40|") {
+				t.Errorf("messages[2]=%q, want it to contain a charge for 2 dressing packs", messages[2])
+			}
+			if got, want := strings.Count(messages[3], "GT1|"), 1; got != want {
+				t.Errorf("strings.Count(%q, %q)=%v, want %v", messages[3], "GT1|", got, want)
+			}
+		},
+	}, {
+		name: "Insurance and guarantor",
+		pathway: pathway.Pathway{
+			Persons: &pathway.Persons{
+				"main-patient": {
+					Insurance: &pathway.Insurance{Plan: "ACME-LOCAL", PolicyNumber: "POL-1"},
+					Guarantor: &pathway.Guarantor{Relationship: "SPO"},
+				},
+			},
+			Pathway: []pathway.Step{
+				{Admission: &pathway.Admission{Loc: testLoc}},
+				{UpdatePerson: &pathway.UpdatePerson{Person: &pathway.Person{Insurance: &pathway.Insurance{SelfPay: true}}}},
+			},
+		},
+		wantMessageTypes: []string{"ADT^A01", "ADT^A08"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			admission, update := messages[0], messages[1]
+			if !strings.Contains(admission, "IN1|1|ACME-LOCAL^Acme Local^LOCAL^^|ACME|Acme Health|") || !strings.Contains(admission, "|POL-1") {
+				t.Errorf("admission=%q, want it to contain the insurance from the pathway", admission)
+			}
+			// The spouse is the guarantor, the holder of the policy and a next of kin.
+			for _, s := range []string{"GT1|", "IN2|", "NK1|"} {
+				if got, want := strings.Count(admission, s), 1; got != want {
+					t.Errorf("strings.Count(%q, %q)=%v, want %v", admission, s, got, want)
+				}
+			}
+			if got, want := strings.Count(admission, "SPO^SPO^HL70063^^"), 3; got != want {
+				t.Errorf("strings.Count(%q, %q)=%v, want %v", admission, "|SPO^SPO^HL70063^^|", got, want)
+			}
+			// The patient is now self-pay, but keeps the guarantor.
+			if strings.Contains(update, "IN1|") {
+				t.Errorf("update=%q, want it not to contain IN1 segments", update)
+			}
+			if got, want := strings.Count(update, "GT1|"), 1; got != want {
+				t.Errorf("strings.Count(%q, %q)=%v, want %v", update, "GT1|", got, want)
+			}
+		},
+	}, {
+		name: "Leave of absence holding the bed",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{LeaveOfAbsence: &pathway.LeaveOfAbsence{ExpectedReturnTimeFromNow: &twoHours}},
+			{ReturnFromLOA: &pathway.ReturnFromLOA{}},
+		}},
+		wantMessageTypes: []string{"ADT^A01", "ADT^A21", "ADT^A22"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			admission, leave, back := messages[0], messages[1], messages[2]
+			if testhl7.PV2(t, leave).ExpectedLOAReturnDateTime == nil {
+				t.Errorf("PV2(%q).ExpectedLOAReturnDateTime got <nil>, want non nil", leave)
+			}
+			if got, want := testhl7.PV1(t, back).AssignedPatientLocation.Bed.String(), testhl7.PV1(t, admission).AssignedPatientLocation.Bed.String(); got != want {
+				t.Errorf("PV1(%q).AssignedPatientLocation.Bed got %v, want %v", back, got, want)
+			}
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds(), 1; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds()=%v, want %v", got, want)
+			}
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].HeldBeds(), 0; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].HeldBeds()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Leave of absence releasing the bed",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{LeaveOfAbsence: &pathway.LeaveOfAbsence{ReleaseBed: true}},
+			{ReturnFromLOA: &pathway.ReturnFromLOA{Loc: testLocAE}},
+		}},
+		wantMessageTypes: []string{"ADT^A01", "ADT^A21", "ADT^A22"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds(), 0; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds()=%v, want %v", got, want)
+			}
+			if got, want := hospital.LocationManager.RoomManagers[testLocAE].OccupiedBeds(), 1; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLocAE].OccupiedBeds()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Discharge during leave of absence",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{LeaveOfAbsence: &pathway.LeaveOfAbsence{}},
+			{Discharge: &pathway.Discharge{}},
+		}},
+		wantMessageTypes: []string{"ADT^A01", "ADT^A21", "ADT^A03"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds(), 0; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds()=%v, want %v", got, want)
+			}
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].HeldBeds(), 0; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].HeldBeds()=%v, want %v", got, want)
+			}
+		},
+	}, {
+		name: "Return from leave of absence without leave",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Admission: &pathway.Admission{Loc: testLoc}},
+			{ReturnFromLOA: &pathway.ReturnFromLOA{}},
+		}},
+		wantMessageTypes: []string{"ADT^A01"},
+		wantMetrics: []metric{{
+			name: "simulated_hospital_errors_total",
+			labels: map[string]string{
+				"pathway_name": testPathwayName,
+				"reason":       "the patient is not on leave of absence",
+			},
+			wantDiff: 1,
+		}},
+	}, {
+		name: "Link with unknown patient",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{AddPerson: &pathway.AddPerson{}},
+			{LinkPatients: &pathway.LinkPatients{Patient1: pathway.Current, Patient2: "unknown-mrn"}},
+		}},
+		wantMessageTypes: []string{"ADT^A28"},
+		wantMetrics: []metric{{
+			name: "simulated_hospital_errors_total",
+			labels: map[string]string{
+				"pathway_name": testPathwayName,
+				"reason":       "unknown MRN in link",
+			},
+			wantDiff: 1,
+		}},
+	}, {
+		name: "Change identifier",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{AddPerson: &pathway.AddPerson{}},
+			{ChangeIdentifier: &pathway.ChangeIdentifier{}},
+			{UpdatePerson: &pathway.UpdatePerson{}},
+		}},
+		wantMessageTypes: []string{"ADT^A28", "ADT^A47", "ADT^A31"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			priorMRN, newMRN := testhl7.MRN(t, messages[0]), testhl7.MRN(t, messages[1])
+			if newMRN == priorMRN {
+				t.Errorf("testhl7.MRN(changeIdentifier)=%q, want a new MRN", newMRN)
+			}
+			if got, want := testhl7.MRG(t, messages[1]).PriorPatientIdentifierList[0].IDNumber.String(), priorMRN; got != want {
+				t.Errorf("MRG(changeIdentifier).PriorPatientIdentifierList[0].IDNumber=%q, want %q", got, want)
+			}
+			if got, want := testhl7.MRN(t, messages[2]), newMRN; got != want {
+				t.Errorf("testhl7.MRN(update)=%q, want %q", got, want)
+			}
+		},
+	}, {
+		name: "Change patient class",
+		pathway: pathway.Pathway{Pathway: []pathway.Step{
+			{Registration: &pathway.Registration{}},
+			{ChangePatientClass: &pathway.ChangePatientClass{Loc: testLoc}},
+			{ChangePatientClass: &pathway.ChangePatientClass{}},
+		}},
+		wantMessageTypes: []string{"ADT^A04", "ADT^A06", "ADT^A07"},
+		want: func(t *testing.T, messages []string, hospital *testhospital.Hospital) {
+			wantPatientClass := []string{"RECURRING", "INPATIENT", "OUTPATIENT"}
+			gotPatientClass := testhl7.Fields(t, messages, testhl7.PatientClass)
+			if diff := cmp.Diff(wantPatientClass, gotPatientClass); diff != "" {
+				t.Errorf("StartPathway(%v) generated PatientClass with diff (-want, +got):\n%s", testPathwayName, diff)
+			}
+			if got, want := hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds(), 1; got != want {
+				t.Errorf("hospital.LocationManager.RoomManagers[testLoc].OccupiedBeds()=%v, want %v", got, want)
+			}
+		},
 	}, {
 		name: "Document with existing Document ID",
 		pathway: pathway.Pathway{Pathway: []pathway.Step{
@@ -2651,6 +3098,61 @@ func TestRunPathwayBedSwapStepPersonsSection(t *testing.T) {
 	}
 }
 
+func TestRunPathwayLinkAndChangeIdentifierPersonsSection(t *testing.T) {
+	ctx := context.Background()
+	p1ID := pathway.PatientID("patient-1")
+	p2ID := pathway.PatientID("patient-2")
+	newMRN := "new-mrn"
+
+	pathways := map[string]pathway.Pathway{
+		testPathwayName: {
+			Persons: &pathway.Persons{
+				p1ID: {FirstName: "Patient 1"},
+				p2ID: {FirstName: "Patient 2"},
+			},
+			Pathway: []pathway.Step{
+				{UsePatient: &pathway.UsePatient{Patient: p1ID}},
+				{AddPerson: &pathway.AddPerson{}},
+				{UsePatient: &pathway.UsePatient{Patient: p2ID}},
+				{AddPerson: &pathway.AddPerson{}},
+				{LinkPatients: &pathway.LinkPatients{Patient1: p2ID, Patient2: p1ID}},
+				{ChangeIdentifier: &pathway.ChangeIdentifier{NewMRN: newMRN}},
+				// The patient can still be referred to with the prior MRN.
+				{UnlinkPatients: &pathway.UnlinkPatients{Patient1: p2ID, Patient2: p1ID}},
+				{UsePatient: &pathway.UsePatient{Patient: p1ID}},
+				{UsePatient: &pathway.UsePatient{Patient: p2ID}},
+				{UpdatePerson: &pathway.UpdatePerson{}},
+			},
+		},
+	}
+
+	hospital := newHospital(ctx, t, Config{}, pathways)
+	defer hospital.Close()
+	startPathway(t, hospital, testPathwayName)
+	_, messages := hospital.ConsumeQueues(ctx, t)
+
+	wantMessageTypes := []string{"ADT^A28", "ADT^A28", "ADT^A24", "ADT^A47", "ADT^A37", "ADT^A31"}
+	if diff := cmp.Diff(wantMessageTypes, testhl7.Fields(t, messages, testhl7.MessageType)); diff != "" {
+		t.Fatalf("StartPathway(%v) got message types diff (-want, +got):\n%s", testPathwayName, diff)
+	}
+	mrn1, mrn2 := testhl7.MRN(t, messages[0]), testhl7.MRN(t, messages[1])
+	link, changeIdentifier, unlink, update := messages[2], messages[3], messages[4], messages[5]
+	if got := strings.Count(link, mrn1); got == 0 {
+		t.Errorf("link=%q, want it to contain the MRN of the linked patient %q", link, mrn1)
+	}
+	if got, want := testhl7.MRN(t, changeIdentifier), newMRN; got != want {
+		t.Errorf("testhl7.MRN(changeIdentifier)=%q, want %q", got, want)
+	}
+	if got, want := testhl7.MRG(t, changeIdentifier).PriorPatientIdentifierList[0].IDNumber.String(), mrn2; got != want {
+		t.Errorf("MRG(changeIdentifier).PriorPatientIdentifierList[0].IDNumber=%q, want %q", got, want)
+	}
+	for _, m := range []string{unlink, update} {
+		if got, want := testhl7.MRN(t, m), newMRN; got != want {
+			t.Errorf("testhl7.MRN(%q)=%q, want %q", m, got, want)
+		}
+	}
+}
+
 func TestRunPathwayBedSwapStepWrongMRN(t *testing.T) {
 	ctx := context.Background()
 	mr := testmetrics.NewRetrieverFromGatherer(t)
@@ -4064,6 +4566,43 @@ func TestRunPathwayResultsOnSameOrDifferentOrders(t *testing.T) {
 			}}},
 		wantResultStatus: [][]string{{"P", "P"}, {"F", "F"}, {"C", "C"}},
 		wantSetID:        [][]string{{"1", "2"}, {"1", "2"}, {"1", "2"}},
+	}, {
+		name: "revisions",
+		pathway: pathway.Pathway{
+			History: []pathway.Step{{
+				Result: &pathway.Results{
+					OrderID:      "uspelvis_transa_transv1",
+					OrderProfile: orderProfile,
+					Results:      []*pathway.Result{result, {TestName: "UPELC", Value: "Normal"}},
+					OrderStatus:  "A",
+					ResultStatus: "P",
+					ResultID:     "preliminary",
+				},
+				Parameters: &pathway.Parameters{TimeFromNow: &timeFromNow},
+			}},
+			Pathway: []pathway.Step{{
+				Result: &pathway.Results{
+					OrderID:  "uspelvis_transa_transv1",
+					Revises:  "preliminary",
+					ResultID: "final",
+				},
+			}, {
+				Result: &pathway.Results{
+					OrderID:       "uspelvis_transa_transv1",
+					Revises:       "final",
+					Results:       []*pathway.Result{{TestName: "UPELC", Value: "Abnormal"}},
+					AmendmentNote: "Reviewed by a second radiologist",
+				},
+			}, {
+				Result: &pathway.Results{
+					OrderID:      "uspelvis_transa_transv1",
+					OrderProfile: orderProfile,
+					Results:      []*pathway.Result{result},
+				},
+			}}},
+		// Revisions keep the SetIDs of the revised results, and only flag the revised results as corrected.
+		wantResultStatus: [][]string{{"P", "P"}, {"F", "F"}, {"F", "C"}, {"C"}},
+		wantSetID:        [][]string{{"1", "2"}, {"1", "2"}, {"1", "2"}, {"3"}},
 	}} {
 		t.Run(tt.name, func(t *testing.T) {
 			pathways := map[string]pathway.Pathway{
@@ -4301,3 +4840,44 @@ func TestStartNextPathway(t *testing.T) {
 		}
 	}
 }
+
+func TestNewSender_Format(t *testing.T) {
+	ctx := context.Background()
+	dir := testwrite.TempDir(t)
+	tests := []struct {
+		name      string
+		arguments SenderArguments
+		wantErr   bool
+	}{{
+		name:      "stdout er7",
+		arguments: SenderArguments{Output: "stdout", Format: "er7"},
+	}, {
+		name:      "stdout xml",
+		arguments: SenderArguments{Output: "stdout", Format: "xml"},
+	}, {
+		name:      "file xml",
+		arguments: SenderArguments{Output: "file", OutputFile: dir + "/messages.xml", Format: "xml"},
+	}, {
+		name:      "batch er7",
+		arguments: SenderArguments{Output: "batch", BatchFilePattern: dir + "/batch_{seq}.hl7", Format: "er7"},
+	}, {
+		name:      "batch xml",
+		arguments: SenderArguments{Output: "batch", BatchFilePattern: dir + "/batch_{seq}.hl7", Format: "xml"},
+		wantErr:   true,
+	}, {
+		name:      "unknown format",
+		arguments: SenderArguments{Output: "stdout", Format: "json"},
+		wantErr:   true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewSender(ctx, tc.arguments)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("NewSender(%+v) got err %v, want error: %t", tc.arguments, err, tc.wantErr)
+			}
+			if s != nil {
+				s.Close()
+			}
+		})
+	}
+}
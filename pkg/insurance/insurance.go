@@ -0,0 +1,217 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package insurance is responsible for parsing the catalogue of the payers and insurance plans that
+// cover patients, and of the guarantors of the patients, together with how often they occur.
+package insurance
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/sample"
+	"github.com/pkg/errors"
+)
+
+var log = logging.ForCallerPackage()
+
+// Catalogue contains the insurance plans that can cover patients and the relationships of the
+// guarantors to the patients.
+type Catalogue struct {
+	// plans is a map of Plans keyed by their codes.
+	plans map[string]*Plan
+	// coverage is the distribution of the Plans that cover patients. A nil value means that the
+	// patient is not insured.
+	coverage sample.DiscreteDistribution
+	// insured is the distribution of the Plans that cover patients who are insured.
+	insured sample.DiscreteDistribution
+	// relationships is the distribution of the relationships of the guarantors to the patients.
+	relationships sample.DiscreteDistribution
+	// minorRelationship is the relationship of the guarantors of minors to the patients.
+	minorRelationship string
+}
+
+// Payer is a company that pays for the care of the patients it insures.
+type Payer struct {
+	ID   string
+	Name string
+}
+
+// Plan is an insurance plan offered by a payer.
+type Plan struct {
+	// Code identifies the plan. Its Text is the name of the plan.
+	Code  ir.CodedElement
+	Payer *Payer
+	// Type is the type of the plan, e.g., "PPO".
+	Type string
+	// GroupNumbers are the group numbers that the group number of each coverage is picked from.
+	GroupNumbers []string
+	// Weight is how often patients are covered by the plan, relative to the other plans.
+	Weight uint
+}
+
+// Guarantors contains how often the guarantors of patients have each relationship to the patients.
+type Guarantors struct {
+	// Relationships are the relationships to the patients (HL7 table 0063) and their weights.
+	Relationships map[string]uint
+	// MinorRelationship is the relationship of the guarantors of minors, who cannot be their own
+	// guarantors. If empty, the relationship of the guarantors of minors is picked from Relationships.
+	MinorRelationship string
+}
+
+// New returns a new Catalogue from a plans map keyed by the plan codes, the weight of the patients
+// that are not insured and the guarantors.
+func New(plans map[string]*Plan, uninsuredWeight uint, guarantors Guarantors) *Catalogue {
+	codes := make([]string, 0, len(plans))
+	for k := range plans {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+	insured := sample.DiscreteDistribution{}
+	for _, code := range codes {
+		insured.WeightedValues = append(insured.WeightedValues, sample.WeightedValue{Value: plans[code], Frequency: plans[code].Weight})
+	}
+	coverage := sample.DiscreteDistribution{}
+	if uninsuredWeight > 0 {
+		coverage.WeightedValues = append(coverage.WeightedValues, sample.WeightedValue{Value: (*Plan)(nil), Frequency: uninsuredWeight})
+	}
+	coverage.WeightedValues = append(coverage.WeightedValues, insured.WeightedValues...)
+
+	relationships := make([]string, 0, len(guarantors.Relationships))
+	for k := range guarantors.Relationships {
+		relationships = append(relationships, k)
+	}
+	sort.Strings(relationships)
+	rd := sample.DiscreteDistribution{}
+	for _, r := range relationships {
+		rd.WeightedValues = append(rd.WeightedValues, sample.WeightedValue{Value: r, Frequency: guarantors.Relationships[r]})
+	}
+	return &Catalogue{
+		plans:             plans,
+		coverage:          coverage,
+		insured:           insured,
+		relationships:     rd,
+		minorRelationship: guarantors.MinorRelationship,
+	}
+}
+
+// Plan returns the Plan with the given code.
+func (c *Catalogue) Plan(code string) (*Plan, bool) {
+	plan, ok := c.plans[code]
+	return plan, ok
+}
+
+// RandomPlan returns a random Plan according to the weights of the plans, or nil if the patient is
+// not insured.
+func (c *Catalogue) RandomPlan() *Plan {
+	plan, _ := c.coverage.Random().(*Plan)
+	return plan
+}
+
+// RandomInsuredPlan returns a random Plan according to the weights of the plans, ignoring the
+// patients that are not insured. Returns nil if no plan has a weight.
+func (c *Catalogue) RandomInsuredPlan() *Plan {
+	plan, _ := c.insured.Random().(*Plan)
+	return plan
+}
+
+// RandomRelationship returns a random relationship of a guarantor to the patient, or an empty
+// string if there are no relationships. If minor is true and the catalogue has a relationship for
+// the guarantors of minors, that relationship is returned.
+func (c *Catalogue) RandomRelationship(minor bool) string {
+	if minor && c.minorRelationship != "" {
+		return c.minorRelationship
+	}
+	r, _ := c.relationships.Random().(string)
+	return r
+}
+
+// RandomGroupNumber returns a random group number of the plan, or an empty string if the plan
+// doesn't have group numbers.
+func (p *Plan) RandomGroupNumber() string {
+	if len(p.GroupNumbers) == 0 {
+		return ""
+	}
+	return p.GroupNumbers[rand.Intn(len(p.GroupNumbers))]
+}
+
+type catalogue struct {
+	UninsuredWeight uint `yaml:"uninsured_weight"`
+	Payers          map[string]payer
+	Guarantors      guarantors
+}
+
+type payer struct {
+	Name  string
+	Plans map[string]plan
+}
+
+type plan struct {
+	Name         string
+	CodingSystem string `yaml:"coding_system"`
+	Type         string
+	GroupNumbers []string `yaml:"group_numbers"`
+	Weight       uint
+}
+
+type guarantors struct {
+	Relationships     map[string]uint
+	MinorRelationship string `yaml:"minor_relationship"`
+}
+
+// Load parses the insurance catalogue from the given file.
+// The coding system of the plans defaults to the coding system in the HL7 configuration.
+func Load(ctx context.Context, filename string, hl7Config *config.HL7Config) (*Catalogue, error) {
+	var parsed catalogue
+	if err := config.LoadYAML(ctx, filename, "insurance", &parsed); err != nil {
+		return nil, err
+	}
+
+	plans := map[string]*Plan{}
+	log.Info("Loading insurance plans")
+	for id, py := range parsed.Payers {
+		if py.Name == "" {
+			return nil, errors.Errorf("payer %q in %s: name is required", id, filename)
+		}
+		if len(py.Plans) == 0 {
+			return nil, errors.Errorf("payer %q in %s: at least one plan is required", id, filename)
+		}
+		p := &Payer{ID: id, Name: py.Name}
+		for code, pl := range py.Plans {
+			if _, ok := plans[code]; ok {
+				return nil, errors.Errorf("plan %q in %s: duplicated plan code", code, filename)
+			}
+			codingSystem := pl.CodingSystem
+			if codingSystem == "" {
+				codingSystem = hl7Config.CodingSystem
+			}
+			plans[code] = &Plan{
+				Code:         ir.CodedElement{ID: code, Text: pl.Name, CodingSystem: codingSystem},
+				Payer:        p,
+				Type:         pl.Type,
+				GroupNumbers: pl.GroupNumbers,
+				Weight:       pl.Weight,
+			}
+			log.Infof(" - %s: %s", id, code)
+		}
+	}
+	return New(plans, parsed.UninsuredWeight, Guarantors{
+		Relationships:     parsed.Guarantors.Relationships,
+		MinorRelationship: parsed.Guarantors.MinorRelationship,
+	}), nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insurance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+var hl7Config = &config.HL7Config{CodingSystem: "WinPath"}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	c, err := Load(ctx, test.InsuranceConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("Load(%s, %+v) failed with %v", test.InsuranceConfigTest, hl7Config, err)
+	}
+
+	acme := &Payer{ID: "ACME", Name: "Acme Health"}
+	tests := []struct {
+		code string
+		want *Plan
+	}{{
+		code: "ACME-PPO",
+		want: &Plan{
+			Code:         ir.CodedElement{ID: "ACME-PPO", Text: "Acme PPO", CodingSystem: "WinPath"},
+			Payer:        acme,
+			Type:         "PPO",
+			GroupNumbers: []string{"G-100"},
+			Weight:       1,
+		},
+	}, {
+		code: "ACME-LOCAL",
+		want: &Plan{
+			Code:  ir.CodedElement{ID: "ACME-LOCAL", Text: "Acme Local", CodingSystem: "LOCAL"},
+			Payer: acme,
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.code, func(t *testing.T) {
+			got, ok := c.Plan(tc.code)
+			if !ok {
+				t.Fatalf("Plan(%q) got ok=false, want true", tc.code)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Plan(%q) got diff (-want, +got):\n%s", tc.code, diff)
+			}
+		})
+	}
+
+	// ACME-LOCAL has no weight and nobody is uninsured, so the random plan is always ACME-PPO.
+	for i := 0; i < 10; i++ {
+		if got, want := c.RandomPlan().Code.ID, "ACME-PPO"; got != want {
+			t.Errorf("RandomPlan().Code.ID got %q, want %q", got, want)
+		}
+	}
+	if got, want := c.RandomRelationship(false), "SEL"; got != want {
+		t.Errorf("RandomRelationship(false) got %q, want %q", got, want)
+	}
+	if got, want := c.RandomRelationship(true), "PAR"; got != want {
+		t.Errorf("RandomRelationship(true) got %q, want %q", got, want)
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	test.CheckLoadFails(t, func(fName string) error {
+		_, err := Load(context.Background(), fName, hl7Config)
+		return err
+	}, []test.InvalidConfig{{
+		Name: "Payer without name",
+		Content: `
+payers:
+  ACME:
+    plans:
+      PPO:
+        weight: 1`,
+	}, {
+		Name: "Payer without plans",
+		Content: `
+payers:
+  ACME:
+    name: Acme`,
+	}, {
+		Name: "Duplicated plan code",
+		Content: `
+payers:
+  ACME:
+    name: Acme
+    plans:
+      PPO:
+        weight: 1
+  OTHER:
+    name: Other
+    plans:
+      PPO:
+        weight: 1`,
+	}, {
+		Name: "Negative weight",
+		Content: `
+payers:
+  ACME:
+    name: Acme
+    plans:
+      PPO:
+        weight: -1`,
+	}, {
+		Name: "Unknown field",
+		Content: `
+payers:
+  ACME:
+    name: Acme
+    phone: 555-0100
+    plans:
+      PPO:
+        weight: 1`,
+	}})
+}
+
+func TestRandomInsuredPlan(t *testing.T) {
+	ppo := &Plan{Code: ir.CodedElement{ID: "PPO"}, Weight: 1}
+	c := New(map[string]*Plan{"PPO": ppo}, 1000000, Guarantors{})
+	for i := 0; i < 10; i++ {
+		if got := c.RandomInsuredPlan(); got != ppo {
+			t.Errorf("RandomInsuredPlan() got %+v, want %+v", got, ppo)
+		}
+	}
+}
+
+func TestRandomPlan_Uninsured(t *testing.T) {
+	c := New(map[string]*Plan{}, 1, Guarantors{})
+	if got := c.RandomPlan(); got != nil {
+		t.Errorf("RandomPlan() got %+v, want nil", got)
+	}
+	if got := c.RandomInsuredPlan(); got != nil {
+		t.Errorf("RandomInsuredPlan() got %+v, want nil", got)
+	}
+	if got := c.RandomRelationship(true); got != "" {
+		t.Errorf("RandomRelationship(true) got %q, want empty", got)
+	}
+}
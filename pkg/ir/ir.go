@@ -111,12 +111,69 @@ type Order struct {
 	// NumberOfPreviousResults is used to keep track of how many results were already sent for this order.
 	// This allows for starting with the correct OBX SetID when sending new results linked to that order.
 	NumberOfPreviousResults int
+	// MessageStyle is the style of the messages that place and acknowledge the order:
+	// "ORM" for ORM^O01 and ORR^O02 messages, or "OML" for OML^O21 and ORL^O22 messages.
+	MessageStyle string
+	// Priority is the TQ1 -> Priority
+	// (https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0485).
+	Priority string
+	// Specimen is the specimen collected for the order. It translates into an SPM segment.
+	Specimen *Specimen
+	// Children are the child orders of the order, e.g., the antibiotic susceptibility panels of the
+	// organisms identified by a culture. Each child order translates into an OBR segment linked to
+	// this order, followed by the OBX segments of its results.
+	Children []*ChildOrder
+	// ResultSets are the results sent for the order that can be revised later, keyed by their IDs.
+	ResultSets map[string]*ResultSet
+}
+
+// ResultSet is a set of results sent in the same message.
+type ResultSet struct {
+	Results []*Result
+	// NumberOfPreviousResults is the number of results that were sent for the order before this set.
+	// Revisions of the set start with the same OBX SetID as the set.
+	NumberOfPreviousResults int
+}
+
+// ChildOrder represents an order that is created as a consequence of the results of its parent
+// order; for instance, the antibiotic susceptibility tests of an organism identified by a culture.
+type ChildOrder struct {
+	// UniversalService is the OBR -> Universal Service Identifier of the child order.
+	UniversalService *CodedElement
+	// ParentResult is the result of the parent order that the child order relates to.
+	// It translates into the OBR -> Parent Result field.
+	ParentResult *Result
+	// Results are the results of the child order.
+	Results []*Result
+}
+
+// Specimen represents a specimen collected for an order.
+type Specimen struct {
+	// Type is the SPM -> Specimen Type
+	// (https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0487).
+	Type *CodedElement
+	// CollectionMethod is the SPM -> Specimen Collection Method
+	// (https://hl7-definition.caristix.com/v2/HL7v2.5.1/Tables/0488).
+	CollectionMethod *CodedElement
+	// BodySite is the SPM -> Specimen Source Site.
+	BodySite *CodedElement
+	// Container is the SPM -> Container Type.
+	Container *CodedElement
+	// CollectedDateTime is the SPM -> Specimen Collection Date/Time.
+	CollectedDateTime NullTime
+	// ReceivedDateTime is the SPM -> Specimen Received Date/Time.
+	ReceivedDateTime NullTime
 }
 
 // Result represents a clinical result.
 type Result struct {
-	TestName            *CodedElement
-	Value               string
+	TestName *CodedElement
+	// SubID is the OBX -> Observation Sub-ID, used to group related results, for instance, the
+	// different organisms identified by a culture.
+	SubID string
+	Value string
+	// CodedValue is the value of results whose value type is CE. If set, it is used instead of Value.
+	CodedValue          *CodedElement
 	Unit                string
 	ValueType           string
 	Range               string
@@ -127,12 +184,23 @@ type Result struct {
 	Status       string
 	Notes        []string
 	ClinicalNote *ClinicalNote
+	// Revision is the number of times that the value or the status of the result has been revised.
+	// It is 0 the first time the result is sent.
+	Revision int
+	// ObservationID is the ID of the FHIR Observation of the result. It is set the first time the
+	// result is converted to FHIR, and kept by the revisions of the result, so that each revision
+	// is a new version of the same Observation.
+	ObservationID string
 }
 
 // Text returns a human-readable representation of the result.
 func (r *Result) Text() string {
 	var sb strings.Builder
-	fmt.Fprintf(&sb, "%s: %s %s", r.TestName.Text, r.Value, r.Unit)
+	value := r.Value
+	if r.CodedValue != nil {
+		value = r.CodedValue.Text
+	}
+	fmt.Fprintf(&sb, "%s: %s %s", r.TestName.Text, value, r.Unit)
 	if strings.TrimSpace(r.AbnormalFlag) != "" {
 		fmt.Fprintf(&sb, " (%s)", r.AbnormalFlag)
 	}
@@ -178,6 +246,187 @@ type Document struct {
 	ContentLine []string
 }
 
+// Appointment represents an appointment booked for a patient, e.g. in an outpatient clinic.
+// It is used to populate the SCH, AIS, AIL and AIP segments of SIU messages.
+type Appointment struct {
+	// Fields used in the SCH segment.
+	PlacerAppointmentID string
+	FillerAppointmentID string
+	// EventReason is the reason for the event that produced the message, e.g. why the appointment
+	// was cancelled.
+	EventReason       string
+	AppointmentReason *CodedElement
+	AppointmentType   *CodedElement
+	FillerStatus      string
+
+	// Fields used in the SCH segment and in the AIS, AIL and AIP resource segments.
+	Start           NullTime
+	DurationMinutes int
+	Service         *CodedElement
+	Location        *PatientLocation
+	Doctor          *Doctor
+}
+
+// End returns the time at which the appointment ends.
+func (a *Appointment) End() NullTime {
+	if !a.Start.Valid {
+		return a.Start
+	}
+	return NewValidTime(a.Start.Add(time.Duration(a.DurationMinutes) * time.Minute))
+}
+
+// MedicationOrder represents a pharmacy order for a medication, with the dispenses and the
+// administrations that happen as a result of the order.
+type MedicationOrder struct {
+	// Placer is the PlacerOrderNumber to be set in the ORC segment.
+	Placer string
+	// Filler is the FillerOrderNumber to be set in the ORC segment. It is also the prescription number.
+	Filler string
+	// OrderDateTime is the ORC -> Date/Time of Transaction.
+	OrderDateTime NullTime
+	// OrderControl is the ORC -> Order Control.
+	OrderControl string
+	// OrderStatus is the ORC -> Order Status.
+	OrderStatus string
+	// Medication is the RXE -> Give Code.
+	Medication *CodedElement
+	Dose       string
+	DoseUnits  string
+	DosageForm string
+	Route      string
+	// Frequency is the interval of the RXE -> Quantity/Timing field, e.g. Q6H.
+	Frequency      string
+	DispenseAmount string
+	DispenseUnits  string
+	// Components are the components of a compound medication, e.g. the base and the additives of an
+	// IV solution. They translate into RXC segments.
+	Components []*MedicationComponent
+	Prescriber *Doctor
+	// DiscontinuedDateTime is the time when the order was discontinued, if it was.
+	DiscontinuedDateTime NullTime
+	// DiscontinueReason is the ORC -> Order Control Code Reason when the order is discontinued.
+	DiscontinueReason string
+	Dispenses         []*MedicationDispense
+	Administrations   []*MedicationAdministration
+}
+
+// MedicationComponent is a component of a compound medication.
+type MedicationComponent struct {
+	// Type is the RXC -> RX Component Type: B for base and A for additive.
+	Type   string
+	Code   *CodedElement
+	Amount string
+	Units  string
+}
+
+// MedicationDispense represents a dispense of a medication order by the pharmacy.
+type MedicationDispense struct {
+	// ID is the RXD -> Dispense Sub-ID Counter.
+	ID       int
+	DateTime NullTime
+	Amount   string
+	Units    string
+}
+
+// MedicationAdministration represents the administration of a dose of a medication order.
+type MedicationAdministration struct {
+	// ID is the RXA -> Administration Sub-ID Counter.
+	ID        int
+	DateTime  NullTime
+	Dose      string
+	DoseUnits string
+	// CompletionStatus is the RXA -> Completion Status.
+	CompletionStatus string
+}
+
+// Discontinued returns whether the medication order has been discontinued.
+func (m *MedicationOrder) Discontinued() bool {
+	return m.DiscontinuedDateTime.Valid
+}
+
+// Vaccination represents the administration of a vaccine to a patient.
+type Vaccination struct {
+	// Placer is the PlacerOrderNumber to be set in the ORC segment.
+	Placer string
+	// Filler is the FillerOrderNumber to be set in the ORC segment.
+	Filler   string
+	DateTime NullTime
+	// Vaccine is the RXA -> Administered Code, coded with CVX.
+	Vaccine   *CodedElement
+	Dose      string
+	DoseUnits string
+	Route     string
+	// Site is the RXR -> Administration Site.
+	Site      string
+	LotNumber string
+	// Manufacturer is the RXA -> Substance Manufacturer Name, coded with MVX.
+	Manufacturer *CodedElement
+	// Administrator is the RXA -> Administering Provider.
+	Administrator *Doctor
+	// CompletionStatus is the RXA -> Completion Status.
+	CompletionStatus string
+	// Observations are sent in OBX segments after the vaccination, e.g. the funding eligibility of
+	// the patient.
+	Observations []*Result
+}
+
+// Account represents the account that the charges of a patient are posted to.
+type Account struct {
+	// Number is the PID -> Patient Account Number.
+	Number        string
+	StartDateTime NullTime
+	// Charges are all the charges posted to the account.
+	Charges []*Charge
+}
+
+// Charge represents a financial transaction posted to an account.
+type Charge struct {
+	// ID is the FT1 -> Transaction ID.
+	ID                  string
+	TransactionDateTime NullTime
+	PostingDateTime     NullTime
+	// TransactionType is the FT1 -> Transaction Type, e.g. CG for charges.
+	TransactionType string
+	// Code is the FT1 -> Transaction Code, i.e. the chargemaster item.
+	Code       *CodedElement
+	Quantity   int
+	UnitAmount float64
+	Location   *PatientLocation
+	// PerformedBy and OrderedBy are the clinicians who performed and ordered the charged service.
+	PerformedBy       *Doctor
+	OrderedBy         *Doctor
+	FillerOrderNumber string
+	// Procedure is the FT1 -> Procedure Code for charges for procedures.
+	Procedure *CodedElement
+	// Source identifies what the charge was captured from, e.g. an order or a bed-day, so that
+	// the same service is not charged twice. It is empty for charges posted explicitly.
+	Source string
+}
+
+// ExtendedAmount returns the total amount of the charge.
+func (c *Charge) ExtendedAmount() float64 {
+	return float64(c.Quantity) * c.UnitAmount
+}
+
+// Insurance represents an insurance policy that covers the patient.
+type Insurance struct {
+	// Plan is the IN1 -> Insurance Plan ID.
+	Plan        *CodedElement
+	CompanyID   string
+	CompanyName string
+	GroupNumber string
+	// EffectiveDate is the IN1 -> Plan Effective Date.
+	EffectiveDate NullTime
+	// PlanType is the IN1 -> Plan Type, e.g., "PPO".
+	PlanType string
+	// Insured is the person who holds the policy, with their relationship to the patient.
+	Insured      *AssociatedParty
+	PolicyNumber string
+	// MemberNumber is the IN2 -> Patient Member Number, i.e., the number that identifies the
+	// patient within the policy.
+	MemberNumber string
+}
+
 // Ethnicity is a HL7v2 coded element to represent ethnicities.
 type Ethnicity CodedElement
 
@@ -298,14 +547,25 @@ type PatientInfo struct {
 	ExpectedAdmitDateTime          NullTime
 	ExpectedDischargeDateTime      NullTime
 	ExpectedTransferDateTime       NullTime
+	ExpectedLOAReturnDateTime      NullTime
 	AssociatedParties              []*AssociatedParty
 	Allergies                      []*Allergy
 	// Diagnoses and Procedures are used in UpdatePerson to build ADT^A31 messages and are cleared
 	// at the end of the event. Not to be confused with Encounter.Diagnoses and Encounter.Procedures
 	// which persist diagnoses and procedures in the medical record.
-	Diagnoses       []*DiagnosisOrProcedure
-	Procedures      []*DiagnosisOrProcedure
-	Encounters      []*Encounter
+	Diagnoses  []*DiagnosisOrProcedure
+	Procedures []*DiagnosisOrProcedure
+	Encounters []*Encounter
+	// Medications are the medication orders of the patient, including the discontinued ones.
+	Medications []*MedicationOrder
+	// Vaccinations are the vaccines administered to the patient.
+	Vaccinations []*Vaccination
+	// Account is the account that charges are posted to. It is nil until the first billing event.
+	Account *Account
+	// Guarantor is the person responsible for the patient's account.
+	Guarantor *AssociatedParty
+	// Insurances are the insurance policies that cover the patient.
+	Insurances      []*Insurance
 	PrimaryFacility *PrimaryFacility
 	// AdditionalData allows users to enter arbitrary information about a patient's medical record.
 	// It is up to the user to decide what data is stored here.
@@ -0,0 +1,144 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Actions that can be requested on a dead-lettered message with a POST request.
+const (
+	actionReplay  = "replay"
+	actionDiscard = "discard"
+)
+
+// summary is the representation of a dead-lettered message in a list, without the message itself.
+type summary struct {
+	ID          string    `json:"id"`
+	ControlID   string    `json:"control_id"`
+	MessageType string    `json:"message_type"`
+	PathwayName string    `json:"pathway_name"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"last_error"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
+// ServeHTTP handles the requests to manage the messages in the dead-letter store.
+// The requests can be:
+// * GET: list the dead-lettered messages, without their content.
+// * GET with an "id" parameter: return the dead-lettered message with that ID, including its content.
+// * POST with "id" and "action=replay" parameters: send the message again. If it is sent
+// successfully, it is removed from the dead-letter store.
+// * POST with "id" and "action=discard" parameters, or DELETE with an "id" parameter: remove the
+// message from the dead-letter store without sending it.
+// Responses are in JSON format.
+func (j *Journal) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	switch r.Method {
+	case "GET":
+		if id == "" {
+			j.list(w)
+			return
+		}
+		j.get(w, id)
+	case "POST":
+		if id == "" {
+			http.Error(w, "Missing id parameter", http.StatusBadRequest)
+			return
+		}
+		switch action := r.FormValue("action"); action {
+		case actionReplay:
+			j.handle(w, id, "replayed", j.Replay)
+		case actionDiscard:
+			j.handle(w, id, "discarded", j.Discard)
+		default:
+			http.Error(w, fmt.Sprintf("Unknown action %q; supported actions: [%s, %s]", action, actionReplay, actionDiscard), http.StatusBadRequest)
+		}
+	case "DELETE":
+		if id == "" {
+			http.Error(w, "Missing id parameter", http.StatusBadRequest)
+			return
+		}
+		j.handle(w, id, "discarded", j.Discard)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (j *Journal) list(w http.ResponseWriter) {
+	entries, err := j.DeadLetters()
+	if err != nil {
+		log.WithError(err).Error("Cannot list dead-lettered messages")
+		http.Error(w, "Cannot list dead-lettered messages", http.StatusInternalServerError)
+		return
+	}
+	summaries := make([]summary, 0, len(entries))
+	for _, e := range entries {
+		summaries = append(summaries, summary{
+			ID:          e.EntryID,
+			ControlID:   e.ControlID,
+			MessageType: e.MessageType,
+			PathwayName: e.PathwayName,
+			Attempts:    e.Attempts,
+			LastError:   e.LastError,
+			Created:     e.Created,
+			Updated:     e.Updated,
+		})
+	}
+	writeJSON(w, summaries)
+}
+
+func (j *Journal) get(w http.ResponseWriter, id string) {
+	e, err := j.DeadLetter(id)
+	if err != nil {
+		log.WithError(err).Error("Cannot get dead-lettered message")
+		http.Error(w, "Cannot get dead-lettered message", http.StatusInternalServerError)
+		return
+	}
+	if e == nil {
+		http.Error(w, ErrNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, e)
+}
+
+func (j *Journal) handle(w http.ResponseWriter, id string, done string, f func(string) error) {
+	err := f(id)
+	switch {
+	case errors.Cause(err) == ErrNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case err != nil:
+		log.WithError(err).WithField("id", id).Error("Cannot handle dead-lettered message")
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	default:
+		writeJSON(w, map[string]string{"id": id, "result": done})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, "Cannot marshal response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
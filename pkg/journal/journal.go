@@ -0,0 +1,415 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package journal provides a durable journal of outbound HL7 messages.
+// Messages are written to the journal before they are sent, and the ones that cannot be sent are
+// kept in a dead-letter store from where they can be inspected, replayed or discarded.
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/clock"
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/monitoring"
+	"github.com/bitcrshr/simhospital/pkg/state/persist"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// interruptedError is the error recorded for messages that were in the journal when it was created,
+// ie: the delivery of which was not confirmed before Simulated Hospital stopped.
+const interruptedError = "delivery was not confirmed before the journal was closed"
+
+var (
+	log = logging.ForCallerPackage()
+
+	counters struct {
+		SimulatedHospital struct {
+			JournalDeadLettersTotal *prometheus.CounterVec `help:"Number of messages moved to the dead-letter store, by pathway" labels:"pathway_name"`
+			JournalReplaysTotal     *prometheus.CounterVec `help:"Number of dead-lettered messages replayed, by result" labels:"result"`
+			JournalDeadLetters      prometheus.Gauge       `help:"Number of messages in the dead-letter store"`
+		}
+	}
+)
+
+func init() {
+	if err := monitoring.CreateAndRegisterMetricsFromStruct(&counters); err != nil {
+		log.WithError(err).Fatal("Cannot register metrics from the 'journal' package")
+	}
+}
+
+// Status is the status of a message in the journal.
+type Status string
+
+// Statuses of messages in the journal.
+const (
+	StatusPending    Status = "pending"
+	StatusDelivered  Status = "delivered"
+	StatusDeadLetter Status = "dead_letter"
+)
+
+// Entry is a message in the journal.
+type Entry struct {
+	EntryID     string    `json:"id"`
+	ControlID   string    `json:"control_id"`
+	MessageType string    `json:"message_type"`
+	PathwayName string    `json:"pathway_name"`
+	Message     string    `json:"message"`
+	Status      Status    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"last_error,omitempty"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
+// ID returns the entry's ID.
+func (e *Entry) ID() (string, error) {
+	return e.EntryID, nil
+}
+
+// Marshal marshals the entry.
+func (e *Entry) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// EntryUnmarshaller can be used to unmarshal persisted entries.
+type EntryUnmarshaller struct{}
+
+// Unmarshal unmarshals the given entry.
+func (u EntryUnmarshaller) Unmarshal(b []byte) (persist.MarshallableItem, error) {
+	var e Entry
+	err := json.Unmarshal(b, &e)
+	return &e, err
+}
+
+// Options contains optional parameters to New.
+type Options struct {
+	// Outbound stores the messages before they are sent.
+	// If nil, the messages are stored in memory.
+	Outbound persist.ItemSyncer
+	// DeadLetters stores the messages that could not be sent.
+	// If nil, the messages are stored in memory.
+	DeadLetters persist.ItemSyncer
+	// RetainDelivered is whether to keep the messages in Outbound after they are delivered,
+	// with StatusDelivered. If false, they are deleted from Outbound.
+	RetainDelivered bool
+	// Clock is used to timestamp the entries. If nil, the real time clock is used.
+	Clock clock.Clock
+}
+
+// Journal is an hl7.Sender that records each message in a journal before sending it with another
+// sender, and moves the messages that cannot be sent to a dead-letter store.
+type Journal struct {
+	sender          hl7.Sender
+	outbound        persist.ItemSyncer
+	deadLetters     persist.ItemSyncer
+	retainDelivered bool
+	clock           clock.Clock
+	// mu guards the fields below and serialises the calls to the sender, because messages can be
+	// replayed at the same time as they are being sent.
+	mu  sync.Mutex
+	seq int
+	// deadLetterCount is the number of messages in the dead-letter store. It is loaded from the
+	// store in New and kept up to date afterwards, so that the store is not read on every change.
+	deadLetterCount int
+}
+
+// New returns a Journal that sends messages with the given sender.
+// Messages that were still pending in the outbound store, eg: because Simulated Hospital stopped
+// before their delivery was confirmed, are moved to the dead-letter store so that they can be replayed.
+func New(sender hl7.Sender, options Options) (*Journal, error) {
+	j := &Journal{
+		sender:          sender,
+		outbound:        options.Outbound,
+		deadLetters:     options.DeadLetters,
+		retainDelivered: options.RetainDelivered,
+		clock:           options.Clock,
+	}
+	if j.outbound == nil {
+		j.outbound = newMemorySyncer()
+	}
+	if j.deadLetters == nil {
+		j.deadLetters = newMemorySyncer()
+	}
+	if j.clock == nil {
+		j.clock = &clock.RealTimeClock{}
+	}
+
+	items, err := j.outbound.LoadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the outbound journal")
+	}
+	for _, item := range items {
+		e, ok := item.(*Entry)
+		if !ok || e.Status != StatusPending {
+			continue
+		}
+		log.WithField("message_control_id", e.ControlID).Warning("Message in the outbound journal was not delivered; moving to the dead-letter store")
+		if err := j.moveToDeadLetters(e, interruptedError); err != nil {
+			return nil, err
+		}
+	}
+	deadLetters, err := j.deadLetters.LoadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the dead-letter store")
+	}
+	j.setDeadLetterCount(len(deadLetters))
+	return j, nil
+}
+
+// Send records the message in the journal and sends it.
+// If the message cannot be sent, it is moved to the dead-letter store and the error is returned.
+func (j *Journal) Send(message []byte) error {
+	return j.SendWithMetadata(message, hl7.Metadata{})
+}
+
+// SendWithMetadata records the message in the journal and sends it.
+// If the message cannot be sent, it is moved to the dead-letter store and the error is returned.
+func (j *Journal) SendWithMetadata(message []byte, metadata hl7.Metadata) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e := j.newEntry(message, metadata)
+	if err := j.outbound.Write(e); err != nil {
+		return errors.Wrap(err, "cannot write message to the outbound journal")
+	}
+	e.Attempts++
+	if sendErr := j.send(e); sendErr != nil {
+		if err := j.moveToDeadLetters(e, sendErr.Error()); err != nil {
+			log.WithError(err).Error("Cannot move message to the dead-letter store")
+		}
+		return errors.Wrapf(sendErr, "message %s moved to the dead-letter store", e.EntryID)
+	}
+	return j.markDelivered(e)
+}
+
+func (j *Journal) newEntry(message []byte, metadata hl7.Metadata) *Entry {
+	now := j.clock.Now()
+	j.seq++
+	e := &Entry{
+		EntryID:     fmt.Sprintf("%d-%d", now.UnixNano(), j.seq),
+		PathwayName: metadata.PathwayName,
+		Message:     string(message),
+		Status:      StatusPending,
+		Created:     now,
+		Updated:     now,
+	}
+	// Only MSH-9 and MSH-10 are needed, so the timezone used to parse the message doesn't matter,
+	// but it must be set.
+	options := hl7.NewParseMessageOptions()
+	if options.TimezoneLoc == nil {
+		options.TimezoneLoc = time.UTC
+	}
+	if m, err := hl7.ParseMessageWithOptions(message, options); err == nil {
+		if msh, err := m.MSH(); err == nil && msh != nil {
+			e.ControlID = msh.MessageControlID.String()
+			if mt := msh.MessageType; mt != nil {
+				e.MessageType = mt.MessageCode.String()
+				if te := mt.TriggerEvent.String(); te != "" {
+					e.MessageType += "^" + te
+				}
+			}
+		}
+	}
+	return e
+}
+
+func (j *Journal) send(e *Entry) error {
+	if ms, ok := j.sender.(hl7.MetadataSender); ok {
+		return ms.SendWithMetadata([]byte(e.Message), hl7.Metadata{PathwayName: e.PathwayName})
+	}
+	return j.sender.Send([]byte(e.Message))
+}
+
+func (j *Journal) markDelivered(e *Entry) error {
+	if !j.retainDelivered {
+		return errors.Wrap(j.outbound.Delete(e), "cannot delete delivered message from the outbound journal")
+	}
+	e.Status = StatusDelivered
+	e.Updated = j.clock.Now()
+	return errors.Wrap(j.outbound.Write(e), "cannot mark message as delivered in the outbound journal")
+}
+
+func (j *Journal) moveToDeadLetters(e *Entry, reason string) error {
+	e.Status = StatusDeadLetter
+	e.LastError = reason
+	e.Updated = j.clock.Now()
+	if err := j.deadLetters.Write(e); err != nil {
+		return errors.Wrapf(err, "cannot write message %s to the dead-letter store", e.EntryID)
+	}
+	counters.SimulatedHospital.JournalDeadLettersTotal.With(prometheus.Labels{"pathway_name": e.PathwayName}).Inc()
+	j.setDeadLetterCount(j.deadLetterCount + 1)
+	if err := j.outbound.Delete(e); err != nil {
+		return errors.Wrapf(err, "cannot delete message %s from the outbound journal", e.EntryID)
+	}
+	return nil
+}
+
+func (j *Journal) setDeadLetterCount(n int) {
+	j.deadLetterCount = n
+	counters.SimulatedHospital.JournalDeadLetters.Set(float64(n))
+}
+
+// DeadLetters returns the messages in the dead-letter store, oldest first.
+func (j *Journal) DeadLetters() ([]*Entry, error) {
+	items, err := j.deadLetters.LoadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load the dead-letter store")
+	}
+	entries := make([]*Entry, 0, len(items))
+	for _, item := range items {
+		e, ok := item.(*Entry)
+		if !ok {
+			return nil, errors.Errorf("unexpected item %v in the dead-letter store", item)
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(a, b int) bool { return entries[a].Created.Before(entries[b].Created) })
+	return entries, nil
+}
+
+// DeadLetter returns the message with the given ID from the dead-letter store,
+// or nil if there is no such message.
+func (j *Journal) DeadLetter(id string) (*Entry, error) {
+	item, err := j.deadLetters.LoadByID(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot load message %s from the dead-letter store", id)
+	}
+	if item == nil {
+		return nil, nil
+	}
+	e, ok := item.(*Entry)
+	if !ok {
+		return nil, errors.Errorf("unexpected item %v in the dead-letter store", item)
+	}
+	return e, nil
+}
+
+// ErrNotFound is returned when a message is not in the dead-letter store.
+var ErrNotFound = errors.New("message not found in the dead-letter store")
+
+// Replay sends the message with the given ID from the dead-letter store again.
+// If it is sent successfully, it is removed from the dead-letter store. Otherwise, it stays
+// in the dead-letter store with the new error, and the error is returned.
+func (j *Journal) Replay(id string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e, err := j.DeadLetter(id)
+	if err != nil {
+		return err
+	}
+	if e == nil {
+		return ErrNotFound
+	}
+	e.Attempts++
+	if sendErr := j.send(e); sendErr != nil {
+		counters.SimulatedHospital.JournalReplaysTotal.With(prometheus.Labels{"result": "error"}).Inc()
+		e.LastError = sendErr.Error()
+		e.Updated = j.clock.Now()
+		if err := j.deadLetters.Write(e); err != nil {
+			log.WithError(err).Error("Cannot update message in the dead-letter store")
+		}
+		return errors.Wrapf(sendErr, "cannot replay message %s", id)
+	}
+	counters.SimulatedHospital.JournalReplaysTotal.With(prometheus.Labels{"result": "success"}).Inc()
+	if err := j.deadLetters.Delete(e); err != nil {
+		return errors.Wrapf(err, "message %s was replayed but cannot be deleted from the dead-letter store", id)
+	}
+	j.setDeadLetterCount(j.deadLetterCount - 1)
+	if j.retainDelivered {
+		return j.markDelivered(e)
+	}
+	return nil
+}
+
+// Discard removes the message with the given ID from the dead-letter store without sending it.
+func (j *Journal) Discard(id string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e, err := j.DeadLetter(id)
+	if err != nil {
+		return err
+	}
+	if e == nil {
+		return ErrNotFound
+	}
+	if err := j.deadLetters.Delete(e); err != nil {
+		return errors.Wrapf(err, "cannot discard message %s", id)
+	}
+	j.setDeadLetterCount(j.deadLetterCount - 1)
+	return nil
+}
+
+// Close closes the underlying sender.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.sender.Close()
+}
+
+// memorySyncer is an in-memory persist.ItemSyncer, used if no syncer is provided.
+type memorySyncer struct {
+	mu    sync.Mutex
+	items map[string]persist.MarshallableItem
+}
+
+func newMemorySyncer() *memorySyncer {
+	return &memorySyncer{items: map[string]persist.MarshallableItem{}}
+}
+
+func (s *memorySyncer) Write(item persist.MarshallableItem) error {
+	id, err := item.ID()
+	if err != nil {
+		return errors.Wrap(err, "cannot get ID")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items[id] = item
+	return nil
+}
+
+func (s *memorySyncer) Delete(item persist.MarshallableItem) error {
+	id, err := item.ID()
+	if err != nil {
+		return errors.Wrap(err, "cannot get ID")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.items, id)
+	return nil
+}
+
+func (s *memorySyncer) LoadAll() ([]persist.MarshallableItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := make([]persist.MarshallableItem, 0, len(s.items))
+	for _, item := range s.items {
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (s *memorySyncer) LoadByID(id string) (persist.MarshallableItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.items[id], nil
+}
@@ -0,0 +1,432 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitcrshr/simhospital/pkg/hl7"
+	"github.com/bitcrshr/simhospital/pkg/state/persist"
+	"github.com/bitcrshr/simhospital/pkg/test/testclock"
+	"github.com/bitcrshr/simhospital/pkg/test/teststate"
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+	"github.com/google/go-cmp/cmp"
+)
+
+const message = "MSH|^~\\&|SIMHOSP|SFAC|RAPP|RFAC|20200501140643||ADT^A01|1234|T|2.3|||AL||44|ASCII\rPID|1"
+
+var errSend = errors.New("connection refused")
+
+// fakeSender records the messages sent and their metadata, and fails while err is set.
+type fakeSender struct {
+	err      error
+	messages []string
+	metadata []hl7.Metadata
+}
+
+func (s *fakeSender) Send(message []byte) error {
+	return s.SendWithMetadata(message, hl7.Metadata{})
+}
+
+func (s *fakeSender) SendWithMetadata(message []byte, metadata hl7.Metadata) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.messages = append(s.messages, string(message))
+	s.metadata = append(s.metadata, metadata)
+	return nil
+}
+
+func (s *fakeSender) Close() error {
+	return nil
+}
+
+func newJournal(t *testing.T, sender hl7.Sender, outbound, deadLetters persist.ItemSyncer, retain bool) *Journal {
+	t.Helper()
+	j, err := New(sender, Options{
+		Outbound:        outbound,
+		DeadLetters:     deadLetters,
+		RetainDelivered: retain,
+		Clock:           testclock.WithTick(time.Date(2020, 5, 1, 14, 6, 43, 0, time.UTC), time.Second),
+	})
+	if err != nil {
+		t.Fatalf("New() failed with %v", err)
+	}
+	return j
+}
+
+func TestSendWithMetadata_Delivered(t *testing.T) {
+	cases := []struct {
+		name         string
+		retain       bool
+		wantOutbound int
+	}{
+		{name: "not retained", retain: false, wantOutbound: 0},
+		{name: "retained", retain: true, wantOutbound: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := &fakeSender{}
+			outbound := teststate.NewItemSyncerWithDelete(true)
+			deadLetters := teststate.NewItemSyncerWithDelete(true)
+			j := newJournal(t, sender, outbound, deadLetters, tc.retain)
+
+			metadata := hl7.Metadata{PathwayName: "pathway1"}
+			if err := j.SendWithMetadata([]byte(message), metadata); err != nil {
+				t.Fatalf("SendWithMetadata() failed with %v", err)
+			}
+			if diff := cmp.Diff([]string{message}, sender.messages); diff != "" {
+				t.Errorf("sent messages diff (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]hl7.Metadata{metadata}, sender.metadata); diff != "" {
+				t.Errorf("sent metadata diff (-want, +got):\n%s", diff)
+			}
+			if got, want := outbound.Count(), tc.wantOutbound; got != want {
+				t.Errorf("outbound.Count() got %d, want %d", got, want)
+			}
+			if got := deadLetters.Count(); got != 0 {
+				t.Errorf("deadLetters.Count() got %d, want 0", got)
+			}
+			if tc.retain {
+				items, err := outbound.LoadAll()
+				if err != nil {
+					t.Fatalf("outbound.LoadAll() failed with %v", err)
+				}
+				if got, want := items[0].(*Entry).Status, StatusDelivered; got != want {
+					t.Errorf("Status got %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSend_Failure(t *testing.T) {
+	sender := &fakeSender{err: errSend}
+	outbound := teststate.NewItemSyncerWithDelete(true)
+	deadLetters := teststate.NewItemSyncerWithDelete(true)
+	j := newJournal(t, sender, outbound, deadLetters, false)
+
+	err := j.SendWithMetadata([]byte(message), hl7.Metadata{PathwayName: "pathway1"})
+	if err == nil {
+		t.Fatal("SendWithMetadata() got nil error, want error")
+	}
+	if got := outbound.Count(); got != 0 {
+		t.Errorf("outbound.Count() got %d, want 0", got)
+	}
+	entries, err := j.DeadLetters()
+	if err != nil {
+		t.Fatalf("DeadLetters() failed with %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("len(DeadLetters()) got %d, want 1", len(entries))
+	}
+	e := entries[0]
+	want := Entry{
+		ControlID:   "1234",
+		MessageType: "ADT^A01",
+		PathwayName: "pathway1",
+		Message:     message,
+		Status:      StatusDeadLetter,
+		Attempts:    1,
+		LastError:   errSend.Error(),
+	}
+	got := Entry{
+		ControlID:   e.ControlID,
+		MessageType: e.MessageType,
+		PathwayName: e.PathwayName,
+		Message:     e.Message,
+		Status:      e.Status,
+		Attempts:    e.Attempts,
+		LastError:   e.LastError,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("dead-lettered entry diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestNew_MovesPendingToDeadLetters(t *testing.T) {
+	outbound := teststate.NewItemSyncerWithDelete(true)
+	deadLetters := teststate.NewItemSyncerWithDelete(true)
+	for _, e := range []*Entry{
+		{EntryID: "pending", Message: message, Status: StatusPending},
+		{EntryID: "delivered", Message: message, Status: StatusDelivered},
+	} {
+		if err := outbound.Write(e); err != nil {
+			t.Fatalf("Write(%v) failed with %v", e, err)
+		}
+	}
+
+	j := newJournal(t, &fakeSender{}, outbound, deadLetters, true)
+
+	e, err := j.DeadLetter("pending")
+	if err != nil {
+		t.Fatalf("DeadLetter(%q) failed with %v", "pending", err)
+	}
+	if e == nil {
+		t.Fatalf("DeadLetter(%q) got nil, want the pending message", "pending")
+	}
+	if got, want := e.LastError, interruptedError; got != want {
+		t.Errorf("LastError got %q, want %q", got, want)
+	}
+	if got, want := deadLetters.Count(), 1; got != want {
+		t.Errorf("deadLetters.Count() got %d, want %d", got, want)
+	}
+	if got, want := outbound.Count(), 1; got != want {
+		t.Errorf("outbound.Count() got %d, want %d", got, want)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	sender := &fakeSender{err: errSend}
+	j := newJournal(t, sender, nil, nil, false)
+	if err := j.Send([]byte(message)); err == nil {
+		t.Fatal("Send() got nil error, want error")
+	}
+	entries, err := j.DeadLetters()
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("DeadLetters() got %v, %v; want one entry and no error", entries, err)
+	}
+	id := entries[0].EntryID
+
+	// The sender still fails: the message stays in the dead-letter store.
+	if err := j.Replay(id); err == nil {
+		t.Errorf("Replay(%q) got nil error, want error", id)
+	}
+	e, err := j.DeadLetter(id)
+	if err != nil || e == nil {
+		t.Fatalf("DeadLetter(%q) got %v, %v; want the entry and no error", id, e, err)
+	}
+	if got, want := e.Attempts, 2; got != want {
+		t.Errorf("Attempts got %d, want %d", got, want)
+	}
+
+	sender.err = nil
+	if err := j.Replay(id); err != nil {
+		t.Fatalf("Replay(%q) failed with %v", id, err)
+	}
+	if diff := cmp.Diff([]string{message}, sender.messages); diff != "" {
+		t.Errorf("sent messages diff (-want, +got):\n%s", diff)
+	}
+	if e, err := j.DeadLetter(id); err != nil || e != nil {
+		t.Errorf("DeadLetter(%q) got %v, %v; want <nil>, <nil>", id, e, err)
+	}
+	if err := j.Replay(id); err != ErrNotFound {
+		t.Errorf("Replay(%q) got err %v, want %v", id, err, ErrNotFound)
+	}
+}
+
+func TestDiscard(t *testing.T) {
+	sender := &fakeSender{err: errSend}
+	j := newJournal(t, sender, nil, nil, false)
+	if err := j.Send([]byte(message)); err == nil {
+		t.Fatal("Send() got nil error, want error")
+	}
+	entries, err := j.DeadLetters()
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("DeadLetters() got %v, %v; want one entry and no error", entries, err)
+	}
+	id := entries[0].EntryID
+
+	if err := j.Discard(id); err != nil {
+		t.Fatalf("Discard(%q) failed with %v", id, err)
+	}
+	if entries, err := j.DeadLetters(); err != nil || len(entries) != 0 {
+		t.Errorf("DeadLetters() got %v, %v; want no entries and no error", entries, err)
+	}
+	if err := j.Discard(id); err != ErrNotFound {
+		t.Errorf("Discard(%q) got err %v, want %v", id, err, ErrNotFound)
+	}
+	if len(sender.messages) != 0 {
+		t.Errorf("sent messages got %v, want none", sender.messages)
+	}
+}
+
+// loadCountingSyncer is a persist.ItemSyncer that counts the calls to LoadAll.
+type loadCountingSyncer struct {
+	persist.ItemSyncer
+	loads int
+}
+
+func (s *loadCountingSyncer) LoadAll() ([]persist.MarshallableItem, error) {
+	s.loads++
+	return s.ItemSyncer.LoadAll()
+}
+
+func TestDeadLetterCount(t *testing.T) {
+	outbound := teststate.NewItemSyncerWithDelete(true)
+	if err := outbound.Write(&Entry{EntryID: "pending", Message: message, Status: StatusPending}); err != nil {
+		t.Fatalf("Write() failed with %v", err)
+	}
+	deadLetters := &loadCountingSyncer{ItemSyncer: teststate.NewItemSyncerWithDelete(true)}
+	if err := deadLetters.Write(&Entry{EntryID: "dead", Message: message, Status: StatusDeadLetter}); err != nil {
+		t.Fatalf("Write() failed with %v", err)
+	}
+	sender := &fakeSender{err: errSend}
+	j := newJournal(t, sender, outbound, deadLetters, false)
+	if got, want := j.deadLetterCount, 2; got != want {
+		t.Errorf("deadLetterCount after New() got %d, want %d", got, want)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := j.Send([]byte(message)); err == nil {
+			t.Fatal("Send() got nil error, want error")
+		}
+	}
+	if got, want := j.deadLetterCount, 5; got != want {
+		t.Errorf("deadLetterCount after failed sends got %d, want %d", got, want)
+	}
+
+	// A failed replay doesn't change the count.
+	if err := j.Replay("dead"); err == nil {
+		t.Error("Replay() got nil error, want error")
+	}
+	if got, want := j.deadLetterCount, 5; got != want {
+		t.Errorf("deadLetterCount after a failed replay got %d, want %d", got, want)
+	}
+	sender.err = nil
+	if err := j.Replay("dead"); err != nil {
+		t.Fatalf("Replay() failed with %v", err)
+	}
+	if err := j.Discard("pending"); err != nil {
+		t.Fatalf("Discard() failed with %v", err)
+	}
+	if got, want := j.deadLetterCount, 3; got != want {
+		t.Errorf("deadLetterCount after replay and discard got %d, want %d", got, want)
+	}
+	// The dead-letter store is only loaded once, in New.
+	if got, want := deadLetters.loads, 1; got != want {
+		t.Errorf("deadLetters.LoadAll() called %d times, want %d", got, want)
+	}
+}
+
+func TestJournal_DirSyncerSurvivesRestart(t *testing.T) {
+	dir := testwrite.TempDir(t)
+	syncers := func() (persist.ItemSyncer, persist.ItemSyncer) {
+		t.Helper()
+		outbound, err := persist.NewDirSyncer(dir+"/outbound", EntryUnmarshaller{})
+		if err != nil {
+			t.Fatalf("NewDirSyncer() failed with %v", err)
+		}
+		deadLetters, err := persist.NewDirSyncer(dir+"/dead_letters", EntryUnmarshaller{})
+		if err != nil {
+			t.Fatalf("NewDirSyncer() failed with %v", err)
+		}
+		return outbound, deadLetters
+	}
+
+	outbound, deadLetters := syncers()
+	j := newJournal(t, &fakeSender{err: errSend}, outbound, deadLetters, false)
+	if err := j.SendWithMetadata([]byte(message), hl7.Metadata{PathwayName: "pathway1"}); err == nil {
+		t.Fatal("SendWithMetadata() got nil error, want error")
+	}
+
+	sender := &fakeSender{}
+	outbound, deadLetters = syncers()
+	j = newJournal(t, sender, outbound, deadLetters, false)
+	entries, err := j.DeadLetters()
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("DeadLetters() got %v, %v; want one entry and no error", entries, err)
+	}
+	if err := j.Replay(entries[0].EntryID); err != nil {
+		t.Fatalf("Replay(%q) failed with %v", entries[0].EntryID, err)
+	}
+	if diff := cmp.Diff([]hl7.Metadata{{PathwayName: "pathway1"}}, sender.metadata); diff != "" {
+		t.Errorf("sent metadata diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	sender := &fakeSender{err: errSend}
+	j := newJournal(t, sender, nil, nil, false)
+	for i := 0; i < 2; i++ {
+		if err := j.Send([]byte(message)); err == nil {
+			t.Fatal("Send() got nil error, want error")
+		}
+	}
+	entries, err := j.DeadLetters()
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("DeadLetters() got %v, %v; want two entries and no error", entries, err)
+	}
+	id1, id2 := entries[0].EntryID, entries[1].EntryID
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		j.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	w := serve("GET", "/deadLetters")
+	if got, want := w.Code, http.StatusOK; got != want {
+		t.Fatalf("GET status got %d, want %d", got, want)
+	}
+	var summaries []summary
+	if err := json.Unmarshal(w.Body.Bytes(), &summaries); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed with %v", w.Body.String(), err)
+	}
+	if got, want := len(summaries), 2; got != want {
+		t.Errorf("len(summaries) got %d, want %d", got, want)
+	}
+
+	w = serve("GET", "/deadLetters?id="+id1)
+	if got, want := w.Code, http.StatusOK; got != want {
+		t.Fatalf("GET id status got %d, want %d", got, want)
+	}
+	var e Entry
+	if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed with %v", w.Body.String(), err)
+	}
+	if got, want := e.Message, message; got != want {
+		t.Errorf("Message got %q, want %q", got, want)
+	}
+
+	cases := []struct {
+		method   string
+		target   string
+		wantCode int
+	}{
+		{method: "GET", target: "/deadLetters?id=unknown", wantCode: http.StatusNotFound},
+		{method: "POST", target: "/deadLetters?action=replay", wantCode: http.StatusBadRequest},
+		{method: "POST", target: "/deadLetters?id=" + id1 + "&action=unknown", wantCode: http.StatusBadRequest},
+		{method: "POST", target: "/deadLetters?id=" + id1 + "&action=replay", wantCode: http.StatusBadGateway},
+		{method: "POST", target: "/deadLetters?id=unknown&action=discard", wantCode: http.StatusNotFound},
+		{method: "DELETE", target: "/deadLetters?id=" + id2, wantCode: http.StatusOK},
+		{method: "PUT", target: "/deadLetters", wantCode: http.StatusMethodNotAllowed},
+	}
+	for _, tc := range cases {
+		t.Run(tc.method+" "+tc.target, func(t *testing.T) {
+			if got := serve(tc.method, tc.target).Code; got != tc.wantCode {
+				t.Errorf("status got %d, want %d", got, tc.wantCode)
+			}
+		})
+	}
+
+	sender.err = nil
+	w = serve("POST", "/deadLetters?id="+id1+"&action=replay")
+	if got, want := w.Code, http.StatusOK; got != want {
+		t.Errorf("POST replay status got %d, want %d; body: %s", got, want, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "replayed") {
+		t.Errorf("POST replay body got %s, want it to contain %q", w.Body.String(), "replayed")
+	}
+	if entries, err := j.DeadLetters(); err != nil || len(entries) != 0 {
+		t.Errorf("DeadLetters() got %v, %v; want no entries and no error", entries, err)
+	}
+}
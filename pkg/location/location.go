@@ -18,6 +18,8 @@ package location
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sort"
 
 	"github.com/bitcrshr/simhospital/pkg/files"
 	"github.com/bitcrshr/simhospital/pkg/ir"
@@ -28,13 +30,18 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const aAndEID = "ED"
+const (
+	aAndEID = "ED"
+	// clinicType is the location type of the clinics where appointments take place.
+	clinicType = "CLINIC"
+)
 
 var (
 	unknownLocation = "unknown location"
 	counters        struct {
 		SimulatedHospital struct {
 			OccupiedBeds *prometheus.GaugeVec `help:"Number of occupied beds" labels:"poc"`
+			HeldBeds     *prometheus.GaugeVec `help:"Number of beds held for patients on leave of absence" labels:"poc"`
 		}
 	}
 	log = logging.ForCallerPackage()
@@ -59,6 +66,9 @@ type RoomManager struct {
 	// isBedOccupied is the set of bed names that are currently occupied.
 	// If true, bed is occupied; if false, bed is not occupied.
 	isBedOccupied map[string]bool
+	// isBedHeld is the set of bed names that are held for patients who are temporarily away, e.g.,
+	// on leave of absence. Held beds are still occupied, so they are not given to other patients.
+	isBedHeld map[string]bool
 }
 
 func init() {
@@ -95,6 +105,7 @@ func NewManager(ctx context.Context, fileName string) (*Manager, error) {
 			Type:          t,
 			occupiedBeds:  0,
 			isBedOccupied: make(map[string]bool),
+			isBedHeld:     make(map[string]bool),
 		}
 		log.Infof(" - id: %s, poc: %s", n, rm.Poc)
 	}
@@ -183,6 +194,9 @@ func (m *Manager) FreeBed(pl *ir.PatientLocation) error {
 		}
 		roomManager.isBedOccupied[pl.Bed] = false
 		roomManager.occupiedBeds--
+		if roomManager.isBedHeld[pl.Bed] {
+			roomManager.setHeld(pl.Bed, false)
+		}
 		counters.SimulatedHospital.OccupiedBeds.With(prometheus.Labels{
 			"poc": pl.Poc,
 		}).Set(float64(roomManager.occupiedBeds))
@@ -192,6 +206,64 @@ func (m *Manager) FreeBed(pl *ir.PatientLocation) error {
 	return fmt.Errorf("%s: %+v", unknownLocation, pl)
 }
 
+// HoldBed holds the bed given in the patient location for the patient who occupies it while the
+// patient is away, e.g., on leave of absence. The bed stays occupied until it is freed with FreeBed.
+// It returns an error if the patient location is not an occupied bed, or if it is already held.
+func (m *Manager) HoldBed(pl *ir.PatientLocation) error {
+	roomManager, err := m.occupiedBedRoomManager(pl)
+	if err != nil {
+		return err
+	}
+	if roomManager.isBedHeld[pl.Bed] {
+		return fmt.Errorf("patient location %+v already held", pl)
+	}
+	roomManager.setHeld(pl.Bed, true)
+	return nil
+}
+
+// ReleaseHold releases the hold on the bed given in the patient location, for instance when the
+// patient returns to it. The bed stays occupied.
+// It returns an error if the bed is not held.
+func (m *Manager) ReleaseHold(pl *ir.PatientLocation) error {
+	roomManager, err := m.occupiedBedRoomManager(pl)
+	if err != nil {
+		return err
+	}
+	if !roomManager.isBedHeld[pl.Bed] {
+		return fmt.Errorf("patient location %+v is not held", pl)
+	}
+	roomManager.setHeld(pl.Bed, false)
+	return nil
+}
+
+// IsHeld returns whether the bed given in the patient location is held.
+func (m *Manager) IsHeld(pl *ir.PatientLocation) bool {
+	roomManager, err := m.occupiedBedRoomManager(pl)
+	return err == nil && roomManager.isBedHeld[pl.Bed]
+}
+
+// occupiedBedRoomManager returns the room manager of the bed given in the patient location.
+// It returns an error if the patient location is not a bed, or if the bed is not occupied.
+func (m *Manager) occupiedBedRoomManager(pl *ir.PatientLocation) (*RoomManager, error) {
+	if pl == nil || !IsBed(pl) {
+		return nil, fmt.Errorf("patient location %+v is not a bed", pl)
+	}
+	for key, roomManager := range m.RoomManagers {
+		matches, err := m.Matches(key, pl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding matching location for %+v failed", pl)
+		}
+		if !matches {
+			continue
+		}
+		if !roomManager.isBedOccupied[pl.Bed] {
+			return nil, fmt.Errorf("patient location %+v is not occupied", pl)
+		}
+		return roomManager, nil
+	}
+	return nil, fmt.Errorf("%s: %+v", unknownLocation, pl)
+}
+
 // Matches returns whether the location name matches the patient location.
 // If pl is nil, this method returns an error.
 func (m *Manager) Matches(locationName string, pl *ir.PatientLocation) (bool, error) {
@@ -205,11 +277,74 @@ func (m *Manager) Matches(locationName string, pl *ir.PatientLocation) (bool, er
 	return roomManager.equalToPatientLocation(pl), nil
 }
 
+// Clinic returns the location with the given name, without a bed.
+// Unlike beds, clinics are not occupied: several patients can have appointments in the same clinic.
+// Returns an error if the location doesn't exist.
+func (m *Manager) Clinic(locationName string) (*ir.PatientLocation, error) {
+	roomManager, ok := m.RoomManagers[locationName]
+	if !ok {
+		return nil, fmt.Errorf("%s: %s", unknownLocation, locationName)
+	}
+	return roomManager.patientLocation(), nil
+}
+
+// RandomClinic returns a random location with the clinic type. If there are no clinics, it returns
+// a random location of any type.
+func (m *Manager) RandomClinic() *ir.PatientLocation {
+	var clinics, all []string
+	for name, roomManager := range m.RoomManagers {
+		all = append(all, name)
+		if roomManager.Type == clinicType {
+			clinics = append(clinics, name)
+		}
+	}
+	if len(clinics) == 0 {
+		clinics = all
+	}
+	if len(clinics) == 0 {
+		return nil
+	}
+	// Sort the names so that the choice only depends on the random number generator, and not on the map order.
+	sort.Strings(clinics)
+	return m.RoomManagers[clinics[rand.Intn(len(clinics))]].patientLocation()
+}
+
 // OccupiedBeds returns the number of beds that are currently occupied.
 func (r *RoomManager) OccupiedBeds() int {
 	return r.occupiedBeds
 }
 
+// HeldBeds returns the number of beds that are currently held.
+func (r *RoomManager) HeldBeds() int {
+	return len(r.isBedHeld)
+}
+
+func (r *RoomManager) setHeld(bedName string, held bool) {
+	if held {
+		if r.isBedHeld == nil {
+			r.isBedHeld = make(map[string]bool)
+		}
+		r.isBedHeld[bedName] = true
+	} else {
+		delete(r.isBedHeld, bedName)
+	}
+	counters.SimulatedHospital.HeldBeds.With(prometheus.Labels{
+		"poc": r.Poc,
+	}).Set(float64(len(r.isBedHeld)))
+	log.Debugf("Held beds in %s: %d", r.Poc, len(r.isBedHeld))
+}
+
+func (r *RoomManager) patientLocation() *ir.PatientLocation {
+	return &ir.PatientLocation{
+		Poc:          r.Poc,
+		Room:         r.Room,
+		Facility:     r.Facility,
+		LocationType: r.Type,
+		Building:     r.Building,
+		Floor:        r.Floor,
+	}
+}
+
 func (r *RoomManager) equalToPatientLocation(pl *ir.PatientLocation) bool {
 	return r.Poc == pl.Poc && r.Facility == pl.Facility &&
 		r.Building == pl.Building && r.Floor == pl.Floor && r.Room == pl.Room
@@ -400,6 +400,75 @@ func TestManagerFreeBedError(t *testing.T) {
 	}
 }
 
+func TestManagerHoldBed(t *testing.T) {
+	ctx := context.Background()
+	locationManager := testlocation.NewLocationManager(ctx, t, aAndEID)
+
+	// Beds that are not occupied cannot be held.
+	if err := locationManager.HoldBed(aAndEBed1); err == nil {
+		t.Errorf("HoldBed(%+v) got nil err, want not nil error", aAndEBed1)
+	}
+
+	got, err := locationManager.OccupyAvailableBed(aAndEID)
+	if err != nil {
+		t.Fatalf("OccupyAvailableBed(%s) failed with %v", aAndEID, err)
+	}
+	if err := locationManager.HoldBed(got); err != nil {
+		t.Fatalf("HoldBed(%+v) failed with %v", got, err)
+	}
+	if !locationManager.IsHeld(got) {
+		t.Errorf("IsHeld(%+v) got false, want true", got)
+	}
+	if gotCount, wantCount := locationManager.RoomManagers[aAndEID].HeldBeds(), 1; gotCount != wantCount {
+		t.Errorf("RoomManagers[%s].HeldBeds()=%d, want %d", aAndEID, gotCount, wantCount)
+	}
+	// Held beds are still occupied.
+	if gotCount, wantCount := locationManager.RoomManagers[aAndEID].OccupiedBeds(), 1; gotCount != wantCount {
+		t.Errorf("RoomManagers[%s].OccupiedBeds()=%d, want %d", aAndEID, gotCount, wantCount)
+	}
+	if _, err := locationManager.OccupySpecificBed(aAndEID, got.Bed); err == nil {
+		t.Errorf("OccupySpecificBed(%s, %s) got nil err, want not nil error", aAndEID, got.Bed)
+	}
+	if err := locationManager.HoldBed(got); err == nil {
+		t.Errorf("HoldBed(%+v) for a held bed got nil err, want not nil error", got)
+	}
+
+	if err := locationManager.ReleaseHold(got); err != nil {
+		t.Fatalf("ReleaseHold(%+v) failed with %v", got, err)
+	}
+	if locationManager.IsHeld(got) {
+		t.Errorf("IsHeld(%+v) got true, want false", got)
+	}
+	if err := locationManager.ReleaseHold(got); err == nil {
+		t.Errorf("ReleaseHold(%+v) for a bed that is not held got nil err, want not nil error", got)
+	}
+	if gotCount, wantCount := locationManager.RoomManagers[aAndEID].OccupiedBeds(), 1; gotCount != wantCount {
+		t.Errorf("RoomManagers[%s].OccupiedBeds()=%d, want %d", aAndEID, gotCount, wantCount)
+	}
+}
+
+func TestManagerFreeBedReleasesHold(t *testing.T) {
+	ctx := context.Background()
+	locationManager := testlocation.NewLocationManager(ctx, t, aAndEID)
+
+	got, err := locationManager.OccupyAvailableBed(aAndEID)
+	if err != nil {
+		t.Fatalf("OccupyAvailableBed(%s) failed with %v", aAndEID, err)
+	}
+	if err := locationManager.HoldBed(got); err != nil {
+		t.Fatalf("HoldBed(%+v) failed with %v", got, err)
+	}
+	if err := locationManager.FreeBed(got); err != nil {
+		t.Fatalf("FreeBed(%+v) failed with %v", got, err)
+	}
+	if locationManager.IsHeld(got) {
+		t.Errorf("IsHeld(%+v) got true, want false", got)
+	}
+	if gotCount, wantCount := locationManager.RoomManagers[aAndEID].HeldBeds(), 0; gotCount != wantCount {
+		t.Errorf("RoomManagers[%s].HeldBeds()=%d, want %d", aAndEID, gotCount, wantCount)
+	}
+}
+
 func TestManagerGetAAndELocation(t *testing.T) {
 	ctx := context.Background()
 	cases := []struct {
@@ -506,6 +575,65 @@ func TestManagerMatches(t *testing.T) {
 	}
 }
 
+func TestManagerClinic(t *testing.T) {
+	ctx := context.Background()
+	manager := testlocation.NewLocationManager(ctx, t, aAndEID)
+	want := &ir.PatientLocation{
+		Poc:          aAndEID,
+		Facility:     "Simulated Hospital",
+		Building:     "Building-1",
+		Floor:        "7",
+		Room:         "Room-1",
+		LocationType: "BED",
+	}
+	got, err := manager.Clinic(aAndEID)
+	if err != nil {
+		t.Fatalf("Clinic(%q) failed with %v", aAndEID, err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Clinic(%q) got diff (-want, +got):\n%s", aAndEID, diff)
+	}
+	if _, err := manager.Clinic("Renal"); err == nil {
+		t.Errorf("Clinic(%q) got nil error, want error", "Renal")
+	}
+	// Several patients can have appointments in the same clinic, so the location is still available.
+	if _, err := manager.OccupyAvailableBed(aAndEID); err != nil {
+		t.Errorf("OccupyAvailableBed(%q) failed with %v", aAndEID, err)
+	}
+}
+
+func TestManagerRandomClinic(t *testing.T) {
+	clinic := &RoomManager{Poc: "Clinic", Facility: "Simulated Hospital", Type: "CLINIC"}
+	ward := &RoomManager{Poc: "Ward", Facility: "Simulated Hospital", Type: "BED"}
+	cases := []struct {
+		name    string
+		manager *Manager
+		want    *ir.PatientLocation
+	}{
+		{
+			name:    "clinics are preferred",
+			manager: &Manager{RoomManagers: map[string]*RoomManager{"Clinic": clinic, "Ward": ward}},
+			want:    &ir.PatientLocation{Poc: "Clinic", Facility: "Simulated Hospital", LocationType: "CLINIC"},
+		}, {
+			name:    "any location if there are no clinics",
+			manager: &Manager{RoomManagers: map[string]*RoomManager{"Ward": ward}},
+			want:    &ir.PatientLocation{Poc: "Ward", Facility: "Simulated Hospital", LocationType: "BED"},
+		}, {
+			name:    "no locations",
+			manager: &Manager{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.manager.RandomClinic()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RandomClinic() got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsBed(t *testing.T) {
 	cases := []struct {
 		name string
@@ -0,0 +1,146 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package medication is responsible for parsing the catalogue of medications that can be prescribed.
+package medication
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/pkg/errors"
+)
+
+var log = logging.ForCallerPackage()
+
+// Medications contains the medications that can be prescribed.
+type Medications struct {
+	// m is a map of Medications keyed by their names.
+	m map[string]*Medication
+	// names is a sorted slice of all Medication names.
+	names []string
+}
+
+// Medication contains the details of a medication, and the default values of the orders for it.
+type Medication struct {
+	// Code identifies the medication. Its Text is the name of the medication.
+	Code       ir.CodedElement
+	Dose       string
+	DoseUnits  string
+	DosageForm string
+	Route      string
+	// Frequency is the interval between doses, e.g. Q6H.
+	Frequency      string
+	DispenseAmount string
+	DispenseUnits  string
+	// Components are the components of compound medications, e.g. the base and the additives of an
+	// IV solution.
+	Components []*ir.MedicationComponent
+}
+
+// New returns a new Medications from a medications map.
+func New(m map[string]*Medication) *Medications {
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return &Medications{m: m, names: names}
+}
+
+// Get returns the Medication with the given name.
+func (m *Medications) Get(name string) (*Medication, bool) {
+	v, ok := m.m[name]
+	return v, ok
+}
+
+// Random returns a random Medication, or nil if there are no medications.
+func (m *Medications) Random() *Medication {
+	if len(m.names) == 0 {
+		return nil
+	}
+	return m.m[m.names[rand.Intn(len(m.names))]]
+}
+
+type component struct {
+	Type         string
+	ID           string
+	Text         string
+	CodingSystem string `yaml:"coding_system"`
+	Amount       string
+	Units        string
+}
+
+type medication struct {
+	ID             string
+	CodingSystem   string `yaml:"coding_system"`
+	Dose           string
+	DoseUnits      string `yaml:"dose_units"`
+	DosageForm     string `yaml:"dosage_form"`
+	Route          string
+	Frequency      string
+	DispenseAmount string `yaml:"dispense_amount"`
+	DispenseUnits  string `yaml:"dispense_units"`
+	Components     []component
+}
+
+// Load parses the medications from the given file.
+// The coding system of the medications defaults to the coding system in the HL7 configuration.
+func Load(ctx context.Context, filename string, hl7Config *config.HL7Config) (*Medications, error) {
+	parsed := map[string]medication{}
+	if err := config.LoadYAML(ctx, filename, "medications", &parsed); err != nil {
+		return nil, err
+	}
+
+	medications := map[string]*Medication{}
+	log.Info("Loading medications")
+	for name, v := range parsed {
+		if v.ID == "" {
+			return nil, errors.Errorf("medication %q in %s: id is required", name, filename)
+		}
+		var components []*ir.MedicationComponent
+		for _, c := range v.Components {
+			components = append(components, &ir.MedicationComponent{
+				Type:   c.Type,
+				Code:   &ir.CodedElement{ID: c.ID, Text: c.Text, CodingSystem: codingSystem(c.CodingSystem, hl7Config)},
+				Amount: c.Amount,
+				Units:  c.Units,
+			})
+		}
+		medications[name] = &Medication{
+			Code:           ir.CodedElement{ID: v.ID, Text: name, CodingSystem: codingSystem(v.CodingSystem, hl7Config)},
+			Dose:           v.Dose,
+			DoseUnits:      v.DoseUnits,
+			DosageForm:     v.DosageForm,
+			Route:          v.Route,
+			Frequency:      v.Frequency,
+			DispenseAmount: v.DispenseAmount,
+			DispenseUnits:  v.DispenseUnits,
+			Components:     components,
+		}
+		log.Infof(" - %s", name)
+	}
+	return New(medications), nil
+}
+
+func codingSystem(cs string, hl7Config *config.HL7Config) string {
+	if cs != "" {
+		return cs
+	}
+	return hl7Config.CodingSystem
+}
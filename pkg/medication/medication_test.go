@@ -0,0 +1,116 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package medication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+var hl7Config = &config.HL7Config{CodingSystem: "WinPath"}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	m, err := Load(ctx, test.MedicationsConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("Load(%s, %+v) failed with %v", test.MedicationsConfigTest, hl7Config, err)
+	}
+
+	tests := []struct {
+		name string
+		want *Medication
+	}{{
+		name: "Paracetamol",
+		want: &Medication{
+			Code:           ir.CodedElement{ID: "med-1", Text: "Paracetamol", CodingSystem: "WinPath"},
+			Dose:           "1000",
+			DoseUnits:      "mg",
+			DosageForm:     "TAB",
+			Route:          "PO",
+			Frequency:      "Q6H",
+			DispenseAmount: "32",
+			DispenseUnits:  "TAB",
+		},
+	}, {
+		name: "Saline with potassium",
+		want: &Medication{
+			Code:           ir.CodedElement{ID: "med-2", Text: "Saline with potassium", CodingSystem: "LOCAL"},
+			Dose:           "1000",
+			DoseUnits:      "ml",
+			DosageForm:     "SOL",
+			Route:          "IV",
+			Frequency:      "Q8H",
+			DispenseAmount: "3",
+			DispenseUnits:  "BAG",
+			Components: []*ir.MedicationComponent{{
+				Type:   "B",
+				Code:   &ir.CodedElement{ID: "med-2-1", Text: "Saline", CodingSystem: "WinPath"},
+				Amount: "1000",
+				Units:  "ml",
+			}, {
+				Type:   "A",
+				Code:   &ir.CodedElement{ID: "med-2-2", Text: "Potassium chloride", CodingSystem: "WinPath"},
+				Amount: "20",
+				Units:  "mmol",
+			}},
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := m.Get(tc.name)
+			if !ok {
+				t.Fatalf("Get(%q) got ok=false, want true", tc.name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Get(%q) got diff (-want, +got):\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	test.CheckLoadFails(t, func(fName string) error {
+		_, err := Load(context.Background(), fName, hl7Config)
+		return err
+	}, []test.InvalidConfig{{
+		Name: "Missing ID",
+		Content: `
+Paracetamol:
+  dose: '1000'`,
+	}, {
+		Name: "Unknown field",
+		Content: `
+Paracetamol:
+  id: med-1
+  strength: '500'`,
+	}})
+}
+
+func TestRandom(t *testing.T) {
+	if got := New(nil).Random(); got != nil {
+		t.Errorf("New(nil).Random() got %+v, want <nil>", got)
+	}
+
+	paracetamol := &Medication{Code: ir.CodedElement{ID: "med-1", Text: "Paracetamol"}}
+	m := New(map[string]*Medication{"Paracetamol": paracetamol})
+	if got := m.Random(); got != paracetamol {
+		t.Errorf("Random() got %+v, want %+v", got, paracetamol)
+	}
+}
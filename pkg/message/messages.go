@@ -17,6 +17,7 @@ package message
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -37,10 +38,28 @@ const (
 	ORM = "ORM"
 	// ORR represents an ORR HL7v2 message.
 	ORR = "ORR"
+	// OML represents an OML HL7v2 message.
+	OML = "OML"
+	// ORL represents an ORL HL7v2 message.
+	ORL = "ORL"
 	// ORU represents an ORU HL7v2 message.
 	ORU = "ORU"
 	// MDM represents an MDM HL7v2 message.
 	MDM = "MDM"
+	// SIU represents an SIU HL7v2 message.
+	SIU = "SIU"
+	// RDE represents an RDE HL7v2 message.
+	RDE = "RDE"
+	// RDS represents an RDS HL7v2 message.
+	RDS = "RDS"
+	// RAS represents an RAS HL7v2 message.
+	RAS = "RAS"
+	// VXU represents a VXU HL7v2 message.
+	VXU = "VXU"
+	// DFT represents a DFT HL7v2 message.
+	DFT = "DFT"
+	// BAR represents a BAR HL7v2 message.
+	BAR = "BAR"
 )
 
 // DiagnosticServIDMDOC is the value of the Diagnostic Serv ID field (OBR_24) for clinical documents.
@@ -48,6 +67,14 @@ const DiagnosticServIDMDOC = "MDOC"
 
 // SegmentTerminator is the string used to terminate segments in HL7v2 messages.
 const SegmentTerminator = constants.SegmentTerminatorStr
+
+const (
+	// defaultVersion is the HL7v2 version of the messages, sent in the MSH.12-Version ID field.
+	defaultVersion = "2.3"
+	// labOrderVersion is the HL7v2 version of the OML^O21 and ORL^O22 messages, which were
+	// introduced after the default version.
+	labOrderVersion = "2.5.1"
+)
 const (
 	listItemsSeparator           = "~"
 	componentSeparator           = "^"
@@ -91,6 +118,7 @@ var (
 		"expand_mrns":  expandMRNs,
 		"HL7_unit":     toHL7Unit,
 		"escape_HL7":   escapeHL7,
+		"HL7_money":    toHL7Money,
 	}
 )
 
@@ -137,6 +165,10 @@ func toHL7Unit(s string) string {
 	return strings.Replace(s, componentSeparator, escapedComponentSeparator, -1)
 }
 
+func toHL7Money(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
 func escapeHL7(s string) string {
 	r := strings.NewReplacer(
 		componentSeparator, escapedComponentSeparator,
@@ -156,6 +188,7 @@ const (
 	ORC             = "ORC"
 	OBR             = "OBR"
 	OBRClinicalNote = "OBRClinicalNote"
+	OBRChild        = "OBRChild"
 	OBX             = "OBX"
 	OBXClinicalNote = "OBXClinicalNote"
 	OBXForMDM       = "OBXForMDM"
@@ -169,6 +202,26 @@ const (
 	PD1             = "PD1"
 	PR1             = "PR1"
 	TXA             = "TXA"
+	SCH             = "SCH"
+	RGS             = "RGS"
+	AIS             = "AIS"
+	AIL             = "AIL"
+	AIP             = "AIP"
+	ORCMedication   = "ORCMedication"
+	RXE             = "RXE"
+	RXR             = "RXR"
+	RXC             = "RXC"
+	RXD             = "RXD"
+	RXA             = "RXA"
+	ORCVaccination  = "ORCVaccination"
+	RXAVaccination  = "RXAVaccination"
+	RXRVaccination  = "RXRVaccination"
+	FT1             = "FT1"
+	GT1             = "GT1"
+	IN1             = "IN1"
+	IN2             = "IN2"
+	TQ1             = "TQ1"
+	SPM             = "SPM"
 )
 
 const (
@@ -180,10 +233,12 @@ const (
 	ceTemplate            = "CETmpl"
 	ceNoteTemplate        = "CENoteTmpl"
 	ceAdmitReasonTemplate = "CEAdmitReasonTmpl"
+	ceEventReasonTemplate = "CEEventReasonTmpl"
 	cxVisitTemplate       = "CXVisitTmpl"
 	cxMRNTemplate         = "CXMRNTmpl"
 	primFacTemplate       = "PrimFacTmpl"
 	noteTemplate          = "NoteTmpl"
+	parentResultTemplate  = "ParentResultTmpl"
 )
 
 var (
@@ -215,6 +270,8 @@ var (
 	ceNoteTmpl = "{{.DocumentType}}^{{.DocumentType}}"
 	// ceAdmitReasonTmpl is CE template for Admit Reason in PV2.3 field.
 	ceAdmitReasonTmpl = "^{{.}}"
+	// ceEventReasonTmpl is the CE template for the Event Reason in the SCH.6 field.
+	ceEventReasonTmpl = "^{{escape_HL7 .}}"
 
 	// primFacTmpl represents the data type XON: Extended Composite Name And Identification Number For Organizations
 	// http://hl7-definition.caristix.com:9010/HL7%20v2.3.1/segment/PD1?version=HL7%20v2.3.1&dataType=XON
@@ -225,6 +282,10 @@ var (
 	cxVisitTmpl = "{{.}}^^^^visitid"
 	// cxMRNTmpl is the template for MRNs.
 	cxMRNTmpl = "{{.MRN}}^^^SIMULATOR MRN^MRN"
+	// parentResultTmpl represents the OBR.Parent Result field: the observation identifier, sub-ID
+	// and value of the parent result.
+	// https://hl7-definition.caristix.com/v2/HL7v2.3/Fields/OBR.26
+	parentResultTmpl = "{{with .TestName}}{{escape_HL7 .ID}}&{{escape_HL7 .Text}}&{{.CodingSystem}}{{end}}^{{.SubID}}^{{if .CodedValue}}{{escape_HL7 .CodedValue.Text}}{{else}}{{escape_HL7 .Value}}{{end}}"
 	// stOBXNoteVal is the template for the OBX.Observation Value for documents.
 	stOBXNoteVal = "^^{{.ContentType}}^{{.DocumentEncoding}}^{{escape_HL7 .DocumentContent}}"
 
@@ -232,7 +293,7 @@ var (
 )
 
 var templates = map[string]*template.Template{
-	MSH: mustParseTemplate(MSH, "MSH|^~\\&|{{.Header.SendingApplication}}|{{.Header.SendingFacility}}|{{.Header.ReceivingApplication}}|{{.Header.ReceivingFacility}}|{{HL7_date .T}}||{{.MsgType.MessageType}}^{{.MsgType.TriggerEvent}}|{{.Header.MessageControlID}}|T|2.3|||AL||44|ASCII"),
+	MSH: mustParseTemplate(MSH, "MSH|^~\\&|{{.Header.SendingApplication}}|{{.Header.SendingFacility}}|{{.Header.ReceivingApplication}}|{{.Header.ReceivingFacility}}|{{HL7_date .T}}||{{.MsgType.MessageType}}^{{.MsgType.TriggerEvent}}|{{.Header.MessageControlID}}|T|{{.Version}}|||AL||44|ASCII"),
 	MSA: mustParseTemplate(MSA, "MSA|AA|{{.OrderMessageControlID}}"),
 	EVN: mustParseTemplates(EVN, map[string]string{
 		doctorTemplate: doctorTmpl,
@@ -244,15 +305,26 @@ var templates = map[string]*template.Template{
 		homeNumberTemplate: homeNumberTmpl,
 		ceTemplate:         ceTmpl,
 		cxMRNTemplate:      cxMRNTmpl,
-		PID:                `PID|1|{{template "CXMRNTmpl" .}}|{{template "CXMRNTmpl" .}}~{{.NHS}}^^^NHSNBR^NHSNMBR||{{template "PersonNameTmpl" .}}||{{HL7_date .Birth}}|{{.Gender}}|||{{template "AddressTmpl" .Address}}||{{template "HomeNumberTmpl" .PhoneNumber}}|||||||||{{template "CETmpl" .Ethnicity}}|||||||{{HL7_date .DateOfDeath}}|{{.DeathIndicator}}`,
+		PID:                `PID|1|{{template "CXMRNTmpl" .}}|{{template "CXMRNTmpl" .}}~{{.NHS}}^^^NHSNBR^NHSNMBR||{{template "PersonNameTmpl" .}}||{{HL7_date .Birth}}|{{.Gender}}|||{{template "AddressTmpl" .Address}}||{{template "HomeNumberTmpl" .PhoneNumber}}|||||{{.AccountNumber}}||||{{template "CETmpl" .Ethnicity}}|||||||{{HL7_date .DateOfDeath}}|{{.DeathIndicator}}`,
 	}),
 	MRG: mustParseTemplate(MRG, "MRG|{{expand_mrns .MRNs}}|"),
 	ORC: mustParseTemplate(ORC, "ORC|{{.OrderControl}}|{{.Placer}}|{{.Filler}}||{{.OrderStatus}}||||{{HL7_date .OrderDateTime}}"),
+	TQ1: mustParseTemplate(TQ1, "TQ1|1||||||{{HL7_date .OrderDateTime}}||{{.Priority}}"),
+	SPM: mustParseTemplates(SPM, map[string]string{
+		ceTemplate: ceTmpl,
+		SPM:        `SPM|1|{{.Placer}}^{{.Filler}}||{{template "CETmpl" .Specimen.Type}}|||{{template "CETmpl" .Specimen.CollectionMethod}}|{{template "CETmpl" .Specimen.BodySite}}|||||||||{{HL7_date .Specimen.CollectedDateTime}}|{{HL7_date .Specimen.ReceivedDateTime}}|||||||||{{template "CETmpl" .Specimen.Container}}`,
+	}),
 	OBR: mustParseTemplates(OBR, map[string]string{
 		ceTemplate:     ceTmpl,
 		doctorTemplate: doctorTmpl,
 		OBR:            `OBR|1|{{.Placer}}|{{.Filler}}|{{template "CETmpl" .OrderProfile}}||{{HL7_date .OrderDateTime}}|{{HL7_date .CollectedDateTime}}|||||||{{HL7_date .ReceivedInLabDateTime}}|{{.SpecimenSource}}|{{template "DoctorTmpl" .OrderingProvider}}||||||{{HL7_date .ReportedDateTime}}||{{.DiagnosticServID}}|{{.ResultsStatus}}||1`,
 	}),
+	OBRChild: mustParseTemplates(OBR, map[string]string{
+		ceTemplate:           ceTmpl,
+		doctorTemplate:       doctorTmpl,
+		parentResultTemplate: parentResultTmpl,
+		OBR:                  `OBR|{{.SetID}}|{{.Placer}}|{{.Filler}}|{{template "CETmpl" .Child.UniversalService}}||{{HL7_date .OrderDateTime}}|{{HL7_date .CollectedDateTime}}|||||||{{HL7_date .ReceivedInLabDateTime}}|{{.SpecimenSource}}|{{template "DoctorTmpl" .OrderingProvider}}||||||{{HL7_date .ReportedDateTime}}||{{.DiagnosticServID}}|{{.ResultsStatus}}|{{template "ParentResultTmpl" .Child.ParentResult}}|1||{{.Placer}}^{{.Filler}}`,
+	}),
 	OBRClinicalNote: mustParseTemplates(OBR, map[string]string{
 		ceTemplate:     ceTmpl,
 		doctorTemplate: doctorTmpl,
@@ -260,7 +332,7 @@ var templates = map[string]*template.Template{
 	}),
 	OBX: mustParseTemplates(OBX, map[string]string{
 		ceTemplate: ceTmpl,
-		OBX:        `OBX|{{.ID}}|{{.ValueType}}|{{template "CETmpl" .TestName}}||{{HL7_repeated .Value}}|{{HL7_unit .Unit}}|{{escape_HL7 .Range}}|{{.AbnormalFlag}}|||{{.Status}}|||{{HL7_date .ObservationDateTime}}||`,
+		OBX:        `OBX|{{.ID}}|{{.ValueType}}|{{template "CETmpl" .TestName}}|{{.SubID}}|{{if .CodedValue}}{{template "CETmpl" .CodedValue}}{{else}}{{HL7_repeated .Value}}{{end}}|{{HL7_unit .Unit}}|{{escape_HL7 .Range}}|{{.AbnormalFlag}}|||{{.Status}}|||{{HL7_date .ObservationDateTime}}||`,
 	}),
 	OBXClinicalNote: mustParseTemplates(OBX, map[string]string{
 		ceNoteTemplate: ceNoteTmpl,
@@ -281,7 +353,7 @@ var templates = map[string]*template.Template{
 	PV2: mustParseTemplates(PV2, map[string]string{
 		locationTemplate:      locationTmpl,
 		ceAdmitReasonTemplate: ceAdmitReasonTmpl,
-		PV2:                   `PV2|{{template "LocationTmpl" .PriorPendingLocation}}||{{template "CEAdmitReasonTmpl" .AdmitReason}}|||||{{HL7_date .ExpectedAdmitDateTime}}|{{HL7_date .ExpectedDischargeDateTime}}`,
+		PV2:                   `PV2|{{template "LocationTmpl" .PriorPendingLocation}}||{{template "CEAdmitReasonTmpl" .AdmitReason}}|||||{{HL7_date .ExpectedAdmitDateTime}}|{{HL7_date .ExpectedDischargeDateTime}}{{if .ExpectedLOAReturnDateTime.Valid}}||||||||||||||||||||||||||||||||||||||{{HL7_date .ExpectedLOAReturnDateTime}}{{end}}`,
 	}),
 	NK1: mustParseTemplates(NK1, map[string]string{
 		personNameTemplate: personNameTmpl,
@@ -313,6 +385,77 @@ var templates = map[string]*template.Template{
 		doctorTemplate: doctorTmpl,
 		TXA:            `TXA|1|{{.DocumentType}}||{{HL7_date .ActivityDateTime}}|{{template "DoctorTmpl" .AttendingDoctor}}|||{{HL7_date .EditDateTime}}||||{{.UniqueDocumentNumber}}|||||{{.DocumentCompletionStatus}}||||||`,
 	}),
+	SCH: mustParseTemplates(SCH, map[string]string{
+		ceTemplate:            ceTmpl,
+		ceEventReasonTemplate: ceEventReasonTmpl,
+		doctorTemplate:        doctorTmpl,
+		SCH:                   `SCH|{{.PlacerAppointmentID}}|{{.FillerAppointmentID}}||||{{template "CEEventReasonTmpl" .EventReason}}|{{template "CETmpl" .AppointmentReason}}|{{template "CETmpl" .AppointmentType}}|{{.DurationMinutes}}|MIN|^^{{.DurationMinutes}}^{{HL7_date .Start}}^{{HL7_date .End}}|||||{{template "DoctorTmpl" .Doctor}}||||{{template "DoctorTmpl" .Doctor}}|||||{{.FillerStatus}}`,
+	}),
+	RGS: mustParseTemplate(RGS, `RGS|{{.ID}}|{{.SegmentActionCode}}`),
+	AIS: mustParseTemplates(AIS, map[string]string{
+		ceTemplate: ceTmpl,
+		AIS:        `AIS|{{.ID}}|{{.SegmentActionCode}}|{{template "CETmpl" .Service}}|{{HL7_date .Start}}|||{{.DurationMinutes}}|MIN||{{.FillerStatus}}`,
+	}),
+	AIL: mustParseTemplates(AIL, map[string]string{
+		locationTemplate: locationTmpl,
+		AIL:              `AIL|{{.ID}}|{{.SegmentActionCode}}|{{template "LocationTmpl" .Location}}|{{if .Location}}^{{.Location.LocationType}}{{end}}||{{HL7_date .Start}}|||{{.DurationMinutes}}|MIN||{{.FillerStatus}}`,
+	}),
+	AIP: mustParseTemplates(AIP, map[string]string{
+		doctorTemplate: doctorTmpl,
+		AIP:            `AIP|{{.ID}}|{{.SegmentActionCode}}|{{template "DoctorTmpl" .Doctor}}|{{if .Doctor}}^{{.Doctor.Specialty}}{{end}}||{{HL7_date .Start}}|||{{.DurationMinutes}}|MIN||{{.FillerStatus}}`,
+	}),
+	ORCMedication: mustParseTemplates(ORC, map[string]string{
+		doctorTemplate:        doctorTmpl,
+		ceEventReasonTemplate: ceEventReasonTmpl,
+		ORC:                   `ORC|{{.OrderControl}}|{{.Placer}}|{{.Filler}}||{{.OrderStatus}}||||{{HL7_date .OrderDateTime}}|||{{template "DoctorTmpl" .Prescriber}}||||{{template "CEEventReasonTmpl" .DiscontinueReason}}`,
+	}),
+	RXE: mustParseTemplates(RXE, map[string]string{
+		ceTemplate: ceTmpl,
+		RXE:        `RXE|^{{.Frequency}}|{{template "CETmpl" .Medication}}|{{.Dose}}||{{.DoseUnits}}|{{.DosageForm}}||||{{.DispenseAmount}}|{{.DispenseUnits}}||||{{.Filler}}`,
+	}),
+	RXR: mustParseTemplate(RXR, `RXR|{{.}}`),
+	RXC: mustParseTemplates(RXC, map[string]string{
+		ceTemplate: ceTmpl,
+		RXC:        `RXC|{{.Type}}|{{template "CETmpl" .Code}}|{{.Amount}}|{{.Units}}`,
+	}),
+	RXD: mustParseTemplates(RXD, map[string]string{
+		ceTemplate: ceTmpl,
+		RXD:        `RXD|{{.ID}}|{{template "CETmpl" .Medication}}|{{HL7_date .DateTime}}|{{.Amount}}|{{.Units}}|{{.DosageForm}}|{{.PrescriptionNumber}}`,
+	}),
+	RXA: mustParseTemplates(RXA, map[string]string{
+		ceTemplate: ceTmpl,
+		RXA:        `RXA|0|{{.ID}}|{{HL7_date .DateTime}}|{{HL7_date .DateTime}}|{{template "CETmpl" .Medication}}|{{.Dose}}|{{.DoseUnits}}|{{.DosageForm}}||||||||||||{{.CompletionStatus}}`,
+	}),
+	ORCVaccination: mustParseTemplates(ORC, map[string]string{
+		doctorTemplate: doctorTmpl,
+		ORC:            `ORC|RE|{{.Placer}}|{{.Filler}}||||||{{HL7_date .DateTime}}|||{{template "DoctorTmpl" .Administrator}}`,
+	}),
+	RXAVaccination: mustParseTemplates(RXA, map[string]string{
+		ceTemplate:     ceTmpl,
+		doctorTemplate: doctorTmpl,
+		RXA:            `RXA|0|1|{{HL7_date .DateTime}}|{{HL7_date .DateTime}}|{{template "CETmpl" .Vaccine}}|{{.Dose}}|{{.DoseUnits}}|||{{template "DoctorTmpl" .Administrator}}|||||{{.LotNumber}}||{{template "CETmpl" .Manufacturer}}|||{{.CompletionStatus}}`,
+	}),
+	RXRVaccination: mustParseTemplate(RXR, `RXR|{{.Route}}|{{.Site}}`),
+	FT1: mustParseTemplates(FT1, map[string]string{
+		ceTemplate:       ceTmpl,
+		locationTemplate: locationTmpl,
+		doctorTemplate:   doctorTmpl,
+		FT1:              `FT1|{{.SetID}}|{{.ID}}||{{HL7_date .TransactionDateTime}}|{{HL7_date .PostingDateTime}}|{{.TransactionType}}|{{template "CETmpl" .Code}}|{{escape_HL7 .Code.Text}}||{{.Quantity}}|{{HL7_money .ExtendedAmount}}|{{HL7_money .UnitAmount}}||||{{template "LocationTmpl" .Location}}||||{{template "DoctorTmpl" .PerformedBy}}|{{template "DoctorTmpl" .OrderedBy}}||{{.FillerOrderNumber}}||{{template "CETmpl" .Procedure}}`,
+	}),
+	GT1: mustParseTemplates(GT1, map[string]string{
+		personNameTemplate: personNameTmpl,
+		addressTemplate:    addressTmpl,
+		homeNumberTemplate: homeNumberTmpl,
+		ceTemplate:         ceTmpl,
+		GT1:                `GT1|{{.ID}}||{{template "PersonNameTmpl" .}}||{{template "AddressTmpl" .Address}}|{{template "HomeNumberTmpl" .PhoneNumber}}||{{HL7_date .Birth}}|{{.Gender}}||{{template "CETmpl" .Relationship}}`,
+	}),
+	IN1: mustParseTemplates(IN1, map[string]string{
+		personNameTemplate: personNameTmpl,
+		addressTemplate:    addressTmpl,
+		ceTemplate:         ceTmpl,
+		IN1:                `IN1|{{.ID}}|{{template "CETmpl" .Plan}}|{{.CompanyID}}|{{escape_HL7 .CompanyName}}||||{{.GroupNumber}}||||{{HL7_date .EffectiveDate}}|||{{.PlanType}}|{{with .Insured}}{{template "PersonNameTmpl" .}}|{{template "CETmpl" .Relationship}}|{{HL7_date .Birth}}|{{template "AddressTmpl" .Address}}{{else}}|||{{end}}|||||||||||||||||{{.PolicyNumber}}`,
+	}),
+	IN2: mustParseTemplate(IN2, `IN2|||||||||||||||||||||||||||||||||||||||||||||||||||||||||||||{{.MemberNumber}}`),
 }
 
 // BuildDocumentNotificationMDMT02 builds and returns a HL7 MDM^T02 message.
@@ -362,7 +505,427 @@ func BuildDocumentNotificationMDMT02(h *HeaderInfo, p *ir.PatientInfo, d *ir.Doc
 	}, nil
 }
 
+// BuildNewAppointmentSIUS12 builds and returns a HL7 SIU^S12 message.
+func BuildNewAppointmentSIUS12(h *HeaderInfo, p *ir.PatientInfo, a *ir.Appointment, msgTime time.Time) (*HL7Message, error) {
+	return buildSIU(h, p, a, msgTime, "S12", segmentActionAdd)
+}
+
+// BuildRescheduleAppointmentSIUS13 builds and returns a HL7 SIU^S13 message.
+func BuildRescheduleAppointmentSIUS13(h *HeaderInfo, p *ir.PatientInfo, a *ir.Appointment, msgTime time.Time) (*HL7Message, error) {
+	return buildSIU(h, p, a, msgTime, "S13", segmentActionUpdate)
+}
+
+// BuildCancelAppointmentSIUS15 builds and returns a HL7 SIU^S15 message.
+func BuildCancelAppointmentSIUS15(h *HeaderInfo, p *ir.PatientInfo, a *ir.Appointment, msgTime time.Time) (*HL7Message, error) {
+	return buildSIU(h, p, a, msgTime, "S15", segmentActionDelete)
+}
+
+// BuildNoShowSIUS26 builds and returns a HL7 SIU^S26 message.
+func BuildNoShowSIUS26(h *HeaderInfo, p *ir.PatientInfo, a *ir.Appointment, msgTime time.Time) (*HL7Message, error) {
+	return buildSIU(h, p, a, msgTime, "S26", segmentActionUpdate)
+}
+
+// Values for the Segment Action Code field of the RGS, AIS, AIL and AIP segments.
+// http://hl7-definition.caristix.com:9010/HL7%20v2.3.1/Default.aspx?version=HL7%20v2.3.1&table=0206
+const (
+	segmentActionAdd    = "A"
+	segmentActionDelete = "D"
+	segmentActionUpdate = "U"
+)
+
+func buildSIU(h *HeaderInfo, p *ir.PatientInfo, a *ir.Appointment, msgTime time.Time, triggerEvent string, action string) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  SIU,
+		TriggerEvent: triggerEvent,
+	}
+
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	sch, err := BuildSCH(a)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build SCH segment")
+	}
+	segments = append(segments, sch)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	for _, build := range []func(int, *ir.Appointment, string) (string, error){BuildRGS, BuildAIS, BuildAIL, BuildAIP} {
+		segment, err := build(1, a, action)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build resource segment")
+		}
+		segments = append(segments, segment)
+	}
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildPharmacyOrderRDEO11 builds and returns a HL7 RDE^O11 message.
+// RDE^O11 messages are sent both when medications are prescribed and when they are discontinued.
+func BuildPharmacyOrderRDEO11(h *HeaderInfo, p *ir.PatientInfo, mo *ir.MedicationOrder, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  RDE,
+		TriggerEvent: "O11",
+	}
+
+	segments, err := segmentsPharmacyOrder(h, p, mo, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildPharmacyDispenseRDSO13 builds and returns a HL7 RDS^O13 message.
+func BuildPharmacyDispenseRDSO13(h *HeaderInfo, p *ir.PatientInfo, mo *ir.MedicationOrder, d *ir.MedicationDispense, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  RDS,
+		TriggerEvent: "O13",
+	}
+
+	segments, err := segmentsPharmacyOrder(h, p, mo, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	rxd, err := BuildRXD(mo, d)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXD segment")
+	}
+	segments = append(segments, rxd)
+	rxr, err := BuildRXR(mo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXR segment")
+	}
+	segments = append(segments, rxr)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildPharmacyAdministrationRASO17 builds and returns a HL7 RAS^O17 message.
+func BuildPharmacyAdministrationRASO17(h *HeaderInfo, p *ir.PatientInfo, mo *ir.MedicationOrder, a *ir.MedicationAdministration, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  RAS,
+		TriggerEvent: "O17",
+	}
+
+	segments, err := segmentsPatientAndMedicationOrder(h, p, mo, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	rxa, err := BuildRXA(mo, a)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXA segment")
+	}
+	segments = append(segments, rxa)
+	rxr, err := BuildRXR(mo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXR segment")
+	}
+	segments = append(segments, rxr)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildVaccinationVXUV04 builds and returns a HL7 VXU^V04 message.
+func BuildVaccinationVXUV04(h *HeaderInfo, p *ir.PatientInfo, v *ir.Vaccination, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  VXU,
+		TriggerEvent: "V04",
+	}
+
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	orc, err := BuildORCForVaccination(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORC segment")
+	}
+	segments = append(segments, orc)
+	rxa, err := BuildRXAForVaccination(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXA segment")
+	}
+	segments = append(segments, rxa)
+	rxr, err := BuildRXRForVaccination(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXR segment")
+	}
+	segments = append(segments, rxr)
+	for id, r := range v.Observations {
+		obx, err := BuildOBX(id+1, r, &ir.Order{OrderingProvider: v.Administrator})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build OBX segment")
+		}
+		segments = append(segments, obx)
+	}
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildChargeDFTP03 builds and returns a HL7 DFT^P03 message with the given charges.
+func BuildChargeDFTP03(h *HeaderInfo, p *ir.PatientInfo, charges []*ir.Charge, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  DFT,
+		TriggerEvent: "P03",
+	}
+
+	segments, err := segmentsFinancial(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	for id, c := range charges {
+		ft1, err := BuildFT1(id+1, c)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build FT1 segment")
+		}
+		segments = append(segments, ft1)
+	}
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildAddAccountBARP01 builds and returns a HL7 BAR^P01 message.
+func BuildAddAccountBARP01(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  BAR,
+		TriggerEvent: "P01",
+	}
+	return buildBAR(h, p, eventTime, msgTime, msgType)
+}
+
+// BuildUpdateAccountBARP05 builds and returns a HL7 BAR^P05 message.
+func BuildUpdateAccountBARP05(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  BAR,
+		TriggerEvent: "P05",
+	}
+	return buildBAR(h, p, eventTime, msgTime, msgType)
+}
+
+// buildBAR builds a BAR message with the diagnoses and procedures of the latest encounter of the
+// patient, and the guarantor and insurances of the patient.
+func buildBAR(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, msgType *Type) (*HL7Message, error) {
+	segments, err := segmentsFinancial(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	if ec := p.LatestEncounter(); ec != nil {
+		for id, d := range ec.Diagnoses {
+			dg1, err := BuildDG1(id, d)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot build DG1 segment")
+			}
+			segments = append(segments, dg1)
+		}
+		for id, pr := range ec.Procedures {
+			pr1, err := BuildPR1(id, pr)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot build PR1 segment")
+			}
+			segments = append(segments, pr1)
+		}
+	}
+	coverage, err := segmentsCoverage(p)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, coverage...)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// segmentsCoverage returns the GT1 segment of the guarantor of the patient, and the IN1 and IN2
+// segments of every insurance of the patient.
+func segmentsCoverage(p *ir.PatientInfo) ([]string, error) {
+	var segments []string
+	if p.Guarantor != nil {
+		gt1, err := BuildGT1(1, p.Guarantor)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build GT1 segment")
+		}
+		segments = append(segments, gt1)
+	}
+	for id, in := range p.Insurances {
+		in1, err := BuildIN1(id+1, in)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build IN1 segment")
+		}
+		segments = append(segments, in1)
+		in2, err := BuildIN2(in)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build IN2 segment")
+		}
+		segments = append(segments, in2)
+	}
+	return segments, nil
+}
+
+// segmentsFinancial returns the MSH, EVN, PID and PV1 segments of financial messages.
+// The PID segment contains the number of the patient's account.
+func segmentsFinancial(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, msgType *Type) ([]string, error) {
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	evn, err := BuildEVN(eventTime, msgType, ir.NewInvalidTime(), p.AttendingDoctor, ir.NewInvalidTime())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build EVN segment")
+	}
+	segments = append(segments, evn)
+	var accountNumber string
+	if p.Account != nil {
+		accountNumber = p.Account.Number
+	}
+	pid, err := BuildPIDWithAccount(p.Person, accountNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	return segments, nil
+}
+
+// segmentsVisitUpdate returns the MSH, EVN, PID, PD1, PV1 and PV2 segments of the messages that
+// update the patient's visit, e.g., a leave of absence or a change of the patient class.
+func segmentsVisitUpdate(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, msgType *Type) ([]string, error) {
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	evn, err := BuildEVN(eventTime, msgType, ir.NewInvalidTime(), p.AttendingDoctor, ir.NewInvalidTime())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build EVN segment")
+	}
+	segments = append(segments, evn)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pd1, err := BuildPD1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PD1 segment")
+	}
+	segments = append(segments, pd1)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	pv2, err := BuildPV2(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV2 segment")
+	}
+	segments = append(segments, pv2)
+	return segments, nil
+}
+
+// segmentsPharmacyOrder returns the MSH, PID, PV1, ORC, RXE, RXR and RXC segments that describe a
+// medication order.
+func segmentsPharmacyOrder(h *HeaderInfo, p *ir.PatientInfo, mo *ir.MedicationOrder, msgTime time.Time, msgType *Type) ([]string, error) {
+	segments, err := segmentsPatientAndMedicationOrder(h, p, mo, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	rxe, err := BuildRXE(mo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXE segment")
+	}
+	segments = append(segments, rxe)
+	rxr, err := BuildRXR(mo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build RXR segment")
+	}
+	segments = append(segments, rxr)
+	for _, c := range mo.Components {
+		rxc, err := BuildRXC(c)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build RXC segment")
+		}
+		segments = append(segments, rxc)
+	}
+	return segments, nil
+}
+
+func segmentsPatientAndMedicationOrder(h *HeaderInfo, p *ir.PatientInfo, mo *ir.MedicationOrder, msgTime time.Time, msgType *Type) ([]string, error) {
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	orc, err := BuildORCForMedication(mo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORC segment")
+	}
+	segments = append(segments, orc)
+	return segments, nil
+}
+
 // BuildResultORUR01 builds and returns a HL7 ORU^R01 message.
+// If the order has a specimen, an SPM segment is added after the results.
 func BuildResultORUR01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
 		MessageType:  ORU,
@@ -373,6 +936,13 @@ func BuildResultORUR01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime ti
 	if err != nil {
 		return nil, err
 	}
+	if o.Specimen != nil {
+		spm, err := BuildSPM(o)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build SPM segment")
+		}
+		segments = append(segments, spm)
+	}
 
 	return &HL7Message{
 		Type:    msgType,
@@ -468,7 +1038,87 @@ func resultsOBX(o *ir.Order, segments []string) ([]string, error) {
 		// We increment by 1 so that the first OBX has a SetID of 1 - that's how segment numbers starts.
 		// We use the number of previous result for the same order so that the SetIDs of OBX segments
 		// of different messages related to the same order (i.e. amendments) don't clash with the previous messages.
-		obx, err := BuildOBX(o.NumberOfPreviousResults+id+1, result, o)
+		var err error
+		if segments, err = appendOBX(segments, o.NumberOfPreviousResults+id+1, result, o); err != nil {
+			return nil, err
+		}
+	}
+	// Each child order is sent in its own OBR segment, followed by the OBX segments of its results.
+	for childID, child := range o.Children {
+		obr, err := BuildChildOBR(childID+2, o, child)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build child OBR segment")
+		}
+		segments = append(segments, obr)
+		for id, result := range child.Results {
+			if segments, err = appendOBX(segments, id+1, result, o); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return segments, nil
+}
+
+// appendOBX appends the OBX segment of the given result and the NTE segments of its notes.
+func appendOBX(segments []string, id int, result *ir.Result, o *ir.Order) ([]string, error) {
+	obx, err := BuildOBX(id, result, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build OBX segment")
+	}
+	segments = append(segments, obx)
+	for noteID, note := range result.Notes {
+		nte, err := BuildNTE(noteID, note)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build NTE segment")
+		}
+		segments = append(segments, nte)
+	}
+	return segments, nil
+}
+
+// BuildOrderORMO01 builds and returns a HL7 ORM^O01 message.
+func BuildOrderORMO01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ORM,
+		TriggerEvent: "O01",
+	}
+
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pv1, err := BuildPV1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PV1 segment")
+	}
+	segments = append(segments, pv1)
+	orc, err := BuildORC(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORC segment")
+	}
+	segments = append(segments, orc)
+	obr, err := BuildOBR(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build OBR segment")
+	}
+	segments = append(segments, obr)
+	for noteID, note := range o.NotesForORM {
+		nte, err := BuildNTE(noteID, note)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build NTE segment")
+		}
+		segments = append(segments, nte)
+	}
+
+	for id, result := range o.ResultsForORM {
+		obx, err := BuildOBX(id+1, result, o)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot build OBX segment")
 		}
@@ -481,18 +1131,56 @@ func resultsOBX(o *ir.Order, segments []string) ([]string, error) {
 			segments = append(segments, nte)
 		}
 	}
-	return segments, nil
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildPathologyORRO02 builds and returns a HL7 ORR^O02 message.
+func BuildPathologyORRO02(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ORR,
+		TriggerEvent: "O02",
+	}
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	msa, err := BuildMSA(o.MessageControlIDOriginalOrder)
+	if err != nil {
+		return nil, errors.Wrap(err, "MSA build MSH segment")
+	}
+	segments = append(segments, msa)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	orc, err := BuildORC(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORC segment")
+	}
+	segments = append(segments, orc)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
 }
 
-// BuildOrderORMO01 builds and returns a HL7 ORM^O01 message.
-func BuildOrderORMO01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
+// BuildLabOrderOMLO21 builds and returns a HL7 OML^O21 message.
+// The SPM segment is only included if the order has a specimen.
+func BuildLabOrderOMLO21(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
-		MessageType:  ORM,
-		TriggerEvent: "O01",
+		MessageType:  OML,
+		TriggerEvent: "O21",
 	}
 
 	var segments []string
-	msh, err := BuildMSH(msgTime, msgType, h)
+	msh, err := buildMSHWithVersion(msgTime, msgType, h, labOrderVersion)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot build MSH segment")
 	}
@@ -507,16 +1195,11 @@ func BuildOrderORMO01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime tim
 		return nil, errors.Wrap(err, "cannot build PV1 segment")
 	}
 	segments = append(segments, pv1)
-	orc, err := BuildORC(o)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot build ORC segment")
-	}
-	segments = append(segments, orc)
-	obr, err := BuildOBR(o)
+	orderSegments, err := buildLabOrderSegments(o)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot build OBR segment")
+		return nil, err
 	}
-	segments = append(segments, obr)
+	segments = append(segments, orderSegments...)
 	for noteID, note := range o.NotesForORM {
 		nte, err := BuildNTE(noteID, note)
 		if err != nil {
@@ -524,7 +1207,6 @@ func BuildOrderORMO01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime tim
 		}
 		segments = append(segments, nte)
 	}
-
 	for id, result := range o.ResultsForORM {
 		obx, err := BuildOBX(id+1, result, o)
 		if err != nil {
@@ -539,27 +1221,35 @@ func BuildOrderORMO01(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime tim
 			segments = append(segments, nte)
 		}
 	}
+	if o.Specimen != nil {
+		spm, err := BuildSPM(o)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build SPM segment")
+		}
+		segments = append(segments, spm)
+	}
 	return &HL7Message{
 		Type:    msgType,
 		Message: strings.Join(segments, SegmentTerminator),
 	}, nil
 }
 
-// BuildPathologyORRO02 builds and returns a HL7 ORR^O02 message.
-func BuildPathologyORRO02(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
+// BuildLabOrderAckORLO22 builds and returns a HL7 ORL^O22 message.
+// The SPM segment is only included if the order has a specimen.
+func BuildLabOrderAckORLO22(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
-		MessageType:  ORR,
-		TriggerEvent: "O02",
+		MessageType:  ORL,
+		TriggerEvent: "O22",
 	}
 	var segments []string
-	msh, err := BuildMSH(msgTime, msgType, h)
+	msh, err := buildMSHWithVersion(msgTime, msgType, h, labOrderVersion)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot build MSH segment")
 	}
 	segments = append(segments, msh)
 	msa, err := BuildMSA(o.MessageControlIDOriginalOrder)
 	if err != nil {
-		return nil, errors.Wrap(err, "MSA build MSH segment")
+		return nil, errors.Wrap(err, "cannot build MSA segment")
 	}
 	segments = append(segments, msa)
 	pid, err := BuildPID(p.Person)
@@ -567,11 +1257,18 @@ func BuildPathologyORRO02(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime
 		return nil, errors.Wrap(err, "cannot build PID segment")
 	}
 	segments = append(segments, pid)
-	orc, err := BuildORC(o)
+	orderSegments, err := buildLabOrderSegments(o)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot build ORC segment")
+		return nil, err
+	}
+	segments = append(segments, orderSegments...)
+	if o.Specimen != nil {
+		spm, err := BuildSPM(o)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build SPM segment")
+		}
+		segments = append(segments, spm)
 	}
-	segments = append(segments, orc)
 
 	return &HL7Message{
 		Type:    msgType,
@@ -579,6 +1276,23 @@ func BuildPathologyORRO02(h *HeaderInfo, p *ir.PatientInfo, o *ir.Order, msgTime
 	}, nil
 }
 
+// buildLabOrderSegments builds the ORC, TQ1 and OBR segments of the given order.
+func buildLabOrderSegments(o *ir.Order) ([]string, error) {
+	orc, err := BuildORC(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build ORC segment")
+	}
+	tq1, err := BuildTQ1(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build TQ1 segment")
+	}
+	obr, err := BuildOBR(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build OBR segment")
+	}
+	return []string{orc, tq1, obr}, nil
+}
+
 // BuildAdmissionADTA01 builds and returns a HL7 ADT^A01 message.
 func BuildAdmissionADTA01(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
@@ -631,6 +1345,11 @@ func BuildAdmissionADTA01(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time,
 		}
 		segments = append(segments, al1)
 	}
+	coverage, err := segmentsCoverage(p)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, coverage...)
 
 	return &HL7Message{
 		Type:    msgType,
@@ -772,6 +1491,11 @@ func BuildRegistrationADTA04(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Ti
 		}
 		segments = append(segments, al1)
 	}
+	coverage, err := segmentsCoverage(p)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, coverage...)
 
 	return &HL7Message{
 		Type:    msgType,
@@ -837,6 +1561,11 @@ func BuildPreAdmitADTA05(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time,
 		}
 		segments = append(segments, dg1)
 	}
+	coverage, err := segmentsCoverage(p)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, coverage...)
 	return &HL7Message{
 		Type:    msgType,
 		Message: strings.Join(segments, SegmentTerminator),
@@ -896,7 +1625,44 @@ func BuildUpdatePatientADTA08(h *HeaderInfo, p *ir.PatientInfo, includeFullPV1 b
 		}
 		segments = append(segments, pr1)
 	}
+	coverage, err := segmentsCoverage(p)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, coverage...)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildChangeOutpatientToInpatientADTA06 builds and returns a HL7 ADT^A06 message.
+func BuildChangeOutpatientToInpatientADTA06(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A06",
+	}
+	segments, err := segmentsVisitUpdate(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
 
+// BuildChangeInpatientToOutpatientADTA07 builds and returns a HL7 ADT^A07 message.
+func BuildChangeInpatientToOutpatientADTA07(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A07",
+	}
+	segments, err := segmentsVisitUpdate(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
 	return &HL7Message{
 		Type:    msgType,
 		Message: strings.Join(segments, SegmentTerminator),
@@ -983,6 +1749,39 @@ func BuildTrackArrivalADTA10(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Ti
 	}, nil
 }
 
+// BuildLeaveOfAbsenceADTA21 builds and returns a HL7 ADT^A21 message.
+// The PV2 segment contains the date and time the patient is expected to return.
+func BuildLeaveOfAbsenceADTA21(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A21",
+	}
+	segments, err := segmentsVisitUpdate(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildReturnFromLeaveOfAbsenceADTA22 builds and returns a HL7 ADT^A22 message.
+func BuildReturnFromLeaveOfAbsenceADTA22(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A22",
+	}
+	segments, err := segmentsVisitUpdate(h, p, eventTime, msgTime, msgType)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
 // BuildCancelVisitADTA11 builds and returns a HL7 ADT^A11 message.
 func BuildCancelVisitADTA11(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
@@ -1074,6 +1873,71 @@ func BuildBedSwapADTA17(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, m
 	}, nil
 }
 
+// BuildLinkPatientADTA24 builds and returns a HL7 ADT^A24 message.
+// The first PID and PV1 segments describe the patient, and the second ones describe the patient
+// whose record is linked to theirs.
+func BuildLinkPatientADTA24(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, otherP *ir.PatientInfo) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A24",
+	}
+	segments, err := segmentsLink(h, p, eventTime, msgTime, msgType, otherP)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// BuildUnlinkPatientADTA37 builds and returns a HL7 ADT^A37 message.
+// The first PID and PV1 segments describe the patient, and the second ones describe the patient
+// whose record is unlinked from theirs.
+func BuildUnlinkPatientADTA37(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, otherP *ir.PatientInfo) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A37",
+	}
+	segments, err := segmentsLink(h, p, eventTime, msgTime, msgType, otherP)
+	if err != nil {
+		return nil, err
+	}
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
+// segmentsLink returns the MSH, EVN, PID, PV1, PID and PV1 segments of the messages that link or
+// unlink the records of two patients.
+func segmentsLink(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, msgType *Type, otherP *ir.PatientInfo) ([]string, error) {
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	evn, err := BuildEVN(eventTime, msgType, ir.NewInvalidTime(), p.AttendingDoctor, ir.NewInvalidTime())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build EVN segment")
+	}
+	segments = append(segments, evn)
+	for _, patient := range []*ir.PatientInfo{p, otherP} {
+		pid, err := BuildPID(patient.Person)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build PID segment")
+		}
+		segments = append(segments, pid)
+		pv1, err := BuildPV1(patient)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build PV1 segment")
+		}
+		segments = append(segments, pv1)
+	}
+	return segments, nil
+}
+
 // BuildAddPersonADTA28 builds and returns a HL7 ADT^A28 message.
 func BuildAddPersonADTA28(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time) (*HL7Message, error) {
 	msgType := &Type{
@@ -1630,13 +2494,60 @@ func BuildMergeADTA40(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msg
 	}, nil
 }
 
+// BuildChangeIdentifierADTA47 builds and returns a HL7 ADT^A47 message.
+// The PID segment contains the new MRN of the patient, and the MRG segment contains the prior one.
+func BuildChangeIdentifierADTA47(h *HeaderInfo, p *ir.PatientInfo, eventTime time.Time, msgTime time.Time, priorMRN string) (*HL7Message, error) {
+	msgType := &Type{
+		MessageType:  ADT,
+		TriggerEvent: "A47",
+	}
+
+	var segments []string
+	msh, err := BuildMSH(msgTime, msgType, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MSH segment")
+	}
+	segments = append(segments, msh)
+	evn, err := BuildEVN(eventTime, msgType, ir.NewInvalidTime(), p.AttendingDoctor, ir.NewInvalidTime())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build EVN segment")
+	}
+	segments = append(segments, evn)
+	pid, err := BuildPID(p.Person)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PID segment")
+	}
+	segments = append(segments, pid)
+	pd1, err := BuildPD1(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build PD1 segment")
+	}
+	segments = append(segments, pd1)
+	mrg, err := BuildMRG([]string{priorMRN})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build MRG segment")
+	}
+	segments = append(segments, mrg)
+
+	return &HL7Message{
+		Type:    msgType,
+		Message: strings.Join(segments, SegmentTerminator),
+	}, nil
+}
+
 // BuildMSH builds and returns a HL7 MSH segment.
 func BuildMSH(t time.Time, messageType *Type, header *HeaderInfo) (string, error) {
+	return buildMSHWithVersion(t, messageType, header, defaultVersion)
+}
+
+// buildMSHWithVersion builds and returns a HL7 MSH segment with the given MSH.12-Version ID.
+func buildMSHWithVersion(t time.Time, messageType *Type, header *HeaderInfo, version string) (string, error) {
 	return executeTemplate(templates[MSH], struct {
 		T       *time.Time
 		MsgType *Type
 		Header  *HeaderInfo
-	}{&t, messageType, header})
+		Version string
+	}{&t, messageType, header, version})
 }
 
 // BuildMSA builds and returns a HL7 MSA segment.
@@ -1659,7 +2570,15 @@ func BuildEVN(t time.Time, messageType *Type, planned ir.NullTime, operator *ir.
 
 // BuildPID builds and returns a HL7 PID segment.
 func BuildPID(p *ir.Person) (string, error) {
-	return executeTemplate(templates[PID], p)
+	return BuildPIDWithAccount(p, "")
+}
+
+// BuildPIDWithAccount builds and returns a HL7 PID segment with the given patient account number.
+func BuildPIDWithAccount(p *ir.Person, accountNumber string) (string, error) {
+	return executeTemplate(templates[PID], struct {
+		*ir.Person
+		AccountNumber string
+	}{p, accountNumber})
 }
 
 // BuildPV1 builds and returns a HL7 PV1 segment.
@@ -1700,6 +2619,17 @@ func BuildORC(o *ir.Order) (string, error) {
 	return executeTemplate(templates[ORC], &o)
 }
 
+// BuildTQ1 builds and returns a HL7 TQ1 segment.
+func BuildTQ1(o *ir.Order) (string, error) {
+	return executeTemplate(templates[TQ1], o)
+}
+
+// BuildSPM builds and returns a HL7 SPM segment for the specimen of the given order.
+// The specimen is identified by the placer and filler order numbers.
+func BuildSPM(o *ir.Order) (string, error) {
+	return executeTemplate(templates[SPM], o)
+}
+
 // BuildOBR builds and returns a HL7 OBR segment.
 func BuildOBR(o *ir.Order) (string, error) {
 	// If this order is sending a ClinicalNote, use the appropriate OBR template.
@@ -1716,6 +2646,17 @@ func BuildOBR(o *ir.Order) (string, error) {
 	}{o, documentID})
 }
 
+// BuildChildOBR builds and returns a HL7 OBR segment for a child order of the given order, with
+// the given SetID. The child order is linked to the given order through the Parent Result and
+// Parent fields.
+func BuildChildOBR(setID int, o *ir.Order, c *ir.ChildOrder) (string, error) {
+	return executeTemplate(templates[OBRChild], struct {
+		*ir.Order
+		SetID int
+		Child *ir.ChildOrder
+	}{o, setID, c})
+}
+
 // BuildOBX builds and returns a HL7 OBX segment.
 func BuildOBX(id int, r *ir.Result, o *ir.Order) (string, error) {
 	return executeTemplate(templates[OBX], struct {
@@ -1793,6 +2734,125 @@ func BuildTXA(p *ir.PatientInfo, d *ir.Document) (string, error) {
 	}{d, p.AttendingDoctor})
 }
 
+// BuildSCH builds and returns a HL7 SCH segment.
+func BuildSCH(a *ir.Appointment) (string, error) {
+	return executeTemplate(templates[SCH], a)
+}
+
+// BuildRGS builds and returns a HL7 RGS segment.
+func BuildRGS(id int, a *ir.Appointment, action string) (string, error) {
+	return executeTemplate(templates[RGS], struct {
+		ID                int
+		SegmentActionCode string
+	}{id, action})
+}
+
+// BuildAIS builds and returns a HL7 AIS segment.
+func BuildAIS(id int, a *ir.Appointment, action string) (string, error) {
+	return buildResourceSegment(AIS, id, a, action)
+}
+
+// BuildAIL builds and returns a HL7 AIL segment.
+func BuildAIL(id int, a *ir.Appointment, action string) (string, error) {
+	return buildResourceSegment(AIL, id, a, action)
+}
+
+// BuildAIP builds and returns a HL7 AIP segment.
+func BuildAIP(id int, a *ir.Appointment, action string) (string, error) {
+	return buildResourceSegment(AIP, id, a, action)
+}
+
+func buildResourceSegment(segment string, id int, a *ir.Appointment, action string) (string, error) {
+	return executeTemplate(templates[segment], struct {
+		*ir.Appointment
+		ID                int
+		SegmentActionCode string
+	}{a, id, action})
+}
+
+// BuildORCForMedication builds and returns a HL7 ORC segment for a medication order.
+func BuildORCForMedication(mo *ir.MedicationOrder) (string, error) {
+	return executeTemplate(templates[ORCMedication], mo)
+}
+
+// BuildRXE builds and returns a HL7 RXE segment.
+func BuildRXE(mo *ir.MedicationOrder) (string, error) {
+	return executeTemplate(templates[RXE], mo)
+}
+
+// BuildRXR builds and returns a HL7 RXR segment.
+func BuildRXR(mo *ir.MedicationOrder) (string, error) {
+	return executeTemplate(templates[RXR], mo.Route)
+}
+
+// BuildRXC builds and returns a HL7 RXC segment.
+func BuildRXC(c *ir.MedicationComponent) (string, error) {
+	return executeTemplate(templates[RXC], c)
+}
+
+// BuildRXD builds and returns a HL7 RXD segment.
+func BuildRXD(mo *ir.MedicationOrder, d *ir.MedicationDispense) (string, error) {
+	return executeTemplate(templates[RXD], struct {
+		*ir.MedicationDispense
+		Medication         *ir.CodedElement
+		DosageForm         string
+		PrescriptionNumber string
+	}{d, mo.Medication, mo.DosageForm, mo.Filler})
+}
+
+// BuildRXA builds and returns a HL7 RXA segment.
+func BuildRXA(mo *ir.MedicationOrder, a *ir.MedicationAdministration) (string, error) {
+	return executeTemplate(templates[RXA], struct {
+		*ir.MedicationAdministration
+		Medication *ir.CodedElement
+		DosageForm string
+	}{a, mo.Medication, mo.DosageForm})
+}
+
+// BuildORCForVaccination builds and returns a HL7 ORC segment for a vaccination.
+func BuildORCForVaccination(v *ir.Vaccination) (string, error) {
+	return executeTemplate(templates[ORCVaccination], v)
+}
+
+// BuildRXAForVaccination builds and returns a HL7 RXA segment for a vaccination.
+func BuildRXAForVaccination(v *ir.Vaccination) (string, error) {
+	return executeTemplate(templates[RXAVaccination], v)
+}
+
+// BuildRXRForVaccination builds and returns a HL7 RXR segment for a vaccination.
+func BuildRXRForVaccination(v *ir.Vaccination) (string, error) {
+	return executeTemplate(templates[RXRVaccination], v)
+}
+
+// BuildFT1 builds and returns a HL7 FT1 segment.
+func BuildFT1(id int, c *ir.Charge) (string, error) {
+	return executeTemplate(templates[FT1], struct {
+		*ir.Charge
+		SetID int
+	}{c, id})
+}
+
+// BuildGT1 builds and returns a HL7 GT1 segment.
+func BuildGT1(id int, g *ir.AssociatedParty) (string, error) {
+	return executeTemplate(templates[GT1], struct {
+		*ir.AssociatedParty
+		ID int
+	}{g, id})
+}
+
+// BuildIN1 builds and returns a HL7 IN1 segment.
+func BuildIN1(id int, in *ir.Insurance) (string, error) {
+	return executeTemplate(templates[IN1], struct {
+		*ir.Insurance
+		ID int
+	}{in, id})
+}
+
+// BuildIN2 builds and returns a HL7 IN2 segment.
+func BuildIN2(in *ir.Insurance) (string, error) {
+	return executeTemplate(templates[IN2], in)
+}
+
 func mustParseTemplate(name string, t string) *template.Template {
 	tmpl, err := template.New(name).Funcs(funcMap).Parse(t)
 	if err != nil {
@@ -16,6 +16,7 @@ package message
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -191,6 +192,58 @@ func TestBuildORC_NoOrderDateTime(t *testing.T) {
 	}
 }
 
+func TestBuildTQ1(t *testing.T) {
+	now := time.Date(2018, 1, 26, 15, 24, 21, 0, time.UTC)
+	o := testOrder(now)
+	o.Priority = "S"
+
+	want := "TQ1|1||||||20180126152421||S"
+	got, err := BuildTQ1(o)
+	if err != nil {
+		t.Fatalf("BuildTQ1(%v) failed with %v", o, err)
+	}
+	if got != want {
+		t.Errorf("BuildTQ1(%v)=%v, want %v", o, got, want)
+	}
+}
+
+func TestBuildSPM(t *testing.T) {
+	now := time.Date(2018, 1, 26, 15, 24, 21, 0, time.UTC)
+	o := testOrder(now)
+	o.Specimen = &ir.Specimen{
+		Type:              &ir.CodedElement{ID: "BLD", Text: "Whole blood", CodingSystem: "HL70487"},
+		CollectionMethod:  &ir.CodedElement{ID: "VENIP", Text: "Venipuncture", CodingSystem: "HL70488"},
+		BodySite:          &ir.CodedElement{ID: "LACF", Text: "Left Antecubital Fossa", CodingSystem: "HL70163"},
+		Container:         &ir.CodedElement{ID: "EDTA", Text: "EDTA tube", CodingSystem: "WinPath"},
+		CollectedDateTime: ir.NewValidTime(now.Add(time.Hour)),
+		ReceivedDateTime:  ir.NewValidTime(now.Add(2 * time.Hour)),
+	}
+
+	want := "SPM|1|9984058^1902082||BLD^Whole blood^HL70487^^|||VENIP^Venipuncture^HL70488^^|LACF^Left Antecubital Fossa^HL70163^^|||||||||20180126162421|20180126172421|||||||||EDTA^EDTA tube^WinPath^^"
+	got, err := BuildSPM(o)
+	if err != nil {
+		t.Fatalf("BuildSPM(%v) failed with %v", o, err)
+	}
+	if got != want {
+		t.Errorf("BuildSPM(%v)=%v, want %v", o, got, want)
+	}
+}
+
+func TestBuildSPM_OnlyType(t *testing.T) {
+	now := time.Date(2018, 1, 26, 15, 24, 21, 0, time.UTC)
+	o := testOrder(now)
+	o.Specimen = &ir.Specimen{Type: &ir.CodedElement{ID: "BLD", Text: "BLD", CodingSystem: "HL70487"}}
+
+	want := "SPM|1|9984058^1902082||BLD^BLD^HL70487^^|||||||||||||||||||||||"
+	got, err := BuildSPM(o)
+	if err != nil {
+		t.Fatalf("BuildSPM(%v) failed with %v", o, err)
+	}
+	if got != want {
+		t.Errorf("BuildSPM(%v)=%v, want %v", o, got, want)
+	}
+}
+
 func TestBuildOBR(t *testing.T) {
 	now := time.Date(2018, 1, 26, 15, 24, 21, 0, time.UTC)
 
@@ -380,6 +433,21 @@ func TestBuildOBX(t *testing.T) {
 			return o
 		},
 		want: "OBX|1|TX|lpdc-2011^Creatinine^WinPath^^||This is the result.~And this is second line.||||||F|||20180126154523||",
+	}, {
+		name: "Coded Value With Sub-ID",
+		setup: func() *ir.Order {
+			o := testOrder(now)
+			o.Results = []*ir.Result{{
+				TestName:            &ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "LN"},
+				SubID:               "1",
+				CodedValue:          &ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SCT"},
+				ValueType:           "CE",
+				Status:              "F",
+				ObservationDateTime: ir.NewValidTime(time.Date(2018, 1, 26, 15, 45, 23, 0, time.UTC)),
+			}}
+			return o
+		},
+		want: "OBX|1|CE|600-7^Bacteria identified^LN^^|1|112283007^Escherichia coli^SCT^^||||||F|||20180126154523||",
 	}}
 
 	for _, tc := range tests {
@@ -396,6 +464,28 @@ func TestBuildOBX(t *testing.T) {
 	}
 }
 
+func TestBuildChildOBR(t *testing.T) {
+	now := time.Date(2018, 1, 26, 15, 24, 21, 0, time.UTC)
+	o := testOrder(now)
+	c := &ir.ChildOrder{
+		UniversalService: &ir.CodedElement{ID: "29576-6", Text: "Bacterial susceptibility panel", CodingSystem: "LN"},
+		ParentResult: &ir.Result{
+			TestName:   &ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "LN"},
+			SubID:      "1",
+			CodedValue: &ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SCT"},
+		},
+	}
+
+	want := "OBR|2|9984058|1902082|29576-6^Bacterial susceptibility panel^LN^^||20180126152421|||||||||||||||||||C|600-7&Bacteria identified&LN^1^Escherichia coli|1||9984058^1902082"
+	got, err := BuildChildOBR(2, o, c)
+	if err != nil {
+		t.Fatalf("BuildChildOBR(%v, %v, %v) failed with %v", 2, o, c, err)
+	}
+	if got != want {
+		t.Errorf("BuildChildOBR(%v, %v, %v)=%v, want %v", 2, o, c, got, want)
+	}
+}
+
 func TestBuildOBXForClinicalNote(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -550,6 +640,21 @@ func TestBuildPV2(t *testing.T) {
 	}
 }
 
+func TestBuildPV2_ExpectedLOAReturnDateTime(t *testing.T) {
+	patientInfo := &ir.PatientInfo{
+		ExpectedLOAReturnDateTime: ir.NewValidTime(time.Date(2018, 4, 29, 21, 45, 30, 0, time.UTC)),
+	}
+
+	want := "PV2|||||||||" + strings.Repeat("|", 38) + "20180429224530"
+	got, err := BuildPV2(patientInfo)
+	if err != nil {
+		t.Fatalf("BuildPV2(%v) failed with %v", patientInfo, err)
+	}
+	if got != want {
+		t.Errorf("BuildPV2(%v)=%v, want %v", patientInfo, got, want)
+	}
+}
+
 func TestBuildNK1(t *testing.T) {
 	p := &ir.AssociatedParty{
 		Person: &ir.Person{
@@ -738,6 +843,221 @@ func TestBuildTXA(t *testing.T) {
 	}
 }
 
+func TestBuildSCH(t *testing.T) {
+	a := testAppointment()
+	want := "SCH|placer-1|filler-1||||^Patient request|ROUTINE^ROUTINE^HL70276^^|Normal^Normal^HL70277^^|30|MIN|^^30^20190615090000^20190615093000|||||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR||||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR|||||Booked"
+	got, err := BuildSCH(a)
+	if err != nil {
+		t.Fatalf("BuildSCH(%v) failed with %v", a, err)
+	}
+	if got != want {
+		t.Errorf("BuildSCH(%v) = %v, want %v", a, got, want)
+	}
+}
+
+func TestBuildAppointmentResourceSegments(t *testing.T) {
+	a := testAppointment()
+	cases := []struct {
+		name  string
+		build func(int, *ir.Appointment, string) (string, error)
+		want  string
+	}{{
+		name:  "RGS",
+		build: BuildRGS,
+		want:  "RGS|1|A",
+	}, {
+		name:  "AIS",
+		build: BuildAIS,
+		want:  "AIS|1|A|MED^MED^^^|20190615090000|||30|MIN||Booked",
+	}, {
+		name:  "AIL",
+		build: BuildAIL,
+		want:  "AIL|1|A|OutpatientClinic^Clinic1^^Simulated Hospital^^CLINIC^Main Building^2|^CLINIC||20190615090000|||30|MIN||Booked",
+	}, {
+		name:  "AIP",
+		build: BuildAIP,
+		want:  "AIP|1|A|216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR|^MED||20190615090000|||30|MIN||Booked",
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.build(1, a, "A")
+			if err != nil {
+				t.Fatalf("Build%s(1, %v, A) failed with %v", tc.name, a, err)
+			}
+			if got != tc.want {
+				t.Errorf("Build%s(1, %v, A) = %v, want %v", tc.name, a, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildPharmacySegments(t *testing.T) {
+	mo := testMedicationOrder()
+	dispense := &ir.MedicationDispense{ID: 1, DateTime: ir.NewValidTime(time.Date(2019, 6, 1, 11, 0, 0, 0, time.UTC)), Amount: "3", Units: "BAG"}
+	administration := &ir.MedicationAdministration{ID: 2, DateTime: ir.NewValidTime(time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)), Dose: "1000", DoseUnits: "ml", CompletionStatus: "CP"}
+	cases := []struct {
+		name  string
+		build func() (string, error)
+		want  string
+	}{{
+		name:  "ORC",
+		build: func() (string, error) { return BuildORCForMedication(mo) },
+		want:  "ORC|NW|placer-1|filler-1||IP||||20190601110000|||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR||||",
+	}, {
+		name:  "RXE",
+		build: func() (string, error) { return BuildRXE(mo) },
+		want:  "RXE|^Q8H|med-2^Saline with potassium^WinPath^^|1000||ml|SOL||||3|BAG||||filler-1",
+	}, {
+		name:  "RXR",
+		build: func() (string, error) { return BuildRXR(mo) },
+		want:  "RXR|IV",
+	}, {
+		name:  "RXC",
+		build: func() (string, error) { return BuildRXC(mo.Components[1]) },
+		want:  "RXC|A|med-2-2^Potassium chloride^WinPath^^|20|mmol",
+	}, {
+		name:  "RXD",
+		build: func() (string, error) { return BuildRXD(mo, dispense) },
+		want:  "RXD|1|med-2^Saline with potassium^WinPath^^|20190601120000|3|BAG|SOL|filler-1",
+	}, {
+		name:  "RXA",
+		build: func() (string, error) { return BuildRXA(mo, administration) },
+		want:  "RXA|0|2|20190601130000|20190601130000|med-2^Saline with potassium^WinPath^^|1000|ml|SOL||||||||||||CP",
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.build()
+			if err != nil {
+				t.Fatalf("Build%s() failed with %v", tc.name, err)
+			}
+			if got != tc.want {
+				t.Errorf("Build%s() = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildORCForMedication_Discontinued(t *testing.T) {
+	mo := testMedicationOrder()
+	mo.OrderControl = "DC"
+	mo.OrderStatus = "DC"
+	mo.DiscontinueReason = "Adverse reaction"
+	want := "ORC|DC|placer-1|filler-1||DC||||20190601110000|||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR||||^Adverse reaction"
+	got, err := BuildORCForMedication(mo)
+	if err != nil {
+		t.Fatalf("BuildORCForMedication(%v) failed with %v", mo, err)
+	}
+	if got != want {
+		t.Errorf("BuildORCForMedication(%v) = %v, want %v", mo, got, want)
+	}
+}
+
+func TestBuildVaccinationSegments(t *testing.T) {
+	v := testVaccination()
+	cases := []struct {
+		name  string
+		build func() (string, error)
+		want  string
+	}{{
+		name:  "ORC",
+		build: func() (string, error) { return BuildORCForVaccination(v) },
+		want:  "ORC|RE|placer-1|filler-1||||||20190601110000|||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR",
+	}, {
+		name:  "RXA",
+		build: func() (string, error) { return BuildRXAForVaccination(v) },
+		want:  "RXA|0|1|20190601110000|20190601110000|141^Influenza, seasonal, injectable^CVX^^|0.5|mL|||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR|||||FLU0001A||SKB^GlaxoSmithKline^MVX^^|||CP",
+	}, {
+		name:  "RXR",
+		build: func() (string, error) { return BuildRXRForVaccination(v) },
+		want:  "RXR|IM|LD",
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.build()
+			if err != nil {
+				t.Fatalf("Build%sForVaccination() failed with %v", tc.name, err)
+			}
+			if got != tc.want {
+				t.Errorf("Build%sForVaccination() = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildFinancialSegments(t *testing.T) {
+	c := testCharge()
+	guarantor := &ir.AssociatedParty{
+		Person:       testPersonFemale(),
+		Relationship: &ir.CodedElement{ID: "SEL", Text: "SEL", CodingSystem: "HL70063"},
+	}
+	insurance := &ir.Insurance{
+		Plan:          &ir.CodedElement{ID: "PPO-1", Text: "Standard PPO", CodingSystem: "LOCAL"},
+		CompanyID:     "INS-1",
+		CompanyName:   "Acme Health",
+		GroupNumber:   "G-100",
+		EffectiveDate: ir.NewMidnightTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+		PlanType:      "PPO",
+		Insured:       guarantor,
+		PolicyNumber:  "POL-123",
+		MemberNumber:  "MEM-1",
+	}
+	noInsured := &ir.Insurance{
+		Plan:         &ir.CodedElement{ID: "PPO-1"},
+		PolicyNumber: "POL-123",
+	}
+	cases := []struct {
+		name  string
+		build func() (string, error)
+		want  string
+	}{{
+		name:  "FT1",
+		build: func() (string, error) { return BuildFT1(1, c) },
+		want:  "FT1|1|transaction-1||20190601110000|20190601120000|CG|PROC-1^Procedure1^LOCAL^^|Procedure1||2|600.00|300.00||||RAL 12 West^Bay01^Bed10^RAL RF^^BED^RFH^||||216865551019^Osman^Arthur^^^Dr^^^DRNBR^PRSNL^^^ORGDR|||filler-1||P24.9^Procedure1^SNMCT^^",
+	}, {
+		name:  "GT1",
+		build: func() (string, error) { return BuildGT1(1, guarantor) },
+		want:  "GT1|1||Smiths^Helen^Matilda^Junior^Miss^Dr^CURRENT||1 Goodwill Hunting Road^Kings Cross^London^^N1C 4AG^GBR^HOME|020 7031 3000^HOME||19940704133518|F||SEL^SEL^HL70063^^",
+	}, {
+		name:  "IN1",
+		build: func() (string, error) { return BuildIN1(1, insurance) },
+		want:  "IN1|1|PPO-1^Standard PPO^LOCAL^^|INS-1|Acme Health||||G-100||||20190101000000|||PPO|Smiths^Helen^Matilda^Junior^Miss^Dr^CURRENT|SEL^SEL^HL70063^^|19940704133518|1 Goodwill Hunting Road^Kings Cross^London^^N1C 4AG^GBR^HOME|||||||||||||||||POL-123",
+	}, {
+		name:  "IN1 without insured",
+		build: func() (string, error) { return BuildIN1(2, noInsured) },
+		want:  "IN1|2|PPO-1^^^^||||||||||||||||||||||||||||||||||POL-123",
+	}, {
+		name:  "IN2",
+		build: func() (string, error) { return BuildIN2(insurance) },
+		want:  "IN2|||||||||||||||||||||||||||||||||||||||||||||||||||||||||||||MEM-1",
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.build()
+			if err != nil {
+				t.Fatalf("Build%s() failed with %v", tc.name, err)
+			}
+			if got != tc.want {
+				t.Errorf("Build%s() = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildPIDWithAccount(t *testing.T) {
+	p := testPersonFemale()
+	pid, err := BuildPIDWithAccount(p, "account-1")
+	if err != nil {
+		t.Fatalf("BuildPIDWithAccount(%+v, %q) failed with %v", p, "account-1", err)
+	}
+	fields := strings.Split(pid, "|")
+	if got, want := fields[18], "account-1"; got != want {
+		t.Errorf("BuildPIDWithAccount(%+v, %q) PID-18 got %q, want %q", p, "account-1", got, want)
+	}
+	if got, want := fields[22], "A^White British^^^"; got != want {
+		t.Errorf("BuildPIDWithAccount(%+v, %q) PID-22 got %q, want %q", p, "account-1", got, want)
+	}
+}
+
 func TestBuildOBXForMDM(t *testing.T) {
 	observationIdentifier := &ir.CodedElement{
 		ID:           "Established Patient 15",
@@ -2724,6 +3044,61 @@ func TestBuildResultORU(t *testing.T) {
 	}
 }
 
+func TestBuildResultORU_Specimen(t *testing.T) {
+	eventTime := time.Date(2018, 4, 28, 22, 38, 44, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 44, 0, time.UTC)
+	patientInfo := testPatientInfo()
+	header := testHeader()
+
+	tests := []struct {
+		name    string
+		f       func(*HeaderInfo, *ir.PatientInfo, *ir.Order, time.Time) (*HL7Message, error)
+		wantSPM bool
+	}{{
+		name:    "ORU^R01",
+		f:       BuildResultORUR01,
+		wantSPM: true,
+	}, {
+		name: "ORU^R03",
+		f:    BuildResultORUR03,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := testOrderWithResult(eventTime)
+			order.Specimen = &ir.Specimen{
+				Type:              &ir.CodedElement{ID: "SER", Text: "Serum", CodingSystem: "HL70487"},
+				CollectedDateTime: order.CollectedDateTime,
+			}
+			oru, err := tt.f(header, patientInfo, order, msgTime)
+			if err != nil {
+				t.Fatalf("BuildResultORU(%v, %v, %v, %v) failed with %v", header, patientInfo, order, msgTime, err)
+			}
+			segments := strings.Split(oru.Message, SegmentTerminator)
+			last := segments[len(segments)-1]
+			if got := strings.HasPrefix(last, "SPM|"); got != tt.wantSPM {
+				t.Errorf("BuildResultORU(%v, %v, %v, %v) got last segment %q, want SPM segment: %t", header, patientInfo, order, msgTime, last, tt.wantSPM)
+			}
+			if !tt.wantSPM {
+				return
+			}
+
+			mo := hl7.NewParseMessageOptions()
+			mo.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(oru.Message), mo)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", oru.Message, mo, err)
+			}
+			spm, err := m.SPM()
+			if err != nil {
+				t.Fatalf("SPM() failed with %v", err)
+			}
+			if got, want := spm.SpecimenType.Identifier.String(), "SER"; got != want {
+				t.Errorf("spm.SpecimenType.Identifier.String()=%v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestBuildResultORU_StartCountingAtInitialSetID(t *testing.T) {
 	eventTime := time.Date(2018, 4, 28, 22, 38, 44, 0, time.UTC)
 	msgTime := time.Date(2018, 4, 28, 22, 39, 44, 0, time.UTC)
@@ -2789,6 +3164,60 @@ func TestBuildResultORU_StartCountingAtInitialSetID(t *testing.T) {
 	}
 }
 
+func TestBuildResultORU_ChildOrders(t *testing.T) {
+	eventTime := time.Date(2018, 4, 28, 22, 38, 44, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 44, 0, time.UTC)
+	patientInfo := testPatientInfo()
+	header := testHeader()
+
+	o := testOrder(eventTime)
+	organism := func(subID string) *ir.Result {
+		return &ir.Result{
+			TestName:   &ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "LN"},
+			SubID:      subID,
+			CodedValue: &ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SCT"},
+			ValueType:  "CE",
+			Status:     "F",
+		}
+	}
+	susceptibility := func(antibiotic string) *ir.Result {
+		return &ir.Result{
+			TestName:     &ir.CodedElement{ID: antibiotic, Text: antibiotic, CodingSystem: "WinPath"},
+			Value:        "8",
+			Unit:         "mg/L",
+			ValueType:    "NM",
+			AbnormalFlag: "S",
+			Status:       "F",
+		}
+	}
+	panel := &ir.CodedElement{ID: "29576-6", Text: "Bacterial susceptibility panel", CodingSystem: "LN"}
+	o.Results = []*ir.Result{organism("1"), organism("2")}
+	o.Children = []*ir.ChildOrder{{
+		UniversalService: panel,
+		ParentResult:     o.Results[0],
+		Results:          []*ir.Result{susceptibility("AMP"), susceptibility("CIP")},
+	}, {
+		UniversalService: panel,
+		ParentResult:     o.Results[1],
+		Results:          []*ir.Result{susceptibility("GEN")},
+	}}
+
+	oru, err := BuildResultORUR01(header, patientInfo, o, msgTime)
+	if err != nil {
+		t.Fatalf("BuildResultORUR01(%v, %v, %v, %v) failed with %v", header, patientInfo, o, msgTime, err)
+	}
+	var got []string
+	for _, segment := range strings.Split(oru.Message, SegmentTerminator) {
+		if strings.HasPrefix(segment, "OBR") || strings.HasPrefix(segment, "OBX") {
+			got = append(got, strings.Join(strings.SplitN(segment, "|", 3)[:2], "|"))
+		}
+	}
+	want := []string{"OBR|1", "OBX|1", "OBX|2", "OBR|2", "OBX|1", "OBX|2", "OBR|3", "OBX|1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("BuildResultORUR01(%v, %v, %v, %v) got OBR and OBX segments diff (-want, +got):\n%s", header, patientInfo, o, msgTime, diff)
+	}
+}
+
 func TestBuildOrderORMO01(t *testing.T) {
 	eventTime := time.Date(2018, 4, 28, 22, 38, 44, 0, time.UTC)
 	msgTime := time.Date(2018, 4, 28, 22, 39, 44, 0, time.UTC)
@@ -2904,92 +3333,216 @@ func TestBuildOrderORMO01(t *testing.T) {
 	}
 }
 
-func TestBuildBedSwapADTA17(t *testing.T) {
-	mergeTime := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
-	msgTime := time.Date(2018, 4, 28, 22, 39, 14, 0, time.UTC)
+func TestBuildLabOrderOMLO21(t *testing.T) {
+	eventTime := time.Date(2018, 4, 28, 22, 38, 44, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 44, 0, time.UTC)
 	patientInfo := testPatientInfo()
-	patientInfo.Location.Poc = "onc-poc"
-	otherPatientInfo := testPatientInfo()
-	otherPatientInfo.Location.Poc = "another-poc"
+	order := testOrder(eventTime)
+	order.Priority = "R"
+	order.Specimen = &ir.Specimen{Type: &ir.CodedElement{ID: "BLD", Text: "BLD", CodingSystem: "HL70487"}}
+	order.NotesForORM = []string{"Random order Note 1"}
 	header := testHeader()
 
-	adt, err := BuildBedSwapADTA17(header, patientInfo, mergeTime, msgTime, otherPatientInfo)
+	omlO21, err := BuildLabOrderOMLO21(header, patientInfo, order, msgTime)
 	if err != nil {
-		t.Fatalf("BuildBedSwapADTA17(%v, %v, %v, %v, %v) failed with %v", header, patientInfo, mergeTime, msgTime, otherPatientInfo, err)
+		t.Fatalf("BuildLabOrderOMLO21(%v, %v, %v, %v) failed with %v", header, patientInfo, order, msgTime, err)
 	}
-
 	mo := hl7.NewParseMessageOptions()
 	mo.TimezoneLoc = time.UTC
-	m, err := hl7.ParseMessageWithOptions([]byte(adt.Message), mo)
+	m, err := hl7.ParseMessageWithOptions([]byte(omlO21.Message), mo)
 	if err != nil {
-		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", adt.Message, mo, err)
+		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", omlO21.Message, mo, err)
 	}
 
 	msh, err := m.MSH()
 	if err != nil {
 		t.Fatalf("MSH() failed with %v", err)
 	}
-	if msh == nil {
-		t.Fatal("MSH() got nil MSH segment, want non nil")
-	}
-	if got, want := msh.MessageType.MessageCode.String(), "ADT"; got != want {
-		t.Errorf("msh.MessageType.MessageType.String()=%v, want %v", got, want)
+	if got, want := msh.MessageType.MessageCode.String(), "OML"; got != want {
+		t.Errorf("msh.MessageType.MessageCode.String()=%v, want %v", got, want)
 	}
-	if got, want := msh.MessageType.TriggerEvent.String(), "A17"; got != want {
+	if got, want := msh.MessageType.TriggerEvent.String(), "O21"; got != want {
 		t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
 	}
-	if got, want := msh.DateTimeOfMessage.Time.Second(), msgTime.In(hl7.Location).Second(); got != want {
-		t.Errorf("msh.DateTimeOfMessage.Time.Second()=%v, want %v", got, want)
+	if got, want := msh.VersionID.VersionID.String(), "2.5.1"; got != want {
+		t.Errorf("msh.VersionID.VersionID.String()=%v, want %v", got, want)
 	}
 
-	evn, err := m.EVN()
-	if err != nil {
-		t.Fatalf("EVN() failed with %v", err)
-	}
-	if evn == nil {
-		t.Fatal("EVN() got nil EVN segment, want non nil")
-	}
-	if got, want := evn.EventTypeCode.String(), "A17"; got != want {
-		t.Errorf("evn.EventTypeCode.String()=%v, want %v", got, want)
+	var gotSegments []string
+	for _, s := range strings.Split(omlO21.Message, SegmentTerminator) {
+		gotSegments = append(gotSegments, s[:3])
 	}
-	if got, want := evn.RecordedDateTime.Time.Second(), mergeTime.In(hl7.Location).Second(); got != want {
-		t.Errorf("evn.RecordedDateTime.Time.Second()=%v, want %v", got, want)
+	wantSegments := []string{"MSH", "PID", "PV1", "ORC", "TQ1", "OBR", "NTE", "SPM"}
+	if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+		t.Errorf("BuildLabOrderOMLO21(%v, %v, %v, %v) got segments diff (-want, +got):\n%s", header, patientInfo, order, msgTime, diff)
 	}
 
-	pids, err := m.AllPID()
+	tq1, err := m.TQ1()
 	if err != nil {
-		t.Fatalf("AllPID() failed with %v", err)
+		t.Fatalf("TQ1() failed with %v", err)
 	}
-	if got, want := len(pids), 2; got != want {
-		t.Fatalf("len(pids)=%v, want %v", got, want)
+	if got, want := tq1.Priority[0].Identifier.String(), "R"; got != want {
+		t.Errorf("tq1.Priority[0].Identifier.String()=%v, want %v", got, want)
 	}
-
-	pv1s, err := m.AllPV1()
+	spm, err := m.SPM()
 	if err != nil {
-		t.Fatalf("AllPV1() failed with %v", err)
-	}
-	if got, want := len(pv1s), 2; got != want {
-		t.Fatalf("len(pv1s)=%v, want %v", got, want)
+		t.Fatalf("SPM() failed with %v", err)
 	}
-	if diff := cmp.Diff(pv1s[0].AssignedPatientLocation, pv1s[1].AssignedPatientLocation); diff == "" {
-		t.Error("PV1.AssignedPatientLocation returned no diff between the two PV1 segments; want diff")
+	if got, want := spm.SpecimenType.Identifier.String(), "BLD"; got != want {
+		t.Errorf("spm.SpecimenType.Identifier.String()=%v, want %v", got, want)
 	}
 }
 
-func TestBuildMergeADTA34(t *testing.T) {
-	mergeTime := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
+func TestBuildLabOrderAckORLO22(t *testing.T) {
+	now := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
 	msgTime := time.Date(2018, 4, 28, 22, 39, 14, 0, time.UTC)
 	patientInfo := testPatientInfo()
 	header := testHeader()
-	mrn := "123"
 
-	adt, err := BuildMergeADTA34(header, patientInfo, mergeTime, msgTime, mrn)
-	if err != nil {
-		t.Fatalf("BuildMergeADTA34(%v, %v, %v, %v, %v) failed with %v", header, patientInfo, mergeTime, msgTime, mrn, err)
-	}
+	tests := []struct {
+		name         string
+		specimen     *ir.Specimen
+		wantSegments []string
+	}{{
+		name:         "with specimen",
+		specimen:     &ir.Specimen{Type: &ir.CodedElement{ID: "BLD"}},
+		wantSegments: []string{"MSH", "MSA", "PID", "ORC", "TQ1", "OBR", "SPM"},
+	}, {
+		name:         "without specimen",
+		wantSegments: []string{"MSH", "MSA", "PID", "ORC", "TQ1", "OBR"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			order := testOrder(now)
+			order.MessageControlIDOriginalOrder = "12345"
+			order.Specimen = tc.specimen
 
-	mo := hl7.NewParseMessageOptions()
-	mo.TimezoneLoc = time.UTC
+			orlO22, err := BuildLabOrderAckORLO22(header, patientInfo, order, msgTime)
+			if err != nil {
+				t.Fatalf("BuildLabOrderAckORLO22(%v, %v, %v, %v) failed with %v", header, patientInfo, order, msgTime, err)
+			}
+			mo := hl7.NewParseMessageOptions()
+			mo.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(orlO22.Message), mo)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", orlO22.Message, mo, err)
+			}
+
+			msh, err := m.MSH()
+			if err != nil {
+				t.Fatalf("MSH() failed with %v", err)
+			}
+			if got, want := msh.MessageType.MessageCode.String(), "ORL"; got != want {
+				t.Errorf("msh.MessageType.MessageCode.String()=%v, want %v", got, want)
+			}
+			if got, want := msh.MessageType.TriggerEvent.String(), "O22"; got != want {
+				t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
+			}
+			msa, err := m.MSA()
+			if err != nil {
+				t.Fatalf("MSA() failed with %v", err)
+			}
+			if got, want := msa.MessageControlID.String(), "12345"; got != want {
+				t.Errorf("msa.MessageControlID.String()=%v, want %v", got, want)
+			}
+
+			var gotSegments []string
+			for _, s := range strings.Split(orlO22.Message, SegmentTerminator) {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			if diff := cmp.Diff(tc.wantSegments, gotSegments); diff != "" {
+				t.Errorf("BuildLabOrderAckORLO22(%v, %v, %v, %v) got segments diff (-want, +got):\n%s", header, patientInfo, order, msgTime, diff)
+			}
+		})
+	}
+}
+
+func TestBuildBedSwapADTA17(t *testing.T) {
+	mergeTime := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 14, 0, time.UTC)
+	patientInfo := testPatientInfo()
+	patientInfo.Location.Poc = "onc-poc"
+	otherPatientInfo := testPatientInfo()
+	otherPatientInfo.Location.Poc = "another-poc"
+	header := testHeader()
+
+	adt, err := BuildBedSwapADTA17(header, patientInfo, mergeTime, msgTime, otherPatientInfo)
+	if err != nil {
+		t.Fatalf("BuildBedSwapADTA17(%v, %v, %v, %v, %v) failed with %v", header, patientInfo, mergeTime, msgTime, otherPatientInfo, err)
+	}
+
+	mo := hl7.NewParseMessageOptions()
+	mo.TimezoneLoc = time.UTC
+	m, err := hl7.ParseMessageWithOptions([]byte(adt.Message), mo)
+	if err != nil {
+		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", adt.Message, mo, err)
+	}
+
+	msh, err := m.MSH()
+	if err != nil {
+		t.Fatalf("MSH() failed with %v", err)
+	}
+	if msh == nil {
+		t.Fatal("MSH() got nil MSH segment, want non nil")
+	}
+	if got, want := msh.MessageType.MessageCode.String(), "ADT"; got != want {
+		t.Errorf("msh.MessageType.MessageType.String()=%v, want %v", got, want)
+	}
+	if got, want := msh.MessageType.TriggerEvent.String(), "A17"; got != want {
+		t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
+	}
+	if got, want := msh.DateTimeOfMessage.Time.Second(), msgTime.In(hl7.Location).Second(); got != want {
+		t.Errorf("msh.DateTimeOfMessage.Time.Second()=%v, want %v", got, want)
+	}
+
+	evn, err := m.EVN()
+	if err != nil {
+		t.Fatalf("EVN() failed with %v", err)
+	}
+	if evn == nil {
+		t.Fatal("EVN() got nil EVN segment, want non nil")
+	}
+	if got, want := evn.EventTypeCode.String(), "A17"; got != want {
+		t.Errorf("evn.EventTypeCode.String()=%v, want %v", got, want)
+	}
+	if got, want := evn.RecordedDateTime.Time.Second(), mergeTime.In(hl7.Location).Second(); got != want {
+		t.Errorf("evn.RecordedDateTime.Time.Second()=%v, want %v", got, want)
+	}
+
+	pids, err := m.AllPID()
+	if err != nil {
+		t.Fatalf("AllPID() failed with %v", err)
+	}
+	if got, want := len(pids), 2; got != want {
+		t.Fatalf("len(pids)=%v, want %v", got, want)
+	}
+
+	pv1s, err := m.AllPV1()
+	if err != nil {
+		t.Fatalf("AllPV1() failed with %v", err)
+	}
+	if got, want := len(pv1s), 2; got != want {
+		t.Fatalf("len(pv1s)=%v, want %v", got, want)
+	}
+	if diff := cmp.Diff(pv1s[0].AssignedPatientLocation, pv1s[1].AssignedPatientLocation); diff == "" {
+		t.Error("PV1.AssignedPatientLocation returned no diff between the two PV1 segments; want diff")
+	}
+}
+
+func TestBuildMergeADTA34(t *testing.T) {
+	mergeTime := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 14, 0, time.UTC)
+	patientInfo := testPatientInfo()
+	header := testHeader()
+	mrn := "123"
+
+	adt, err := BuildMergeADTA34(header, patientInfo, mergeTime, msgTime, mrn)
+	if err != nil {
+		t.Fatalf("BuildMergeADTA34(%v, %v, %v, %v, %v) failed with %v", header, patientInfo, mergeTime, msgTime, mrn, err)
+	}
+
+	mo := hl7.NewParseMessageOptions()
+	mo.TimezoneLoc = time.UTC
 	m, err := hl7.ParseMessageWithOptions([]byte(adt.Message), mo)
 	if err != nil {
 		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", adt.Message, mo, err)
@@ -3236,6 +3789,603 @@ func TestBuildDocumentNotificationMDMT02(t *testing.T) {
 	}
 }
 
+func TestBuildAppointmentSIU(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name             string
+		build            func(*HeaderInfo, *ir.PatientInfo, *ir.Appointment, time.Time) (*HL7Message, error)
+		wantTriggerEvent string
+		wantAction       string
+	}{
+		{name: "new appointment", build: BuildNewAppointmentSIUS12, wantTriggerEvent: "S12", wantAction: "A"},
+		{name: "reschedule appointment", build: BuildRescheduleAppointmentSIUS13, wantTriggerEvent: "S13", wantAction: "U"},
+		{name: "cancel appointment", build: BuildCancelAppointmentSIUS15, wantTriggerEvent: "S15", wantAction: "D"},
+		{name: "no show", build: BuildNoShowSIUS26, wantTriggerEvent: "S26", wantAction: "U"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := testAppointment()
+			patientInfo := testPatientInfo()
+			header := testHeader()
+			siu, err := tc.build(header, patientInfo, a, msgTime)
+			if err != nil {
+				t.Fatalf("build(%v, %v, %v, %v) failed with %v", header, patientInfo, a, msgTime, err)
+			}
+
+			mo := hl7.NewParseMessageOptions()
+			mo.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(siu.Message), mo)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", siu.Message, mo, err)
+			}
+
+			msh, err := m.MSH()
+			if err != nil {
+				t.Fatalf("MSH() failed with %v", err)
+			}
+			if got, want := msh.MessageType.MessageCode.String(), "SIU"; got != want {
+				t.Errorf("msh.MessageType.MessageCode.String()=%v, want %v", got, want)
+			}
+			if got, want := msh.MessageType.TriggerEvent.String(), tc.wantTriggerEvent; got != want {
+				t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
+			}
+
+			sch, err := m.SCH()
+			if err != nil {
+				t.Fatalf("SCH() failed with %v", err)
+			}
+			if sch == nil {
+				t.Fatal("SCH() got nil SCH segment, want non nil")
+			}
+			if got, want := sch.FillerAppointmentID.EntityIdentifier.String(), "filler-1"; got != want {
+				t.Errorf("sch.FillerAppointmentID.EntityIdentifier.String()=%v, want %v", got, want)
+			}
+
+			pid, err := m.PID()
+			if err != nil {
+				t.Fatalf("PID() failed with %v", err)
+			}
+			if pid == nil {
+				t.Error("PID() got nil PID segment, want non nil")
+			}
+
+			rgs, err := m.RGS()
+			if err != nil {
+				t.Fatalf("RGS() failed with %v", err)
+			}
+			if rgs == nil {
+				t.Fatal("RGS() got nil RGS segment, want non nil")
+			}
+			if got, want := rgs.SegmentActionCode.String(), tc.wantAction; got != want {
+				t.Errorf("rgs.SegmentActionCode.String()=%v, want %v", got, want)
+			}
+
+			ais, err := m.AIS()
+			if err != nil {
+				t.Fatalf("AIS() failed with %v", err)
+			}
+			if ais == nil {
+				t.Error("AIS() got nil AIS segment, want non nil")
+			}
+			ail, err := m.AIL()
+			if err != nil {
+				t.Fatalf("AIL() failed with %v", err)
+			}
+			if ail == nil {
+				t.Error("AIL() got nil AIL segment, want non nil")
+			}
+			aip, err := m.AIP()
+			if err != nil {
+				t.Fatalf("AIP() failed with %v", err)
+			}
+			if aip == nil {
+				t.Error("AIP() got nil AIP segment, want non nil")
+			}
+		})
+	}
+}
+
+func TestBuildPharmacyMessages(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	mo := testMedicationOrder()
+	dispense := &ir.MedicationDispense{ID: 1, DateTime: ir.NewValidTime(msgTime), Amount: "3", Units: "BAG"}
+	administration := &ir.MedicationAdministration{ID: 1, DateTime: ir.NewValidTime(msgTime), Dose: "1000", DoseUnits: "ml", CompletionStatus: "CP"}
+	patientInfo := testPatientInfo()
+	header := testHeader()
+
+	cases := []struct {
+		name             string
+		build            func() (*HL7Message, error)
+		wantMessageType  string
+		wantTriggerEvent string
+		wantSegments     []string
+	}{{
+		name:             "RDE^O11",
+		build:            func() (*HL7Message, error) { return BuildPharmacyOrderRDEO11(header, patientInfo, mo, msgTime) },
+		wantMessageType:  "RDE",
+		wantTriggerEvent: "O11",
+		wantSegments:     []string{"MSH", "PID", "PV1", "ORC", "RXE", "RXR", "RXC", "RXC"},
+	}, {
+		name: "RDS^O13",
+		build: func() (*HL7Message, error) {
+			return BuildPharmacyDispenseRDSO13(header, patientInfo, mo, dispense, msgTime)
+		},
+		wantMessageType:  "RDS",
+		wantTriggerEvent: "O13",
+		wantSegments:     []string{"MSH", "PID", "PV1", "ORC", "RXE", "RXR", "RXC", "RXC", "RXD", "RXR"},
+	}, {
+		name: "RAS^O17",
+		build: func() (*HL7Message, error) {
+			return BuildPharmacyAdministrationRASO17(header, patientInfo, mo, administration, msgTime)
+		},
+		wantMessageType:  "RAS",
+		wantTriggerEvent: "O17",
+		wantSegments:     []string{"MSH", "PID", "PV1", "ORC", "RXA", "RXR"},
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.build()
+			if err != nil {
+				t.Fatalf("build() failed with %v", err)
+			}
+
+			var gotSegments []string
+			for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			if diff := cmp.Diff(tc.wantSegments, gotSegments); diff != "" {
+				t.Errorf("build() got segments diff (-want, +got):\n%s", diff)
+			}
+
+			po := hl7.NewParseMessageOptions()
+			po.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(msg.Message), po)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", msg.Message, po, err)
+			}
+			msh, err := m.MSH()
+			if err != nil {
+				t.Fatalf("MSH() failed with %v", err)
+			}
+			if got, want := msh.MessageType.MessageCode.String(), tc.wantMessageType; got != want {
+				t.Errorf("msh.MessageType.MessageCode.String()=%v, want %v", got, want)
+			}
+			if got, want := msh.MessageType.TriggerEvent.String(), tc.wantTriggerEvent; got != want {
+				t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
+			}
+			orc, err := m.ORC()
+			if err != nil {
+				t.Fatalf("ORC() failed with %v", err)
+			}
+			if got, want := orc.FillerOrderNumber.EntityIdentifier.String(), "filler-1"; got != want {
+				t.Errorf("orc.FillerOrderNumber.EntityIdentifier.String()=%v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBuildVaccinationVXUV04(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	v := testVaccination()
+	msg, err := BuildVaccinationVXUV04(testHeader(), testPatientInfo(), v, msgTime)
+	if err != nil {
+		t.Fatalf("BuildVaccinationVXUV04() failed with %v", err)
+	}
+
+	var gotSegments []string
+	for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+		gotSegments = append(gotSegments, s[:3])
+	}
+	wantSegments := []string{"MSH", "PID", "PV1", "ORC", "RXA", "RXR", "OBX"}
+	if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+		t.Errorf("BuildVaccinationVXUV04() got segments diff (-want, +got):\n%s", diff)
+	}
+
+	po := hl7.NewParseMessageOptions()
+	po.TimezoneLoc = time.UTC
+	m, err := hl7.ParseMessageWithOptions([]byte(msg.Message), po)
+	if err != nil {
+		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", msg.Message, po, err)
+	}
+	msh, err := m.MSH()
+	if err != nil {
+		t.Fatalf("MSH() failed with %v", err)
+	}
+	if got, want := msh.MessageType.MessageCode.String(), "VXU"; got != want {
+		t.Errorf("msh.MessageType.MessageCode.String()=%v, want %v", got, want)
+	}
+	if got, want := msh.MessageType.TriggerEvent.String(), "V04"; got != want {
+		t.Errorf("msh.MessageType.TriggerEvent.String()=%v, want %v", got, want)
+	}
+	obx, err := m.OBX()
+	if err != nil {
+		t.Fatalf("OBX() failed with %v", err)
+	}
+	if got, want := obx.ObservationIdentifier.Identifier.String(), "64994-7"; got != want {
+		t.Errorf("obx.ObservationIdentifier.Identifier.String()=%v, want %v", got, want)
+	}
+}
+
+func TestBuildChargeDFTP03(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	p := testPatientInfo()
+	p.Account = &ir.Account{Number: "account-1"}
+	charges := []*ir.Charge{testCharge(), testCharge()}
+	msg, err := BuildChargeDFTP03(testHeader(), p, charges, msgTime, msgTime)
+	if err != nil {
+		t.Fatalf("BuildChargeDFTP03() failed with %v", err)
+	}
+
+	var gotSegments []string
+	for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+		gotSegments = append(gotSegments, s[:5])
+	}
+	wantSegments := []string{"MSH|^", "EVN|P", "PID|1", "PV1|1", "FT1|1", "FT1|2"}
+	if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+		t.Errorf("BuildChargeDFTP03() got segments diff (-want, +got):\n%s", diff)
+	}
+	if got, want := msg.Type.String(), "DFT^P03"; got != want {
+		t.Errorf("msg.Type.String()=%v, want %v", got, want)
+	}
+}
+
+func TestBuildAccountBAR(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	p := testPatientInfo()
+	p.Account = &ir.Account{Number: "account-1"}
+	p.Guarantor = &ir.AssociatedParty{Person: p.Person, Relationship: &ir.CodedElement{ID: "SEL", Text: "SEL"}}
+	p.Insurances = []*ir.Insurance{{CompanyName: "Acme Health"}, {CompanyName: "Other Health"}}
+	p.Encounters = []*ir.Encounter{{
+		Diagnoses:  []*ir.DiagnosisOrProcedure{testDiagnosis()},
+		Procedures: []*ir.DiagnosisOrProcedure{testProcedure(), testProcedure()},
+	}}
+
+	cases := []struct {
+		name  string
+		build func(*HeaderInfo, *ir.PatientInfo, time.Time, time.Time) (*HL7Message, error)
+		want  string
+	}{
+		{name: "P01", build: BuildAddAccountBARP01, want: "BAR^P01"},
+		{name: "P05", build: BuildUpdateAccountBARP05, want: "BAR^P05"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.build(testHeader(), p, msgTime, msgTime)
+			if err != nil {
+				t.Fatalf("Build BAR^%s failed with %v", tc.name, err)
+			}
+			if got := msg.Type.String(); got != tc.want {
+				t.Errorf("msg.Type.String()=%v, want %v", got, tc.want)
+			}
+			var gotSegments []string
+			for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			wantSegments := []string{"MSH", "EVN", "PID", "PV1", "DG1", "PR1", "PR1", "GT1", "IN1", "IN2", "IN1", "IN2"}
+			if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+				t.Errorf("Build BAR^%s got segments diff (-want, +got):\n%s", tc.name, diff)
+			}
+			if !strings.Contains(msg.Message, "|account-1|") {
+				t.Errorf("Build BAR^%s got message %q, want it to contain the account number", tc.name, msg.Message)
+			}
+		})
+	}
+}
+
+func TestBuildADTWithCoverage(t *testing.T) {
+	msgTime := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	p := testPatientInfo()
+	p.Guarantor = &ir.AssociatedParty{Person: p.Person, Relationship: &ir.CodedElement{ID: "SEL", Text: "SEL"}}
+	p.Insurances = []*ir.Insurance{{CompanyName: "Acme Health", Insured: p.Guarantor}}
+
+	cases := []struct {
+		name  string
+		build func() (*HL7Message, error)
+	}{{
+		name:  "ADT^A01",
+		build: func() (*HL7Message, error) { return BuildAdmissionADTA01(testHeader(), p, msgTime, msgTime) },
+	}, {
+		name:  "ADT^A04",
+		build: func() (*HL7Message, error) { return BuildRegistrationADTA04(testHeader(), p, msgTime, msgTime) },
+	}, {
+		name:  "ADT^A05",
+		build: func() (*HL7Message, error) { return BuildPreAdmitADTA05(testHeader(), p, msgTime, msgTime) },
+	}, {
+		name:  "ADT^A08",
+		build: func() (*HL7Message, error) { return BuildUpdatePatientADTA08(testHeader(), p, true, msgTime, msgTime) },
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.build()
+			if err != nil {
+				t.Fatalf("Build %s failed with %v", tc.name, err)
+			}
+			segments := strings.Split(msg.Message, SegmentTerminator)
+			var gotSegments []string
+			for _, s := range segments[len(segments)-3:] {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			wantSegments := []string{"GT1", "IN1", "IN2"}
+			if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+				t.Errorf("Build %s got last segments diff (-want, +got):\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestBuildVisitUpdateADT(t *testing.T) {
+	eventTime := time.Date(2018, 7, 2, 15, 36, 30, 0, time.UTC)
+	msgTime := time.Date(2018, 7, 2, 15, 37, 30, 0, time.UTC)
+	p := testPatientInfo()
+
+	cases := []struct {
+		triggerEvent string
+		build        func(*HeaderInfo, *ir.PatientInfo, time.Time, time.Time) (*HL7Message, error)
+	}{
+		{triggerEvent: "A06", build: BuildChangeOutpatientToInpatientADTA06},
+		{triggerEvent: "A07", build: BuildChangeInpatientToOutpatientADTA07},
+		{triggerEvent: "A21", build: BuildLeaveOfAbsenceADTA21},
+		{triggerEvent: "A22", build: BuildReturnFromLeaveOfAbsenceADTA22},
+	}
+	for _, tc := range cases {
+		t.Run(tc.triggerEvent, func(t *testing.T) {
+			header := testHeader()
+			msg, err := tc.build(header, p, eventTime, msgTime)
+			if err != nil {
+				t.Fatalf("Build ADT^%s(%v, %v, %v, %v) failed with %v", tc.triggerEvent, header, p, eventTime, msgTime, err)
+			}
+			if got, want := msg.Type.TriggerEvent, tc.triggerEvent; got != want {
+				t.Errorf("msg.Type.TriggerEvent=%v, want %v", got, want)
+			}
+			var gotSegments []string
+			for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			wantSegments := []string{"MSH", "EVN", "PID", "PD1", "PV1", "PV2"}
+			if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+				t.Errorf("Build ADT^%s got segments diff (-want, +got):\n%s", tc.triggerEvent, diff)
+			}
+
+			mo := hl7.NewParseMessageOptions()
+			mo.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(msg.Message), mo)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", msg.Message, mo, err)
+			}
+			evn, err := m.EVN()
+			if err != nil {
+				t.Fatalf("EVN() failed with %v", err)
+			}
+			if got, want := evn.EventTypeCode.String(), tc.triggerEvent; got != want {
+				t.Errorf("evn.EventTypeCode.String()=%v, want %v", got, want)
+			}
+			pv1, err := m.PV1()
+			if err != nil {
+				t.Fatalf("PV1() failed with %v", err)
+			}
+			if got, want := pv1.PatientClass.String(), p.Class; got != want {
+				t.Errorf("pv1.PatientClass.String()=%v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBuildLinkADT(t *testing.T) {
+	eventTime := time.Date(2018, 7, 2, 15, 36, 30, 0, time.UTC)
+	msgTime := time.Date(2018, 7, 2, 15, 37, 30, 0, time.UTC)
+	p := testPatientInfo()
+	other := testPatientInfo()
+	otherPerson := *other.Person
+	otherPerson.MRN = "456"
+	other.Person = &otherPerson
+
+	cases := []struct {
+		triggerEvent string
+		build        func(*HeaderInfo, *ir.PatientInfo, time.Time, time.Time, *ir.PatientInfo) (*HL7Message, error)
+	}{
+		{triggerEvent: "A24", build: BuildLinkPatientADTA24},
+		{triggerEvent: "A37", build: BuildUnlinkPatientADTA37},
+	}
+	for _, tc := range cases {
+		t.Run(tc.triggerEvent, func(t *testing.T) {
+			header := testHeader()
+			msg, err := tc.build(header, p, eventTime, msgTime, other)
+			if err != nil {
+				t.Fatalf("Build ADT^%s(%v, %v, %v, %v, %v) failed with %v", tc.triggerEvent, header, p, eventTime, msgTime, other, err)
+			}
+			var gotSegments []string
+			for _, s := range strings.Split(msg.Message, SegmentTerminator) {
+				gotSegments = append(gotSegments, s[:3])
+			}
+			wantSegments := []string{"MSH", "EVN", "PID", "PV1", "PID", "PV1"}
+			if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+				t.Errorf("Build ADT^%s got segments diff (-want, +got):\n%s", tc.triggerEvent, diff)
+			}
+
+			mo := hl7.NewParseMessageOptions()
+			mo.TimezoneLoc = time.UTC
+			m, err := hl7.ParseMessageWithOptions([]byte(msg.Message), mo)
+			if err != nil {
+				t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", msg.Message, mo, err)
+			}
+			pids, err := m.AllPID()
+			if err != nil {
+				t.Fatalf("AllPID() failed with %v", err)
+			}
+			var gotMRNs []string
+			for _, pid := range pids {
+				gotMRNs = append(gotMRNs, pid.PatientIdentifierList[0].IDNumber.String())
+			}
+			if diff := cmp.Diff([]string{p.Person.MRN, "456"}, gotMRNs); diff != "" {
+				t.Errorf("Build ADT^%s got PID MRNs diff (-want, +got):\n%s", tc.triggerEvent, diff)
+			}
+		})
+	}
+}
+
+func TestBuildChangeIdentifierADTA47(t *testing.T) {
+	eventTime := time.Date(2018, 7, 2, 15, 36, 30, 0, time.UTC)
+	msgTime := time.Date(2018, 7, 2, 15, 37, 30, 0, time.UTC)
+	p := testPatientInfo()
+	header := testHeader()
+	priorMRN := "123"
+
+	adt, err := BuildChangeIdentifierADTA47(header, p, eventTime, msgTime, priorMRN)
+	if err != nil {
+		t.Fatalf("BuildChangeIdentifierADTA47(%v, %v, %v, %v, %v) failed with %v", header, p, eventTime, msgTime, priorMRN, err)
+	}
+
+	mo := hl7.NewParseMessageOptions()
+	mo.TimezoneLoc = time.UTC
+	m, err := hl7.ParseMessageWithOptions([]byte(adt.Message), mo)
+	if err != nil {
+		t.Fatalf("ParseMessageWithOptions(%v, %v) failed with %v", adt.Message, mo, err)
+	}
+	evn, err := m.EVN()
+	if err != nil {
+		t.Fatalf("EVN() failed with %v", err)
+	}
+	if got, want := evn.EventTypeCode.String(), "A47"; got != want {
+		t.Errorf("evn.EventTypeCode.String()=%v, want %v", got, want)
+	}
+	pid, err := m.PID()
+	if err != nil {
+		t.Fatalf("PID() failed with %v", err)
+	}
+	if got, want := pid.PatientIdentifierList[0].IDNumber.String(), p.Person.MRN; got != want {
+		t.Errorf("pid.PatientIdentifierList[0].IDNumber.String()=%v, want %v", got, want)
+	}
+	mrg, err := m.MRG()
+	if err != nil {
+		t.Fatalf("MRG() failed with %v", err)
+	}
+	if mrg == nil {
+		t.Fatal("MRG() got nil MRG segment, want non nil")
+	}
+	if got, want := mrg.PriorPatientIdentifierList[0].IDNumber.String(), priorMRN; got != want {
+		t.Errorf("mrg.PriorPatientIdentifierList[0].IDNumber.String()=%v, want %v", got, want)
+	}
+}
+
+// TestBuild_XMLRoundTrip checks that all the message types that the simulator produces can be
+// converted to the XML encoding and back without losing any values.
+func TestBuild_XMLRoundTrip(t *testing.T) {
+	eventTime := time.Date(2018, 4, 28, 22, 38, 14, 0, time.UTC)
+	msgTime := time.Date(2018, 4, 28, 22, 39, 14, 0, time.UTC)
+	h := testHeader()
+	p := testPatientInfo()
+	other := testPatientInfo()
+	order := testOrderWithResult(eventTime)
+	mo := testMedicationOrder()
+	dispense := &ir.MedicationDispense{ID: 1, DateTime: ir.NewValidTime(eventTime), Amount: "3", Units: "BAG"}
+	administration := &ir.MedicationAdministration{ID: 2, DateTime: ir.NewValidTime(eventTime), Dose: "1000", DoseUnits: "ml", CompletionStatus: "CP"}
+	adt := map[string]func(*HeaderInfo, *ir.PatientInfo, time.Time, time.Time) (*HL7Message, error){
+		"ADT^A01": BuildAdmissionADTA01,
+		"ADT^A02": BuildTransferADTA02,
+		"ADT^A03": BuildDischargeADTA03,
+		"ADT^A04": BuildRegistrationADTA04,
+		"ADT^A05": BuildPreAdmitADTA05,
+		"ADT^A06": BuildChangeOutpatientToInpatientADTA06,
+		"ADT^A07": BuildChangeInpatientToOutpatientADTA07,
+		"ADT^A09": BuildTrackDepartureADTA09,
+		"ADT^A10": BuildTrackArrivalADTA10,
+		"ADT^A11": BuildCancelVisitADTA11,
+		"ADT^A12": BuildCancelTransferADTA12,
+		"ADT^A13": BuildCancelDischargeADTA13,
+		"ADT^A14": BuildPendingAdmissionADTA14,
+		"ADT^A15": BuildPendingTransferADTA15,
+		"ADT^A16": BuildPendingDischargeADTA16,
+		"ADT^A21": BuildLeaveOfAbsenceADTA21,
+		"ADT^A22": BuildReturnFromLeaveOfAbsenceADTA22,
+		"ADT^A23": BuildDeleteVisitADTA23,
+		"ADT^A25": BuildCancelPendingDischargeADTA25,
+		"ADT^A26": BuildCancelPendingTransferADTA26,
+		"ADT^A27": BuildCancelPendingAdmitADTA27,
+		"ADT^A28": BuildAddPersonADTA28,
+		"ADT^A31": BuildUpdatePersonADTA31,
+		"BAR^P01": BuildAddAccountBARP01,
+		"BAR^P05": BuildUpdateAccountBARP05,
+	}
+	builders := map[string]func() (*HL7Message, error){
+		"ADT^A08": func() (*HL7Message, error) { return BuildUpdatePatientADTA08(h, p, true, eventTime, msgTime) },
+		"ADT^A17": func() (*HL7Message, error) { return BuildBedSwapADTA17(h, p, eventTime, msgTime, other) },
+		"ADT^A24": func() (*HL7Message, error) { return BuildLinkPatientADTA24(h, p, eventTime, msgTime, other) },
+		"ADT^A34": func() (*HL7Message, error) { return BuildMergeADTA34(h, p, eventTime, msgTime, "123") },
+		"ADT^A37": func() (*HL7Message, error) { return BuildUnlinkPatientADTA37(h, p, eventTime, msgTime, other) },
+		"ADT^A40": func() (*HL7Message, error) { return BuildMergeADTA40(h, p, eventTime, msgTime, []string{"123", "456"}) },
+		"ADT^A47": func() (*HL7Message, error) { return BuildChangeIdentifierADTA47(h, p, eventTime, msgTime, "123") },
+		"DFT^P03": func() (*HL7Message, error) {
+			return BuildChargeDFTP03(h, p, []*ir.Charge{testCharge()}, eventTime, msgTime)
+		},
+		"MDM^T02": func() (*HL7Message, error) {
+			return BuildDocumentNotificationMDMT02(h, p, document(), eventTime, msgTime)
+		},
+		"OML^O21": func() (*HL7Message, error) { return BuildLabOrderOMLO21(h, p, order, msgTime) },
+		"ORL^O22": func() (*HL7Message, error) { return BuildLabOrderAckORLO22(h, p, order, msgTime) },
+		"ORM^O01": func() (*HL7Message, error) { return BuildOrderORMO01(h, p, order, msgTime) },
+		"ORR^O02": func() (*HL7Message, error) { return BuildPathologyORRO02(h, p, order, msgTime) },
+		"ORU^R01": func() (*HL7Message, error) { return BuildResultORUR01(h, p, order, msgTime) },
+		"ORU^R01 with a clinical note": func() (*HL7Message, error) {
+			return BuildResultORUR01(h, p, orderWithClinicalNote(eventTime, rtfContent), msgTime)
+		},
+		"ORU^R03": func() (*HL7Message, error) { return BuildResultORUR03(h, p, order, msgTime) },
+		"ORU^R32": func() (*HL7Message, error) { return BuildResultORUR32(h, p, order, msgTime) },
+		"RAS^O17": func() (*HL7Message, error) {
+			return BuildPharmacyAdministrationRASO17(h, p, mo, administration, msgTime)
+		},
+		"RDE^O11": func() (*HL7Message, error) { return BuildPharmacyOrderRDEO11(h, p, mo, msgTime) },
+		"RDS^O13": func() (*HL7Message, error) { return BuildPharmacyDispenseRDSO13(h, p, mo, dispense, msgTime) },
+		"SIU^S12": func() (*HL7Message, error) { return BuildNewAppointmentSIUS12(h, p, testAppointment(), msgTime) },
+		"SIU^S13": func() (*HL7Message, error) { return BuildRescheduleAppointmentSIUS13(h, p, testAppointment(), msgTime) },
+		"SIU^S15": func() (*HL7Message, error) { return BuildCancelAppointmentSIUS15(h, p, testAppointment(), msgTime) },
+		"SIU^S26": func() (*HL7Message, error) { return BuildNoShowSIUS26(h, p, testAppointment(), msgTime) },
+		"VXU^V04": func() (*HL7Message, error) { return BuildVaccinationVXUV04(h, p, testVaccination(), msgTime) },
+	}
+	for name, build := range adt {
+		build := build
+		builders[name] = func() (*HL7Message, error) { return build(h, p, eventTime, msgTime) }
+	}
+
+	for name, build := range builders {
+		t.Run(name, func(t *testing.T) {
+			msg, err := build()
+			if err != nil {
+				t.Fatalf("Build%s() failed with %v", name, err)
+			}
+			m, err := hl7.ParseMessage([]byte(msg.Message))
+			if err != nil {
+				t.Fatalf("hl7.ParseMessage(%q) failed with %v", msg.Message, err)
+			}
+			x, err := hl7.MarshalMessageXML(m)
+			if err != nil {
+				t.Fatalf("hl7.MarshalMessageXML() failed with %v", err)
+			}
+			got, err := hl7.ParseMessageXML(x)
+			if err != nil {
+				t.Fatalf("hl7.ParseMessageXML(%s) failed with %v", x, err)
+			}
+			wantSegments, err := m.All()
+			if err != nil {
+				t.Fatalf("All() failed with %v", err)
+			}
+			gotSegments, err := got.All()
+			if err != nil {
+				t.Fatalf("All() failed with %v", err)
+			}
+			if diff := cmp.Diff(wantSegments, gotSegments); diff != "" {
+				t.Errorf("hl7.ParseMessageXML(%s) got segments with diff (-want, +got):\n%s", x, diff)
+			}
+			gotX, err := hl7.MarshalMessageXML(got)
+			if err != nil {
+				t.Fatalf("hl7.MarshalMessageXML() failed with %v", err)
+			}
+			if diff := cmp.Diff(string(x), string(gotX)); diff != "" {
+				t.Errorf("hl7.MarshalMessageXML() of the round-tripped message got diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func testOrderWithResult(now time.Time) *ir.Order {
 	order := testOrder(now)
 	order.Results = []*ir.Result{{
@@ -3326,6 +4476,101 @@ func document() *ir.Document {
 	}
 }
 
+func testAppointment() *ir.Appointment {
+	doctor := testDoctor()
+	doctor.Specialty = "MED"
+	return &ir.Appointment{
+		PlacerAppointmentID: "placer-1",
+		FillerAppointmentID: "filler-1",
+		EventReason:         "Patient request",
+		AppointmentReason:   &ir.CodedElement{ID: "ROUTINE", Text: "ROUTINE", CodingSystem: "HL70276"},
+		AppointmentType:     &ir.CodedElement{ID: "Normal", Text: "Normal", CodingSystem: "HL70277"},
+		FillerStatus:        "Booked",
+		Start:               ir.NewValidTime(time.Date(2019, 6, 15, 8, 0, 0, 0, time.UTC)),
+		DurationMinutes:     30,
+		Service:             &ir.CodedElement{ID: "MED", Text: "MED"},
+		Location: &ir.PatientLocation{
+			Poc:          "OutpatientClinic",
+			Room:         "Clinic1",
+			Facility:     "Simulated Hospital",
+			LocationType: "CLINIC",
+			Building:     "Main Building",
+			Floor:        "2",
+		},
+		Doctor: doctor,
+	}
+}
+
+func testMedicationOrder() *ir.MedicationOrder {
+	return &ir.MedicationOrder{
+		Placer:         "placer-1",
+		Filler:         "filler-1",
+		OrderDateTime:  ir.NewValidTime(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)),
+		OrderControl:   "NW",
+		OrderStatus:    "IP",
+		Medication:     &ir.CodedElement{ID: "med-2", Text: "Saline with potassium", CodingSystem: "WinPath"},
+		Dose:           "1000",
+		DoseUnits:      "ml",
+		DosageForm:     "SOL",
+		Route:          "IV",
+		Frequency:      "Q8H",
+		DispenseAmount: "3",
+		DispenseUnits:  "BAG",
+		Components: []*ir.MedicationComponent{{
+			Type:   "B",
+			Code:   &ir.CodedElement{ID: "med-2-1", Text: "Saline", CodingSystem: "WinPath"},
+			Amount: "1000",
+			Units:  "ml",
+		}, {
+			Type:   "A",
+			Code:   &ir.CodedElement{ID: "med-2-2", Text: "Potassium chloride", CodingSystem: "WinPath"},
+			Amount: "20",
+			Units:  "mmol",
+		}},
+		Prescriber: testDoctor(),
+	}
+}
+
+func testVaccination() *ir.Vaccination {
+	return &ir.Vaccination{
+		Placer:           "placer-1",
+		Filler:           "filler-1",
+		DateTime:         ir.NewValidTime(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)),
+		Vaccine:          &ir.CodedElement{ID: "141", Text: "Influenza, seasonal, injectable", CodingSystem: "CVX"},
+		Dose:             "0.5",
+		DoseUnits:        "mL",
+		Route:            "IM",
+		Site:             "LD",
+		LotNumber:        "FLU0001A",
+		Manufacturer:     &ir.CodedElement{ID: "SKB", Text: "GlaxoSmithKline", CodingSystem: "MVX"},
+		Administrator:    testDoctor(),
+		CompletionStatus: "CP",
+		Observations: []*ir.Result{{
+			TestName:            &ir.CodedElement{ID: "64994-7", Text: "Vaccine funding program eligibility category", CodingSystem: "LN"},
+			ValueType:           "CE",
+			Value:               "V01^^HL70064",
+			Status:              "F",
+			ObservationDateTime: ir.NewValidTime(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)),
+		}},
+	}
+}
+
+func testCharge() *ir.Charge {
+	return &ir.Charge{
+		ID:                  "transaction-1",
+		TransactionDateTime: ir.NewValidTime(time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)),
+		PostingDateTime:     ir.NewValidTime(time.Date(2019, 6, 1, 11, 0, 0, 0, time.UTC)),
+		TransactionType:     "CG",
+		Code:                &ir.CodedElement{ID: "PROC-1", Text: "Procedure1", CodingSystem: "LOCAL"},
+		Quantity:            2,
+		UnitAmount:          300,
+		Location:            testPatientInfo().Location,
+		PerformedBy:         testDoctor(),
+		FillerOrderNumber:   "filler-1",
+		Procedure:           &ir.CodedElement{ID: "P24.9", Text: "Procedure1", CodingSystem: "SNMCT"},
+	}
+}
+
 func testPatientInfo() *ir.PatientInfo {
 	ap := &ir.AssociatedParty{
 		Person: &ir.Person{
@@ -0,0 +1,280 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package microbiology is responsible for parsing the catalogue of the organisms that are identified
+// by microbiology cultures, together with how often they occur, and of the antibiotics that the
+// organisms are tested against.
+package microbiology
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/bitcrshr/simhospital/pkg/sample"
+	"github.com/pkg/errors"
+)
+
+var log = logging.ForCallerPackage()
+
+// organismCodingSystem is the default coding system of the organisms: SNOMED CT.
+const organismCodingSystem = "SCT"
+
+// defaultMICUnit is the default unit of the minimum inhibitory concentrations.
+const defaultMICUnit = "mg/L"
+
+// Catalogue contains the organisms that are identified by cultures and the antibiotics that they
+// are tested against.
+type Catalogue struct {
+	// OrganismObservation is the observation identifier of the results that report the organisms
+	// identified by a culture, or the lack of growth.
+	OrganismObservation ir.CodedElement
+	// SusceptibilityPanel is the universal service identifier of the child orders that report the
+	// antibiotic susceptibilities of each organism.
+	SusceptibilityPanel ir.CodedElement
+	// MICUnit is the unit of the minimum inhibitory concentrations.
+	MICUnit string
+	// organisms is a map of Organisms keyed by their lowercase codes and names.
+	organisms map[string]*Organism
+	// antibiotics is a map of Antibiotics keyed by their lowercase codes and names.
+	antibiotics map[string]*Antibiotic
+	// distribution is the distribution of the Organisms that are identified by cultures.
+	distribution sample.DiscreteDistribution
+}
+
+// Organism is an organism that can be identified by a culture.
+type Organism struct {
+	// Code identifies the organism. Its Text is the name of the organism.
+	Code ir.CodedElement
+	// Panel are the antibiotics that the organism is tested against, sorted by antibiotic code.
+	Panel []*PanelAntibiotic
+	// Weight is how often the organism is identified, relative to the other organisms.
+	Weight uint
+}
+
+// PanelAntibiotic is an antibiotic in the susceptibility panel of an organism.
+type PanelAntibiotic struct {
+	Antibiotic *Antibiotic
+	// Resistance is the percentage of the isolates of the organism that are resistant to the
+	// antibiotic.
+	Resistance uint
+}
+
+// Antibiotic is an antibiotic that organisms are tested against.
+type Antibiotic struct {
+	// Code identifies the antibiotic. Its Text is the name of the antibiotic.
+	Code ir.CodedElement
+	// SusceptibleMIC is the breakpoint at or below which organisms are susceptible to the antibiotic.
+	SusceptibleMIC float64
+	// ResistantMIC is the breakpoint above which organisms are resistant to the antibiotic.
+	// Organisms with a MIC between both breakpoints are intermediately susceptible.
+	ResistantMIC float64
+}
+
+// Organism returns the Organism with the given code or name. The name is case insensitive.
+func (c *Catalogue) Organism(key string) (*Organism, bool) {
+	o, ok := c.organisms[strings.ToLower(key)]
+	return o, ok
+}
+
+// Antibiotic returns the Antibiotic with the given code or name. The name is case insensitive.
+func (c *Catalogue) Antibiotic(key string) (*Antibiotic, bool) {
+	a, ok := c.antibiotics[strings.ToLower(key)]
+	return a, ok
+}
+
+// RandomOrganism returns a random Organism according to the weights of the organisms, or nil if
+// no organism has a weight.
+func (c *Catalogue) RandomOrganism() *Organism {
+	o, _ := c.distribution.Random().(*Organism)
+	return o
+}
+
+// RandomInterpretation returns whether the organism is susceptible or resistant to the antibiotic,
+// at random according to the resistance of the organism to the antibiotic.
+func (p *PanelAntibiotic) RandomInterpretation() string {
+	if uint(rand.Intn(100)) < p.Resistance {
+		return constants.Resistant
+	}
+	return constants.Susceptible
+}
+
+// Interpretation returns whether an organism with the given MIC is susceptible, intermediately
+// susceptible or resistant to the antibiotic.
+func (a *Antibiotic) Interpretation(mic float64) string {
+	switch {
+	case mic <= a.SusceptibleMIC:
+		return constants.Susceptible
+	case mic > a.ResistantMIC:
+		return constants.Resistant
+	default:
+		return constants.Intermediate
+	}
+}
+
+// RandomMIC returns a random MIC in the doubling dilution series of the antibiotic that matches
+// the given interpretation. Returns false if there is no such MIC, for instance, for intermediate
+// susceptibilities to antibiotics that don't have an intermediate range.
+func (a *Antibiotic) RandomMIC(interpretation string) (float64, bool) {
+	switch interpretation {
+	case constants.Susceptible:
+		return a.SusceptibleMIC / math.Pow(2, float64(rand.Intn(4))), true
+	case constants.Intermediate:
+		if mic := a.SusceptibleMIC * 2; mic <= a.ResistantMIC {
+			return mic, true
+		}
+		return 0, false
+	case constants.Resistant:
+		return a.ResistantMIC * math.Pow(2, float64(1+rand.Intn(2))), true
+	default:
+		return 0, false
+	}
+}
+
+type catalogue struct {
+	OrganismObservation coded  `yaml:"organism_observation"`
+	SusceptibilityPanel coded  `yaml:"susceptibility_panel"`
+	MICUnit             string `yaml:"mic_unit"`
+	Antibiotics         map[string]antibiotic
+	Organisms           map[string]organism
+}
+
+type coded struct {
+	ID           string
+	Text         string
+	CodingSystem string `yaml:"coding_system"`
+}
+
+type antibiotic struct {
+	Name           string
+	CodingSystem   string  `yaml:"coding_system"`
+	SusceptibleMIC float64 `yaml:"susceptible_mic"`
+	ResistantMIC   float64 `yaml:"resistant_mic"`
+}
+
+type organism struct {
+	Name         string
+	CodingSystem string `yaml:"coding_system"`
+	Weight       uint
+	// Susceptibilities are the resistance percentages keyed by antibiotic code.
+	Susceptibilities map[string]uint
+}
+
+func (c coded) codedElement() ir.CodedElement {
+	text := c.Text
+	if text == "" {
+		text = c.ID
+	}
+	return ir.CodedElement{ID: c.ID, Text: text, CodingSystem: c.CodingSystem}
+}
+
+// Load parses the microbiology catalogue from the given file.
+// The coding system of the antibiotics defaults to the coding system in the HL7 configuration, and
+// the coding system of the organisms defaults to SNOMED CT.
+func Load(ctx context.Context, filename string, hl7Config *config.HL7Config) (*Catalogue, error) {
+	var parsed catalogue
+	if err := config.LoadYAML(ctx, filename, "microbiology", &parsed); err != nil {
+		return nil, err
+	}
+	if parsed.OrganismObservation.ID == "" {
+		return nil, errors.Errorf("organism_observation in %s: id is required", filename)
+	}
+	if parsed.SusceptibilityPanel.ID == "" {
+		return nil, errors.Errorf("susceptibility_panel in %s: id is required", filename)
+	}
+	micUnit := parsed.MICUnit
+	if micUnit == "" {
+		micUnit = defaultMICUnit
+	}
+
+	antibiotics := map[string]*Antibiotic{}
+	byCode := map[string]*Antibiotic{}
+	log.Info("Loading antibiotics")
+	for code, ab := range parsed.Antibiotics {
+		if ab.Name == "" {
+			return nil, errors.Errorf("antibiotic %q in %s: name is required", code, filename)
+		}
+		if ab.SusceptibleMIC <= 0 || ab.ResistantMIC < ab.SusceptibleMIC {
+			return nil, errors.Errorf("antibiotic %q in %s: susceptible_mic must be positive and not greater than resistant_mic", code, filename)
+		}
+		codingSystem := ab.CodingSystem
+		if codingSystem == "" {
+			codingSystem = hl7Config.CodingSystem
+		}
+		a := &Antibiotic{
+			Code:           ir.CodedElement{ID: code, Text: ab.Name, CodingSystem: codingSystem},
+			SusceptibleMIC: ab.SusceptibleMIC,
+			ResistantMIC:   ab.ResistantMIC,
+		}
+		byCode[code] = a
+		antibiotics[strings.ToLower(code)] = a
+		antibiotics[strings.ToLower(ab.Name)] = a
+		log.Infof(" - %s: %s", code, ab.Name)
+	}
+
+	organisms := map[string]*Organism{}
+	codes := make([]string, 0, len(parsed.Organisms))
+	log.Info("Loading organisms")
+	for code, org := range parsed.Organisms {
+		if org.Name == "" {
+			return nil, errors.Errorf("organism %q in %s: name is required", code, filename)
+		}
+		codingSystem := org.CodingSystem
+		if codingSystem == "" {
+			codingSystem = organismCodingSystem
+		}
+		o := &Organism{
+			Code:   ir.CodedElement{ID: code, Text: org.Name, CodingSystem: codingSystem},
+			Weight: org.Weight,
+		}
+		for abCode, resistance := range org.Susceptibilities {
+			ab, ok := byCode[abCode]
+			if !ok {
+				return nil, errors.Errorf("organism %q in %s: unknown antibiotic %q", code, filename, abCode)
+			}
+			if resistance > 100 {
+				return nil, errors.Errorf("organism %q in %s: resistance to %q must be a percentage", code, filename, abCode)
+			}
+			o.Panel = append(o.Panel, &PanelAntibiotic{Antibiotic: ab, Resistance: resistance})
+		}
+		sort.Slice(o.Panel, func(i, j int) bool {
+			return o.Panel[i].Antibiotic.Code.ID < o.Panel[j].Antibiotic.Code.ID
+		})
+		organisms[strings.ToLower(code)] = o
+		organisms[strings.ToLower(org.Name)] = o
+		codes = append(codes, code)
+		log.Infof(" - %s: %s", code, org.Name)
+	}
+
+	sort.Strings(codes)
+	d := sample.DiscreteDistribution{}
+	for _, code := range codes {
+		o := organisms[strings.ToLower(code)]
+		d.WeightedValues = append(d.WeightedValues, sample.WeightedValue{Value: o, Frequency: o.Weight})
+	}
+	return &Catalogue{
+		OrganismObservation: parsed.OrganismObservation.codedElement(),
+		SusceptibilityPanel: parsed.SusceptibilityPanel.codedElement(),
+		MICUnit:             micUnit,
+		organisms:           organisms,
+		antibiotics:         antibiotics,
+		distribution:        d,
+	}, nil
+}
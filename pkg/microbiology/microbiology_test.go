@@ -0,0 +1,216 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package microbiology
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/constants"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+var hl7Config = &config.HL7Config{CodingSystem: "WinPath"}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	c, err := Load(ctx, test.MicrobiologyConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("Load(%s, %+v) failed with %v", test.MicrobiologyConfigTest, hl7Config, err)
+	}
+
+	if diff := cmp.Diff(ir.CodedElement{ID: "600-7", Text: "Bacteria identified", CodingSystem: "LN"}, c.OrganismObservation); diff != "" {
+		t.Errorf("OrganismObservation got diff (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(ir.CodedElement{ID: "29576-6", Text: "29576-6", CodingSystem: "LN"}, c.SusceptibilityPanel); diff != "" {
+		t.Errorf("SusceptibilityPanel got diff (-want, +got):\n%s", diff)
+	}
+	if got, want := c.MICUnit, "mg/L"; got != want {
+		t.Errorf("MICUnit got %q, want %q", got, want)
+	}
+
+	amp := &Antibiotic{Code: ir.CodedElement{ID: "AMP", Text: "Ampicillin", CodingSystem: "WinPath"}, SusceptibleMIC: 8, ResistantMIC: 8}
+	cip := &Antibiotic{Code: ir.CodedElement{ID: "CIP", Text: "Ciprofloxacin", CodingSystem: "LOCAL"}, SusceptibleMIC: 0.25, ResistantMIC: 0.5}
+	ecoli := &Organism{
+		Code:   ir.CodedElement{ID: "112283007", Text: "Escherichia coli", CodingSystem: "SCT"},
+		Panel:  []*PanelAntibiotic{{Antibiotic: amp, Resistance: 100}, {Antibiotic: cip}},
+		Weight: 1,
+	}
+	for _, key := range []string{"112283007", "Escherichia coli", "escherichia COLI"} {
+		got, ok := c.Organism(key)
+		if !ok {
+			t.Fatalf("Organism(%q) got ok=false, want true", key)
+		}
+		if diff := cmp.Diff(ecoli, got); diff != "" {
+			t.Errorf("Organism(%q) got diff (-want, +got):\n%s", key, diff)
+		}
+	}
+	for _, key := range []string{"CIP", "ciprofloxacin"} {
+		got, ok := c.Antibiotic(key)
+		if !ok {
+			t.Fatalf("Antibiotic(%q) got ok=false, want true", key)
+		}
+		if diff := cmp.Diff(cip, got); diff != "" {
+			t.Errorf("Antibiotic(%q) got diff (-want, +got):\n%s", key, diff)
+		}
+	}
+	if _, ok := c.Organism("unknown"); ok {
+		t.Error(`Organism("unknown") got ok=true, want false`)
+	}
+
+	// Staphylococcus aureus has no weight, so the random organism is always Escherichia coli.
+	for i := 0; i < 10; i++ {
+		if got, want := c.RandomOrganism().Code.ID, "112283007"; got != want {
+			t.Errorf("RandomOrganism().Code.ID got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestLoad_Prod(t *testing.T) {
+	ctx := context.Background()
+	if _, err := Load(ctx, test.MicrobiologyConfigProd, hl7Config); err != nil {
+		t.Errorf("Load(%s, %+v) failed with %v", test.MicrobiologyConfigProd, hl7Config, err)
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	header := `
+organism_observation:
+  id: 600-7
+susceptibility_panel:
+  id: 29576-6
+`
+	test.CheckLoadFails(t, func(fName string) error {
+		_, err := Load(context.Background(), fName, hl7Config)
+		return err
+	}, []test.InvalidConfig{{
+		Name: "Missing organism observation",
+		Content: `
+susceptibility_panel:
+  id: 29576-6`,
+	}, {
+		Name: "Missing susceptibility panel",
+		Content: `
+organism_observation:
+  id: 600-7`,
+	}, {
+		Name: "Antibiotic without name",
+		Content: header + `
+antibiotics:
+  AMP:
+    susceptible_mic: 8
+    resistant_mic: 8`,
+	}, {
+		Name: "Resistant breakpoint below susceptible breakpoint",
+		Content: header + `
+antibiotics:
+  AMP:
+    name: Ampicillin
+    susceptible_mic: 8
+    resistant_mic: 4`,
+	}, {
+		Name: "Organism without name",
+		Content: header + `
+organisms:
+  "112283007":
+    weight: 1`,
+	}, {
+		Name: "Unknown antibiotic",
+		Content: header + `
+organisms:
+  "112283007":
+    name: Escherichia coli
+    susceptibilities:
+      AMP: 50`,
+	}, {
+		Name: "Resistance above 100",
+		Content: header + `
+antibiotics:
+  AMP:
+    name: Ampicillin
+    susceptible_mic: 8
+    resistant_mic: 8
+organisms:
+  "112283007":
+    name: Escherichia coli
+    susceptibilities:
+      AMP: 150`,
+	}})
+}
+
+func TestAntibiotic_Interpretation(t *testing.T) {
+	a := &Antibiotic{SusceptibleMIC: 1, ResistantMIC: 4}
+	tests := []struct {
+		mic  float64
+		want string
+	}{
+		{mic: 0.5, want: constants.Susceptible},
+		{mic: 1, want: constants.Susceptible},
+		{mic: 2, want: constants.Intermediate},
+		{mic: 4, want: constants.Intermediate},
+		{mic: 8, want: constants.Resistant},
+	}
+	for _, tc := range tests {
+		if got := a.Interpretation(tc.mic); got != tc.want {
+			t.Errorf("Interpretation(%v) got %q, want %q", tc.mic, got, tc.want)
+		}
+	}
+}
+
+func TestAntibiotic_RandomMIC(t *testing.T) {
+	withIntermediate := &Antibiotic{SusceptibleMIC: 1, ResistantMIC: 4}
+	withoutIntermediate := &Antibiotic{SusceptibleMIC: 8, ResistantMIC: 8}
+	for _, a := range []*Antibiotic{withIntermediate, withoutIntermediate} {
+		for _, interpretation := range []string{constants.Susceptible, constants.Resistant} {
+			for i := 0; i < 10; i++ {
+				mic, ok := a.RandomMIC(interpretation)
+				if !ok {
+					t.Fatalf("%+v.RandomMIC(%q) got ok=false, want true", a, interpretation)
+				}
+				if got := a.Interpretation(mic); got != interpretation {
+					t.Errorf("%+v.RandomMIC(%q) got MIC %v with interpretation %q, want %q", a, interpretation, mic, got, interpretation)
+				}
+			}
+		}
+	}
+
+	if mic, ok := withIntermediate.RandomMIC(constants.Intermediate); !ok || withIntermediate.Interpretation(mic) != constants.Intermediate {
+		t.Errorf("%+v.RandomMIC(%q) got (%v, %t), want an intermediate MIC", withIntermediate, constants.Intermediate, mic, ok)
+	}
+	if _, ok := withoutIntermediate.RandomMIC(constants.Intermediate); ok {
+		t.Errorf("%+v.RandomMIC(%q) got ok=true, want false", withoutIntermediate, constants.Intermediate)
+	}
+}
+
+func TestPanelAntibiotic_RandomInterpretation(t *testing.T) {
+	tests := []struct {
+		resistance uint
+		want       string
+	}{
+		{resistance: 0, want: constants.Susceptible},
+		{resistance: 100, want: constants.Resistant},
+	}
+	for _, tc := range tests {
+		p := &PanelAntibiotic{Antibiotic: &Antibiotic{}, Resistance: tc.resistance}
+		for i := 0; i < 10; i++ {
+			if got := p.RandomInterpretation(); got != tc.want {
+				t.Errorf("RandomInterpretation() with resistance %d got %q, want %q", tc.resistance, got, tc.want)
+			}
+		}
+	}
+}
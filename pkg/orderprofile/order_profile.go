@@ -43,6 +43,9 @@ type OrderProfile struct {
 	UniversalService ir.CodedElement
 	// TestTypes is a map of all Test Types for the Order Profile, keys by their names.
 	TestTypes map[string]*TestType
+	// Specimen is the specimen collected for orders with this Order Profile, or nil if the Order
+	// Profile doesn't define one. Its dates are not set.
+	Specimen *ir.Specimen
 }
 
 // TestType represents the Test Type of the Order Profile.
@@ -148,6 +151,61 @@ type op struct {
 	UniversalServiceID string        `yaml:"universal_service_id"`
 	CodingSystem       string        `yaml:"coding_system"`
 	TestTypes          map[string]tt `yaml:"test_types"`
+	Specimen           *specimen
+}
+
+type specimen struct {
+	Type             *coded
+	CollectionMethod *coded `yaml:"collection_method"`
+	BodySite         *coded `yaml:"body_site"`
+	Container        *coded
+}
+
+type coded struct {
+	ID           string
+	Text         string
+	CodingSystem string `yaml:"coding_system"`
+}
+
+// Default coding systems of the specimen fields.
+const (
+	specimenTypeCodingSystem     = "HL70487"
+	collectionMethodCodingSystem = "HL70488"
+	bodySiteCodingSystem         = "HL70163"
+)
+
+// codedElement returns the CodedElement for the given coded value, or nil if the value is not set.
+// The Text defaults to the ID, and the CodingSystem to the given coding system.
+func codedElement(c *coded, codingSystem string) *ir.CodedElement {
+	if c == nil || c.ID == "" {
+		return nil
+	}
+	ce := &ir.CodedElement{ID: c.ID, Text: c.Text, CodingSystem: c.CodingSystem}
+	if ce.Text == "" {
+		ce.Text = ce.ID
+	}
+	if ce.CodingSystem == "" {
+		ce.CodingSystem = codingSystem
+	}
+	return ce
+}
+
+// orderSpecimen returns the specimen for the given specimen configuration.
+// The coding system of the containers defaults to the given coding system.
+func orderSpecimen(s *specimen, codingSystem string) (*ir.Specimen, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t := codedElement(s.Type, specimenTypeCodingSystem)
+	if t == nil {
+		return nil, errors.New("specimen type is required")
+	}
+	return &ir.Specimen{
+		Type:             t,
+		CollectionMethod: codedElement(s.CollectionMethod, collectionMethodCodingSystem),
+		BodySite:         codedElement(s.BodySite, bodySiteCodingSystem),
+		Container:        codedElement(s.Container, codingSystem),
+	}, nil
 }
 
 func testType(ttName string, ttValue tt, codingSystem string) *TestType {
@@ -220,9 +278,14 @@ func Load(ctx context.Context, filename string, hl7Config *config.HL7Config) (*O
 		if v.CodingSystem != "" {
 			codingSystem = v.CodingSystem
 		}
+		s, err := orderSpecimen(v.Specimen, hl7Config.CodingSystem)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid specimen in order profile %q in %s", k, filename)
+		}
 		orderProfiles[k] = &OrderProfile{
 			UniversalService: ir.CodedElement{ID: v.UniversalServiceID, Text: k, CodingSystem: codingSystem},
 			TestTypes:        testTypes,
+			Specimen:         s,
 		}
 		log.Infof(" - %s", k)
 	}
@@ -45,6 +45,42 @@ UREA AND ELECTROLYTES:
       ref_range: 49 - 92
       unit: UMOLL
       value: '70'
+      value_type: NM`)
+
+	ureaOPSpecimen = []byte(`
+UREA AND ELECTROLYTES:
+  universal_service_id: lpdc-3969
+  specimen:
+    type:
+      id: SER
+      text: Serum
+    collection_method:
+      id: VENIP
+    body_site:
+      id: LACF
+      text: Left Antecubital Fossa
+      coding_system: SNOMED
+    container:
+      id: SST
+      text: Serum separator tube
+  test_types:
+    Creatinine:
+      id: lpdc-2012
+      ref_range: 49 - 92
+      unit: UMOLL
+      value: '70'
+      value_type: NM`)
+
+	ureaOPSpecimenNoType = []byte(`
+UREA AND ELECTROLYTES:
+  universal_service_id: lpdc-3969
+  specimen:
+    container:
+      id: SST
+  test_types:
+    Creatinine:
+      id: lpdc-2012
+      value: '70'
       value_type: NM`)
 
 	ureaOPValPrefix = []byte(`
@@ -155,6 +191,7 @@ func TestLoad(t *testing.T) {
 		ttName        string
 		wantUS        ir.CodedElement
 		wantTT        *TestType
+		wantSpecimen  *ir.Specimen
 	}{
 		{
 			name:          "Default Coding System",
@@ -192,10 +229,32 @@ func TestLoad(t *testing.T) {
 				ValueType: "NM",
 				RefRange:  "36-38",
 			},
+		}, {
+			name:          "Specimen",
+			opFileContent: ureaOPSpecimen,
+			opName:        "UREA AND ELECTROLYTES",
+			ttName:        "Creatinine",
+			wantUS:        ir.CodedElement{ID: "lpdc-3969", Text: "UREA AND ELECTROLYTES", CodingSystem: "WinPath"},
+			wantTT: &TestType{
+				Name:      ir.CodedElement{ID: "lpdc-2012", Text: "Creatinine", CodingSystem: "WinPath"},
+				Unit:      "UMOLL",
+				ValueType: "NM",
+				RefRange:  "49 - 92",
+			},
+			wantSpecimen: &ir.Specimen{
+				Type:             &ir.CodedElement{ID: "SER", Text: "Serum", CodingSystem: "HL70487"},
+				CollectionMethod: &ir.CodedElement{ID: "VENIP", Text: "VENIP", CodingSystem: "HL70488"},
+				BodySite:         &ir.CodedElement{ID: "LACF", Text: "Left Antecubital Fossa", CodingSystem: "SNOMED"},
+				Container:        &ir.CodedElement{ID: "SST", Text: "Serum separator tube", CodingSystem: "WinPath"},
+			},
 		}, {
 			name:          "Invalid Order Profile",
 			opFileContent: invalidOP,
 			wantLoadErr:   true,
+		}, {
+			name:          "Specimen without type",
+			opFileContent: ureaOPSpecimenNoType,
+			wantLoadErr:   true,
 		},
 	}
 
@@ -218,6 +277,9 @@ func TestLoad(t *testing.T) {
 			if diff := cmp.Diff(tc.wantUS, op.UniversalService); diff != "" {
 				t.Errorf("[%+v].UniversalService -want, +got:\n%s", op, diff)
 			}
+			if diff := cmp.Diff(tc.wantSpecimen, op.Specimen); diff != "" {
+				t.Errorf("[%+v].Specimen -want, +got:\n%s", op, diff)
+			}
 
 			tt, ok := op.TestTypes[tc.ttName]
 			if !ok {
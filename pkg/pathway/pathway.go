@@ -67,6 +67,28 @@ const (
 	StepDocument               = "Document"
 	StepGeneric                = "Generic"
 	StepGenerateResources      = "GenerateResources"
+	StepAppointment            = "Appointment"
+	StepRescheduleAppointment  = "RescheduleAppointment"
+	StepCancelAppointment      = "CancelAppointment"
+	StepNoShow                 = "NoShow"
+	StepPrescribe              = "Prescribe"
+	StepDispense               = "Dispense"
+	StepAdminister             = "Administer"
+	StepDiscontinueMedication  = "DiscontinueMedication"
+	StepVaccination            = "Vaccination"
+	StepCharge                 = "Charge"
+	StepAccountCreate          = "AccountCreate"
+	StepAccountUpdate          = "AccountUpdate"
+	StepLeaveOfAbsence         = "LeaveOfAbsence"
+	StepReturnFromLOA          = "ReturnFromLOA"
+	StepChangePatientClass     = "ChangePatientClass"
+	StepLinkPatients           = "LinkPatients"
+	StepUnlinkPatients         = "UnlinkPatients"
+	StepChangeIdentifier       = "ChangeIdentifier"
+	StepCancelOrder            = "CancelOrder"
+	StepDiscontinueOrder       = "DiscontinueOrder"
+	StepHoldOrder              = "HoldOrder"
+	StepReleaseOrder           = "ReleaseOrder"
 )
 
 const (
@@ -115,6 +137,30 @@ type Person struct {
 	Address     *Address
 	NHS         string
 	MRN         string
+	// Insurance overrides the insurance coverage of the person.
+	Insurance *Insurance
+	// Guarantor overrides the guarantor of the person.
+	Guarantor *Guarantor
+}
+
+// Insurance is the insurance coverage of a person.
+// Fields that are not set are generated randomly.
+type Insurance struct {
+	// Plan is the code of the insurance plan. If the plan is not in the insurance catalogue, it is
+	// used as the plan code with no payer.
+	Plan         string
+	GroupNumber  string `yaml:"group_number"`
+	PolicyNumber string `yaml:"policy_number"`
+	// SelfPay means that the person is not insured. No other field can be set.
+	SelfPay bool `yaml:"self_pay"`
+}
+
+// Guarantor is the guarantor of a person.
+type Guarantor struct {
+	// Relationship is the relationship of the guarantor to the person (HL7 table 0063).
+	// The self relationship in the HL7 configuration means that the person is their own guarantor.
+	// Any other relationship means that the guarantor is a new associated party of the person.
+	Relationship string
 }
 
 // Gender represents a gender of the person.
@@ -218,7 +264,11 @@ type Order struct {
 	OrderProfile string `yaml:"order_profile"`
 	// Status of the order. If order status is not provided, hl7.OrderStatus.InProcess will be used.
 	OrderStatus string `yaml:"order_status"`
-	// NoAcknowledgementMessage indicates, that an Order acknowledgement message (ORR^O02)
+	// MessageStyle is the style of the messages that place and acknowledge the order:
+	// ORM for ORM^O01 and ORR^O02 messages, or OML for OML^O21 and ORL^O22 messages.
+	// If not provided, hl7.Order.MessageStyle will be used.
+	MessageStyle string `yaml:"message_style"`
+	// NoAcknowledgementMessage indicates, that an Order acknowledgement message (ORR^O02 or ORL^O22)
 	// should not be sent following the Order message.
 	// The default behaviour is that this message is always sent.
 	NoAcknowledgementMessage bool `yaml:"no_acknowledgement_message"`
@@ -262,10 +312,31 @@ type Results struct {
 	ReceivedInLabDateTime string `yaml:"received_in_lab_datetime"`
 	// Results contain a slice of results.
 	Results []*Result
+	// Culture contains the results of a microbiology culture.
+	// Optional.
+	// If specified, the organisms identified by the culture are reported after any Results, and
+	// the results of the test types of the order profile are not generated at random.
+	Culture *Culture
 	// TriggerEvent is the HL7 trigger event for the ORU message.
 	// Optional.
 	// Supported: R01 (default), R03 and R32, case insensitive.
 	TriggerEvent string `yaml:"trigger_event"`
+	// ResultID identifies this set of results, so that later Results steps can revise them.
+	// Optional.
+	ResultID string `yaml:"result_id"`
+	// Revises is the ResultID of an earlier Results step for the same order that this step revises.
+	// Optional.
+	// If specified, the results of the earlier step are carried forward unchanged, except for the
+	// ones in Results with the same test name, which replace them. Results whose Value is not
+	// specified keep the previous value, so that only their status and notes can be revised.
+	// Results in Results that were not in the earlier step are added.
+	// The OBX SetIDs start with the same number as the earlier step.
+	Revises string `yaml:"revises"`
+	// AmendmentNote is a note that explains the revision. It is added to the notes of every result
+	// that is revised.
+	// Optional.
+	// It can only be specified if Revises is specified.
+	AmendmentNote string `yaml:"amendment_note"`
 	// ExpectCorrection indicates that we expect a correction or amendment for the same order.
 	// The next message for the same order will be treated as the amendment.
 	// The NumberOfPreviousResults for this order won't advance until the amendment message is
@@ -320,6 +391,84 @@ type Result struct {
 	Notes []string
 }
 
+// Culture represents the results of a microbiology culture.
+// Each identified organism is reported as a result, and the antibiotic susceptibilities of each
+// organism are reported as the results of a child order linked to the organism's result.
+// Preliminary results only report the organisms; the susceptibilities are reported with the final
+// results.
+type Culture struct {
+	// NoGrowth indicates that no organisms grew in the culture.
+	// Optional.
+	// If set, Organisms cannot be specified.
+	NoGrowth bool `yaml:"no_growth"`
+	// Organisms are the organisms identified by the culture.
+	// Optional.
+	// If not specified, the organisms identified by the previous results of the same order are
+	// reported again, or a random organism from the microbiology catalogue if there are none.
+	Organisms []*Organism
+}
+
+// Organism represents an organism identified by a culture.
+type Organism struct {
+	// Name is the name or the code of the organism in the microbiology catalogue.
+	// Required.
+	// Organisms that are not in the catalogue are reported with the given name.
+	Name string
+	// Susceptibilities are the antibiotic susceptibilities of the organism.
+	// Optional.
+	// If not specified, the susceptibility panel of the organism in the microbiology catalogue is
+	// generated at random.
+	Susceptibilities []*Susceptibility
+}
+
+// Susceptibility represents the susceptibility of an organism to an antibiotic.
+type Susceptibility struct {
+	// Antibiotic is the name or the code of the antibiotic in the microbiology catalogue.
+	// Required.
+	Antibiotic string
+	// Interpretation is whether the organism is susceptible (S), intermediately susceptible (I)
+	// or resistant (R) to the antibiotic.
+	// At least one of Interpretation or MIC is required.
+	// If not specified, it is derived from the MIC and the breakpoints of the antibiotic.
+	Interpretation string
+	// MIC is the minimum inhibitory concentration, e.g. "4" or "<=0.25".
+	// At least one of Interpretation or MIC is required.
+	// If not specified, a MIC that matches the Interpretation is generated at random.
+	MIC string `yaml:"mic"`
+}
+
+// CancelOrder is a step to cancel an Order before any results are received for it.
+// It produces an ORM^O01 message. Results cannot be sent for cancelled orders.
+type CancelOrder struct {
+	// OrderID is the ID of the Order to cancel.
+	// Required.
+	OrderID string `yaml:"order_id"`
+}
+
+// DiscontinueOrder is a step to stop an Order that is already in process.
+// It produces an ORM^O01 message.
+type DiscontinueOrder struct {
+	// OrderID is the ID of the Order to discontinue.
+	// Required.
+	OrderID string `yaml:"order_id"`
+}
+
+// HoldOrder is a step to put an Order on hold. The order can be released later with a ReleaseOrder
+// step. It produces an ORM^O01 message.
+type HoldOrder struct {
+	// OrderID is the ID of the Order to put on hold.
+	// Required.
+	OrderID string `yaml:"order_id"`
+}
+
+// ReleaseOrder is a step to release an Order that was put on hold with a HoldOrder step.
+// It produces an ORM^O01 message.
+type ReleaseOrder struct {
+	// OrderID is the ID of the Order to release.
+	// Required.
+	OrderID string `yaml:"order_id"`
+}
+
 // Admission is a step to admit the patient to the hospital. It produces an ADT^A01 message.
 type Admission struct {
 	// Loc is a location (point of care) the patient is admitted to.
@@ -389,6 +538,194 @@ type Document struct {
 	NumRandomContentLines *Interval `yaml:"num_random_content_lines"`
 }
 
+// Appointment is a step to book an appointment for the patient, e.g. in an outpatient clinic.
+// It produces an SIU^S12 message.
+type Appointment struct {
+	// ID is the pathway appointment ID that links to an appointment in the reschedule_appointment,
+	// cancel_appointment and no_show steps. It is unrelated to the HL7 appointment IDs.
+	ID string
+	// Loc is the location (clinic) where the appointment takes place.
+	// Simulated Hospital picks a clinic from the locations file if this isn't set.
+	Loc string `yaml:",omitempty"`
+	// DoctorID is the ID of the doctor the appointment is with, from the doctors file.
+	// Simulated Hospital picks a random doctor if this isn't set.
+	DoctorID string `yaml:"doctor_id,omitempty"`
+	// TimeFromNow is how long after the step the appointment starts.
+	// Simulated Hospital picks a time in the following four weeks if this isn't set.
+	TimeFromNow *time.Duration `yaml:"time_from_now,omitempty"`
+	// Duration is the duration of the appointment. It defaults to 30 minutes.
+	Duration *time.Duration `yaml:",omitempty"`
+	// Reason populates the SCH.7-Appointment Reason field.
+	// Simulated Hospital picks a value from the HL7 configuration file if this isn't set.
+	Reason string `yaml:",omitempty"`
+	// Type populates the SCH.8-Appointment Type field.
+	// Simulated Hospital picks a value from the HL7 configuration file if this isn't set.
+	Type string `yaml:",omitempty"`
+	// Service populates the AIS.3-Universal Service Identifier field.
+	// It defaults to the doctor's specialty.
+	Service string `yaml:",omitempty"`
+}
+
+// RescheduleAppointment is a step to move an existing appointment to a different time or location.
+// It produces an SIU^S13 message.
+type RescheduleAppointment struct {
+	// ID is the pathway appointment ID of the appointment to reschedule.
+	// Required.
+	ID string
+	// Loc is the new location of the appointment. The location doesn't change if this isn't set.
+	Loc string `yaml:",omitempty"`
+	// TimeFromNow is how long after the step the appointment starts.
+	// Simulated Hospital picks a time in the following four weeks if this isn't set.
+	TimeFromNow *time.Duration `yaml:"time_from_now,omitempty"`
+	// Duration is the new duration of the appointment. The duration doesn't change if this isn't set.
+	Duration *time.Duration `yaml:",omitempty"`
+	// Reason populates the SCH.6-Event Reason field.
+	Reason string `yaml:",omitempty"`
+}
+
+// CancelAppointment is a step to cancel an existing appointment. It produces an SIU^S15 message.
+type CancelAppointment struct {
+	// ID is the pathway appointment ID of the appointment to cancel.
+	// Required.
+	ID string
+	// Reason populates the SCH.6-Event Reason field.
+	Reason string `yaml:",omitempty"`
+}
+
+// NoShow is a step to record that the patient did not show up for an appointment.
+// It produces an SIU^S26 message.
+type NoShow struct {
+	// ID is the pathway appointment ID of the appointment the patient missed.
+	// Required.
+	ID string
+}
+
+// Prescribe is a step to prescribe a medication to the patient. It produces an RDE^O11 message.
+type Prescribe struct {
+	// ID is the pathway medication order ID that links to a prescription in the dispense, administer
+	// and discontinue_medication steps. It is unrelated to the HL7 order IDs.
+	ID string
+	// Medication is the name of the medication from the medications file.
+	// If the medication is not in the file, it is used as both the code and the text of the
+	// medication, and the remaining fields are only populated from the step.
+	// If Medication is not set or is set to RANDOM, Simulated Hospital picks a random medication from
+	// the medications file.
+	Medication string `yaml:",omitempty"`
+	// Dose, DoseUnits, Route and Frequency override the defaults of the medication.
+	Dose      string `yaml:",omitempty"`
+	DoseUnits string `yaml:"dose_units,omitempty"`
+	Route     string `yaml:",omitempty"`
+	Frequency string `yaml:",omitempty"`
+}
+
+// Dispense is a step to record that the pharmacy dispensed a prescribed medication.
+// It produces an RDS^O13 message.
+type Dispense struct {
+	// ID is the pathway medication order ID of the prescription.
+	// Required.
+	ID string
+	// Amount is the amount dispensed. It defaults to the dispense amount of the medication.
+	Amount string `yaml:",omitempty"`
+}
+
+// Administer is a step to record that a dose of a prescribed medication was given to the patient.
+// It produces an RAS^O17 message.
+type Administer struct {
+	// ID is the pathway medication order ID of the prescription.
+	// Required.
+	ID string
+	// Dose is the dose that was given. It defaults to the dose of the prescription.
+	Dose string `yaml:",omitempty"`
+}
+
+// DiscontinueMedication is a step to stop a prescription. It produces an RDE^O11 message.
+type DiscontinueMedication struct {
+	// ID is the pathway medication order ID of the prescription to stop.
+	// Required.
+	ID string
+	// Reason populates the ORC.16-Order Control Code Reason field.
+	Reason string `yaml:",omitempty"`
+}
+
+// Vaccination is a step to record that a vaccine was administered to the patient.
+// It produces a VXU^V04 message.
+type Vaccination struct {
+	// Vaccine is the name of the vaccine from the vaccines file.
+	// If the vaccine is not in the file, it is used as both the code and the text of the vaccine,
+	// and the remaining fields are only populated from the step.
+	// If Vaccine is not set or is set to RANDOM, Simulated Hospital picks a random vaccine from the
+	// vaccines file.
+	Vaccine string `yaml:",omitempty"`
+	// Dose, Site and LotNumber override the defaults of the vaccine. If LotNumber is not set, a
+	// random lot number of the vaccine is used.
+	Dose      string `yaml:",omitempty"`
+	Site      string `yaml:",omitempty"`
+	LotNumber string `yaml:"lot_number,omitempty"`
+	// DoctorID is the ID of the clinician who administers the vaccine. It must be one of the doctors
+	// in the doctors file. If not set, a random doctor is picked.
+	DoctorID string `yaml:"doctor_id,omitempty"`
+}
+
+// Charge is a step to post charges to the patient's account. It produces a DFT^P03 message.
+// If the patient doesn't have an account, one is created.
+type Charge struct {
+	// Code is the code of the item from the chargemaster to charge.
+	// If Code is not set, charges are captured automatically for the orders, procedures and
+	// bed-days of the patient's current encounter that have not been charged yet.
+	Code string `yaml:",omitempty"`
+	// Quantity is the number of units of the item to charge. It can only be set together with Code.
+	// Defaults to 1.
+	Quantity int `yaml:",omitempty"`
+}
+
+// AccountCreate is a step to open a new account for the patient. It produces a BAR^P01 message.
+// If the patient doesn't have a guarantor, the patient is their own guarantor.
+type AccountCreate struct{}
+
+// AccountUpdate is a step to send the current information of the patient's account.
+// It produces a BAR^P05 message. If the patient doesn't have an account, one is created.
+type AccountUpdate struct{}
+
+// LeaveOfAbsence is a step to record that an admitted patient has gone on leave of absence.
+// It produces an ADT^A21 message. The patient's bed is held until they return, unless ReleaseBed
+// is set.
+type LeaveOfAbsence struct {
+	// ExpectedReturnTimeFromNow specifies when in the future the patient is expected to return.
+	// Optional.
+	ExpectedReturnTimeFromNow *time.Duration `yaml:"expected_return_time_from_now,omitempty"`
+	// ReleaseBed indicates whether the patient's bed is released while the patient is on leave,
+	// instead of being held for them. Patients whose bed was released need a location to return to.
+	ReleaseBed bool `yaml:"release_bed,omitempty"`
+}
+
+// ReturnFromLOA is a step to record that a patient on leave of absence (LOA) has returned.
+// It produces an ADT^A22 message. If Loc is not set, the patient returns to their held bed.
+type ReturnFromLOA struct {
+	// Loc is the location (point of care) the patient returns to. If set, the patient's held bed,
+	// if any, is released.
+	// Required if the patient's bed was released when they went on leave.
+	Loc string `yaml:",omitempty"`
+	// Bed is the bed the patient returns to. It can only be set together with Loc.
+	// Optional.
+	Bed string `yaml:",omitempty"`
+}
+
+// ChangePatientClass is a step to change the class of the patient, e.g., to convert an outpatient
+// into an inpatient. It produces an ADT^A06 message if the patient becomes an inpatient, and an
+// ADT^A07 message otherwise.
+type ChangePatientClass struct {
+	// PatientClass is the new class of the patient. If not set, inpatients become outpatients and
+	// any other patients become inpatients, according to the patient classes in the HL7 config.
+	PatientClass string `yaml:"patient_class,omitempty"`
+	// Loc is the location (point of care) the patient moves to. If not set, the patient stays in
+	// their current location.
+	// Optional.
+	Loc string `yaml:",omitempty"`
+	// Bed is the bed the patient moves to. It can only be set together with Loc.
+	// Optional.
+	Bed string `yaml:",omitempty"`
+}
+
 // Registration is a step to register the patient. It produces an ADT^A04 message.
 type Registration struct {
 	PatientClass string `yaml:"patient_class"`
@@ -653,6 +990,38 @@ type BedSwap struct {
 	Patient2 PatientID `yaml:"patient_2"`
 }
 
+// LinkPatients step links the records of two patients, for instance because they are the same
+// person, without merging them. Both patients are still in use after the link.
+// It produces an ADT^A24 message.
+type LinkPatients struct {
+	// Patient1 is the first patient to be linked. It must be the current patient.
+	// Required.
+	Patient1 PatientID `yaml:"patient_1"`
+	// Patient2 is the patient that Patient1 is linked to.
+	// Required.
+	Patient2 PatientID `yaml:"patient_2"`
+}
+
+// UnlinkPatients step unlinks the records of two patients that were previously linked with a
+// LinkPatients step.
+// It produces an ADT^A37 message.
+type UnlinkPatients struct {
+	// Patient1 is the first patient to be unlinked. It must be the current patient.
+	// Required.
+	Patient1 PatientID `yaml:"patient_1"`
+	// Patient2 is the patient that Patient1 is unlinked from.
+	// Required.
+	Patient2 PatientID `yaml:"patient_2"`
+}
+
+// ChangeIdentifier step changes the MRN of the current patient.
+// Later steps can refer to the patient with either the old or the new MRN.
+// It produces an ADT^A47 message.
+type ChangeIdentifier struct {
+	// NewMRN is the new MRN of the patient. If not set, a new MRN is generated.
+	NewMRN string `yaml:"new_mrn"`
+}
+
 // Delay step is a delay between two steps in the pathway.
 // It is defined as a random duration between From and To.
 // Delays are not supported in Historical steps. For historical steps, use Parameters.TimeFromNow
@@ -983,6 +1352,28 @@ type Step struct {
 	Document               *Document               `yaml:",omitempty"`
 	Generic                *Generic                `yaml:",omitempty"`
 	GenerateResources      *GenerateResources      `yaml:"generate_resources,omitempty"`
+	Appointment            *Appointment            `yaml:",omitempty"`
+	RescheduleAppointment  *RescheduleAppointment  `yaml:"reschedule_appointment,omitempty"`
+	CancelAppointment      *CancelAppointment      `yaml:"cancel_appointment,omitempty"`
+	NoShow                 *NoShow                 `yaml:"no_show,omitempty"`
+	Prescribe              *Prescribe              `yaml:",omitempty"`
+	Dispense               *Dispense               `yaml:",omitempty"`
+	Administer             *Administer             `yaml:",omitempty"`
+	DiscontinueMedication  *DiscontinueMedication  `yaml:"discontinue_medication,omitempty"`
+	Vaccination            *Vaccination            `yaml:",omitempty"`
+	Charge                 *Charge                 `yaml:",omitempty"`
+	AccountCreate          *AccountCreate          `yaml:"account_create,omitempty"`
+	AccountUpdate          *AccountUpdate          `yaml:"account_update,omitempty"`
+	LeaveOfAbsence         *LeaveOfAbsence         `yaml:"leave_of_absence,omitempty"`
+	ReturnFromLOA          *ReturnFromLOA          `yaml:"return_from_leave_of_absence,omitempty"`
+	ChangePatientClass     *ChangePatientClass     `yaml:"change_patient_class,omitempty"`
+	LinkPatients           *LinkPatients           `yaml:"link_patients,omitempty"`
+	UnlinkPatients         *UnlinkPatients         `yaml:"unlink_patients,omitempty"`
+	ChangeIdentifier       *ChangeIdentifier       `yaml:"change_identifier,omitempty"`
+	CancelOrder            *CancelOrder            `yaml:"cancel_order,omitempty"`
+	DiscontinueOrder       *DiscontinueOrder       `yaml:"discontinue_order,omitempty"`
+	HoldOrder              *HoldOrder              `yaml:"hold_order,omitempty"`
+	ReleaseOrder           *ReleaseOrder           `yaml:"release_order,omitempty"`
 	// Up to this point, only one of the fields can be set. The pathway will be considered invalid if
 	// more than one of the above fields is set.
 
@@ -363,6 +363,28 @@ func TestStepStepType(t *testing.T) {
 		{step: Step{ClinicalNote: &ClinicalNote{}}, want: StepClinicalNote},
 		{step: Step{HardcodedMessage: &HardcodedMessage{}}, want: StepHardcodedMessage},
 		{step: Step{Document: &Document{}}, want: StepDocument},
+		{step: Step{Appointment: &Appointment{}}, want: StepAppointment},
+		{step: Step{RescheduleAppointment: &RescheduleAppointment{}}, want: StepRescheduleAppointment},
+		{step: Step{CancelAppointment: &CancelAppointment{}}, want: StepCancelAppointment},
+		{step: Step{NoShow: &NoShow{}}, want: StepNoShow},
+		{step: Step{Prescribe: &Prescribe{}}, want: StepPrescribe},
+		{step: Step{Dispense: &Dispense{}}, want: StepDispense},
+		{step: Step{Administer: &Administer{}}, want: StepAdminister},
+		{step: Step{Vaccination: &Vaccination{}}, want: StepVaccination},
+		{step: Step{DiscontinueMedication: &DiscontinueMedication{}}, want: StepDiscontinueMedication},
+		{step: Step{Charge: &Charge{}}, want: StepCharge},
+		{step: Step{AccountCreate: &AccountCreate{}}, want: StepAccountCreate},
+		{step: Step{AccountUpdate: &AccountUpdate{}}, want: StepAccountUpdate},
+		{step: Step{LeaveOfAbsence: &LeaveOfAbsence{}}, want: StepLeaveOfAbsence},
+		{step: Step{ReturnFromLOA: &ReturnFromLOA{}}, want: StepReturnFromLOA},
+		{step: Step{ChangePatientClass: &ChangePatientClass{}}, want: StepChangePatientClass},
+		{step: Step{LinkPatients: &LinkPatients{}}, want: StepLinkPatients},
+		{step: Step{UnlinkPatients: &UnlinkPatients{}}, want: StepUnlinkPatients},
+		{step: Step{ChangeIdentifier: &ChangeIdentifier{}}, want: StepChangeIdentifier},
+		{step: Step{CancelOrder: &CancelOrder{}}, want: StepCancelOrder},
+		{step: Step{DiscontinueOrder: &DiscontinueOrder{}}, want: StepDiscontinueOrder},
+		{step: Step{HoldOrder: &HoldOrder{}}, want: StepHoldOrder},
+		{step: Step{ReleaseOrder: &ReleaseOrder{}}, want: StepReleaseOrder},
 	}
 	for _, tc := range cases {
 		t.Run(fmt.Sprintf("%v", tc.want), func(t *testing.T) {
@@ -382,6 +382,24 @@ func (r *Result) valid(orderProfile *orderprofile.OrderProfile) error {
 	return ec
 }
 
+// validRevision validates a result without a value that revises a previous result.
+// Only the status and notes of such results can be revised.
+func (r *Result) validRevision(orderProfile *orderprofile.OrderProfile) error {
+	var ec error
+	if r.TestName == "" {
+		ec = combineErrors(ec, fmt.Errorf("parameter TestName is missing in result: %v", r))
+	}
+	if r.Unit != "" || r.ReferenceRange != "" || r.AbnormalFlag != "" {
+		ec = combineErrors(ec, fmt.Errorf("parameters Unit, ReferenceRange and AbnormalFlag cannot be revised without the Value in result: %v", r))
+	}
+	if orderProfile != nil {
+		if _, ok := orderProfile.TestTypes[r.TestName]; !ok {
+			ec = combineErrors(ec, fmt.Errorf("test type %q doesn't exist in the order profile %s", r.TestName, orderProfile.UniversalService.Text))
+		}
+	}
+	return ec
+}
+
 func (r *Result) validValueAndRefRange(refRange string) error {
 	g, err := orderprofile.ValueGeneratorFromRange(refRange)
 	if err != nil {
@@ -444,9 +462,69 @@ func (r *Results) valid() error {
 	if te != "" && te != constants.R01 && te != constants.R03 && te != constants.R32 {
 		ec = combineErrors(ec, fmt.Errorf("invalid trigger_event: %s; want R01, R03, R32 or empty", r.TriggerEvent))
 	}
+	if r.Culture != nil && r.OrderProfile == constants.RandomString {
+		ec = combineErrors(ec, fmt.Errorf("parameter OrderProfile is set to %s, but Culture is specified. Culture can only be specified "+
+			"for the non-random OrderProfile", constants.RandomString))
+	}
+	if err := r.Culture.valid(); err != nil {
+		ec = combineErrors(ec, errors.Wrap(err, "invalid culture"))
+	}
+	if r.Revises != "" && r.OrderProfile == constants.RandomString {
+		ec = combineErrors(ec, fmt.Errorf("parameter OrderProfile is set to %s, but Revises is specified. Results can only be revised "+
+			"for the non-random OrderProfile", constants.RandomString))
+	}
+	if r.Revises != "" && r.Culture != nil {
+		ec = combineErrors(ec, errors.New("parameter Revises cannot be specified together with Culture; culture results always "+
+			"revise the previous results of the same order"))
+	}
+	if r.AmendmentNote != "" && r.Revises == "" {
+		ec = combineErrors(ec, errors.New("parameter AmendmentNote can only be specified if Revises is specified"))
+	}
 	return ec
 }
 
+func (c *Culture) valid() error {
+	if c == nil {
+		return nil
+	}
+	var ec error
+	if c.NoGrowth && len(c.Organisms) > 0 {
+		ec = combineErrors(ec, errors.New("organisms cannot be specified if no_growth is set"))
+	}
+	for _, o := range c.Organisms {
+		if o.Name == "" {
+			ec = combineErrors(ec, errors.New("parameter Name is missing in organism"))
+		}
+		for _, s := range o.Susceptibilities {
+			if err := s.valid(); err != nil {
+				ec = combineErrors(ec, errors.Wrapf(err, "invalid susceptibility of organism %q", o.Name))
+			}
+		}
+	}
+	return ec
+}
+
+func (s *Susceptibility) valid() error {
+	if s.Antibiotic == "" {
+		return errors.New("parameter Antibiotic is missing")
+	}
+	if s.Interpretation == "" && s.MIC == "" {
+		return fmt.Errorf("antibiotic %q: at least one of interpretation or mic is required", s.Antibiotic)
+	}
+	switch s.Interpretation {
+	case "", constants.Susceptible, constants.Intermediate, constants.Resistant:
+	default:
+		return fmt.Errorf("antibiotic %q: invalid interpretation %q: must be one of %s, %s or %s",
+			s.Antibiotic, s.Interpretation, constants.Susceptible, constants.Intermediate, constants.Resistant)
+	}
+	if s.MIC != "" {
+		if _, _, err := orderprofile.ValueFromString(s.MIC); err != nil {
+			return errors.Wrapf(err, "antibiotic %q: invalid mic %q", s.Antibiotic, s.MIC)
+		}
+	}
+	return nil
+}
+
 func validDate(d string) bool {
 	return d == "" || d == constants.MidnightDate || d == constants.EmptyString
 }
@@ -459,6 +537,11 @@ func (o *Order) valid() error {
 		return errors.New("neither OrderId nor OrderProfile specified. Order must either relate to another Order / " +
 			"Result, or have OrderProfile specified")
 	}
+	switch strings.ToUpper(o.MessageStyle) {
+	case "", constants.ORMOrderStyle, constants.OMLOrderStyle:
+	default:
+		return fmt.Errorf("invalid message_style %q: must be one of %s or %s", o.MessageStyle, constants.ORMOrderStyle, constants.OMLOrderStyle)
+	}
 	return nil
 }
 
@@ -548,6 +631,9 @@ type orderIDAndProfileValidator struct {
 	orderProfiles         *orderprofile.OrderProfiles
 	orderIDSeen           map[string]bool
 	orderIDToOrderProfile map[string]string
+	orderIDCancelled      map[string]bool
+	// resultIDToOrderID maps the IDs of the results declared so far to the IDs of their orders.
+	resultIDToOrderID map[string]string
 }
 
 func (v *orderIDAndProfileValidator) addOrderIDAndProfile(s Step) error {
@@ -556,10 +642,57 @@ func (v *orderIDAndProfileValidator) addOrderIDAndProfile(s Step) error {
 		ec = combineErrors(ec, v.validateOrderIDAndOrderProfile(s.Order.OrderID, s.Order.OrderProfile))
 	}
 	if s.Result != nil && s.Result.OrderID != "" {
+		if v.orderIDCancelled[s.Result.OrderID] {
+			ec = combineErrors(ec, fmt.Errorf("order id %q is used in a result after the order was cancelled", s.Result.OrderID))
+		}
 		ec = combineErrors(ec, v.validateOrderIDAndOrderProfile(s.Result.OrderID, s.Result.OrderProfile))
 	}
 	if s.Result != nil {
 		ec = combineErrors(ec, v.validateResultAgainstOrderProfile(s.Result))
+		ec = combineErrors(ec, v.addResultID(s.Result))
+	}
+	if orderID, ok := s.orderStatusChange(); ok && orderID != "" {
+		if !v.orderIDSeen[orderID] {
+			ec = combineErrors(ec, fmt.Errorf("order id %q is used in a %s step before the order is declared", orderID, s.StepType()))
+		}
+		if s.CancelOrder != nil {
+			v.orderIDCancelled[orderID] = true
+		}
+	}
+	return ec
+}
+
+// orderStatusChange returns the ID of the order whose status the step changes, and whether the
+// step changes the status of an order.
+func (s Step) orderStatusChange() (string, bool) {
+	switch {
+	case s.CancelOrder != nil:
+		return s.CancelOrder.OrderID, true
+	case s.DiscontinueOrder != nil:
+		return s.DiscontinueOrder.OrderID, true
+	case s.HoldOrder != nil:
+		return s.HoldOrder.OrderID, true
+	case s.ReleaseOrder != nil:
+		return s.ReleaseOrder.OrderID, true
+	}
+	return "", false
+}
+
+// addResultID validates that the results revised by the given step were declared in an earlier
+// step for the same order, and records the ID of the results of the step.
+func (v *orderIDAndProfileValidator) addResultID(result *Results) error {
+	var ec error
+	if result.Revises != "" {
+		orderID, ok := v.resultIDToOrderID[result.Revises]
+		switch {
+		case !ok:
+			ec = combineErrors(ec, fmt.Errorf("result id %q is revised before the results are declared", result.Revises))
+		case orderID != result.OrderID:
+			ec = combineErrors(ec, fmt.Errorf("result id %q is revised for order id %q, but the results belong to order id %q", result.Revises, result.OrderID, orderID))
+		}
+	}
+	if result.ResultID != "" {
+		v.resultIDToOrderID[result.ResultID] = result.OrderID
 	}
 	return ec
 }
@@ -575,6 +708,11 @@ func (v *orderIDAndProfileValidator) validateResultAgainstOrderProfile(result *R
 	op, _ := v.orderProfiles.Get(profileName)
 	var ec error
 	for _, r := range result.Results {
+		if result.Revises != "" && r.Value == "" {
+			// Revised results without a value keep their previous value.
+			ec = combineErrors(ec, r.validRevision(op))
+			continue
+		}
 		ec = combineErrors(ec, r.valid(op))
 	}
 	return ec
@@ -622,6 +760,95 @@ func (d *Document) valid() error {
 	return ec
 }
 
+func (a *Appointment) valid(lm *location.Manager) error {
+	if a == nil {
+		return nil
+	}
+	if a.Loc != "" {
+		if err := validLocation(a.Loc, lm); err != nil {
+			return errors.Wrap(err, "error validating location in appointment")
+		}
+	}
+	return validAppointmentTimes(a.TimeFromNow, a.Duration)
+}
+
+func (r *RescheduleAppointment) valid(lm *location.Manager) error {
+	if r == nil {
+		return nil
+	}
+	if r.ID == "" {
+		return errors.New("RescheduleAppointment.ID is required to reschedule an appointment")
+	}
+	if r.Loc != "" {
+		if err := validLocation(r.Loc, lm); err != nil {
+			return errors.Wrap(err, "error validating location in reschedule_appointment")
+		}
+	}
+	return validAppointmentTimes(r.TimeFromNow, r.Duration)
+}
+
+func validAppointmentTimes(timeFromNow *time.Duration, duration *time.Duration) error {
+	if timeFromNow != nil && *timeFromNow < time.Duration(0) {
+		return errors.New("time_from_now must not be negative")
+	}
+	if duration != nil && *duration < time.Minute {
+		return errors.New("duration must be at least one minute")
+	}
+	return nil
+}
+
+func (c *Charge) valid() error {
+	if c == nil {
+		return nil
+	}
+	if c.Quantity < 0 {
+		return errors.New("quantity must not be negative")
+	}
+	if c.Quantity > 0 && c.Code == "" {
+		return errors.New("quantity requires a code")
+	}
+	return nil
+}
+
+func (l *LeaveOfAbsence) valid() error {
+	if l == nil {
+		return nil
+	}
+	if l.ExpectedReturnTimeFromNow != nil && *l.ExpectedReturnTimeFromNow < time.Duration(0) {
+		return errors.New("expected_return_time_from_now must not be negative")
+	}
+	return nil
+}
+
+func (r *ReturnFromLOA) valid(lm *location.Manager) error {
+	if r == nil {
+		return nil
+	}
+	return validOptionalLocationAndBed(r.Loc, r.Bed, lm)
+}
+
+func (c *ChangePatientClass) valid(lm *location.Manager) error {
+	if c == nil {
+		return nil
+	}
+	return validOptionalLocationAndBed(c.Loc, c.Bed, lm)
+}
+
+// validOptionalLocationAndBed validates a location that is not required. The bed can only be set
+// if the location is set.
+func validOptionalLocationAndBed(loc string, bed string, lm *location.Manager) error {
+	if loc == "" {
+		if bed != "" {
+			return fmt.Errorf("bed %q requires a loc", bed)
+		}
+		return nil
+	}
+	if err := validLocation(loc, lm); err != nil {
+		return errors.Wrap(err, "error validating location")
+	}
+	return nil
+}
+
 func (s Step) valid(now time.Time, lm *location.Manager) error {
 	if s.StepType() == stepInvalid {
 		return errors.New("cannot detect step type, exactly one field must be set")
@@ -670,6 +897,15 @@ func (s Step) valid(now time.Time, lm *location.Manager) error {
 	if s.BedSwap != nil && (s.BedSwap.Patient1 == "" || s.BedSwap.Patient2 == "") {
 		return errors.New("a bed swap requires patient_1 and patient_2 to be set")
 	}
+	if s.LinkPatients != nil && (s.LinkPatients.Patient1 == "" || s.LinkPatients.Patient2 == "") {
+		return errors.New("a link requires patient_1 and patient_2 to be set")
+	}
+	if s.UnlinkPatients != nil && (s.UnlinkPatients.Patient1 == "" || s.UnlinkPatients.Patient2 == "") {
+		return errors.New("an unlink requires patient_1 and patient_2 to be set")
+	}
+	if orderID, ok := s.orderStatusChange(); ok && orderID == "" {
+		return fmt.Errorf("a %s step requires order_id to be set", s.StepType())
+	}
 	if err := s.PendingAdmission.valid(lm); err != nil {
 		return errors.Wrap(err, "invalid PendingAdmission step")
 	}
@@ -712,6 +948,39 @@ func (s Step) valid(now time.Time, lm *location.Manager) error {
 	if err := s.HardcodedMessage.valid(); err != nil {
 		return errors.Wrap(err, "invalid HardcodedMessage step")
 	}
+	if err := s.Appointment.valid(lm); err != nil {
+		return errors.Wrap(err, "invalid Appointment step")
+	}
+	if err := s.RescheduleAppointment.valid(lm); err != nil {
+		return errors.Wrap(err, "invalid RescheduleAppointment step")
+	}
+	if s.CancelAppointment != nil && s.CancelAppointment.ID == "" {
+		return errors.New("invalid CancelAppointment step: CancelAppointment.ID is required to cancel an appointment")
+	}
+	if s.NoShow != nil && s.NoShow.ID == "" {
+		return errors.New("invalid NoShow step: NoShow.ID is required")
+	}
+	if s.Dispense != nil && s.Dispense.ID == "" {
+		return errors.New("invalid Dispense step: Dispense.ID is required")
+	}
+	if s.Administer != nil && s.Administer.ID == "" {
+		return errors.New("invalid Administer step: Administer.ID is required")
+	}
+	if s.DiscontinueMedication != nil && s.DiscontinueMedication.ID == "" {
+		return errors.New("invalid DiscontinueMedication step: DiscontinueMedication.ID is required")
+	}
+	if err := s.Charge.valid(); err != nil {
+		return errors.Wrap(err, "invalid Charge step")
+	}
+	if err := s.LeaveOfAbsence.valid(); err != nil {
+		return errors.Wrap(err, "invalid LeaveOfAbsence step")
+	}
+	if err := s.ReturnFromLOA.valid(lm); err != nil {
+		return errors.Wrap(err, "invalid ReturnFromLOA step")
+	}
+	if err := s.ChangePatientClass.valid(lm); err != nil {
+		return errors.Wrap(err, "invalid ChangePatientClass step")
+	}
 	return nil
 }
 
@@ -866,6 +1135,12 @@ func (p *Person) valid() error {
 	if err := p.Age.valid(); err != nil {
 		return errors.Wrapf(err, "invalid age")
 	}
+	if err := p.Insurance.valid(); err != nil {
+		return errors.Wrapf(err, "invalid insurance")
+	}
+	if err := p.Guarantor.valid(); err != nil {
+		return errors.Wrapf(err, "invalid guarantor")
+	}
 	g := p.Gender
 	if g == "" || g == constants.RandomString || g == Male || g == Female {
 		// Gender can be "", a string of the form "RANDOM...", Male, or Female.
@@ -874,6 +1149,26 @@ func (p *Person) valid() error {
 	return fmt.Errorf("unknown gender: %s", g)
 }
 
+func (i *Insurance) valid() error {
+	if i == nil {
+		return nil
+	}
+	if i.SelfPay && (i.Plan != "" || i.GroupNumber != "" || i.PolicyNumber != "") {
+		return errors.New("self_pay cannot be set together with plan, group_number or policy_number")
+	}
+	return nil
+}
+
+func (g *Guarantor) valid() error {
+	if g == nil {
+		return nil
+	}
+	if g.Relationship == "" {
+		return errors.New("relationship is required")
+	}
+	return nil
+}
+
 func (a *Address) valid() error {
 	if a == nil {
 		return nil
@@ -984,6 +1279,8 @@ func (p *Pathway) Valid(clock clock.Clock, orderProfiles *orderprofile.OrderProf
 		orderProfiles:         orderProfiles,
 		orderIDSeen:           make(map[string]bool),
 		orderIDToOrderProfile: make(map[string]string),
+		orderIDCancelled:      make(map[string]bool),
+		resultIDToOrderID:     make(map[string]string),
 	}
 	if err := validateHistory(p.History, clock, lm, validator); err != nil {
 		ec = combineErrors(ec, err)
@@ -466,7 +466,99 @@ func TestResultsValid(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true},
+			wantErr: true,
+		}, {
+			name: "valid: culture",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture: &Culture{
+					Organisms: []*Organism{{
+						Name: "Escherichia coli",
+						Susceptibilities: []*Susceptibility{
+							{Antibiotic: "AMP", Interpretation: "R"},
+							{Antibiotic: "CIP", MIC: "<=0.25"},
+							{Antibiotic: "GEN", Interpretation: "S", MIC: "1"},
+						},
+					}},
+				},
+			},
+			wantErr: false,
+		}, {
+			name:    "valid: culture with no growth",
+			r:       &Results{OrderProfile: "BLOOD CULTURE", Culture: &Culture{NoGrowth: true}},
+			wantErr: false,
+		}, {
+			name:    "valid: culture with random organism",
+			r:       &Results{OrderProfile: "BLOOD CULTURE", Culture: &Culture{}},
+			wantErr: false,
+		}, {
+			name:    "invalid: culture with random order profile",
+			r:       &Results{OrderProfile: constants.RandomString, Culture: &Culture{}},
+			wantErr: true,
+		}, {
+			name: "invalid: culture with no growth and organisms",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture:      &Culture{NoGrowth: true, Organisms: []*Organism{{Name: "Escherichia coli"}}},
+			},
+			wantErr: true,
+		}, {
+			name:    "invalid: organism without name",
+			r:       &Results{OrderProfile: "BLOOD CULTURE", Culture: &Culture{Organisms: []*Organism{{}}}},
+			wantErr: true,
+		}, {
+			name: "invalid: susceptibility without antibiotic",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture: &Culture{Organisms: []*Organism{{
+					Name:             "Escherichia coli",
+					Susceptibilities: []*Susceptibility{{Interpretation: "S"}},
+				}}},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid: susceptibility without interpretation nor MIC",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture: &Culture{Organisms: []*Organism{{
+					Name:             "Escherichia coli",
+					Susceptibilities: []*Susceptibility{{Antibiotic: "AMP"}},
+				}}},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid: susceptibility with unknown interpretation",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture: &Culture{Organisms: []*Organism{{
+					Name:             "Escherichia coli",
+					Susceptibilities: []*Susceptibility{{Antibiotic: "AMP", Interpretation: "X"}},
+				}}},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid: susceptibility with non numerical MIC",
+			r: &Results{
+				OrderProfile: "BLOOD CULTURE",
+				Culture: &Culture{Organisms: []*Organism{{
+					Name:             "Escherichia coli",
+					Susceptibilities: []*Susceptibility{{Antibiotic: "AMP", MIC: "high"}},
+				}}},
+			},
+			wantErr: true,
+		}, {
+			name:    "valid: result id",
+			r:       &Results{OrderProfile: "UREA AND ELECTROLYTES", ResultID: "result1"},
+			wantErr: false,
+		}, {
+			name:    "invalid: amendment note without revises",
+			r:       &Results{OrderProfile: "UREA AND ELECTROLYTES", AmendmentNote: "Recalculated"},
+			wantErr: true,
+		}, {
+			name:    "invalid: revises undeclared results",
+			r:       &Results{OrderProfile: "UREA AND ELECTROLYTES", Revises: "result1"},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -658,6 +750,10 @@ func TestPathwayValidStep(t *testing.T) {
 		{step: Step{Order: &Order{OrderProfile: "profile"}}},
 		{step: Step{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}},
 		{step: Step{Order: &Order{}}, wantErr: true},
+		// The message style of Orders is either ORM or OML, in any case.
+		{step: Step{Order: &Order{OrderProfile: "profile", MessageStyle: "ORM"}}},
+		{step: Step{Order: &Order{OrderProfile: "profile", MessageStyle: "oml"}}},
+		{step: Step{Order: &Order{OrderProfile: "profile", MessageStyle: "OMG"}}, wantErr: true},
 		{step: Step{Result: &Results{OrderProfile: "profile"}}},
 		{step: Step{Result: &Results{OrderID: "order1", OrderProfile: "profile"}}},
 		{step: Step{Result: &Results{}}, wantErr: true},
@@ -808,6 +904,56 @@ func TestPathwayValidStep(t *testing.T) {
 		{step: Step{Document: &Document{ID: "docid1", UpdateType: "append", HeaderContentLines: []string{"header"}, NumRandomContentLines: &Interval{}}}, wantErr: false},
 		{step: Step{Document: &Document{ID: "docid1", UpdateType: "append", EndingContentLines: []string{"ending"}, NumRandomContentLines: &Interval{}}}, wantErr: false},
 		{step: Step{Document: &Document{ID: "docid1", UpdateType: "append", EndingContentLines: []string{"ending"}}}, wantErr: false},
+		{step: Step{Appointment: &Appointment{}}},
+		{step: Step{Appointment: &Appointment{ID: "appt1", Loc: "ED", TimeFromNow: &oneHour, Duration: &fiveMinutes}}},
+		{step: Step{Appointment: &Appointment{Loc: "Renal"}}, wantErr: true},
+		{step: Step{Appointment: &Appointment{TimeFromNow: &negativeOneHour}}, wantErr: true},
+		{step: Step{Appointment: &Appointment{Duration: &negativeOneHour}}, wantErr: true},
+		{step: Step{RescheduleAppointment: &RescheduleAppointment{ID: "appt1"}}},
+		{step: Step{RescheduleAppointment: &RescheduleAppointment{ID: "appt1", Loc: "ED", TimeFromNow: &twoHours}}},
+		{step: Step{RescheduleAppointment: &RescheduleAppointment{}}, wantErr: true},
+		{step: Step{RescheduleAppointment: &RescheduleAppointment{ID: "appt1", Loc: "Renal"}}, wantErr: true},
+		{step: Step{CancelAppointment: &CancelAppointment{ID: "appt1"}}},
+		{step: Step{CancelAppointment: &CancelAppointment{}}, wantErr: true},
+		{step: Step{NoShow: &NoShow{ID: "appt1"}}},
+		{step: Step{NoShow: &NoShow{}}, wantErr: true},
+		{step: Step{Prescribe: &Prescribe{}}},
+		{step: Step{Prescribe: &Prescribe{ID: "med1", Medication: "Paracetamol", Dose: "500"}}},
+		{step: Step{Dispense: &Dispense{ID: "med1"}}},
+		{step: Step{Dispense: &Dispense{}}, wantErr: true},
+		{step: Step{Administer: &Administer{ID: "med1", Dose: "500"}}},
+		{step: Step{Administer: &Administer{}}, wantErr: true},
+		{step: Step{Vaccination: &Vaccination{}}},
+		{step: Step{Vaccination: &Vaccination{Vaccine: "Influenza", LotNumber: "LOT-1"}}},
+		{step: Step{DiscontinueMedication: &DiscontinueMedication{ID: "med1"}}},
+		{step: Step{DiscontinueMedication: &DiscontinueMedication{}}, wantErr: true},
+		{step: Step{Charge: &Charge{}}},
+		{step: Step{Charge: &Charge{Code: "SUP-DRESS", Quantity: 2}}},
+		{step: Step{Charge: &Charge{Code: "SUP-DRESS", Quantity: -1}}, wantErr: true},
+		{step: Step{Charge: &Charge{Quantity: 2}}, wantErr: true},
+		{step: Step{AccountCreate: &AccountCreate{}}},
+		{step: Step{AccountUpdate: &AccountUpdate{}}},
+		{step: Step{LeaveOfAbsence: &LeaveOfAbsence{}}},
+		{step: Step{LeaveOfAbsence: &LeaveOfAbsence{ExpectedReturnTimeFromNow: &oneHour, ReleaseBed: true}}},
+		{step: Step{LeaveOfAbsence: &LeaveOfAbsence{ExpectedReturnTimeFromNow: &negativeOneHour}}, wantErr: true},
+		{step: Step{ReturnFromLOA: &ReturnFromLOA{}}},
+		{step: Step{ReturnFromLOA: &ReturnFromLOA{Loc: "ED", Bed: "Bed 1"}}},
+		{step: Step{ReturnFromLOA: &ReturnFromLOA{Bed: "Bed 1"}}, wantErr: true},
+		{step: Step{ReturnFromLOA: &ReturnFromLOA{Loc: "Unknown"}}, wantErr: true},
+		{step: Step{ChangePatientClass: &ChangePatientClass{}}},
+		{step: Step{ChangePatientClass: &ChangePatientClass{PatientClass: "INPATIENT", Loc: "ED"}}},
+		{step: Step{ChangePatientClass: &ChangePatientClass{Bed: "Bed 1"}}, wantErr: true},
+		{step: Step{ChangePatientClass: &ChangePatientClass{Loc: "Unknown"}}, wantErr: true},
+		{step: Step{LinkPatients: &LinkPatients{Patient1: "CURRENT", Patient2: "1234"}}},
+		{step: Step{LinkPatients: &LinkPatients{Patient1: "CURRENT"}}, wantErr: true},
+		{step: Step{UnlinkPatients: &UnlinkPatients{Patient1: "CURRENT", Patient2: "1234"}}},
+		{step: Step{UnlinkPatients: &UnlinkPatients{Patient2: "1234"}}, wantErr: true},
+		{step: Step{ChangeIdentifier: &ChangeIdentifier{}}},
+		{step: Step{ChangeIdentifier: &ChangeIdentifier{NewMRN: "1234"}}},
+		{step: Step{CancelOrder: &CancelOrder{}}, wantErr: true},
+		{step: Step{DiscontinueOrder: &DiscontinueOrder{}}, wantErr: true},
+		{step: Step{HoldOrder: &HoldOrder{}}, wantErr: true},
+		{step: Step{ReleaseOrder: &ReleaseOrder{}}, wantErr: true},
 	}
 	for i, tc := range cases {
 		t.Run(fmt.Sprintf("id:%d-step:%+v-valid:%t", i, tc.step, !tc.wantErr), func(t *testing.T) {
@@ -928,6 +1074,13 @@ func TestPathwayValidPathway(t *testing.T) {
 		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {Result: &Results{OrderID: "order2", OrderProfile: "profile"}}}}, wantErr: false},
 		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}}}, wantErr: false},
 		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderProfile: "profile"}}}}, wantErr: false},
+		// Orders can only change status after they are declared, and cancelled orders cannot have results.
+		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {HoldOrder: &HoldOrder{OrderID: "order1"}}, {ReleaseOrder: &ReleaseOrder{OrderID: "order1"}}, {Result: &Results{OrderID: "order1"}}}}, wantErr: false},
+		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {DiscontinueOrder: &DiscontinueOrder{OrderID: "order1"}}}}, wantErr: false},
+		{pathway: &Pathway{History: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}, Parameters: &Parameters{TimeFromNow: &twoHoursAgo}}}, Pathway: []Step{{CancelOrder: &CancelOrder{OrderID: "order1"}}}}, wantErr: false},
+		{pathway: &Pathway{Pathway: []Step{{CancelOrder: &CancelOrder{OrderID: "order1"}}, {Order: &Order{OrderID: "order1", OrderProfile: "profile"}}}}, wantErr: true},
+		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {CancelOrder: &CancelOrder{OrderID: "order1"}}, {Result: &Results{OrderID: "order1"}}}}, wantErr: true},
+		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {CancelOrder: &CancelOrder{OrderID: "order1"}}, {Result: &Results{OrderID: "order2", OrderProfile: "profile"}}}}, wantErr: false},
 		// AddPerson needs to be the first step.
 		{pathway: &Pathway{Pathway: []Step{addPerson}}, wantErr: false},
 		{pathway: &Pathway{Pathway: []Step{admit, addPerson}}, wantErr: true},
@@ -960,6 +1113,36 @@ func TestPathwayValidPathway(t *testing.T) {
 		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {Result: &Results{OrderID: "order2", OrderProfile: "profile"}}, validNote}}, wantErr: false},
 		{pathway: &Pathway{Pathway: []Step{{Order: &Order{OrderID: "order1", OrderProfile: "profile"}}, {Result: &Results{OrderID: "order2", OrderProfile: "profile"}}, invalidNote}}, wantErr: true},
 		{pathway: &Pathway{Pathway: []Step{{Document: &Document{}}}}, wantErr: false},
+		// Revised results need to be declared in an earlier step for the same order.
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}},
+			{Result: &Results{OrderID: "order1", Revises: "result1", AmendmentNote: "Recalculated"}},
+		}}, wantErr: false},
+		{pathway: &Pathway{
+			History: []Step{{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}, Parameters: &Parameters{TimeFromNow: &twoHoursAgo}}},
+			Pathway: []Step{{Result: &Results{OrderID: "order1", Revises: "result1"}}},
+		}, wantErr: false},
+		// Revised results without a value keep their previous value, so only their status and notes can be revised.
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}},
+			{Result: &Results{OrderID: "order1", Revises: "result1", Results: []*Result{{TestName: "Creatinine", ResultStatus: "X"}}}},
+		}}, wantErr: false},
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}},
+			{Result: &Results{OrderID: "order1", Revises: "result1", Results: []*Result{{TestName: "Creatinine", Unit: "UMOLL"}}}},
+		}}, wantErr: true},
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", Revises: "result1"}},
+			{Result: &Results{OrderID: "order1", ResultID: "result1"}},
+		}}, wantErr: true},
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}},
+			{Result: &Results{OrderID: "order2", OrderProfile: "profile", Revises: "result1"}},
+		}}, wantErr: true},
+		{pathway: &Pathway{Pathway: []Step{
+			{Result: &Results{OrderID: "order1", OrderProfile: "profile", ResultID: "result1"}},
+			{Result: &Results{OrderID: "order1", Revises: "result1", Culture: &Culture{}}},
+		}}, wantErr: true},
 	}
 
 	for i, tc := range cases {
@@ -1073,6 +1256,13 @@ func TestPathwayValidPerson(t *testing.T) {
 		{name: "Age 20-30", persons: &Persons{"main_patient": Person{Age: &Age{From: 20, To: 30}}}, wantErr: false},
 		{name: "Age 30-30", persons: &Persons{"main_patient": Person{Age: &Age{From: 30, To: 30}}}, wantErr: false},
 		{name: "Age 30-20", persons: &Persons{"main_patient": Person{Age: &Age{From: 30, To: 20}}}, wantErr: true},
+		// If SelfPay is set, no other Insurance field can be set.
+		{name: "Insurance plan set", persons: &Persons{"main_patient": Person{Insurance: &Insurance{Plan: "PPO", PolicyNumber: "POL-1"}}}, wantErr: false},
+		{name: "Insurance SelfPay set", persons: &Persons{"main_patient": Person{Insurance: &Insurance{SelfPay: true}}}, wantErr: false},
+		{name: "Insurance SelfPay and plan set", persons: &Persons{"main_patient": Person{Insurance: &Insurance{SelfPay: true, Plan: "PPO"}}}, wantErr: true},
+		// Guarantors must have a relationship.
+		{name: "Guarantor relationship set", persons: &Persons{"main_patient": Person{Guarantor: &Guarantor{Relationship: "SPO"}}}, wantErr: false},
+		{name: "Guarantor relationship not set", persons: &Persons{"main_patient": Person{Guarantor: &Guarantor{}}}, wantErr: true},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
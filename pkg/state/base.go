@@ -33,6 +33,12 @@ const (
 
 	// PatientItemType used for Patient items.
 	PatientItemType = "patient"
+
+	// OutboundMessageItemType used for messages in the outbound journal.
+	OutboundMessageItemType = "outbound_message"
+
+	// DeadLetterItemType used for messages that could not be delivered.
+	DeadLetterItemType = "dead_letter"
 )
 
 var (
@@ -33,6 +33,15 @@ type Patient struct {
 	Orders     map[string]*ir.Order
 	PastVisits []uint64
 	Documents  map[string]*ir.Document
+	// Appointments maps from pathway appointment IDs to the appointments booked for the patient,
+	// so that they can be rescheduled or cancelled later on.
+	Appointments map[string]*ir.Appointment
+	// MedicationOrders maps from pathway medication order IDs to the medication orders of the
+	// patient, so that they can be dispensed, administered or discontinued later on.
+	MedicationOrders map[string]*ir.MedicationOrder
+	// LinkedMRNs are the MRNs of the patients whose records are linked to this patient's record,
+	// e.g., because they belong to the same person.
+	LinkedMRNs []string
 }
 
 // GetOrder retrieves an order by its identifier.
@@ -68,6 +77,72 @@ func (p *Patient) AddDocument(pathwayDocumentID string, document *ir.Document) {
 	}
 }
 
+// GetAppointment retrieves an appointment by the pathway Appointment ID.
+func (p *Patient) GetAppointment(pathwayAppointmentID string) *ir.Appointment {
+	return p.Appointments[pathwayAppointmentID]
+}
+
+// AddAppointment adds an appointment to the map against the specified pathway Appointment ID, so that it can be
+// looked up and updated. If the pathwayAppointmentID is not specified, a unique ID is generated.
+func (p *Patient) AddAppointment(pathwayAppointmentID string, appointment *ir.Appointment) {
+	if p.Appointments == nil {
+		// Patients that were persisted before appointments were supported don't have the map.
+		p.Appointments = make(map[string]*ir.Appointment)
+	}
+	if pathwayAppointmentID == "" {
+		pathwayAppointmentID = fmt.Sprintf(generatedIDPattern, len(p.Appointments))
+	}
+	p.Appointments[pathwayAppointmentID] = appointment
+}
+
+// GetMedicationOrder retrieves a medication order by the pathway medication order ID.
+func (p *Patient) GetMedicationOrder(pathwayOrderID string) *ir.MedicationOrder {
+	return p.MedicationOrders[pathwayOrderID]
+}
+
+// AddMedicationOrder adds a medication order to the map against the specified pathway medication
+// order ID if it does not exist, and adds it to the patient's medications. If the pathwayOrderID is
+// not specified, a unique ID is generated.
+func (p *Patient) AddMedicationOrder(pathwayOrderID string, order *ir.MedicationOrder) {
+	if p.MedicationOrders == nil {
+		// Patients that were persisted before medications were supported don't have the map.
+		p.MedicationOrders = make(map[string]*ir.MedicationOrder)
+	}
+	if pathwayOrderID == "" {
+		pathwayOrderID = fmt.Sprintf(generatedIDPattern, len(p.MedicationOrders))
+	}
+	if _, ok := p.MedicationOrders[pathwayOrderID]; !ok {
+		p.PatientInfo.Medications = append(p.PatientInfo.Medications, order)
+		p.MedicationOrders[pathwayOrderID] = order
+	}
+}
+
+// IsLinked returns whether the patient's record is linked to the record of the patient with the
+// given MRN.
+func (p *Patient) IsLinked(mrn string) bool {
+	for _, m := range p.LinkedMRNs {
+		if m == mrn {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Patient) link(mrn string) {
+	if !p.IsLinked(mrn) {
+		p.LinkedMRNs = append(p.LinkedMRNs, mrn)
+	}
+}
+
+func (p *Patient) unlink(mrn string) {
+	for i, m := range p.LinkedMRNs {
+		if m == mrn {
+			p.LinkedMRNs = append(p.LinkedMRNs[:i], p.LinkedMRNs[i+1:]...)
+			return
+		}
+	}
+}
+
 // PushPastVisit appends a visit number to the patients PastVisits slice.
 func (p *Patient) PushPastVisit(visit uint64) {
 	p.PastVisits = append(p.PastVisits, visit)
@@ -144,6 +144,39 @@ func TestPatient_GetDocument(t *testing.T) {
 	}
 }
 
+func TestPatient_GetMedicationOrder(t *testing.T) {
+	// MedicationOrders is nil, as in patients persisted before medications were supported.
+	p := Patient{PatientInfo: &ir.PatientInfo{}}
+	orderID := "orderID1"
+
+	if p.GetMedicationOrder(orderID) != nil {
+		t.Errorf("p.GetMedicationOrder(%q) is something, want <nil>", orderID)
+	}
+
+	order1 := &ir.MedicationOrder{Medication: &ir.CodedElement{ID: "1", Text: "medication1"}}
+	p.AddMedicationOrder(orderID, order1)
+	if diff := cmp.Diff(order1, p.GetMedicationOrder(orderID)); diff != "" {
+		t.Errorf("Patient.GetMedicationOrder(%q) mismatch (-want +got):\n%s", orderID, diff)
+	}
+
+	// Adding the same order again does not duplicate it in the patient's medications.
+	p.AddMedicationOrder(orderID, order1)
+	if diff := cmp.Diff([]*ir.MedicationOrder{order1}, p.PatientInfo.Medications); diff != "" {
+		t.Errorf("p.PatientInfo.Medications mismatch (-want +got):\n%s", diff)
+	}
+
+	// Add an order with an empty ID: the ID is generated.
+	orderNoID := &ir.MedicationOrder{Medication: &ir.CodedElement{ID: "2", Text: "medication2"}}
+	p.AddMedicationOrder("", orderNoID)
+	wantOrderID := "generated-1"
+	if diff := cmp.Diff(orderNoID, p.GetMedicationOrder(wantOrderID)); diff != "" {
+		t.Errorf("Patient.GetMedicationOrder(%q) mismatch (-want +got):\n%s", wantOrderID, diff)
+	}
+	if diff := cmp.Diff([]*ir.MedicationOrder{order1, orderNoID}, p.PatientInfo.Medications); diff != "" {
+		t.Errorf("p.PatientInfo.Medications mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestPatient_PushPastVisit_PopPastVisit(t *testing.T) {
 	p := Patient{}
 
@@ -26,6 +26,9 @@ import (
 type PatientsMap struct {
 	m     map[string]*Patient
 	mutex sync.Mutex
+	// aliases maps the previous IDs of the patients whose IDs changed to their current IDs, so that
+	// patients can be retrieved by either of them.
+	aliases map[string]string
 	// syncer receives all operations (Put, Get, Delete, etc.) performed on the PatientsMap.
 	// Optional.
 	syncer      persist.ItemSyncer
@@ -42,6 +45,7 @@ func NewPatientsMap(syncer persist.ItemSyncer, deleteFromMap bool) *PatientsMap
 	return &PatientsMap{
 		m:           map[string]*Patient{},
 		mutex:       sync.Mutex{},
+		aliases:     map[string]string{},
 		syncer:      syncer,
 		deleteFromM: deleteFromMap,
 	}
@@ -67,9 +71,17 @@ func (m *PatientsMap) put(p *Patient) error {
 }
 
 // Get returns a patient if its identifier is present within the internal patients map or the syncer, in this order.
+// The identifier can also be a previous identifier of a patient whose identifier was changed with ChangeID.
 func (m *PatientsMap) Get(id string) *Patient {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+	return m.get(id)
+}
+
+func (m *PatientsMap) get(id string) *Patient {
+	if current, ok := m.aliases[id]; ok {
+		id = current
+	}
 	logLocal := log.WithField("patient_id", id)
 	if p, ok := m.m[id]; ok {
 		logLocal.Debug("Patient found in the map")
@@ -104,6 +116,112 @@ func (m *PatientsMap) getFromSyncer(id string) *Patient {
 	return m.m[id]
 }
 
+// ChangeID changes the identifier of the patient with the given identifier to newID, and updates the
+// records linked to the patient accordingly. The patient can still be retrieved with its previous
+// identifier afterwards.
+// ChangeID returns an error if the patient doesn't exist, or if another patient has the new identifier.
+func (m *PatientsMap) ChangeID(id string, newID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	p := m.get(id)
+	if p == nil {
+		return errors.Errorf("patient %s not found", id)
+	}
+	oldID, err := p.ID()
+	if err != nil {
+		return errors.Wrap(err, "cannot get ID")
+	}
+	if other := m.get(newID); other != nil && other != p {
+		return errors.Errorf("patient %s already exists", newID)
+	}
+	if m.syncer != nil {
+		if err := m.syncer.Delete(*p); err != nil {
+			log.WithField("patient_id", oldID).WithError(err).Error("Cannot delete patient from the syncer")
+		}
+	}
+	delete(m.m, oldID)
+	p.PatientInfo.Person.MRN = newID
+	if err := m.put(p); err != nil {
+		return errors.Wrap(err, "cannot put patient")
+	}
+	for alias, current := range m.aliases {
+		if current == oldID {
+			m.aliases[alias] = newID
+		}
+	}
+	m.aliases[oldID] = newID
+	delete(m.aliases, newID)
+	m.write(p)
+
+	for _, linkedID := range p.LinkedMRNs {
+		if linked := m.get(linkedID); linked != nil {
+			linked.unlink(oldID)
+			linked.link(newID)
+			m.write(linked)
+		}
+	}
+	return nil
+}
+
+// Link links the records of the patients with the given identifiers.
+// Link returns an error if any of the patients doesn't exist.
+func (m *PatientsMap) Link(id1 string, id2 string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	p1, p2, err := m.getPair(id1, id2)
+	if err != nil {
+		return err
+	}
+	p1.link(p2.PatientInfo.Person.MRN)
+	p2.link(p1.PatientInfo.Person.MRN)
+	m.write(p1)
+	m.write(p2)
+	return nil
+}
+
+// Unlink unlinks the records of the patients with the given identifiers.
+// Unlink returns an error if any of the patients doesn't exist, or if they are not linked.
+func (m *PatientsMap) Unlink(id1 string, id2 string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	p1, p2, err := m.getPair(id1, id2)
+	if err != nil {
+		return err
+	}
+	if !p1.IsLinked(p2.PatientInfo.Person.MRN) {
+		return errors.Errorf("patients %s and %s are not linked", id1, id2)
+	}
+	p1.unlink(p2.PatientInfo.Person.MRN)
+	p2.unlink(p1.PatientInfo.Person.MRN)
+	m.write(p1)
+	m.write(p2)
+	return nil
+}
+
+func (m *PatientsMap) getPair(id1 string, id2 string) (*Patient, *Patient, error) {
+	p1 := m.get(id1)
+	if p1 == nil {
+		return nil, nil, errors.Errorf("patient %s not found", id1)
+	}
+	p2 := m.get(id2)
+	if p2 == nil {
+		return nil, nil, errors.Errorf("patient %s not found", id2)
+	}
+	if p1 == p2 {
+		return nil, nil, errors.Errorf("patients %s and %s are the same patient", id1, id2)
+	}
+	return p1, p2, nil
+}
+
+func (m *PatientsMap) write(p *Patient) {
+	if m.syncer == nil {
+		return
+	}
+	if err := m.syncer.Write(*p); err != nil {
+		log.WithError(err).Error("Cannot write patient to the syncer")
+	}
+}
+
 // Delete deletes a patient from the internal patients map and the syncer, by its identifier.
 func (m *PatientsMap) Delete(id string) {
 	m.mutex.Lock()
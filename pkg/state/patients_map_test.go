@@ -231,3 +231,80 @@ func TestPatientsMap_Len(t *testing.T) {
 		t.Errorf("pm.Len() = %d, want: %d", got, want)
 	}
 }
+
+func TestPatientsMap_ChangeID(t *testing.T) {
+	pm := NewPatientsMap(teststate.NewItemSyncerWithDelete(true), true)
+	p1 := &Patient{PatientInfo: &ir.PatientInfo{Person: &ir.Person{MRN: testID}}}
+	p2 := &Patient{PatientInfo: &ir.PatientInfo{Person: &ir.Person{MRN: testID2}}}
+	pm.Put(p1)
+	pm.Put(p2)
+	if err := pm.Link(testID, testID2); err != nil {
+		t.Fatalf("pm.Link(%q, %q) failed with %v", testID, testID2, err)
+	}
+
+	newID := "3"
+	if err := pm.ChangeID(testID, newID); err != nil {
+		t.Fatalf("pm.ChangeID(%q, %q) failed with %v", testID, newID, err)
+	}
+	for _, id := range []string{testID, newID} {
+		if got := pm.Get(id); got != p1 {
+			t.Errorf("pm.Get(%q) = %v, want: %v", id, got, p1)
+		}
+	}
+	if got := p1.PatientInfo.Person.MRN; got != newID {
+		t.Errorf("p1.PatientInfo.Person.MRN = %q, want: %q", got, newID)
+	}
+	if diff := cmp.Diff([]string{newID}, p2.LinkedMRNs); diff != "" {
+		t.Errorf("p2.LinkedMRNs mismatch (-want, +got):\n%s", diff)
+	}
+	if got, _ := pm.syncer.LoadByID(testID); got != nil {
+		t.Errorf("syncer LoadByID(%q) = %v, want: nil", testID, got)
+	}
+	if got, _ := pm.syncer.LoadByID(newID); !cmp.Equal(*p1, got) {
+		t.Errorf("syncer LoadByID(%q) = %v, want: %v", newID, got, p1)
+	}
+
+	if err := pm.ChangeID(newID, testID2); err == nil {
+		t.Errorf("pm.ChangeID(%q, %q) got nil error, want non nil", newID, testID2)
+	}
+	if err := pm.ChangeID("unknown", "4"); err == nil {
+		t.Errorf("pm.ChangeID(%q, %q) got nil error, want non nil", "unknown", "4")
+	}
+}
+
+func TestPatientsMap_LinkUnlink(t *testing.T) {
+	pm := NewPatientsMap(nil, true)
+	p1 := &Patient{PatientInfo: &ir.PatientInfo{Person: &ir.Person{MRN: testID}}}
+	p2 := &Patient{PatientInfo: &ir.PatientInfo{Person: &ir.Person{MRN: testID2}}}
+	pm.Put(p1)
+	pm.Put(p2)
+
+	if err := pm.Unlink(testID, testID2); err == nil {
+		t.Errorf("pm.Unlink(%q, %q) before linking got nil error, want non nil", testID, testID2)
+	}
+	if err := pm.Link(testID, testID2); err != nil {
+		t.Fatalf("pm.Link(%q, %q) failed with %v", testID, testID2, err)
+	}
+	if !p1.IsLinked(testID2) || !p2.IsLinked(testID) {
+		t.Errorf("p1.IsLinked(%q)=%t, p2.IsLinked(%q)=%t, want both true", testID2, p1.IsLinked(testID2), testID, p2.IsLinked(testID))
+	}
+	// Linking twice doesn't duplicate the links.
+	if err := pm.Link(testID2, testID); err != nil {
+		t.Fatalf("pm.Link(%q, %q) failed with %v", testID2, testID, err)
+	}
+	if got, want := len(p1.LinkedMRNs), 1; got != want {
+		t.Errorf("len(p1.LinkedMRNs) = %d, want: %d", got, want)
+	}
+	if err := pm.Unlink(testID, testID2); err != nil {
+		t.Fatalf("pm.Unlink(%q, %q) failed with %v", testID, testID2, err)
+	}
+	if p1.IsLinked(testID2) || p2.IsLinked(testID) {
+		t.Errorf("p1.IsLinked(%q)=%t, p2.IsLinked(%q)=%t, want both false", testID2, p1.IsLinked(testID2), testID, p2.IsLinked(testID))
+	}
+
+	for _, ids := range [][]string{{testID, "unknown"}, {testID, testID}} {
+		if err := pm.Link(ids[0], ids[1]); err == nil {
+			t.Errorf("pm.Link(%q, %q) got nil error, want non nil", ids[0], ids[1])
+		}
+	}
+}
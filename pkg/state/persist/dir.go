@@ -0,0 +1,132 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persist
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const dirSyncerExt = ".item"
+
+// DirSyncer is an ItemSyncer that stores each item in its own file in a local directory.
+// Items are written to a temporary file first and then renamed, so that an item is never
+// partially written.
+type DirSyncer struct {
+	dir          string
+	unmarshaller Unmarshaller
+}
+
+// NewDirSyncer returns a DirSyncer that stores items in dir, creating it if it doesn't exist.
+// The unmarshaller is used to load the items.
+func NewDirSyncer(dir string, unmarshaller Unmarshaller) (*DirSyncer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "cannot create directory %s", dir)
+	}
+	return &DirSyncer{dir: dir, unmarshaller: unmarshaller}, nil
+}
+
+// fileName returns the name of the file for the item with the given ID.
+// IDs are hashed because they can contain characters that are not valid in file names.
+func (s *DirSyncer) fileName(id string) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%X%s", sha256.Sum256([]byte(id)), dirSyncerExt))
+}
+
+// Write writes the item to its file.
+func (s *DirSyncer) Write(item MarshallableItem) error {
+	id, err := item.ID()
+	if err != nil {
+		return errors.Wrap(err, "cannot get ID")
+	}
+	b, err := item.Marshal()
+	if err != nil {
+		return errors.Wrapf(err, "cannot marshal item %s", id)
+	}
+	tmp, err := ioutil.TempFile(s.dir, "tmp")
+	if err != nil {
+		return errors.Wrap(err, "cannot create temporary file")
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return errors.Wrapf(err, "cannot write item %s", id)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrapf(err, "cannot write item %s", id)
+	}
+	if err := os.Rename(tmp.Name(), s.fileName(id)); err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrapf(err, "cannot write item %s", id)
+	}
+	return nil
+}
+
+// Delete deletes the file of the item. Deleting an item that doesn't exist is not an error.
+func (s *DirSyncer) Delete(item MarshallableItem) error {
+	id, err := item.ID()
+	if err != nil {
+		return errors.Wrap(err, "cannot get ID")
+	}
+	if err := os.Remove(s.fileName(id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "cannot delete item %s", id)
+	}
+	return nil
+}
+
+// LoadAll loads all the items in the directory, sorted by file name.
+func (s *DirSyncer) LoadAll() ([]MarshallableItem, error) {
+	names, err := filepath.Glob(filepath.Join(s.dir, "*"+dirSyncerExt))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot list items in %s", s.dir)
+	}
+	sort.Strings(names)
+	var items []MarshallableItem
+	for _, n := range names {
+		item, err := s.load(n)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// LoadByID loads the item with the given ID. It returns nil if the item doesn't exist.
+func (s *DirSyncer) LoadByID(id string) (MarshallableItem, error) {
+	item, err := s.load(s.fileName(id))
+	if os.IsNotExist(errors.Cause(err)) {
+		return nil, nil
+	}
+	return item, err
+}
+
+func (s *DirSyncer) load(fileName string) (MarshallableItem, error) {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read item file %s", fileName)
+	}
+	item, err := s.unmarshaller.Unmarshal(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot unmarshal item file %s", fileName)
+	}
+	return item, nil
+}
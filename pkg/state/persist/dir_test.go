@@ -0,0 +1,81 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persist
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+)
+
+type item string
+
+func (i item) ID() (string, error) {
+	return string(i)[:1], nil
+}
+
+func (i item) Marshal() ([]byte, error) {
+	return []byte(i), nil
+}
+
+type itemUnmarshaller struct{}
+
+func (itemUnmarshaller) Unmarshal(b []byte) (MarshallableItem, error) {
+	return item(b), nil
+}
+
+func TestDirSyncer(t *testing.T) {
+	dir := filepath.Join(testwrite.TempDir(t), "items")
+	s, err := NewDirSyncer(dir, itemUnmarshaller{})
+	if err != nil {
+		t.Fatalf("NewDirSyncer(%q) failed with %v", dir, err)
+	}
+	for _, i := range []item{"a1", "b1", "a/2"} {
+		if err := s.Write(i); err != nil {
+			t.Fatalf("Write(%q) failed with %v", i, err)
+		}
+	}
+
+	// A new syncer on the same directory sees the items written by the first one.
+	s, err = NewDirSyncer(dir, itemUnmarshaller{})
+	if err != nil {
+		t.Fatalf("NewDirSyncer(%q) failed with %v", dir, err)
+	}
+	got, err := s.LoadByID("a")
+	if err != nil {
+		t.Fatalf("LoadByID(%q) failed with %v", "a", err)
+	}
+	if want := item("a/2"); got != want {
+		t.Errorf("LoadByID(%q) got %v, want %v", "a", got, want)
+	}
+	if got, err := s.LoadByID("c"); err != nil || got != nil {
+		t.Errorf("LoadByID(%q) got %v, %v; want <nil>, <nil>", "c", got, err)
+	}
+
+	if err := s.Delete(item("b")); err != nil {
+		t.Fatalf("Delete(%q) failed with %v", "b", err)
+	}
+	if err := s.Delete(item("c")); err != nil {
+		t.Errorf("Delete(%q) got err %v, want <nil>", "c", err)
+	}
+	all, err := s.LoadAll()
+	if err != nil {
+		t.Fatalf("LoadAll() failed with %v", err)
+	}
+	if len(all) != 1 || all[0] != item("a/2") {
+		t.Errorf("LoadAll() got %v, want [a/2]", all)
+	}
+}
@@ -61,6 +61,16 @@ var (
 	DataMessageConfigTest = path.Join(testConfigDir, "sh_data_message_config_test.yml")
 	// OrderProfilesConfigTest is the path to the config file with order profiles for testing.
 	OrderProfilesConfigTest = path.Join(testConfigDir, "sh_order_profiles_test.yml")
+	// MedicationsConfigTest is the path to the medications config file for testing.
+	MedicationsConfigTest = path.Join(testConfigDir, "sh_medications_test.yml")
+	// VaccinesConfigTest is the path to the vaccines config file for testing.
+	VaccinesConfigTest = path.Join(testConfigDir, "sh_vaccines_test.yml")
+	// ChargemasterConfigTest is the path to the chargemaster config file for testing.
+	ChargemasterConfigTest = path.Join(testConfigDir, "sh_chargemaster_test.yml")
+	// InsuranceConfigTest is the path to the insurance config file for testing.
+	InsuranceConfigTest = path.Join(testConfigDir, "sh_insurance_test.yml")
+	// MicrobiologyConfigTest is the path to the microbiology config file for testing.
+	MicrobiologyConfigTest = path.Join(testConfigDir, "sh_microbiology_test.yml")
 	// PatientClassConfigTest is the path to the patient class config file for testing.
 	PatientClassConfigTest = path.Join(testConfigDir, "sh_patient_class_test.csv")
 	// SurnamesConfigTest is the path to the surnames config file for testing.
@@ -88,6 +98,20 @@ var (
 	EthnicityConfigProd = path.Join(prodConfigDir, "hl7_messages", "ethnicity.csv")
 	// OrderProfilesConfigProd is the path to the prod config file with order profiles.
 	OrderProfilesConfigProd = path.Join(prodConfigDir, "hl7_messages", "order_profiles.yml")
+	// MedicationsConfigProd is the path to the prod medications config file.
+	MedicationsConfigProd = path.Join(prodConfigDir, "hl7_messages", "medications.yml")
+	// VaccinesConfigProd is the path to the prod vaccines config file.
+	VaccinesConfigProd = path.Join(prodConfigDir, "hl7_messages", "vaccines.yml")
+	// ChargemasterConfigProd is the path to the prod chargemaster config file.
+	ChargemasterConfigProd = path.Join(prodConfigDir, "hl7_messages", "chargemaster.yml")
+	// InsuranceConfigProd is the path to the prod insurance config file.
+	InsuranceConfigProd = path.Join(prodConfigDir, "hl7_messages", "insurance.yml")
+	// MicrobiologyConfigProd is the path to the prod microbiology config file.
+	MicrobiologyConfigProd = path.Join(prodConfigDir, "hl7_messages", "microbiology.yml")
+	// ValidationConfigProd is the path to the prod config file to validate HL7 messages.
+	ValidationConfigProd = path.Join(prodConfigDir, "hl7_validation", "validation.yml")
+	// ConformanceProfileProd is the path to the prod conformance profile.
+	ConformanceProfileProd = path.Join(prodConfigDir, "hl7_validation", "conformance_profile.yml")
 	// PatientClassConfigProd is the path to the prod patient class config file.
 	PatientClassConfigProd = path.Join(prodConfigDir, "hl7_messages", "patient_class.csv")
 	// MessageConfigProd is the path to the prod message config file.
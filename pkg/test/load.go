@@ -0,0 +1,42 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/test/testwrite"
+)
+
+// InvalidConfig is the content of a configuration file that cannot be loaded.
+type InvalidConfig struct {
+	// Name is the name of the test case, eg: "Missing ID".
+	Name    string
+	Content string
+}
+
+// CheckLoadFails checks that load returns an error for each of the given configuration files.
+// Load is called with the name of a file with the content of the configuration.
+func CheckLoadFails(t *testing.T, load func(fileName string) error, configs []InvalidConfig) {
+	t.Helper()
+	for _, c := range configs {
+		t.Run(c.Name, func(t *testing.T) {
+			fName := testwrite.BytesToFile(t, []byte(c.Content))
+			if err := load(fName); err == nil {
+				t.Errorf("Load(%s) got nil error, want non nil", fName)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testconfig contains functionality to load the test configuration files in pkg/test/data,
+// failing the test if they cannot be loaded.
+package testconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/chargemaster"
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/doctor"
+	"github.com/bitcrshr/simhospital/pkg/insurance"
+	"github.com/bitcrshr/simhospital/pkg/location"
+	"github.com/bitcrshr/simhospital/pkg/medication"
+	"github.com/bitcrshr/simhospital/pkg/microbiology"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/bitcrshr/simhospital/pkg/vaccine"
+)
+
+// Doctors returns the doctors in test.DoctorsConfigTest.
+func Doctors(t *testing.T) *doctor.Doctors {
+	t.Helper()
+	d, err := doctor.LoadDoctors(context.Background(), test.DoctorsConfigTest)
+	if err != nil {
+		t.Fatalf("LoadDoctors(%s) failed with %v", test.DoctorsConfigTest, err)
+	}
+	return d
+}
+
+// LocationManager returns a location manager with the locations in test.LocationsConfigTest.
+func LocationManager(t *testing.T) *location.Manager {
+	t.Helper()
+	lm, err := location.NewManager(context.Background(), test.LocationsConfigTest)
+	if err != nil {
+		t.Fatalf("location.NewManager(%s) failed with %v", test.LocationsConfigTest, err)
+	}
+	return lm
+}
+
+// Medications returns the medications in test.MedicationsConfigTest.
+func Medications(t *testing.T, hl7Config *config.HL7Config) *medication.Medications {
+	t.Helper()
+	m, err := medication.Load(context.Background(), test.MedicationsConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("medication.Load(%s, %+v) failed with %v", test.MedicationsConfigTest, hl7Config, err)
+	}
+	return m
+}
+
+// Vaccines returns the vaccines in test.VaccinesConfigTest.
+func Vaccines(t *testing.T) *vaccine.Vaccines {
+	t.Helper()
+	v, err := vaccine.Load(context.Background(), test.VaccinesConfigTest)
+	if err != nil {
+		t.Fatalf("vaccine.Load(%s) failed with %v", test.VaccinesConfigTest, err)
+	}
+	return v
+}
+
+// Chargemaster returns the chargemaster in test.ChargemasterConfigTest.
+func Chargemaster(t *testing.T, hl7Config *config.HL7Config) *chargemaster.Chargemaster {
+	t.Helper()
+	c, err := chargemaster.Load(context.Background(), test.ChargemasterConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("chargemaster.Load(%s, %+v) failed with %v", test.ChargemasterConfigTest, hl7Config, err)
+	}
+	return c
+}
+
+// Insurance returns the insurance catalogue in test.InsuranceConfigTest.
+func Insurance(t *testing.T, hl7Config *config.HL7Config) *insurance.Catalogue {
+	t.Helper()
+	c, err := insurance.Load(context.Background(), test.InsuranceConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("insurance.Load(%s, %+v) failed with %v", test.InsuranceConfigTest, hl7Config, err)
+	}
+	return c
+}
+
+// Microbiology returns the microbiology catalogue in test.MicrobiologyConfigTest.
+func Microbiology(t *testing.T, hl7Config *config.HL7Config) *microbiology.Catalogue {
+	t.Helper()
+	c, err := microbiology.Load(context.Background(), test.MicrobiologyConfigTest, hl7Config)
+	if err != nil {
+		t.Fatalf("microbiology.Load(%s, %+v) failed with %v", test.MicrobiologyConfigTest, hl7Config, err)
+	}
+	return c
+}
@@ -202,6 +202,42 @@ func TXA(t *testing.T, message string) *hl7.TXA {
 	return txa
 }
 
+// SCH returns the message's SCH segment.
+func SCH(t *testing.T, message string) *hl7.SCH {
+	t.Helper()
+	m := Parse(t, message)
+
+	sch, err := m.SCH()
+	if err != nil {
+		t.Fatalf("SCH() failed with %v", err)
+	}
+	return sch
+}
+
+// RXE returns the RXE segment.
+func RXE(t *testing.T, message string) *hl7.RXE {
+	t.Helper()
+	m := Parse(t, message)
+
+	rxe, err := m.RXE()
+	if err != nil {
+		t.Fatalf("RXE() failed with %v", err)
+	}
+	return rxe
+}
+
+// RXA returns the RXA segment.
+func RXA(t *testing.T, message string) *hl7.RXA {
+	t.Helper()
+	m := Parse(t, message)
+
+	rxa, err := m.RXA()
+	if err != nil {
+		t.Fatalf("RXA() failed with %v", err)
+	}
+	return rxa
+}
+
 // AllDG1 returns all DG1 segments.
 func AllDG1(t *testing.T, message string) []*hl7.DG1 {
 	t.Helper()
@@ -265,6 +301,21 @@ func ORC(t *testing.T, message string) *hl7.ORC {
 	return orc
 }
 
+// SPM returns the message's SPM segment.
+func SPM(t *testing.T, message string) *hl7.SPM {
+	t.Helper()
+	m := Parse(t, message)
+
+	spm, err := m.SPM()
+	if err != nil {
+		t.Fatalf("SPM() failed with %v", err)
+	}
+	if spm == nil {
+		t.Fatal("SPM() got nil SPM segment, want non nil")
+	}
+	return spm
+}
+
 // MRG returns the message's MRG segment.
 func MRG(t *testing.T, message string) *hl7.MRG {
 	t.Helper()
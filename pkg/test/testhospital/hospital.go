@@ -44,6 +44,11 @@ var (
 	Arguments = hospital.Arguments{
 		DoctorsFile:          &test.DoctorsConfigTest,
 		OrderProfilesFile:    &test.OrderProfilesConfigTest,
+		MedicationsFile:      &test.MedicationsConfigTest,
+		VaccinesFile:         &test.VaccinesConfigTest,
+		ChargemasterFile:     &test.ChargemasterConfigTest,
+		InsuranceFile:        &test.InsuranceConfigTest,
+		MicrobiologyFile:     &test.MicrobiologyConfigTest,
 		PathwayArguments:     &hospital.PathwayArguments{Dir: test.PathwaysDirTest, Type: "distribution"},
 		Hl7ConfigFile:        &test.MessageConfigTest,
 		HeaderConfigFile:     &test.HeaderConfigTest,
@@ -115,6 +120,21 @@ func WithTime(ctx context.Context, t *testing.T, cfg Config, now time.Time) *Hos
 	if cfg.OrderProfiles != nil {
 		c.OrderProfiles = cfg.OrderProfiles
 	}
+	if cfg.Medications != nil {
+		c.Medications = cfg.Medications
+	}
+	if cfg.Vaccines != nil {
+		c.Vaccines = cfg.Vaccines
+	}
+	if cfg.Chargemaster != nil {
+		c.Chargemaster = cfg.Chargemaster
+	}
+	if cfg.Insurance != nil {
+		c.Insurance = cfg.Insurance
+	}
+	if cfg.Microbiology != nil {
+		c.Microbiology = cfg.Microbiology
+	}
 	if cfg.PathwayManager != nil {
 		c.PathwayManager = cfg.PathwayManager
 	}
@@ -128,6 +148,9 @@ func WithTime(ctx context.Context, t *testing.T, cfg Config, now time.Time) *Hos
 	} else {
 		c.ResourceWriter = testfhir.NewWriter()
 	}
+	if cfg.Journal != nil {
+		c.Journal = cfg.Journal
+	}
 
 	c.AdditionalConfig = cfg.AdditionalConfig
 	c.AdditionalConfig.AddressGenerator = &testaddress.ArbitraryGenerator
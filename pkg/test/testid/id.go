@@ -19,11 +19,15 @@ import "strconv"
 
 // Generator is a generator of identifiers.
 type Generator struct {
-	nID int
+	// Prefix is prepended to every identifier, eg: to tell apart the identifiers of different
+	// generators used in the same test.
+	Prefix string
+	nID    int
 }
 
-// NewID returns a new identifier that increments with every invocation, starting with "1".
+// NewID returns a new identifier that increments with every invocation, starting with "1",
+// preceded by the prefix.
 func (g *Generator) NewID() string {
 	g.nID++
-	return strconv.Itoa(g.nID)
+	return g.Prefix + strconv.Itoa(g.nID)
 }
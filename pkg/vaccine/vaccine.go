@@ -0,0 +1,135 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vaccine is responsible for parsing the catalogue of vaccines that can be administered.
+package vaccine
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"github.com/bitcrshr/simhospital/pkg/config"
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/logging"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CVX is the coding system of vaccines.
+	// See https://www2.cdc.gov/vaccines/iis/iisstandards/vaccines.asp?rpt=cvx.
+	CVX = "CVX"
+	// MVX is the coding system of vaccine manufacturers.
+	// See https://www2.cdc.gov/vaccines/iis/iisstandards/vaccines.asp?rpt=mvx.
+	MVX = "MVX"
+)
+
+var log = logging.ForCallerPackage()
+
+// Vaccines contains the vaccines that can be administered.
+type Vaccines struct {
+	// m is a map of Vaccines keyed by their names.
+	m map[string]*Vaccine
+	// names is a sorted slice of all Vaccine names.
+	names []string
+}
+
+// Vaccine contains the details of a vaccine, and the default values of its administrations.
+type Vaccine struct {
+	// Code identifies the vaccine with its CVX code. Its Text is the name of the vaccine.
+	Code ir.CodedElement
+	// Manufacturer identifies the manufacturer with its MVX code.
+	Manufacturer *ir.CodedElement
+	// LotNumbers are the lot numbers that the lot number of each administration is picked from.
+	LotNumbers []string
+	Dose       string
+	DoseUnits  string
+	Route      string
+	Site       string
+}
+
+// New returns a new Vaccines from a vaccines map.
+func New(m map[string]*Vaccine) *Vaccines {
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return &Vaccines{m: m, names: names}
+}
+
+// Get returns the Vaccine with the given name.
+func (v *Vaccines) Get(name string) (*Vaccine, bool) {
+	vaccine, ok := v.m[name]
+	return vaccine, ok
+}
+
+// Random returns a random Vaccine, or nil if there are no vaccines.
+func (v *Vaccines) Random() *Vaccine {
+	if len(v.names) == 0 {
+		return nil
+	}
+	return v.m[v.names[rand.Intn(len(v.names))]]
+}
+
+// RandomLotNumber returns a random lot number of the vaccine, or an empty string if the vaccine
+// doesn't have lot numbers.
+func (v *Vaccine) RandomLotNumber() string {
+	if len(v.LotNumbers) == 0 {
+		return ""
+	}
+	return v.LotNumbers[rand.Intn(len(v.LotNumbers))]
+}
+
+type vaccine struct {
+	CVX          string
+	MVX          string
+	Manufacturer string
+	LotNumbers   []string `yaml:"lot_numbers"`
+	Dose         string
+	DoseUnits    string `yaml:"dose_units"`
+	Route        string
+	Site         string
+}
+
+// Load parses the vaccines from the given file.
+func Load(ctx context.Context, filename string) (*Vaccines, error) {
+	parsed := map[string]vaccine{}
+	if err := config.LoadYAML(ctx, filename, "vaccines", &parsed); err != nil {
+		return nil, err
+	}
+
+	vaccines := map[string]*Vaccine{}
+	log.Info("Loading vaccines")
+	for name, v := range parsed {
+		if v.CVX == "" {
+			return nil, errors.Errorf("vaccine %q in %s: cvx is required", name, filename)
+		}
+		var manufacturer *ir.CodedElement
+		if v.MVX != "" {
+			manufacturer = &ir.CodedElement{ID: v.MVX, Text: v.Manufacturer, CodingSystem: MVX}
+		}
+		vaccines[name] = &Vaccine{
+			Code:         ir.CodedElement{ID: v.CVX, Text: name, CodingSystem: CVX},
+			Manufacturer: manufacturer,
+			LotNumbers:   v.LotNumbers,
+			Dose:         v.Dose,
+			DoseUnits:    v.DoseUnits,
+			Route:        v.Route,
+			Site:         v.Site,
+		}
+		log.Infof(" - %s", name)
+	}
+	return New(vaccines), nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaccine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcrshr/simhospital/pkg/ir"
+	"github.com/bitcrshr/simhospital/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	v, err := Load(ctx, test.VaccinesConfigTest)
+	if err != nil {
+		t.Fatalf("Load(%s) failed with %v", test.VaccinesConfigTest, err)
+	}
+
+	tests := []struct {
+		name string
+		want *Vaccine
+	}{{
+		name: "Influenza",
+		want: &Vaccine{
+			Code:         ir.CodedElement{ID: "141", Text: "Influenza", CodingSystem: CVX},
+			Manufacturer: &ir.CodedElement{ID: "SKB", Text: "GlaxoSmithKline", CodingSystem: MVX},
+			LotNumbers:   []string{"LOT-1"},
+			Dose:         "0.5",
+			DoseUnits:    "mL",
+			Route:        "IM",
+			Site:         "LD",
+		},
+	}, {
+		name: "Hepatitis B",
+		want: &Vaccine{
+			Code: ir.CodedElement{ID: "43", Text: "Hepatitis B", CodingSystem: CVX},
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := v.Get(tc.name)
+			if !ok {
+				t.Fatalf("Get(%q) got ok=false, want true", tc.name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Get(%q) got diff (-want, +got):\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	test.CheckLoadFails(t, func(fName string) error {
+		_, err := Load(context.Background(), fName)
+		return err
+	}, []test.InvalidConfig{{
+		Name: "Missing CVX",
+		Content: `
+Influenza:
+  dose: '0.5'`,
+	}, {
+		Name: "Unknown field",
+		Content: `
+Influenza:
+  cvx: '141'
+  brand: 'Fluarix'`,
+	}})
+}
+
+func TestRandomLotNumber(t *testing.T) {
+	if got := (&Vaccine{}).RandomLotNumber(); got != "" {
+		t.Errorf("RandomLotNumber() got %q, want empty", got)
+	}
+	v := &Vaccine{LotNumbers: []string{"LOT-1"}}
+	if got, want := v.RandomLotNumber(), "LOT-1"; got != want {
+		t.Errorf("RandomLotNumber() got %q, want %q", got, want)
+	}
+}